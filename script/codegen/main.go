@@ -217,6 +217,14 @@ var funcData []FuncTemplateData = []FuncTemplateData{
 		FileName:    "organization_users",
 		ExtraParam:  true,
 	},
+	{
+		FuncName:    "GroupUsers",
+		ObjectName:  "User",
+		ApiEndpoint: "/groups/%d/users.json",
+		JsonName:    "users",
+		FileName:    "group_users",
+		ExtraParam:  true,
+	},
 	{
 		FuncName:    "Views",
 		ObjectName:  "View",
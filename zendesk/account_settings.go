@@ -0,0 +1,185 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// AccountSettings is the settings of the account.
+//
+// Settings are grouped in sections. The most used sections are typed, their fields are pointers
+// so that only the fields which are set are sent by UpdateAccountSettings. The other sections
+// are kept in Other as raw JSON, so that settings added by Zendesk are not lost.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/
+type AccountSettings struct {
+	Agents       *AccountAgentsSettings       `json:"agents,omitempty"`
+	Apps         *AccountAppsSettings         `json:"apps,omitempty"`
+	Branding     *AccountBrandingSettings     `json:"branding,omitempty"`
+	Groups       *AccountGroupsSettings       `json:"groups,omitempty"`
+	Localization *AccountLocalizationSettings `json:"localization,omitempty"`
+	Tickets      *AccountTicketsSettings      `json:"tickets,omitempty"`
+	User         *AccountUserSettings         `json:"user,omitempty"`
+
+	// Other contains the sections without a typed field, keyed by section name
+	Other map[string]json.RawMessage `json:"-"`
+}
+
+// AccountAgentsSettings is the agents section of AccountSettings
+type AccountAgentsSettings struct {
+	AgentHome            *bool `json:"agent_home,omitempty"`
+	AgentWorkspace       *bool `json:"agent_workspace,omitempty"`
+	FocusMode            *bool `json:"focus_mode,omitempty"`
+	UnifiedAgentStatuses *bool `json:"unified_agent_statuses,omitempty"`
+}
+
+// AccountAppsSettings is the apps section of AccountSettings
+type AccountAppsSettings struct {
+	CreatePrivate *bool `json:"create_private,omitempty"`
+	CreatePublic  *bool `json:"create_public,omitempty"`
+	UseApps       *bool `json:"use,omitempty"`
+}
+
+// AccountBrandingSettings is the branding section of AccountSettings
+type AccountBrandingSettings struct {
+	FaviconURL          *string `json:"favicon_url,omitempty"`
+	HeaderColor         *string `json:"header_color,omitempty"`
+	HeaderLogoURL       *string `json:"header_logo_url,omitempty"`
+	PageBackgroundColor *string `json:"page_background_color,omitempty"`
+	TabBackgroundColor  *string `json:"tab_background_color,omitempty"`
+	TextColor           *string `json:"text_color,omitempty"`
+}
+
+// AccountGroupsSettings is the groups section of AccountSettings
+type AccountGroupsSettings struct {
+	CheckGroupNameUniqueness *bool `json:"check_group_name_uniqueness,omitempty"`
+}
+
+// AccountLocalizationSettings is the localization section of AccountSettings
+type AccountLocalizationSettings struct {
+	LocaleIDs []int64 `json:"locale_ids,omitempty"`
+}
+
+// AccountTicketsSettings is the tickets section of AccountSettings
+type AccountTicketsSettings struct {
+	AgentCollision             *bool  `json:"agent_collision,omitempty"`
+	AgentTicketDeletion        *bool  `json:"agent_ticket_deletion,omitempty"`
+	AllowGroupReset            *bool  `json:"allow_group_reset,omitempty"`
+	AssignTicketsUponSolve     *bool  `json:"assign_tickets_upon_solve,omitempty"`
+	Collaboration              *bool  `json:"collaboration,omitempty"`
+	CommentsPublicByDefault    *bool  `json:"comments_public_by_default,omitempty"`
+	EmojiAutocompletion        *bool  `json:"emoji_autocompletion,omitempty"`
+	ListEmptyViews             *bool  `json:"list_empty_views,omitempty"`
+	ListNewestCommentsFirst    *bool  `json:"list_newest_comments_first,omitempty"`
+	MarkdownTicketComments     *bool  `json:"markdown_ticket_comments,omitempty"`
+	MaximumPersonalViewsToList *int64 `json:"maximum_personal_views_to_list,omitempty"`
+	PrivateAttachments         *bool  `json:"private_attachments,omitempty"`
+	RichTextComments           *bool  `json:"rich_text_comments,omitempty"`
+	StatusHold                 *bool  `json:"status_hold,omitempty"`
+	Tagging                    *bool  `json:"tagging,omitempty"`
+}
+
+// AccountUserSettings is the user section of AccountSettings
+type AccountUserSettings struct {
+	AgentCreatedWelcomeEmails    *bool `json:"agent_created_welcome_emails,omitempty"`
+	EndUserPhoneNumberValidation *bool `json:"end_user_phone_number_validation,omitempty"`
+	HaveGravatarsEnabled         *bool `json:"have_gravatars_enabled,omitempty"`
+	LanguageSelection            *bool `json:"language_selection,omitempty"`
+	MultipleOrganizations        *bool `json:"multiple_organizations,omitempty"`
+	Tagging                      *bool `json:"tagging,omitempty"`
+	TimeZoneSelection            *bool `json:"time_zone_selection,omitempty"`
+}
+
+// accountSettingsSections are the sections with a typed field in AccountSettings
+var accountSettingsSections = []string{"agents", "apps", "branding", "groups", "localization", "tickets", "user"}
+
+// UnmarshalJSON keeps the sections without a typed field in Other
+func (s *AccountSettings) UnmarshalJSON(data []byte) error {
+	type alias AccountSettings
+	var tmp alias
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return err
+	}
+	for _, key := range accountSettingsSections {
+		delete(sections, key)
+	}
+	if len(sections) > 0 {
+		tmp.Other = sections
+	}
+
+	*s = AccountSettings(tmp)
+	return nil
+}
+
+// MarshalJSON sends the sections in Other along with the typed sections
+func (s AccountSettings) MarshalJSON() ([]byte, error) {
+	type alias AccountSettings
+	data, err := json.Marshal(alias(s))
+	if err != nil || len(s.Other) == 0 {
+		return data, err
+	}
+
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return nil, err
+	}
+	for key, value := range s.Other {
+		if _, ok := sections[key]; !ok {
+			sections[key] = value
+		}
+	}
+	return json.Marshal(sections)
+}
+
+// AccountSettingsAPI an interface containing all account settings related methods
+type AccountSettingsAPI interface {
+	GetAccountSettings(ctx context.Context) (AccountSettings, error)
+	UpdateAccountSettings(ctx context.Context, settings AccountSettings) (AccountSettings, error)
+}
+
+// GetAccountSettings gets the settings of the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#show-settings
+func (z *Client) GetAccountSettings(ctx context.Context) (AccountSettings, error) {
+	var result struct {
+		Settings AccountSettings `json:"settings"`
+	}
+
+	body, err := z.get(ctx, "/account/settings.json")
+	if err != nil {
+		return AccountSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+	return result.Settings, nil
+}
+
+// UpdateAccountSettings updates the settings which are set in settings and returns all settings.
+// Settings which are nil are left unchanged.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/account_settings/#update-account-settings
+func (z *Client) UpdateAccountSettings(ctx context.Context, settings AccountSettings) (AccountSettings, error) {
+	var data, result struct {
+		Settings AccountSettings `json:"settings"`
+	}
+	data.Settings = settings
+
+	body, err := z.put(ctx, "/account/settings.json", data)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AccountSettings{}, err
+	}
+	return result.Settings, nil
+}
@@ -0,0 +1,67 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAccountSettings(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account_settings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	settings, err := client.GetAccountSettings(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get account settings: %s", err)
+	}
+
+	if settings.Tickets == nil || settings.Tickets.CommentsPublicByDefault == nil || !*settings.Tickets.CommentsPublicByDefault {
+		t.Fatalf("unexpected tickets settings: %+v", settings.Tickets)
+	}
+	if *settings.Tickets.MaximumPersonalViewsToList != 8 {
+		t.Fatalf("unexpected maximum personal views: %d", *settings.Tickets.MaximumPersonalViewsToList)
+	}
+	if settings.Branding.FaviconURL != nil || *settings.Branding.HeaderColor != "1A00C3" {
+		t.Fatalf("unexpected branding settings: %+v", settings.Branding)
+	}
+	if len(settings.Other) != 2 || settings.Other["voice"] == nil || settings.Other["api"] == nil {
+		t.Fatalf("unexpected other settings: %v", settings.Other)
+	}
+}
+
+func TestUpdateAccountSettings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/account/settings.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			Settings map[string]map[string]interface{} `json:"settings"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.Settings) != 2 || len(data.Settings["tickets"]) != 1 {
+			t.Fatalf("unexpected settings: %v", data.Settings)
+		}
+		if data.Settings["tickets"]["comments_public_by_default"] != false {
+			t.Fatalf("unexpected tickets settings: %v", data.Settings["tickets"])
+		}
+		if data.Settings["voice"]["enabled"] != false {
+			t.Fatalf("unexpected voice settings: %v", data.Settings["voice"])
+		}
+		w.Write(readFixture("GET/account_settings.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	commentsPublicByDefault := false
+	_, err := client.UpdateAccountSettings(ctx, AccountSettings{
+		Tickets: &AccountTicketsSettings{CommentsPublicByDefault: &commentsPublicByDefault},
+		Other:   map[string]json.RawMessage{"voice": json.RawMessage(`{"enabled":false}`)},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update account settings: %s", err)
+	}
+}
@@ -0,0 +1,223 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Agent availability channel names
+const (
+	AgentChannelSupport   = "support"
+	AgentChannelTalk      = "talk"
+	AgentChannelMessaging = "messaging"
+)
+
+// Agent availability channel statuses
+const (
+	AgentChannelOnline  = "online"
+	AgentChannelAway    = "away"
+	AgentChannelOffline = "offline"
+)
+
+// AgentStatus is a unified status an agent can set across channels,
+// such as the system statuses "Online", "Away" and "Offline" or a custom status
+//
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/introduction/
+type AgentStatus struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// AgentChannelAvailability is the status of an agent in a channel
+type AgentChannelAvailability struct {
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AgentAvailability is the unified status of an agent and its status in each channel
+//
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/introduction/
+type AgentAvailability struct {
+	AgentID     int64                      `json:"agent_id"`
+	AgentStatus AgentStatus                `json:"agent_status"`
+	Version     int64                      `json:"version"`
+	UpdatedAt   time.Time                  `json:"updated_at"`
+	Channels    []AgentChannelAvailability `json:"channels"`
+}
+
+// AgentAvailabilityListOptions is options for GetAgentAvailabilities.
+// ChannelStatus filters by the status of a channel and has the form "channel:status", such as "support:online".
+type AgentAvailabilityListOptions struct {
+	CursorPagination
+	AgentStatusID int64  `url:"filter[agent_status_id],omitempty"`
+	ChannelStatus string `url:"filter[channel_status],omitempty"`
+}
+
+// agentAvailabilityResource is an agent availability in the JSON:API format of the endpoints
+type agentAvailabilityResource struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		AgentStatus AgentStatus `json:"agent_status"`
+		Version     int64       `json:"version"`
+		UpdatedAt   time.Time   `json:"updated_at"`
+	} `json:"attributes"`
+	Relationships struct {
+		Channels struct {
+			Data []struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		} `json:"channels"`
+	} `json:"relationships"`
+}
+
+// agentChannelResource is an agent channel included in the JSON:API payload
+type agentChannelResource struct {
+	ID         string                   `json:"id"`
+	Type       string                   `json:"type"`
+	Attributes AgentChannelAvailability `json:"attributes"`
+}
+
+// AgentAvailabilityAPI an interface containing all agent availability related methods
+type AgentAvailabilityAPI interface {
+	GetAgentStatuses(ctx context.Context) ([]AgentStatus, error)
+	GetAgentAvailabilities(
+		ctx context.Context, opts *AgentAvailabilityListOptions) ([]AgentAvailability, CursorPaginationMeta, error)
+	GetAgentAvailability(ctx context.Context, agentID int64) (AgentAvailability, error)
+	UpdateAgentStatus(ctx context.Context, agentID int64, statusID int64) error
+}
+
+// GetAgentStatuses lists the system and custom statuses agents can set
+//
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-statuses/#list-agent-statuses
+func (z *Client) GetAgentStatuses(ctx context.Context) ([]AgentStatus, error) {
+	var result struct {
+		Data []struct {
+			ID         string      `json:"id"`
+			Attributes AgentStatus `json:"attributes"`
+		} `json:"data"`
+	}
+
+	body, err := z.get(ctx, "/agent_availabilities/agent_statuses")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]AgentStatus, 0, len(result.Data))
+	for _, data := range result.Data {
+		status := data.Attributes
+		if status.ID == 0 {
+			status.ID, _ = strconv.ParseInt(data.ID, 10, 64)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// GetAgentAvailabilities searches the availabilities of the agents of the account
+//
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-availabilities/#list-agent-availabilities
+func (z *Client) GetAgentAvailabilities(
+	ctx context.Context, opts *AgentAvailabilityListOptions,
+) ([]AgentAvailability, CursorPaginationMeta, error) {
+	var result struct {
+		Data     []agentAvailabilityResource `json:"data"`
+		Included []agentChannelResource      `json:"included"`
+		Meta     CursorPaginationMeta        `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &AgentAvailabilityListOptions{}
+	}
+
+	u, err := addOptions("/agent_availabilities", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	availabilities := make([]AgentAvailability, 0, len(result.Data))
+	for _, data := range result.Data {
+		availabilities = append(availabilities, data.toAgentAvailability(result.Included))
+	}
+	return availabilities, result.Meta, nil
+}
+
+// GetAgentAvailability gets the availability of an agent
+//
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-availabilities/#show-agent-availability
+func (z *Client) GetAgentAvailability(ctx context.Context, agentID int64) (AgentAvailability, error) {
+	var result struct {
+		Data     agentAvailabilityResource `json:"data"`
+		Included []agentChannelResource    `json:"included"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/agent_availabilities/%d", agentID))
+	if err != nil {
+		return AgentAvailability{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AgentAvailability{}, err
+	}
+	return result.Data.toAgentAvailability(result.Included), nil
+}
+
+// UpdateAgentStatus sets the unified status of an agent, which sets its status in every channel
+//
+// ref: https://developer.zendesk.com/api-reference/agent-availability/agent-availability-api/agent-statuses/#update-agent-status
+func (z *Client) UpdateAgentStatus(ctx context.Context, agentID int64, statusID int64) error {
+	var data struct {
+		ID int64 `json:"id"`
+	}
+	data.ID = statusID
+
+	_, err := z.put(ctx, fmt.Sprintf("/agent_availabilities/agent_statuses/agents/%d", agentID), data)
+	return err
+}
+
+// toAgentAvailability resolves the channels of the availability from the included resources
+func (r agentAvailabilityResource) toAgentAvailability(included []agentChannelResource) AgentAvailability {
+	availability := AgentAvailability{
+		AgentStatus: r.Attributes.AgentStatus,
+		Version:     r.Attributes.Version,
+		UpdatedAt:   r.Attributes.UpdatedAt,
+	}
+	availability.AgentID, _ = strconv.ParseInt(r.ID, 10, 64)
+
+	for _, ref := range r.Relationships.Channels.Data {
+		for _, channel := range included {
+			if channel.ID != ref.ID {
+				continue
+			}
+			c := channel.Attributes
+			if c.Name == "" {
+				// channel ids have the form "{agent_id}-{channel}"
+				c.Name = ref.ID[strings.LastIndex(ref.ID, "-")+1:]
+			}
+			availability.Channels = append(availability.Channels, c)
+		}
+	}
+	return availability
+}
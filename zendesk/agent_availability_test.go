@@ -0,0 +1,100 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetAgentStatuses(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "agent_statuses.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetAgentStatuses(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get agent statuses: %s", err)
+	}
+
+	if len(statuses) != 3 {
+		t.Fatalf("expected length of agent statuses is 3, but got %d", len(statuses))
+	}
+	if statuses[2].ID != 100 || statuses[2].Name != "Lunch" {
+		t.Fatalf("unexpected agent status: %+v", statuses[2])
+	}
+}
+
+func TestGetAgentAvailabilities(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/agent_availabilities" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if status := r.URL.Query().Get("filter[channel_status]"); status != "support:online" {
+			t.Fatalf("unexpected channel status filter: %s", status)
+		}
+		w.Write(readFixture("GET/agent_availabilities.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availabilities, _, err := client.GetAgentAvailabilities(ctx, &AgentAvailabilityListOptions{
+		ChannelStatus: AgentChannelSupport + ":" + AgentChannelOnline,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get agent availabilities: %s", err)
+	}
+
+	if len(availabilities) != 2 {
+		t.Fatalf("expected length of agent availabilities is 2, but got %d", len(availabilities))
+	}
+	if availabilities[0].AgentID != 1234 || len(availabilities[0].Channels) != 2 {
+		t.Fatalf("unexpected agent availability: %+v", availabilities[0])
+	}
+	if availabilities[1].Channels[0].Status != AgentChannelAway {
+		t.Fatalf("unexpected channel status: %s", availabilities[1].Channels[0].Status)
+	}
+}
+
+func TestGetAgentAvailability(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "agent_availability.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availability, err := client.GetAgentAvailability(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to get agent availability: %s", err)
+	}
+
+	if availability.AgentStatus.Name != "Online" {
+		t.Fatalf("unexpected agent status: %s", availability.AgentStatus.Name)
+	}
+	if len(availability.Channels) != 2 || availability.Channels[1].Name != AgentChannelTalk {
+		t.Fatalf("unexpected channels: %+v", availability.Channels)
+	}
+}
+
+func TestUpdateAgentStatus(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/agent_availabilities/agent_statuses/agents/1234" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			ID int64 `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if data.ID != 100 {
+			t.Fatalf("unexpected status id: %d", data.ID)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.UpdateAgentStatus(ctx, 1234, 100)
+	if err != nil {
+		t.Fatalf("Failed to update agent status: %s", err)
+	}
+}
@@ -0,0 +1,51 @@
+package zendesk
+
+import "context"
+
+// Reasons for rejecting an article recommended by Answer Bot
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/answer-bot/answer_bot/#mark-article-as-irrelevant
+const (
+	AnswerBotRejectionUnknown         = 0
+	AnswerBotRejectionNotRelated      = 1
+	AnswerBotRejectionRelatedNoAnswer = 2
+)
+
+// AnswerBotResolution reports that an article recommended by Answer Bot resolved the enquiry.
+// InteractionAccessToken is returned along with the recommended articles.
+type AnswerBotResolution struct {
+	ArticleID              int64  `json:"article_id"`
+	InteractionAccessToken string `json:"interaction_access_token"`
+}
+
+// AnswerBotRejection reports that an article recommended by Answer Bot was irrelevant
+type AnswerBotRejection struct {
+	ArticleID              int64  `json:"article_id"`
+	InteractionAccessToken string `json:"interaction_access_token"`
+
+	// ReasonID can take AnswerBotRejectionUnknown, AnswerBotRejectionNotRelated
+	// or AnswerBotRejectionRelatedNoAnswer
+	ReasonID int `json:"reason_id"`
+}
+
+// AnswerBotAPI an interface containing all answer bot related methods
+type AnswerBotAPI interface {
+	ResolveAnswerBotEnquiry(ctx context.Context, resolution AnswerBotResolution) error
+	RejectAnswerBotArticle(ctx context.Context, rejection AnswerBotRejection) error
+}
+
+// ResolveAnswerBotEnquiry marks the enquiry as resolved by the article
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/answer-bot/answer_bot/#resolve-enquiry
+func (z *Client) ResolveAnswerBotEnquiry(ctx context.Context, resolution AnswerBotResolution) error {
+	_, err := z.post(ctx, "/answer_bot/resolution.json", resolution)
+	return err
+}
+
+// RejectAnswerBotArticle marks the article as irrelevant to the enquiry
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/answer-bot/answer_bot/#mark-article-as-irrelevant
+func (z *Client) RejectAnswerBotArticle(ctx context.Context, rejection AnswerBotRejection) error {
+	_, err := z.post(ctx, "/answer_bot/rejection.json", rejection)
+	return err
+}
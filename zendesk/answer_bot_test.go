@@ -0,0 +1,55 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveAnswerBotEnquiry(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/answer_bot/resolution.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"article_id":360001,"interaction_access_token":"token"}` {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ResolveAnswerBotEnquiry(ctx, AnswerBotResolution{
+		ArticleID:              360001,
+		InteractionAccessToken: "token",
+	})
+	if err != nil {
+		t.Fatalf("Failed to resolve answer bot enquiry: %s", err)
+	}
+}
+
+func TestRejectAnswerBotArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/answer_bot/rejection.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"article_id":360001,"interaction_access_token":"token","reason_id":1}` {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.RejectAnswerBotArticle(ctx, AnswerBotRejection{
+		ArticleID:              360001,
+		InteractionAccessToken: "token",
+		ReasonID:               AnswerBotRejectionNotRelated,
+	})
+	if err != nil {
+		t.Fatalf("Failed to reject answer bot article: %s", err)
+	}
+}
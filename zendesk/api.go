@@ -5,35 +5,86 @@ package zendesk
 
 // API an interface containing all of the zendesk client methods
 type API interface {
+	AccountSettingsAPI
+	AgentAvailabilityAPI
+	AnswerBotAPI
 	AppAPI
+	ArticleAPI
+	ArticleAttachmentAPI
+	ArticleCommentAPI
 	AttachmentAPI
+	AuditLogAPI
 	AutomationAPI
 	BaseAPI
 	BrandAPI
+	CategoryAPI
+	ChatAPI
+	ChatAgentAPI
+	ChatBanAPI
+	ChatDepartmentAPI
+	ChatGoalAPI
+	ChatIncrementalExportAPI
+	ChatRealTimeAPI
+	ChatRoutingAPI
+	ChatShortcutAPI
+	ChatTriggerAPI
+	ChatVisitorAPI
 	CustomRoleAPI
+	DeletedUserAPI
+	DeletionScheduleAPI
 	DynamicContentAPI
+	EssentialsCardAPI
 	GroupAPI
 	GroupMembershipAPI
+	HelpCenterSearchAPI
+	IncrementalExportAPI
+	JobStatusAPI
 	LocaleAPI
+	LookupRelationshipAPI
 	MacroAPI
 	OrganizationAPI
 	OrganizationFieldAPI
 	OrganizationMembershipAPI
+	OrganizationMergeAPI
+	PermissionGroupAPI
+	PostAPI
+	PostCommentAPI
 	SearchAPI
+	SectionAPI
+	SessionAPI
 	SLAPolicyAPI
+	SubscriptionAPI
 	TagAPI
+	TalkAvailabilityAPI
+	TalkGreetingAPI
+	TalkIVRAPI
+	TalkLineAPI
+	TalkRecordingAPI
+	TalkStatsAPI
 	TargetAPI
+	ThemeAPI
 	TicketAuditAPI
+	TicketEventAPI
 	TicketAPI
 	TicketCommentAPI
 	TicketFieldAPI
 	TicketFormAPI
+	TopicAPI
+	TranslationAPI
 	TriggerAPI
+	TriggerCategoryAPI
+	TwitterAPI
 	UserAPI
+	UserIdentityAPI
+	UserImageAPI
 	UserFieldAPI
 	ViewAPI
+	VoteAPI
 	WebhookAPI
+	WebhookInvocationAPI
 	CustomObjectAPI
+	CustomObjectFieldAPI
+	CustomObjectRecordsJobAPI
 }
 
 var _ API = (*Client)(nil)
@@ -0,0 +1,238 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Article is a Help Center article.
+// Title and Body are the content in Locale. The content in other locales is managed with translations.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#json-format
+type Article struct {
+	ID                int64  `json:"id,omitempty"`
+	URL               string `json:"url,omitempty"`
+	HTMLURL           string `json:"html_url,omitempty"`
+	AuthorID          int64  `json:"author_id,omitempty"`
+	SectionID         int64  `json:"section_id,omitempty"`
+	PermissionGroupID int64  `json:"permission_group_id,omitempty"`
+	// UserSegmentID is the user segment who can view the article. Nil means everyone.
+	UserSegmentID    *int64     `json:"user_segment_id,omitempty"`
+	Title            string     `json:"title,omitempty"`
+	Body             string     `json:"body,omitempty"`
+	Locale           string     `json:"locale,omitempty"`
+	SourceLocale     string     `json:"source_locale,omitempty"`
+	Draft            *bool      `json:"draft,omitempty"`
+	Promoted         *bool      `json:"promoted,omitempty"`
+	CommentsDisabled *bool      `json:"comments_disabled,omitempty"`
+	Outdated         bool       `json:"outdated,omitempty"`
+	OutdatedLocales  []string   `json:"outdated_locales,omitempty"`
+	Position         int64      `json:"position,omitempty"`
+	VoteSum          int64      `json:"vote_sum,omitempty"`
+	VoteCount        int64      `json:"vote_count,omitempty"`
+	LabelNames       []string   `json:"label_names,omitempty"`
+	ContentTagIDs    []string   `json:"content_tag_ids,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+	EditedAt         *time.Time `json:"edited_at,omitempty"`
+}
+
+// ArticleListOptions is options for ListArticles.
+// The articles are scoped to SectionID, CategoryID or UserID when one of them is set,
+// and to Locale when it is set.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#list-articles
+type ArticleListOptions struct {
+	CursorPagination
+	Locale     string `url:"-"`
+	SectionID  int64  `url:"-"`
+	CategoryID int64  `url:"-"`
+	UserID     int64  `url:"-"`
+
+	// LabelNames is a comma separated list of labels, the articles must have all of them
+	LabelNames string `url:"label_names,omitempty"`
+
+	// SortBy can take "position", "title", "created_at", "updated_at" or "edited_at"
+	SortBy string `url:"sort_by,omitempty"`
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// ArticleAPI an interface containing all Help Center article related methods
+type ArticleAPI interface {
+	ListArticles(ctx context.Context, opts *ArticleListOptions) ([]Article, CursorPaginationMeta, error)
+	GetArticle(ctx context.Context, articleID int64) (Article, error)
+	CreateArticle(ctx context.Context, sectionID int64, article Article) (Article, error)
+	UpdateArticle(ctx context.Context, articleID int64, article Article) (Article, error)
+	ArchiveArticle(ctx context.Context, articleID int64) error
+	ListArticleTranslations(ctx context.Context, articleID int64) ([]Translation, error)
+	GetArticleTranslation(ctx context.Context, articleID int64, locale string) (Translation, error)
+	CreateArticleTranslation(ctx context.Context, articleID int64, translation Translation) (Translation, error)
+	UpdateArticleTranslation(
+		ctx context.Context, articleID int64, locale string, translation Translation) (Translation, error)
+	ImportArticles(
+		ctx context.Context, items <-chan ArticleImportItem, opts *ArticleImportOptions) (*ArticleImportProgress, error)
+}
+
+// ListArticles lists the articles of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#list-articles
+func (z *Client) ListArticles(
+	ctx context.Context, opts *ArticleListOptions,
+) ([]Article, CursorPaginationMeta, error) {
+	var result struct {
+		Articles []Article            `json:"articles"`
+		Meta     CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ArticleListOptions{}
+	}
+
+	path := "/help_center"
+	if tmp.Locale != "" {
+		path += "/" + tmp.Locale
+	}
+	switch {
+	case tmp.SectionID != 0:
+		path += fmt.Sprintf("/sections/%d", tmp.SectionID)
+	case tmp.CategoryID != 0:
+		path += fmt.Sprintf("/categories/%d", tmp.CategoryID)
+	case tmp.UserID != 0:
+		path += fmt.Sprintf("/users/%d", tmp.UserID)
+	}
+
+	u, err := addOptions(path+"/articles.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Articles, result.Meta, nil
+}
+
+// GetArticle gets an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#show-article
+func (z *Client) GetArticle(ctx context.Context, articleID int64) (Article, error) {
+	var result struct {
+		Article Article `json:"article"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d.json", articleID))
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// CreateArticle creates an article in a section.
+// The article is visible to everyone when its UserSegmentID is nil.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#create-article
+func (z *Client) CreateArticle(ctx context.Context, sectionID int64, article Article) (Article, error) {
+	var data struct {
+		Article struct {
+			Article
+			// user_segment_id is required, null means everyone
+			UserSegmentID *int64 `json:"user_segment_id"`
+		} `json:"article"`
+	}
+	data.Article.Article = article
+	data.Article.UserSegmentID = article.UserSegmentID
+
+	var result struct {
+		Article Article `json:"article"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/sections/%d/articles.json", sectionID), data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// UpdateArticle updates the metadata of an article, such as its section or its labels.
+// The title and the body are updated with UpdateArticleTranslation.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#update-article
+func (z *Client) UpdateArticle(ctx context.Context, articleID int64, article Article) (Article, error) {
+	var data, result struct {
+		Article Article `json:"article"`
+	}
+	data.Article = article
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/articles/%d.json", articleID), data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// ArchiveArticle archives an article. Archived articles can be restored in Guide.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#archive-article
+func (z *Client) ArchiveArticle(ctx context.Context, articleID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/articles/%d.json", articleID), nil)
+}
+
+// ListArticleTranslations lists the translations of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-translations
+func (z *Client) ListArticleTranslations(ctx context.Context, articleID int64) ([]Translation, error) {
+	return z.getTranslations(ctx, fmt.Sprintf("/help_center/articles/%d/translations.json", articleID))
+}
+
+// GetArticleTranslation gets the translation of an article in a locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#show-translation
+func (z *Client) GetArticleTranslation(ctx context.Context, articleID int64, locale string) (Translation, error) {
+	return z.getTranslation(ctx, fmt.Sprintf("/help_center/articles/%d/translations/%s.json", articleID, locale))
+}
+
+// CreateArticleTranslation creates the translation of an article in the locale of the translation
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#create-translation
+func (z *Client) CreateArticleTranslation(
+	ctx context.Context, articleID int64, translation Translation,
+) (Translation, error) {
+	return z.saveTranslation(ctx, http.MethodPost,
+		fmt.Sprintf("/help_center/articles/%d/translations.json", articleID), translation)
+}
+
+// UpdateArticleTranslation updates the translation of an article in a locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#update-translation
+func (z *Client) UpdateArticleTranslation(
+	ctx context.Context, articleID int64, locale string, translation Translation,
+) (Translation, error) {
+	return z.saveTranslation(ctx, http.MethodPut,
+		fmt.Sprintf("/help_center/articles/%d/translations/%s.json", articleID, locale), translation)
+}
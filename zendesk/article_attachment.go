@@ -0,0 +1,163 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ArticleAttachment is a file attached to a Help Center article.
+// Inline attachments are images displayed in the body, block attachments are listed below the body.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#json-format
+type ArticleAttachment struct {
+	ID              int64     `json:"id"`
+	URL             string    `json:"url,omitempty"`
+	ArticleID       int64     `json:"article_id,omitempty"`
+	ContentType     string    `json:"content_type"`
+	ContentURL      string    `json:"content_url"`
+	DisplayFileName string    `json:"display_file_name,omitempty"`
+	FileName        string    `json:"file_name"`
+	RelativePath    string    `json:"relative_path,omitempty"`
+	Size            int64     `json:"size"`
+	Inline          bool      `json:"inline"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at,omitempty"`
+}
+
+// ArticleAttachmentAPI an interface containing all Help Center article attachment related methods
+type ArticleAttachmentAPI interface {
+	ListArticleAttachments(ctx context.Context, articleID int64) ([]ArticleAttachment, error)
+	GetArticleAttachment(ctx context.Context, attachmentID int64) (ArticleAttachment, error)
+	UploadArticleAttachment(
+		ctx context.Context, articleID int64, filename string, file io.Reader, inline bool) (ArticleAttachment, error)
+	UploadUnassociatedArticleAttachment(
+		ctx context.Context, filename string, file io.Reader, inline bool) (ArticleAttachment, error)
+	AssociateArticleAttachments(ctx context.Context, articleID int64, attachmentIDs []int64) ([]ArticleAttachment, error)
+	DeleteArticleAttachment(ctx context.Context, attachmentID int64) error
+}
+
+// ListArticleAttachments lists the inline and block attachments of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#list-article-attachments
+func (z *Client) ListArticleAttachments(ctx context.Context, articleID int64) ([]ArticleAttachment, error) {
+	var result struct {
+		ArticleAttachments []ArticleAttachment `json:"article_attachments"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d/attachments.json", articleID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.ArticleAttachments, nil
+}
+
+// GetArticleAttachment gets an article attachment
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#show-article-attachment
+func (z *Client) GetArticleAttachment(ctx context.Context, attachmentID int64) (ArticleAttachment, error) {
+	var result struct {
+		ArticleAttachment ArticleAttachment `json:"article_attachment"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/attachments/%d.json", attachmentID))
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+	return result.ArticleAttachment, nil
+}
+
+// UploadArticleAttachment uploads a file and attaches it to an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#create-article-attachment
+func (z *Client) UploadArticleAttachment(
+	ctx context.Context, articleID int64, filename string, file io.Reader, inline bool,
+) (ArticleAttachment, error) {
+	return z.uploadArticleAttachment(ctx,
+		fmt.Sprintf("/help_center/articles/%d/attachments.json", articleID), filename, file, inline)
+}
+
+// UploadUnassociatedArticleAttachment uploads a file which is not attached to any article yet.
+// The attachment is attached to an article with AssociateArticleAttachments, typically right after
+// the article is created, so the body of the article can refer to inline images from its first version.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#create-unassociated-attachment
+func (z *Client) UploadUnassociatedArticleAttachment(
+	ctx context.Context, filename string, file io.Reader, inline bool,
+) (ArticleAttachment, error) {
+	return z.uploadArticleAttachment(ctx, "/help_center/articles/attachments.json", filename, file, inline)
+}
+
+func (z *Client) uploadArticleAttachment(
+	ctx context.Context, path string, filename string, file io.Reader, inline bool,
+) (ArticleAttachment, error) {
+	var result struct {
+		ArticleAttachment ArticleAttachment `json:"article_attachment"`
+	}
+
+	fields := map[string]string{"inline": strconv.FormatBool(inline)}
+	body, err := z.uploadFileWithFields(ctx, http.MethodPost, path, fields, "file", filename, file)
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleAttachment{}, err
+	}
+	return result.ArticleAttachment, nil
+}
+
+// AssociateArticleAttachments attaches unassociated attachments to an article.
+// Up to 20 attachments can be associated at once.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#associate-attachments-in-bulk-to-article
+func (z *Client) AssociateArticleAttachments(
+	ctx context.Context, articleID int64, attachmentIDs []int64,
+) ([]ArticleAttachment, error) {
+	var data struct {
+		AttachmentIDs []int64 `json:"attachment_ids"`
+	}
+	data.AttachmentIDs = attachmentIDs
+
+	var result struct {
+		ArticleAttachments []ArticleAttachment `json:"article_attachments"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/articles/%d/bulk_attachments.json", articleID), data)
+	if err != nil {
+		return nil, err
+	}
+
+	// the endpoint may answer with an empty body
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.ArticleAttachments, nil
+}
+
+// DeleteArticleAttachment deletes an article attachment
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_attachments/#delete-article-attachment
+func (z *Client) DeleteArticleAttachment(ctx context.Context, attachmentID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/articles/attachments/%d.json", attachmentID), nil)
+}
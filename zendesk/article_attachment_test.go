@@ -0,0 +1,104 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListArticleAttachments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article_attachments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachments, err := client.ListArticleAttachments(ctx, 37486578)
+	if err != nil {
+		t.Fatalf("Failed to list article attachments: %s", err)
+	}
+
+	if len(attachments) != 2 || !attachments[0].Inline || attachments[1].Inline {
+		t.Fatalf("unexpected article attachments: %+v", attachments)
+	}
+}
+
+func TestUploadUnassociatedArticleAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/attachments.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Failed to parse multipart form: %s", err)
+		}
+		if r.FormValue("inline") != "true" {
+			t.Fatalf("expected inline to be true, but got %s", r.FormValue("inline"))
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Failed to get file: %s", err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "gear.png" || string(content) != "png data" {
+			t.Fatalf("unexpected file %s: %s", header.Filename, content)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/article_attachment.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.UploadUnassociatedArticleAttachment(ctx, "gear.png", strings.NewReader("png data"), true)
+	if err != nil {
+		t.Fatalf("Failed to upload article attachment: %s", err)
+	}
+
+	if attachment.ID != 1428 {
+		t.Fatalf("expected id is 1428, but got %d", attachment.ID)
+	}
+}
+
+func TestAssociateArticleAttachments(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/37486578/bulk_attachments.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		var data struct {
+			AttachmentIDs []int64 `json:"attachment_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode body: %s", err)
+		}
+		if len(data.AttachmentIDs) != 2 {
+			t.Fatalf("unexpected attachment ids: %v", data.AttachmentIDs)
+		}
+		w.Write(readFixture("GET/article_attachments.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachments, err := client.AssociateArticleAttachments(ctx, 37486578, []int64{1428, 1429})
+	if err != nil {
+		t.Fatalf("Failed to associate article attachments: %s", err)
+	}
+
+	if len(attachments) != 2 {
+		t.Fatalf("expected length of attachments is 2, but got %d", len(attachments))
+	}
+}
+
+func TestDeleteArticleAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/articles/attachments/1428.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteArticleAttachment(ctx, 1428); err != nil {
+		t.Fatalf("Failed to delete article attachment: %s", err)
+	}
+}
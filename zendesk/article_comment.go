@@ -0,0 +1,183 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ArticleComment is a comment of an end user or an agent on a Help Center article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#json-format
+type ArticleComment struct {
+	ID      int64  `json:"id,omitempty"`
+	URL     string `json:"url,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+	Body    string `json:"body"`
+	// AuthorID can be set by agents to comment on behalf of another user
+	AuthorID           int64     `json:"author_id,omitempty"`
+	SourceID           int64     `json:"source_id,omitempty"`
+	SourceType         string    `json:"source_type,omitempty"`
+	Locale             string    `json:"locale,omitempty"`
+	VoteSum            int64     `json:"vote_sum,omitempty"`
+	VoteCount          int64     `json:"vote_count,omitempty"`
+	NonAuthorEditorID  int64     `json:"non_author_editor_id,omitempty"`
+	NonAuthorUpdatedAt time.Time `json:"non_author_updated_at,omitempty"`
+	CreatedAt          time.Time `json:"created_at,omitempty"`
+	UpdatedAt          time.Time `json:"updated_at,omitempty"`
+}
+
+// ArticleCommentListOptions is options for ListArticleComments and ListUserArticleComments
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#list-comments
+type ArticleCommentListOptions struct {
+	CursorPagination
+
+	// SortBy can take "created_at" or "updated_at"
+	SortBy string `url:"sort_by,omitempty"`
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// ArticleCommentAPI an interface containing all Help Center article comment related methods
+type ArticleCommentAPI interface {
+	ListArticleComments(
+		ctx context.Context, articleID int64, opts *ArticleCommentListOptions) ([]ArticleComment, CursorPaginationMeta, error)
+	ListUserArticleComments(
+		ctx context.Context, userID int64, opts *ArticleCommentListOptions) ([]ArticleComment, CursorPaginationMeta, error)
+	GetArticleComment(ctx context.Context, articleID int64, commentID int64) (ArticleComment, error)
+	CreateArticleComment(
+		ctx context.Context, articleID int64, comment ArticleComment, notifySubscribers bool) (ArticleComment, error)
+	UpdateArticleComment(
+		ctx context.Context, articleID int64, commentID int64, comment ArticleComment) (ArticleComment, error)
+	DeleteArticleComment(ctx context.Context, articleID int64, commentID int64) error
+}
+
+// ListArticleComments lists the comments of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#list-comments
+func (z *Client) ListArticleComments(
+	ctx context.Context, articleID int64, opts *ArticleCommentListOptions,
+) ([]ArticleComment, CursorPaginationMeta, error) {
+	return z.listArticleComments(ctx, fmt.Sprintf("/help_center/articles/%d/comments.json", articleID), opts)
+}
+
+// ListUserArticleComments lists the comments a user made on articles
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#list-comments
+func (z *Client) ListUserArticleComments(
+	ctx context.Context, userID int64, opts *ArticleCommentListOptions,
+) ([]ArticleComment, CursorPaginationMeta, error) {
+	return z.listArticleComments(ctx, fmt.Sprintf("/help_center/users/%d/comments.json", userID), opts)
+}
+
+func (z *Client) listArticleComments(
+	ctx context.Context, path string, opts *ArticleCommentListOptions,
+) ([]ArticleComment, CursorPaginationMeta, error) {
+	var result struct {
+		Comments []ArticleComment     `json:"comments"`
+		Meta     CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ArticleCommentListOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Comments, result.Meta, nil
+}
+
+// GetArticleComment gets a comment of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#show-comment
+func (z *Client) GetArticleComment(ctx context.Context, articleID int64, commentID int64) (ArticleComment, error) {
+	var result struct {
+		Comment ArticleComment `json:"comment"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/articles/%d/comments/%d.json", articleID, commentID))
+	if err != nil {
+		return ArticleComment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleComment{}, err
+	}
+	return result.Comment, nil
+}
+
+// CreateArticleComment adds a comment to an article.
+// The subscribers of the article are notified when notifySubscribers is true.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#create-comment
+func (z *Client) CreateArticleComment(
+	ctx context.Context, articleID int64, comment ArticleComment, notifySubscribers bool,
+) (ArticleComment, error) {
+	var data struct {
+		Comment           ArticleComment `json:"comment"`
+		NotifySubscribers bool           `json:"notify_subscribers"`
+	}
+	data.Comment = comment
+	data.NotifySubscribers = notifySubscribers
+
+	var result struct {
+		Comment ArticleComment `json:"comment"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/articles/%d/comments.json", articleID), data)
+	if err != nil {
+		return ArticleComment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleComment{}, err
+	}
+	return result.Comment, nil
+}
+
+// UpdateArticleComment updates a comment of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#update-comment
+func (z *Client) UpdateArticleComment(
+	ctx context.Context, articleID int64, commentID int64, comment ArticleComment,
+) (ArticleComment, error) {
+	var data, result struct {
+		Comment ArticleComment `json:"comment"`
+	}
+	data.Comment = comment
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/articles/%d/comments/%d.json", articleID, commentID), data)
+	if err != nil {
+		return ArticleComment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ArticleComment{}, err
+	}
+	return result.Comment, nil
+}
+
+// DeleteArticleComment deletes a comment of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/article_comments/#delete-comment
+func (z *Client) DeleteArticleComment(ctx context.Context, articleID int64, commentID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/articles/%d/comments/%d.json", articleID, commentID), nil)
+}
@@ -0,0 +1,110 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListArticleComments(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/37486578/comments.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/article_comments.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comments, _, err := client.ListArticleComments(ctx, 37486578, nil)
+	if err != nil {
+		t.Fatalf("Failed to list article comments: %s", err)
+	}
+
+	if len(comments) != 2 || comments[1].NonAuthorEditorID != 3466 {
+		t.Fatalf("unexpected article comments: %+v", comments)
+	}
+}
+
+func TestListUserArticleComments(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/users/89567/comments.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/article_comments.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, _, err := client.ListUserArticleComments(ctx, 89567, nil); err != nil {
+		t.Fatalf("Failed to list user article comments: %s", err)
+	}
+}
+
+func TestGetArticleComment(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article_comment.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comment, err := client.GetArticleComment(ctx, 37486578, 1635)
+	if err != nil {
+		t.Fatalf("Failed to get article comment: %s", err)
+	}
+
+	if comment.AuthorID != 89567 {
+		t.Fatalf("expected author id is 89567, but got %d", comment.AuthorID)
+	}
+}
+
+func TestCreateArticleComment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Comment           ArticleComment `json:"comment"`
+			NotifySubscribers bool           `json:"notify_subscribers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode body: %s", err)
+		}
+		if data.NotifySubscribers || data.Comment.AuthorID != 89567 {
+			t.Fatalf("unexpected body: %+v", data)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/article_comment.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateArticleComment(ctx, 37486578, ArticleComment{
+		Body:     "Thanks, this solved my issue.",
+		AuthorID: 89567,
+	}, false)
+	if err != nil {
+		t.Fatalf("Failed to create article comment: %s", err)
+	}
+}
+
+func TestUpdateArticleComment(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "article_comment.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateArticleComment(ctx, 37486578, 1635, ArticleComment{Body: "Edited"}); err != nil {
+		t.Fatalf("Failed to update article comment: %s", err)
+	}
+}
+
+func TestDeleteArticleComment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/articles/37486578/comments/1635.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteArticleComment(ctx, 37486578, 1635); err != nil {
+		t.Fatalf("Failed to delete article comment: %s", err)
+	}
+}
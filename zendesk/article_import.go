@@ -0,0 +1,330 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ArticleImportMaxRetries is the default number of times ImportArticles retries a rate limited request
+const ArticleImportMaxRetries = 5
+
+// articleAttachmentsAssociateLimit is the maximum number of attachments associated to an article at once
+const articleAttachmentsAssociateLimit = 20
+
+// ArticleImportRetryAfter is the default wait before retrying a rate limited request
+// when the response has no Retry-After header
+const ArticleImportRetryAfter = 60 * time.Second
+
+// ArticleImportItem is an article to import with its attachments and translations
+type ArticleImportItem struct {
+	// Key identifies the item in the progress report, such as the id of the article in the source system.
+	// It must be unique and stable between runs.
+	Key       string
+	SectionID int64
+	// Article is created in its Locale with its LabelNames
+	Article      Article
+	Attachments  []ArticleImportAttachment
+	Translations []Translation
+}
+
+// ArticleImportAttachment is a file to attach to an imported article
+type ArticleImportAttachment struct {
+	Filename string
+	// Inline attachments are uploaded before the creation of the article.
+	// The body of the article refers to them by their file name, such as <img src="reset.png">,
+	// and these references are replaced by the path of the uploaded attachment.
+	Inline bool
+	// Open opens the content of the file. It is called right before the upload,
+	// so that thousands of files are not kept open, and again when the upload is retried.
+	Open func() (io.ReadCloser, error)
+}
+
+// ArticleImportProgress is the progress report of ImportArticles.
+// It can be saved as JSON and given back to ImportArticles to resume an import.
+type ArticleImportProgress struct {
+	// Items is the progress of each item, by key
+	Items map[string]*ArticleImportItemProgress `json:"items"`
+}
+
+// ArticleImportItemProgress is the progress of the import of an item
+type ArticleImportItemProgress struct {
+	// ArticleID is the id of the created article
+	ArticleID int64 `json:"article_id,omitempty"`
+	// InlineAttachments is the inline attachments uploaded before the creation of the article
+	InlineAttachments []ArticleImportInlineAttachment `json:"inline_attachments,omitempty"`
+	// Attachments is the file names of the attachments of the article
+	Attachments []string `json:"attachments,omitempty"`
+	// Locales is the locales of the created translations
+	Locales []string `json:"locales,omitempty"`
+	Done    bool     `json:"done"`
+	// Error is the last error of the item
+	Error string `json:"error,omitempty"`
+}
+
+// ArticleImportInlineAttachment is an inline attachment uploaded before the creation of its article
+type ArticleImportInlineAttachment struct {
+	ID       int64  `json:"id"`
+	Filename string `json:"filename"`
+	Path     string `json:"path"`
+}
+
+// ArticleImportOptions is options for ImportArticles
+type ArticleImportOptions struct {
+	// Progress is the report of a previous run to resume. The items which are done are skipped,
+	// the other items are resumed where they failed.
+	Progress *ArticleImportProgress
+
+	// BatchSize is the number of items imported before pausing for BatchInterval.
+	// There is no pause when BatchSize or BatchInterval is zero.
+	BatchSize     int
+	BatchInterval time.Duration
+
+	// MaxRetries is the number of times a rate limited request is retried. Defaults to ArticleImportMaxRetries.
+	MaxRetries int
+
+	// OnProgress is called with the report after each item, typically to save it
+	OnProgress func(progress *ArticleImportProgress)
+}
+
+// ImportArticles imports a stream of articles until items is closed. For each item it:
+//
+//  1. uploads the inline attachments of the article
+//  2. creates the article in its section, with its labels, referring to the uploaded inline attachments
+//  3. associates the inline attachments to the article and uploads its other attachments
+//  4. creates the translations of the article
+//
+// Rate limited requests are retried after the delay of the Retry-After header.
+// An item which fails is recorded in the report and the import goes on with the next items,
+// ImportArticles then returns an *ArticleImportError. The report is returned in every case.
+func (z *Client) ImportArticles(
+	ctx context.Context, items <-chan ArticleImportItem, opts *ArticleImportOptions,
+) (*ArticleImportProgress, error) {
+	tmp := ArticleImportOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	if tmp.MaxRetries <= 0 {
+		tmp.MaxRetries = ArticleImportMaxRetries
+	}
+
+	progress := tmp.Progress
+	if progress == nil {
+		progress = &ArticleImportProgress{}
+	}
+	if progress.Items == nil {
+		progress.Items = map[string]*ArticleImportItemProgress{}
+	}
+
+	errs := map[string]error{}
+	count := 0
+	for {
+		var item ArticleImportItem
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return progress, ctx.Err()
+		case item, ok = <-items:
+		}
+		if !ok {
+			break
+		}
+
+		p := progress.Items[item.Key]
+		if p == nil {
+			p = &ArticleImportItemProgress{}
+			progress.Items[item.Key] = p
+		}
+		if p.Done {
+			continue
+		}
+
+		if tmp.BatchSize > 0 && tmp.BatchInterval > 0 && count > 0 && count%tmp.BatchSize == 0 {
+			select {
+			case <-ctx.Done():
+				return progress, ctx.Err()
+			case <-time.After(tmp.BatchInterval):
+			}
+		}
+		count++
+
+		err := z.importArticle(ctx, item, p, tmp.MaxRetries)
+		if err != nil {
+			p.Error = err.Error()
+			errs[item.Key] = err
+		} else {
+			p.Done = true
+			p.Error = ""
+		}
+
+		if tmp.OnProgress != nil {
+			tmp.OnProgress(progress)
+		}
+		if ctx.Err() != nil {
+			return progress, ctx.Err()
+		}
+	}
+
+	if len(errs) > 0 {
+		return progress, &ArticleImportError{Errors: errs}
+	}
+	return progress, nil
+}
+
+// importArticle runs the steps of the import of an item which are not done yet
+func (z *Client) importArticle(
+	ctx context.Context, item ArticleImportItem, p *ArticleImportItemProgress, maxRetries int,
+) error {
+	if p.ArticleID == 0 {
+		for _, attachment := range item.Attachments {
+			if !attachment.Inline || containsInlineAttachment(p.InlineAttachments, attachment.Filename) {
+				continue
+			}
+			var uploaded ArticleAttachment
+			err := retryRateLimited(ctx, maxRetries, func() error {
+				file, err := attachment.Open()
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				uploaded, err = z.UploadUnassociatedArticleAttachment(ctx, attachment.Filename, file, true)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			path := uploaded.RelativePath
+			if path == "" {
+				path = uploaded.ContentURL
+			}
+			p.InlineAttachments = append(p.InlineAttachments, ArticleImportInlineAttachment{
+				ID:       uploaded.ID,
+				Filename: attachment.Filename,
+				Path:     path,
+			})
+		}
+
+		article := item.Article
+		for _, inline := range p.InlineAttachments {
+			article.Body = strings.ReplaceAll(article.Body, `src="`+inline.Filename+`"`, `src="`+inline.Path+`"`)
+		}
+		err := retryRateLimited(ctx, maxRetries, func() error {
+			created, err := z.CreateArticle(ctx, item.SectionID, article)
+			p.ArticleID = created.ID
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var inlines []ArticleImportInlineAttachment
+	for _, inline := range p.InlineAttachments {
+		if !containsString(p.Attachments, inline.Filename) {
+			inlines = append(inlines, inline)
+		}
+	}
+	for len(inlines) > 0 {
+		batch := inlines
+		if len(batch) > articleAttachmentsAssociateLimit {
+			batch = batch[:articleAttachmentsAssociateLimit]
+		}
+		ids := make([]int64, 0, len(batch))
+		for _, inline := range batch {
+			ids = append(ids, inline.ID)
+		}
+		err := retryRateLimited(ctx, maxRetries, func() error {
+			_, err := z.AssociateArticleAttachments(ctx, p.ArticleID, ids)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		for _, inline := range batch {
+			p.Attachments = append(p.Attachments, inline.Filename)
+		}
+		inlines = inlines[len(batch):]
+	}
+
+	for _, attachment := range item.Attachments {
+		if attachment.Inline || containsString(p.Attachments, attachment.Filename) {
+			continue
+		}
+		err := retryRateLimited(ctx, maxRetries, func() error {
+			file, err := attachment.Open()
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			_, err = z.UploadArticleAttachment(ctx, p.ArticleID, attachment.Filename, file, false)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		p.Attachments = append(p.Attachments, attachment.Filename)
+	}
+
+	for _, translation := range item.Translations {
+		if containsString(p.Locales, translation.Locale) {
+			continue
+		}
+		err := retryRateLimited(ctx, maxRetries, func() error {
+			_, err := z.CreateArticleTranslation(ctx, p.ArticleID, translation)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		p.Locales = append(p.Locales, translation.Locale)
+	}
+	return nil
+}
+
+// retryRateLimited calls fn until it is not rate limited, up to maxRetries more times,
+// waiting for the delay of the Retry-After header between two calls
+func retryRateLimited(ctx context.Context, maxRetries int, fn func() error) error {
+	for i := 0; ; i++ {
+		err := fn()
+
+		var zerr Error
+		if i >= maxRetries || !errors.As(err, &zerr) || zerr.Status() != http.StatusTooManyRequests {
+			return err
+		}
+
+		wait := ArticleImportRetryAfter
+		if seconds, err := strconv.Atoi(zerr.Headers().Get("Retry-After")); err == nil {
+			wait = time.Duration(seconds) * time.Second
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func containsInlineAttachment(inlines []ArticleImportInlineAttachment, filename string) bool {
+	for _, inline := range inlines {
+		if inline.Filename == filename {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
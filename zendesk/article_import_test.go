@@ -0,0 +1,166 @@
+package zendesk
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newArticleImportItems(items ...ArticleImportItem) <-chan ArticleImportItem {
+	c := make(chan ArticleImportItem, len(items))
+	for _, item := range items {
+		c <- item
+	}
+	close(c)
+	return c
+}
+
+func TestImportArticles(t *testing.T) {
+	requests := map[string]int{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/help_center/sections/98838/articles.json":
+			// the first creation is rate limited
+			if requests[r.URL.Path] == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/article.json"))
+		case "/help_center/articles/37486578/attachments.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/article_attachment.json"))
+		case "/help_center/articles/37486578/translations.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/translation.json"))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	item := ArticleImportItem{
+		Key:       "kb-1",
+		SectionID: 98838,
+		Article:   Article{Title: "How to reset your password", Locale: "en-us", LabelNames: []string{"password"}},
+		Attachments: []ArticleImportAttachment{{
+			Filename: "reset.png",
+			Open: func() (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("png")), nil
+			},
+		}},
+		Translations: []Translation{{Locale: "fr", Title: "Comment réinitialiser votre mot de passe"}},
+	}
+
+	reports := 0
+	progress, err := client.ImportArticles(ctx, newArticleImportItems(item), &ArticleImportOptions{
+		OnProgress: func(*ArticleImportProgress) { reports++ },
+	})
+	if err != nil {
+		t.Fatalf("Failed to import articles: %s", err)
+	}
+
+	p := progress.Items["kb-1"]
+	if !p.Done || p.ArticleID != 37486578 || len(p.Attachments) != 1 || len(p.Locales) != 1 {
+		t.Fatalf("unexpected progress: %+v", p)
+	}
+	if reports != 1 {
+		t.Fatalf("expected 1 progress report, but got %d", reports)
+	}
+}
+
+func TestImportArticlesInlineAttachments(t *testing.T) {
+	var order []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		order = append(order, r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/help_center/articles/attachments.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/article_attachment.json"))
+		case "/help_center/sections/98838/articles.json":
+			if !strings.Contains(string(body), `src=\"/hc/article_attachments/1428/gear.png\"`) {
+				t.Fatalf("the body does not refer to the uploaded attachment: %s", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/article.json"))
+		case "/help_center/articles/37486578/bulk_attachments.json":
+			if string(body) != `{"attachment_ids":[1428]}` {
+				t.Fatalf("unexpected body: %s", body)
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	item := ArticleImportItem{
+		Key:       "kb-1",
+		SectionID: 98838,
+		Article:   Article{Title: "Settings", Locale: "en-us", Body: `<p><img src="gear.png"></p>`},
+		Attachments: []ArticleImportAttachment{{
+			Filename: "gear.png",
+			Inline:   true,
+			Open: func() (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader("png")), nil
+			},
+		}},
+	}
+
+	progress, err := client.ImportArticles(ctx, newArticleImportItems(item), nil)
+	if err != nil {
+		t.Fatalf("Failed to import articles: %s", err)
+	}
+
+	expected := []string{
+		"/help_center/articles/attachments.json",
+		"/help_center/sections/98838/articles.json",
+		"/help_center/articles/37486578/bulk_attachments.json",
+	}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Fatalf("unexpected requests: %v", order)
+	}
+	p := progress.Items["kb-1"]
+	if !p.Done || len(p.InlineAttachments) != 1 || p.InlineAttachments[0].ID != 1428 || len(p.Attachments) != 1 {
+		t.Fatalf("unexpected progress: %+v", p)
+	}
+}
+
+func TestImportArticlesResume(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/37486578/translations.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	// kb-1 is done and kb-2 failed after the creation of its article
+	previous := &ArticleImportProgress{Items: map[string]*ArticleImportItemProgress{
+		"kb-1": {ArticleID: 37486577, Done: true},
+		"kb-2": {ArticleID: 37486578},
+	}}
+	items := newArticleImportItems(
+		ArticleImportItem{Key: "kb-1", SectionID: 98838},
+		ArticleImportItem{Key: "kb-2", SectionID: 98838, Translations: []Translation{{Locale: "fr"}}},
+	)
+
+	progress, err := client.ImportArticles(ctx, items, &ArticleImportOptions{Progress: previous})
+
+	var importErr *ArticleImportError
+	if !errors.As(err, &importErr) || len(importErr.Errors) != 1 || importErr.Errors["kb-2"] == nil {
+		t.Fatalf("expected an import error for kb-2, but got %v", err)
+	}
+	if progress.Items["kb-2"].Done || progress.Items["kb-2"].Error == "" {
+		t.Fatalf("unexpected progress: %+v", progress.Items["kb-2"])
+	}
+}
@@ -0,0 +1,169 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListArticles(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/en-us/sections/98838/articles.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("label_names") != "password" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/articles.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	articles, _, err := client.ListArticles(ctx, &ArticleListOptions{
+		Locale:     "en-us",
+		SectionID:  98838,
+		LabelNames: "password",
+	})
+	if err != nil {
+		t.Fatalf("Failed to list articles: %s", err)
+	}
+
+	if len(articles) != 2 {
+		t.Fatalf("expected length of articles is 2, but got %d", len(articles))
+	}
+	if articles[0].UserSegmentID != nil || *articles[1].UserSegmentID != 7 {
+		t.Fatalf("unexpected user segments: %v %v", articles[0].UserSegmentID, articles[1].UserSegmentID)
+	}
+}
+
+func TestGetArticle(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	article, err := client.GetArticle(ctx, 37486578)
+	if err != nil {
+		t.Fatalf("Failed to get article: %s", err)
+	}
+
+	if article.ID != 37486578 {
+		t.Fatalf("expected id is 37486578, but got %d", article.ID)
+	}
+}
+
+func TestCreateArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/sections/98838/articles.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		var data map[string]map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode body: %s", err)
+		}
+		if segment, ok := data["article"]["user_segment_id"]; !ok || segment != nil {
+			t.Fatalf("expected user_segment_id to be null, but got %v", data)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/article.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateArticle(ctx, 98838, Article{
+		Title:             "How to reset your password",
+		Body:              "<p>Use the gear icon to update your password.</p>",
+		Locale:            "en-us",
+		PermissionGroupID: 123,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create article: %s", err)
+	}
+}
+
+func TestUpdateArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		// the flags which are not set are not sent, and a flag set to false is sent
+		if string(body) != `{"article":{"promoted":false,"label_names":["password"]}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/article.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	promoted := false
+	_, err := client.UpdateArticle(ctx, 37486578, Article{Promoted: &promoted, LabelNames: []string{"password"}})
+	if err != nil {
+		t.Fatalf("Failed to update article: %s", err)
+	}
+}
+
+func TestArchiveArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/articles/37486578.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.ArchiveArticle(ctx, 37486578); err != nil {
+		t.Fatalf("Failed to archive article: %s", err)
+	}
+}
+
+func TestArticleTranslations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /help_center/articles/37486578/translations.json":
+			w.Write(readFixture("GET/translations.json"))
+		case "GET /help_center/articles/37486578/translations/fr.json":
+			w.Write(readFixture("GET/translation.json"))
+		case "POST /help_center/articles/37486578/translations.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/translation.json"))
+		case "PUT /help_center/articles/37486578/translations/fr.json":
+			// a translation updated without Draft stays a draft
+			if body, _ := io.ReadAll(r.Body); string(body) != `{"translation":{"locale":"fr","title":"Titre"}}` {
+				t.Fatalf("unexpected body: %s", body)
+			}
+			w.Write(readFixture("PUT/translation.json"))
+		case "DELETE /help_center/translations/1636.json":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	translations, err := client.ListArticleTranslations(ctx, 37486578)
+	if err != nil {
+		t.Fatalf("Failed to list article translations: %s", err)
+	}
+	if len(translations) != 2 {
+		t.Fatalf("expected length of translations is 2, but got %d", len(translations))
+	}
+
+	translation, err := client.GetArticleTranslation(ctx, 37486578, "fr")
+	if err != nil {
+		t.Fatalf("Failed to get article translation: %s", err)
+	}
+	if translation.Locale != "fr" || !translation.Outdated {
+		t.Fatalf("unexpected translation: %+v", translation)
+	}
+
+	if _, err := client.CreateArticleTranslation(ctx, 37486578, Translation{Locale: "fr", Title: "Titre"}); err != nil {
+		t.Fatalf("Failed to create article translation: %s", err)
+	}
+	if _, err := client.UpdateArticleTranslation(ctx, 37486578, "fr", Translation{Locale: "fr", Title: "Titre"}); err != nil {
+		t.Fatalf("Failed to update article translation: %s", err)
+	}
+	if err := client.DeleteTranslation(ctx, 1636); err != nil {
+		t.Fatalf("Failed to delete translation: %s", err)
+	}
+}
@@ -20,8 +20,23 @@ type Attachment struct {
 	Size        int64   `json:"size,omitempty"`
 	Thumbnails  []Photo `json:"thumbnails,omitempty"`
 	Inline      bool    `json:"inline,omitempty"`
+	Deleted     bool    `json:"deleted,omitempty"`
+
+	// MalwareScanResult can take "malware_found", "malware_not_found", "failed_to_scan" or "not_scanned"
+	MalwareScanResult string `json:"malware_scan_result,omitempty"`
+
+	// MalwareAccessOverride is true when an admin allowed the download of an attachment with malware
+	MalwareAccessOverride bool `json:"malware_access_override,omitempty"`
 }
 
+// Malware scan results of attachments
+const (
+	MalwareScanResultFound        = "malware_found"
+	MalwareScanResultNotFound     = "malware_not_found"
+	MalwareScanResultFailedToScan = "failed_to_scan"
+	MalwareScanResultNotScanned   = "not_scanned"
+)
+
 // Photo is thumbnail which is included in attachment
 type Photo struct {
 	ID          int64  `json:"id"`
@@ -158,6 +173,8 @@ type AttachmentAPI interface {
 	UploadAttachment(ctx context.Context, filename string, token string) UploadWriter
 	DeleteUpload(ctx context.Context, token string) error
 	GetAttachment(ctx context.Context, id int64) (Attachment, error)
+	RedactCommentAttachment(ctx context.Context, ticketID, commentID, attachmentID int64) error
+	SetAttachmentMalwareAccessOverride(ctx context.Context, id int64, override bool) (Attachment, error)
 }
 
 // UploadAttachment returns a writer that can be used to create a zendesk attachment
@@ -200,7 +217,37 @@ func (z *Client) GetAttachment(ctx context.Context, id int64) (Attachment, error
 // RedactCommentAttachment deletes an attachment with attachmentID on comment with commentID for ticket with ticketID
 // https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-attachments/#redact-comment-attachment
 func (z *Client) RedactCommentAttachment(ctx context.Context, ticketID, commentID, attachmentID int64) error {
-	path := fmt.Sprintf("/api/v2/tickets/%d/comments/%d/attachments/%d/redact", ticketID, commentID, attachmentID)
+	path := fmt.Sprintf("/tickets/%d/comments/%d/attachments/%d/redact.json", ticketID, commentID, attachmentID)
 	_, err := z.put(ctx, path, nil)
 	return err
 }
+
+// SetAttachmentMalwareAccessOverride allows or blocks the download of an attachment
+// in which malware was detected
+// https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-attachments/#update-attachment-for-malware
+func (z *Client) SetAttachmentMalwareAccessOverride(
+	ctx context.Context, id int64, override bool,
+) (Attachment, error) {
+	var data struct {
+		Attachment struct {
+			MalwareAccessOverride bool `json:"malware_access_override"`
+		} `json:"attachment"`
+	}
+	data.Attachment.MalwareAccessOverride = override
+
+	var result struct {
+		Attachment Attachment `json:"attachment"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/attachments/%d.json", id), data)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Attachment{}, err
+	}
+
+	return result.Attachment, nil
+}
@@ -120,7 +120,12 @@ func TestGetAttachment(t *testing.T) {
 }
 
 func TestRedactCommentAttachment(t *testing.T) {
-	mockAPI := newMockAPI(http.MethodPut, "redact_ticket_comment_attachment.json")
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/123/comments/456/attachments/789/redact.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("PUT/redact_ticket_comment_attachment.json"))
+	}))
 	client := newTestClient(mockAPI)
 	defer mockAPI.Close()
 
@@ -130,3 +135,24 @@ func TestRedactCommentAttachment(t *testing.T) {
 		t.Fatalf("Failed to redact ticket comment attachment: %s", err)
 	}
 }
+
+func TestSetAttachmentMalwareAccessOverride(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"attachment":{"malware_access_override":true}}` {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.Write(readFixture("PUT/attachment.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.SetAttachmentMalwareAccessOverride(ctx, 498483, true)
+	if err != nil {
+		t.Fatalf("Failed to override attachment malware access: %s", err)
+	}
+
+	if !attachment.MalwareAccessOverride || attachment.MalwareScanResult != MalwareScanResultFound {
+		t.Fatalf("unexpected attachment: %+v", attachment)
+	}
+}
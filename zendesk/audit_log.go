@@ -0,0 +1,150 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AuditLog is a change made in the account, such as the update of a trigger or a setting
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/audit_logs/#json-format
+type AuditLog struct {
+	ID                int64     `json:"id"`
+	URL               string    `json:"url,omitempty"`
+	Action            string    `json:"action"`
+	ActionLabel       string    `json:"action_label,omitempty"`
+	ActorID           int64     `json:"actor_id"`
+	ActorName         string    `json:"actor_name,omitempty"`
+	ChangeDescription string    `json:"change_description,omitempty"`
+	IPAddress         string    `json:"ip_address,omitempty"`
+	SourceID          int64     `json:"source_id"`
+	SourceLabel       string    `json:"source_label,omitempty"`
+	SourceType        string    `json:"source_type"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// AuditLogListOptions is options for ListAuditLogs and ExportAuditLogs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/audit_logs/#list-audit-logs
+type AuditLogListOptions struct {
+	CursorPagination
+
+	// Action can take "create", "destroy", "exported", "login" or "update"
+	Action    string `url:"filter[action],omitempty"`
+	ActorID   int64  `url:"filter[actor_id],omitempty"`
+	IPAddress string `url:"filter[ip_address],omitempty"`
+	SourceID  int64  `url:"filter[source_id],omitempty"`
+
+	// SourceType is the type of the changed object, such as "user", "rule" or "account_setting"
+	SourceType string `url:"filter[source_type],omitempty"`
+
+	// CreatedAfter and CreatedBefore filter by creation time.
+	// When only one of them is set, the range is open on the other side.
+	CreatedAfter  time.Time `url:"-"`
+	CreatedBefore time.Time `url:"-"`
+
+	// SortBy can take "created_at"
+	SortBy string `url:"sort_by,omitempty"`
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// auditLogQuery adds the created_at range filter to the query of AuditLogListOptions
+type auditLogQuery struct {
+	AuditLogListOptions
+	CreatedAt []string `url:"filter[created_at][],omitempty"`
+}
+
+func newAuditLogQuery(opts *AuditLogListOptions) auditLogQuery {
+	q := auditLogQuery{}
+	if opts != nil {
+		q.AuditLogListOptions = *opts
+	}
+
+	if !q.CreatedAfter.IsZero() || !q.CreatedBefore.IsZero() {
+		start, end := q.CreatedAfter, q.CreatedBefore
+		if start.IsZero() {
+			start = time.Unix(0, 0)
+		}
+		if end.IsZero() {
+			end = time.Now()
+		}
+		q.CreatedAt = []string{start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)}
+	}
+	return q
+}
+
+// AuditLogAPI an interface containing all audit log related methods
+type AuditLogAPI interface {
+	ListAuditLogs(ctx context.Context, opts *AuditLogListOptions) ([]AuditLog, CursorPaginationMeta, error)
+	GetAuditLog(ctx context.Context, auditLogID int64) (AuditLog, error)
+	ExportAuditLogs(ctx context.Context, opts *AuditLogListOptions) error
+}
+
+// ListAuditLogs lists the audit logs of the account.
+// Audit logs are only available on Enterprise plans.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/audit_logs/#list-audit-logs
+func (z *Client) ListAuditLogs(
+	ctx context.Context, opts *AuditLogListOptions,
+) ([]AuditLog, CursorPaginationMeta, error) {
+	var result struct {
+		AuditLogs []AuditLog           `json:"audit_logs"`
+		Meta      CursorPaginationMeta `json:"meta"`
+	}
+
+	u, err := addOptions("/audit_logs.json", newAuditLogQuery(opts))
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.AuditLogs, result.Meta, nil
+}
+
+// GetAuditLog gets an audit log
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/audit_logs/#show-audit-log
+func (z *Client) GetAuditLog(ctx context.Context, auditLogID int64) (AuditLog, error) {
+	var result struct {
+		AuditLog AuditLog `json:"audit_log"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/audit_logs/%d.json", auditLogID))
+	if err != nil {
+		return AuditLog{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AuditLog{}, err
+	}
+	return result.AuditLog, nil
+}
+
+// ExportAuditLogs starts the export of the audit logs matching opts.
+// The CSV file is sent by email to the current user when the export is done.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/audit_logs/#export-audit-logs
+func (z *Client) ExportAuditLogs(ctx context.Context, opts *AuditLogListOptions) error {
+	q := newAuditLogQuery(opts)
+	q.CursorPagination = CursorPagination{}
+
+	u, err := addOptions("/audit_logs/export.json", q)
+	if err != nil {
+		return err
+	}
+
+	_, err = z.post(ctx, u, nil)
+	return err
+}
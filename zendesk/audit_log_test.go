@@ -0,0 +1,90 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListAuditLogs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/audit_logs.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("filter[source_type]") != "user" || query.Get("filter[actor_id]") != "1234" {
+			t.Fatalf("unexpected filters: %s", r.URL.RawQuery)
+		}
+		createdAt := query["filter[created_at][]"]
+		if len(createdAt) != 2 || createdAt[0] != "2012-03-01T00:00:00Z" || createdAt[1] != "2012-04-01T00:00:00Z" {
+			t.Fatalf("unexpected created_at filter: %v", createdAt)
+		}
+		w.Write(readFixture("GET/audit_logs.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	auditLogs, _, err := client.ListAuditLogs(ctx, &AuditLogListOptions{
+		SourceType:    "user",
+		ActorID:       1234,
+		CreatedAfter:  time.Date(2012, 3, 1, 0, 0, 0, 0, time.UTC),
+		CreatedBefore: time.Date(2012, 4, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Failed to list audit logs: %s", err)
+	}
+
+	if len(auditLogs) != 2 {
+		t.Fatalf("expected length of audit logs is 2, but got %d", len(auditLogs))
+	}
+}
+
+func TestGetAuditLog(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "audit_log.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	auditLog, err := client.GetAuditLog(ctx, 498483)
+	if err != nil {
+		t.Fatalf("Failed to get audit log: %s", err)
+	}
+
+	if auditLog.SourceType != "user" || auditLog.ActorID != 1234 {
+		t.Fatalf("unexpected audit log: %+v", auditLog)
+	}
+}
+
+func TestExportAuditLogs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/audit_logs/export.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("filter[action]") != "update" || query.Get("page[size]") != "" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ExportAuditLogs(ctx, &AuditLogListOptions{
+		CursorPagination: CursorPagination{PageSize: 10},
+		Action:           "update",
+	})
+	if err != nil {
+		t.Fatalf("Failed to export audit logs: %s", err)
+	}
+}
+
+func TestNewAuditLogQueryOpenRange(t *testing.T) {
+	q := newAuditLogQuery(&AuditLogListOptions{CreatedBefore: time.Date(2012, 4, 1, 0, 0, 0, 0, time.UTC)})
+	if len(q.CreatedAt) != 2 || q.CreatedAt[0] != "1970-01-01T00:00:00Z" {
+		t.Fatalf("unexpected created_at filter: %v", q.CreatedAt)
+	}
+
+	if q := newAuditLogQuery(nil); q.CreatedAt != nil {
+		t.Fatalf("unexpected created_at filter: %v", q.CreatedAt)
+	}
+}
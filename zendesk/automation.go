@@ -52,6 +52,26 @@ type AutomationListOptions struct {
 	SortOrder string `url:"sort_order,omitempty"`
 }
 
+// AutomationSearchOptions is options for SearchAutomations
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/automations/#search-automations
+type AutomationSearchOptions struct {
+	PageOptions
+	Query     string `url:"query"`
+	Active    *bool  `url:"active,omitempty"`
+	Include   string `url:"include,omitempty"`
+	SortBy    string `url:"sort_by,omitempty"`
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// AutomationUpdate is the change of a single automation sent by UpdateManyAutomations.
+// Only the position and the active state can be updated in bulk.
+type AutomationUpdate struct {
+	ID       int64  `json:"id"`
+	Position *int64 `json:"position,omitempty"`
+	Active   *bool  `json:"active,omitempty"`
+}
+
 // AutomationAPI an interface containing all automation related methods
 type AutomationAPI interface {
 	GetAutomations(ctx context.Context, opts *AutomationListOptions) ([]Automation, Page, error)
@@ -59,6 +79,10 @@ type AutomationAPI interface {
 	GetAutomation(ctx context.Context, id int64) (Automation, error)
 	UpdateAutomation(ctx context.Context, id int64, automation Automation) (Automation, error)
 	DeleteAutomation(ctx context.Context, id int64) error
+	SearchAutomations(ctx context.Context, opts *AutomationSearchOptions) ([]Automation, Page, error)
+	UpdateManyAutomations(ctx context.Context, updates []AutomationUpdate) ([]Automation, error)
+	ReorderAutomations(ctx context.Context, automationIDs []int64) ([]Automation, error)
+	GetAutomationDefinitions(ctx context.Context) (RuleDefinitions, error)
 	GetAutomationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Automation]
 	GetAutomationsOBP(ctx context.Context, opts *OBPOptions) ([]Automation, Page, error)
 	GetAutomationsCBP(ctx context.Context, opts *CBPOptions) ([]Automation, CursorPaginationMeta, error)
@@ -173,3 +197,72 @@ func (z *Client) DeleteAutomation(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// SearchAutomations searches automations by title
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/automations/#search-automations
+func (z *Client) SearchAutomations(
+	ctx context.Context, opts *AutomationSearchOptions,
+) ([]Automation, Page, error) {
+	var data struct {
+		Automations []Automation `json:"automations"`
+		Page
+	}
+
+	if opts == nil {
+		return []Automation{}, Page{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/automations/search.json", opts)
+	if err != nil {
+		return []Automation{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return []Automation{}, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return []Automation{}, Page{}, err
+	}
+	return data.Automations, data.Page, nil
+}
+
+// UpdateManyAutomations updates the position or the active state of multiple automations
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/automations/#update-many-automations
+func (z *Client) UpdateManyAutomations(ctx context.Context, updates []AutomationUpdate) ([]Automation, error) {
+	var data struct {
+		Automations []AutomationUpdate `json:"automations"`
+	}
+	data.Automations = updates
+
+	var result struct {
+		Automations []Automation `json:"automations"`
+	}
+
+	body, err := z.put(ctx, "/automations/update_many.json", data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Automations, nil
+}
+
+// ReorderAutomations sets the position of the automations to their order in automationIDs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/automations/#update-many-automations
+func (z *Client) ReorderAutomations(ctx context.Context, automationIDs []int64) ([]Automation, error) {
+	updates := make([]AutomationUpdate, len(automationIDs))
+	for i, id := range automationIDs {
+		position := int64(i + 1)
+		updates[i] = AutomationUpdate{ID: id, Position: &position}
+	}
+	return z.UpdateManyAutomations(ctx, updates)
+}
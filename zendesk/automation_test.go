@@ -1,6 +1,7 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -130,3 +131,75 @@ func TestDeleteAutomationFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestSearchAutomations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/automations/search.json" || r.URL.Query().Get("query") != "close" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/automations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	automations, _, err := client.SearchAutomations(ctx, &AutomationSearchOptions{Query: "close"})
+	if err != nil {
+		t.Fatalf("Failed to search automations: %s", err)
+	}
+
+	if len(automations) != 3 {
+		t.Fatalf("expected length of automations is 3, but got %d", len(automations))
+	}
+}
+
+func TestSearchAutomationsWithNil(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "automations.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.SearchAutomations(ctx, nil)
+	if _, ok := err.(*OptionsError); !ok {
+		t.Fatalf("unexpected error type: %v", err)
+	}
+}
+
+func TestUpdateManyAutomations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "automations_update_many.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := false
+	automations, err := client.UpdateManyAutomations(ctx, []AutomationUpdate{{ID: 26, Active: &active}})
+	if err != nil {
+		t.Fatalf("Failed to update many automations: %s", err)
+	}
+
+	if len(automations) != 2 || automations[1].Active {
+		t.Fatalf("unexpected automations: %+v", automations)
+	}
+}
+
+func TestReorderAutomations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Automations []AutomationUpdate `json:"automations"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.Automations) != 2 || data.Automations[0].ID != 25 || *data.Automations[1].Position != 2 {
+			t.Fatalf("unexpected automation updates: %+v", data.Automations)
+		}
+		if data.Automations[0].Active != nil {
+			t.Fatal("expected active state not to be sent")
+		}
+		w.Write(readFixture("PUT/automations_update_many.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.ReorderAutomations(ctx, []int64{25, 26})
+	if err != nil {
+		t.Fatalf("Failed to reorder automations: %s", err)
+	}
+}
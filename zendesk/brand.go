@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"time"
 )
 
@@ -27,12 +29,45 @@ type Brand struct {
 	UpdatedAt         time.Time  `json:"updated_at,omitempty"`
 }
 
+// HostMappingValidity is the result of the validation of the CNAME record of a host mapping
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#check-host-mapping-validity
+type HostMappingValidity struct {
+	IsValid        bool     `json:"is_valid"`
+	Reason         string   `json:"reason,omitempty"`
+	CNAME          string   `json:"cname,omitempty"`
+	ExpectedCNAMEs []string `json:"expected_cnames,omitempty"`
+}
+
+// BrandAgent is the membership of an agent in a brand
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brand_agents/#json-format
+type BrandAgent struct {
+	ID        int64     `json:"id"`
+	URL       string    `json:"url,omitempty"`
+	BrandID   int64     `json:"brand_id"`
+	UserID    int64     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// BrandAgentListOptions is options for GetBrandAgents
+type BrandAgentListOptions struct {
+	CursorPagination
+}
+
 // BrandAPI an interface containing all methods associated with zendesk brands
 type BrandAPI interface {
 	CreateBrand(ctx context.Context, brand Brand) (Brand, error)
 	GetBrand(ctx context.Context, brandID int64) (Brand, error)
 	UpdateBrand(ctx context.Context, brandID int64, brand Brand) (Brand, error)
 	DeleteBrand(ctx context.Context, brandID int64) error
+	UpdateBrandLogo(ctx context.Context, brandID int64, filename string, logo io.Reader) (Brand, error)
+	CheckHostMapping(ctx context.Context, hostMapping string, subdomain string) (HostMappingValidity, error)
+	CheckBrandHostMapping(ctx context.Context, brandID int64) (HostMappingValidity, error)
+	GetBrandAgents(ctx context.Context, opts *BrandAgentListOptions) ([]BrandAgent, CursorPaginationMeta, error)
+	GetBrandAgent(ctx context.Context, brandAgentID int64) (BrandAgent, error)
+	GetUserBrandAgents(ctx context.Context, userID int64) ([]BrandAgent, error)
 }
 
 // CreateBrand creates new brand
@@ -110,3 +145,131 @@ func (z *Client) DeleteBrand(ctx context.Context, brandID int64) error {
 
 	return nil
 }
+
+// UpdateBrandLogo uploads the logo of the brand
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#update-a-brands-image
+func (z *Client) UpdateBrandLogo(ctx context.Context, brandID int64, filename string, logo io.Reader) (Brand, error) {
+	var result struct {
+		Brand Brand `json:"brand"`
+	}
+
+	body, err := z.uploadFile(ctx, http.MethodPut, fmt.Sprintf("/brands/%d.json", brandID),
+		"brand[photo][uploaded_data]", filename, logo)
+	if err != nil {
+		return Brand{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Brand{}, err
+	}
+	return result.Brand, nil
+}
+
+// CheckHostMapping checks whether the CNAME record of hostMapping points to subdomain,
+// before creating or updating a brand with the host mapping
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#check-host-mapping-validity
+func (z *Client) CheckHostMapping(
+	ctx context.Context, hostMapping string, subdomain string,
+) (HostMappingValidity, error) {
+	u, err := addOptions("/brands/check_host_mapping.json", struct {
+		HostMapping string `url:"host_mapping"`
+		Subdomain   string `url:"subdomain"`
+	}{hostMapping, subdomain})
+	if err != nil {
+		return HostMappingValidity{}, err
+	}
+
+	return z.checkHostMapping(ctx, u)
+}
+
+// CheckBrandHostMapping checks whether the CNAME record of the host mapping of an existing brand is valid
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brands/#check-host-mapping-validity-for-an-existing-brand
+func (z *Client) CheckBrandHostMapping(ctx context.Context, brandID int64) (HostMappingValidity, error) {
+	return z.checkHostMapping(ctx, fmt.Sprintf("/brands/%d/check_host_mapping.json", brandID))
+}
+
+func (z *Client) checkHostMapping(ctx context.Context, path string) (HostMappingValidity, error) {
+	var result HostMappingValidity
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return HostMappingValidity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return HostMappingValidity{}, err
+	}
+	return result, nil
+}
+
+// GetBrandAgents lists the brand memberships of all agents
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brand_agents/#list-brand-agent-memberships
+func (z *Client) GetBrandAgents(
+	ctx context.Context, opts *BrandAgentListOptions,
+) ([]BrandAgent, CursorPaginationMeta, error) {
+	var result struct {
+		BrandAgents []BrandAgent         `json:"brand_agents"`
+		Meta        CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &BrandAgentListOptions{}
+	}
+
+	u, err := addOptions("/brand_agents.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.BrandAgents, result.Meta, nil
+}
+
+// GetBrandAgent gets a brand membership
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brand_agents/#show-brand-agent-membership
+func (z *Client) GetBrandAgent(ctx context.Context, brandAgentID int64) (BrandAgent, error) {
+	var result struct {
+		BrandAgent BrandAgent `json:"brand_agent"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/brand_agents/%d.json", brandAgentID))
+	if err != nil {
+		return BrandAgent{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return BrandAgent{}, err
+	}
+	return result.BrandAgent, nil
+}
+
+// GetUserBrandAgents lists the brand memberships of an agent
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/brand_agents/#list-brand-agent-memberships
+func (z *Client) GetUserBrandAgents(ctx context.Context, userID int64) ([]BrandAgent, error) {
+	var result struct {
+		BrandAgents []BrandAgent `json:"brand_agents"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/users/%d/brand_agents.json", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.BrandAgents, nil
+}
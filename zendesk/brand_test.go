@@ -2,8 +2,10 @@ package zendesk
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -89,3 +91,117 @@ func TestDeleteBrand(t *testing.T) {
 		t.Fatalf("Failed to delete brand: %s", err)
 	}
 }
+
+func TestUpdateBrandLogo(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/brands/360002783572.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		file, header, err := r.FormFile("brand[photo][uploaded_data]")
+		if err != nil {
+			t.Fatalf("Failed to read form file: %s", err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "logo.png" || string(content) != "image" {
+			t.Fatalf("unexpected uploaded file %s: %s", header.Filename, content)
+		}
+		w.Write(readFixture("GET/brand.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateBrandLogo(ctx, 360002783572, "logo.png", strings.NewReader("image"))
+	if err != nil {
+		t.Fatalf("Failed to update brand logo: %s", err)
+	}
+}
+
+func TestCheckHostMapping(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/brands/check_host_mapping.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("host_mapping") != "support.example.com" || query.Get("subdomain") != "brand1" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/check_host_mapping.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	validity, err := client.CheckHostMapping(ctx, "support.example.com", "brand1")
+	if err != nil {
+		t.Fatalf("Failed to check host mapping: %s", err)
+	}
+
+	if validity.IsValid || validity.Reason != "wrong_cname" || len(validity.ExpectedCNAMEs) != 1 {
+		t.Fatalf("unexpected host mapping validity: %+v", validity)
+	}
+}
+
+func TestCheckBrandHostMapping(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/brands/360002783572/check_host_mapping.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/check_host_mapping.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CheckBrandHostMapping(ctx, 360002783572)
+	if err != nil {
+		t.Fatalf("Failed to check brand host mapping: %s", err)
+	}
+}
+
+func TestGetBrandAgents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "brand_agents.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	brandAgents, _, err := client.GetBrandAgents(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get brand agents: %s", err)
+	}
+
+	if len(brandAgents) != 2 {
+		t.Fatalf("expected length of brand agents is 2, but got %d", len(brandAgents))
+	}
+}
+
+func TestGetBrandAgent(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "brand_agent.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	brandAgent, err := client.GetBrandAgent(ctx, 35436)
+	if err != nil {
+		t.Fatalf("Failed to get brand agent: %s", err)
+	}
+
+	if brandAgent.UserID != 1234 {
+		t.Fatalf("unexpected brand agent user id: %d", brandAgent.UserID)
+	}
+}
+
+func TestGetUserBrandAgents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/1234/brand_agents.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/brand_agents.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	brandAgents, err := client.GetUserBrandAgents(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to get user brand agents: %s", err)
+	}
+
+	if len(brandAgents) != 2 {
+		t.Fatalf("expected length of brand agents is 2, but got %d", len(brandAgents))
+	}
+}
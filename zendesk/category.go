@@ -0,0 +1,218 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Category is a Help Center category, which contains sections
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#json-format
+type Category struct {
+	ID           int64     `json:"id,omitempty"`
+	URL          string    `json:"url,omitempty"`
+	HTMLURL      string    `json:"html_url,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Locale       string    `json:"locale,omitempty"`
+	SourceLocale string    `json:"source_locale,omitempty"`
+	Outdated     bool      `json:"outdated,omitempty"`
+	Position     int64     `json:"position,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+}
+
+// CategoryListOptions is options for ListCategories
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#list-categories
+type CategoryListOptions struct {
+	CursorPagination
+	Locale string `url:"-"`
+
+	// SortBy can take "position", "created_at" or "updated_at"
+	SortBy string `url:"sort_by,omitempty"`
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// CategoryAPI an interface containing all Help Center category related methods
+type CategoryAPI interface {
+	ListCategories(ctx context.Context, opts *CategoryListOptions) ([]Category, CursorPaginationMeta, error)
+	GetCategory(ctx context.Context, categoryID int64) (Category, error)
+	CreateCategory(ctx context.Context, category Category) (Category, error)
+	UpdateCategory(ctx context.Context, categoryID int64, category Category) (Category, error)
+	UpdateCategoryPosition(ctx context.Context, categoryID int64, position int64) (Category, error)
+	DeleteCategory(ctx context.Context, categoryID int64) error
+	ListCategoryTranslations(ctx context.Context, categoryID int64) ([]Translation, error)
+	GetCategoryTranslation(ctx context.Context, categoryID int64, locale string) (Translation, error)
+	CreateCategoryTranslation(ctx context.Context, categoryID int64, translation Translation) (Translation, error)
+	UpdateCategoryTranslation(
+		ctx context.Context, categoryID int64, locale string, translation Translation) (Translation, error)
+}
+
+// ListCategories lists the categories of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#list-categories
+func (z *Client) ListCategories(
+	ctx context.Context, opts *CategoryListOptions,
+) ([]Category, CursorPaginationMeta, error) {
+	var result struct {
+		Categories []Category           `json:"categories"`
+		Meta       CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CategoryListOptions{}
+	}
+
+	path := "/help_center"
+	if tmp.Locale != "" {
+		path += "/" + tmp.Locale
+	}
+
+	u, err := addOptions(path+"/categories.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Categories, result.Meta, nil
+}
+
+// GetCategory gets a category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#show-category
+func (z *Client) GetCategory(ctx context.Context, categoryID int64) (Category, error) {
+	var result struct {
+		Category Category `json:"category"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/categories/%d.json", categoryID))
+	if err != nil {
+		return Category{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Category{}, err
+	}
+	return result.Category, nil
+}
+
+// CreateCategory creates a category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#create-category
+func (z *Client) CreateCategory(ctx context.Context, category Category) (Category, error) {
+	var data, result struct {
+		Category Category `json:"category"`
+	}
+	data.Category = category
+
+	body, err := z.post(ctx, "/help_center/categories.json", data)
+	if err != nil {
+		return Category{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Category{}, err
+	}
+	return result.Category, nil
+}
+
+// UpdateCategory updates a category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#update-category
+func (z *Client) UpdateCategory(ctx context.Context, categoryID int64, category Category) (Category, error) {
+	var data struct {
+		Category Category `json:"category"`
+	}
+	data.Category = category
+
+	return z.updateCategory(ctx, categoryID, data)
+}
+
+// UpdateCategoryPosition moves a category to position in the list of categories.
+// Position 0 is the first position.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#update-category
+func (z *Client) UpdateCategoryPosition(ctx context.Context, categoryID int64, position int64) (Category, error) {
+	var data struct {
+		Category struct {
+			Position int64 `json:"position"`
+		} `json:"category"`
+	}
+	data.Category.Position = position
+
+	return z.updateCategory(ctx, categoryID, data)
+}
+
+func (z *Client) updateCategory(ctx context.Context, categoryID int64, data interface{}) (Category, error) {
+	var result struct {
+		Category Category `json:"category"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/categories/%d.json", categoryID), data)
+	if err != nil {
+		return Category{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Category{}, err
+	}
+	return result.Category, nil
+}
+
+// DeleteCategory deletes a category with its sections and articles
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/categories/#delete-category
+func (z *Client) DeleteCategory(ctx context.Context, categoryID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/categories/%d.json", categoryID), nil)
+}
+
+// ListCategoryTranslations lists the translations of a category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-translations
+func (z *Client) ListCategoryTranslations(ctx context.Context, categoryID int64) ([]Translation, error) {
+	return z.getTranslations(ctx, fmt.Sprintf("/help_center/categories/%d/translations.json", categoryID))
+}
+
+// GetCategoryTranslation gets the translation of a category in a locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#show-translation
+func (z *Client) GetCategoryTranslation(ctx context.Context, categoryID int64, locale string) (Translation, error) {
+	return z.getTranslation(ctx, fmt.Sprintf("/help_center/categories/%d/translations/%s.json", categoryID, locale))
+}
+
+// CreateCategoryTranslation creates the translation of a category in the locale of the translation
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#create-translation
+func (z *Client) CreateCategoryTranslation(
+	ctx context.Context, categoryID int64, translation Translation,
+) (Translation, error) {
+	return z.saveTranslation(ctx, http.MethodPost,
+		fmt.Sprintf("/help_center/categories/%d/translations.json", categoryID), translation)
+}
+
+// UpdateCategoryTranslation updates the translation of a category in a locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#update-translation
+func (z *Client) UpdateCategoryTranslation(
+	ctx context.Context, categoryID int64, locale string, translation Translation,
+) (Translation, error) {
+	return z.saveTranslation(ctx, http.MethodPut,
+		fmt.Sprintf("/help_center/categories/%d/translations/%s.json", categoryID, locale), translation)
+}
@@ -0,0 +1,100 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListCategories(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/fr/categories.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/categories.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, _, err := client.ListCategories(ctx, &CategoryListOptions{Locale: "fr"})
+	if err != nil {
+		t.Fatalf("Failed to list categories: %s", err)
+	}
+
+	if len(categories) != 2 {
+		t.Fatalf("expected length of categories is 2, but got %d", len(categories))
+	}
+}
+
+func TestGetCategory(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "category.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.GetCategory(ctx, 37486578)
+	if err != nil {
+		t.Fatalf("Failed to get category: %s", err)
+	}
+
+	if category.Name != "Account" {
+		t.Fatalf("expected name is Account, but got %s", category.Name)
+	}
+}
+
+func TestCreateCategory(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "category.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateCategory(ctx, Category{Name: "Account", Locale: "en-us"})
+	if err != nil {
+		t.Fatalf("Failed to create category: %s", err)
+	}
+}
+
+func TestUpdateCategoryPosition(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"category":{"position":0}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/category.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateCategoryPosition(ctx, 37486578, 0); err != nil {
+		t.Fatalf("Failed to update category position: %s", err)
+	}
+}
+
+func TestDeleteCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/categories/37486578.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteCategory(ctx, 37486578); err != nil {
+		t.Fatalf("Failed to delete category: %s", err)
+	}
+}
+
+func TestListCategoryTranslations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "translations.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	translations, err := client.ListCategoryTranslations(ctx, 37486578)
+	if err != nil {
+		t.Fatalf("Failed to list category translations: %s", err)
+	}
+
+	if len(translations) != 2 {
+		t.Fatalf("expected length of translations is 2, but got %d", len(translations))
+	}
+}
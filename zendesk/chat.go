@@ -0,0 +1,402 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	chatBaseURL = "https://www.zopim.com/api/v2"
+)
+
+// Chat types
+const (
+	ChatTypeChat           = "chat"
+	ChatTypeOfflineMessage = "offline_msg"
+)
+
+// SetChatEndpointURL replaces the URL of the Chat API.
+// This is mainly used for testing to point to mock API server.
+func (z *Client) SetChatEndpointURL(newURL string) error {
+	chatURL, err := url.Parse(newURL)
+	if err != nil {
+		return err
+	}
+
+	z.chatURL = chatURL
+	return nil
+}
+
+// SetChatCredential saves the credential used by the Chat API, usually a Chat OAuth token
+// created with NewBearerTokenCredential. The credential of the client is used when it is not set.
+func (z *Client) SetChatCredential(cred Credential) {
+	z.chatCredential = cred
+}
+
+func (z *Client) chatEndpoint() string {
+	if z.chatURL == nil {
+		return chatBaseURL
+	}
+	return z.chatURL.String()
+}
+
+// chatRequest sends a request to the Chat API and returns the response body as []byte
+func (z *Client) chatRequest(ctx context.Context, method, path string, data interface{}) ([]byte, error) {
+	return z.sendChatRequest(ctx, method, z.chatEndpoint()+path, data)
+}
+
+// sendChatRequest sends a request to a url of Chat with the Chat credential
+func (z *Client) sendChatRequest(ctx context.Context, method, u string, data interface{}) ([]byte, error) {
+	var reqBody io.Reader
+	if data != nil {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequest(method, u, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.WithContext(ctx)
+	z.includeHeaders(req)
+	z.includeChatCredential(req)
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+	return body, nil
+}
+
+// includeChatCredential authenticates req with the Chat credential, or the credential of the client when it is not set
+func (z *Client) includeChatCredential(req *http.Request) {
+	cred := z.chatCredential
+	if cred == nil {
+		cred = z.credential
+	}
+	if cred != nil {
+		if cred.Bearer() {
+			req.Header.Add("Authorization", "Bearer "+cred.Secret())
+		} else {
+			req.SetBasicAuth(cred.Email(), cred.Secret())
+		}
+	}
+}
+
+// chatListPath returns the path of a page of a Chat list. pageURL is the next_url or the prev_url
+// of a previous page and takes precedence over opts.
+func (z *Client) chatListPath(path, pageURL string, opts interface{}) (string, error) {
+	if pageURL == "" {
+		return addOptions(path, opts)
+	}
+
+	endpoint := z.chatEndpoint()
+	if !strings.HasPrefix(pageURL, endpoint) {
+		return "", fmt.Errorf("page url %s is not on the Chat API %s", pageURL, endpoint)
+	}
+	return strings.TrimPrefix(pageURL, endpoint), nil
+}
+
+// ChatPage is the pagination of the Chat API lists
+type ChatPage struct {
+	Count   int64  `json:"count"`
+	NextURL string `json:"next_url"`
+	PrevURL string `json:"prev_url"`
+}
+
+// ChatSession is the browser session of the visitor of a chat
+type ChatSession struct {
+	ID          string    `json:"id"`
+	Browser     string    `json:"browser"`
+	Platform    string    `json:"platform"`
+	UserAgent   string    `json:"user_agent"`
+	IP          string    `json:"ip"`
+	City        string    `json:"city"`
+	Region      string    `json:"region"`
+	CountryCode string    `json:"country_code"`
+	CountryName string    `json:"country_name"`
+	StartDate   time.Time `json:"start_date"`
+	EndDate     time.Time `json:"end_date"`
+}
+
+// ChatWebpath is a page the visitor of a chat went through
+type ChatWebpath struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Title     string    `json:"title"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ChatHistoryEvent is an event of the history of a chat, such as a message or an agent joining
+type ChatHistoryEvent struct {
+	Type           string    `json:"type"`
+	Name           string    `json:"name"`
+	Nick           string    `json:"nick"`
+	Msg            string    `json:"msg,omitempty"`
+	MsgID          string    `json:"msg_id,omitempty"`
+	Channel        string    `json:"channel,omitempty"`
+	DepartmentID   int64     `json:"department_id,omitempty"`
+	DepartmentName string    `json:"department_name,omitempty"`
+	NewRating      string    `json:"new_rating,omitempty"`
+	NewComment     string    `json:"new_comment,omitempty"`
+	Tags           []string  `json:"tags,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// ChatCount is the number of messages of a chat
+type ChatCount struct {
+	Agent   int64 `json:"agent"`
+	Visitor int64 `json:"visitor"`
+	Total   int64 `json:"total"`
+}
+
+// ChatResponseTime is the response time of the agents of a chat in seconds
+type ChatResponseTime struct {
+	First int64   `json:"first"`
+	Avg   float64 `json:"avg"`
+	Max   int64   `json:"max"`
+}
+
+// Chat is a chat or an offline message of Zendesk Chat
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/chats/
+type Chat struct {
+	ID string `json:"id"`
+	// Type is ChatTypeChat or ChatTypeOfflineMessage
+	Type              string             `json:"type"`
+	Visitor           ChatVisitor        `json:"visitor"`
+	Session           ChatSession        `json:"session"`
+	History           []ChatHistoryEvent `json:"history"`
+	Webpath           []ChatWebpath      `json:"webpath"`
+	AgentIDs          []string           `json:"agent_ids"`
+	AgentNames        []string           `json:"agent_names"`
+	DepartmentID      int64              `json:"department_id"`
+	DepartmentName    string             `json:"department_name"`
+	StartedBy         string             `json:"started_by"`
+	Duration          int64              `json:"duration"`
+	Count             ChatCount          `json:"count"`
+	ResponseTime      ChatResponseTime   `json:"response_time"`
+	Rating            string             `json:"rating"`
+	Comment           string             `json:"comment"`
+	Tags              []string           `json:"tags"`
+	Triggered         bool               `json:"triggered"`
+	TriggeredResponse bool               `json:"triggered_response"`
+	Missed            bool               `json:"missed"`
+	Unread            bool               `json:"unread"`
+	ZendeskTicketID   int64              `json:"zendesk_ticket_id"`
+	// Message is the message of an offline message
+	Message         string    `json:"message"`
+	Timestamp       time.Time `json:"timestamp"`
+	EndTimestamp    time.Time `json:"end_timestamp"`
+	UpdateTimestamp time.Time `json:"update_timestamp"`
+}
+
+// ChatListOptions is options for ListChats
+type ChatListOptions struct {
+	Limit int `url:"limit,omitempty"`
+	// PageURL is the NextURL or the PrevURL of a previous page, it takes precedence over the other options
+	PageURL string `url:"-"`
+}
+
+// ChatSearchResult is a chat found by SearchChats
+type ChatSearchResult struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	URL       string    `json:"url"`
+	Preview   string    `json:"preview"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ChatSearchOptions is options for SearchChats. The fields are combined with AND.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/chats/#search-chats
+type ChatSearchOptions struct {
+	// Query is a raw search query, such as "tags:vip"
+	Query string
+	// From and To bound the time of the chats, either can be zero
+	From         time.Time
+	To           time.Time
+	AgentName    string
+	VisitorName  string
+	VisitorEmail string
+	// PageURL is the NextURL or the PrevURL of a previous page, it takes precedence over the other options
+	PageURL string
+}
+
+// query builds the q parameter of the search
+func (o ChatSearchOptions) query() string {
+	var terms []string
+	if o.Query != "" {
+		terms = append(terms, o.Query)
+	}
+	if !o.From.IsZero() || !o.To.IsZero() {
+		from, to := "*", "*"
+		if !o.From.IsZero() {
+			from = o.From.UTC().Format(time.RFC3339)
+		}
+		if !o.To.IsZero() {
+			to = o.To.UTC().Format(time.RFC3339)
+		}
+		terms = append(terms, fmt.Sprintf("timestamp:[%s TO %s]", from, to))
+	}
+	if o.AgentName != "" {
+		terms = append(terms, fmt.Sprintf("agent_names:%q", o.AgentName))
+	}
+	if o.VisitorName != "" {
+		terms = append(terms, fmt.Sprintf("visitor_name:%q", o.VisitorName))
+	}
+	if o.VisitorEmail != "" {
+		terms = append(terms, fmt.Sprintf("visitor_email:%q", o.VisitorEmail))
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// ChatAPI an interface containing all Chat conversation related methods
+type ChatAPI interface {
+	ListChats(ctx context.Context, opts *ChatListOptions) ([]Chat, ChatPage, error)
+	GetChat(ctx context.Context, chatID string) (Chat, error)
+	GetChats(ctx context.Context, chatIDs []string) (map[string]Chat, error)
+	SearchChats(ctx context.Context, opts *ChatSearchOptions) ([]ChatSearchResult, ChatPage, error)
+	DeleteChat(ctx context.Context, chatID string) error
+}
+
+// ListChats lists the chats and the offline messages
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/chats/#list-chats
+func (z *Client) ListChats(ctx context.Context, opts *ChatListOptions) ([]Chat, ChatPage, error) {
+	var result struct {
+		Chats []Chat `json:"chats"`
+		ChatPage
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ChatListOptions{}
+	}
+
+	u, err := z.chatListPath("/chats", tmp.PageURL, tmp)
+	if err != nil {
+		return nil, ChatPage{}, err
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, ChatPage{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, ChatPage{}, err
+	}
+	return result.Chats, result.ChatPage, nil
+}
+
+// GetChat gets a chat or an offline message
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/chats/#show-chat
+func (z *Client) GetChat(ctx context.Context, chatID string) (Chat, error) {
+	var result Chat
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/chats/"+url.PathEscape(chatID), nil)
+	if err != nil {
+		return Chat{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Chat{}, err
+	}
+	return result, nil
+}
+
+// GetChats gets chats by their ids, keyed by id
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/chats/#bulk-show-chats
+func (z *Client) GetChats(ctx context.Context, chatIDs []string) (map[string]Chat, error) {
+	var result struct {
+		Docs map[string]Chat `json:"docs"`
+	}
+
+	u, err := addOptions("/chats", struct {
+		IDs string `url:"ids"`
+	}{strings.Join(chatIDs, ",")})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Docs, nil
+}
+
+// SearchChats searches the chats by date, agent, visitor or a raw query
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/chats/#search-chats
+func (z *Client) SearchChats(ctx context.Context, opts *ChatSearchOptions) ([]ChatSearchResult, ChatPage, error) {
+	var result struct {
+		Results []ChatSearchResult `json:"results"`
+		ChatPage
+	}
+
+	if opts == nil {
+		return nil, ChatPage{}, &OptionsError{opts}
+	}
+
+	u, err := z.chatListPath("/chats/search", opts.PageURL, struct {
+		Query string `url:"q"`
+	}{opts.query()})
+	if err != nil {
+		return nil, ChatPage{}, err
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, ChatPage{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, ChatPage{}, err
+	}
+	return result.Results, result.ChatPage, nil
+}
+
+// DeleteChat deletes a chat or an offline message
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/chats/#delete-chat
+func (z *Client) DeleteChat(ctx context.Context, chatID string) error {
+	_, err := z.chatRequest(ctx, http.MethodDelete, "/chats/"+url.PathEscape(chatID), nil)
+	return err
+}
@@ -0,0 +1,179 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ChatAgent is an agent of Zendesk Chat
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/agents/
+type ChatAgent struct {
+	ID          int64  `json:"id,omitempty"`
+	FirstName   string `json:"first_name,omitempty"`
+	LastName    string `json:"last_name,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+	Email       string `json:"email,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	// Role is the legacy role of the agent, "owner", "administrator" or "agent"
+	Role        string     `json:"role,omitempty"`
+	RoleID      int64      `json:"role_id,omitempty"`
+	Departments []int64    `json:"departments,omitempty"`
+	Skills      []int64    `json:"skills,omitempty"`
+	LoginCount  int64      `json:"login_count,omitempty"`
+	CreateDate  *time.Time `json:"create_date,omitempty"`
+	LastLogin   *time.Time `json:"last_login,omitempty"`
+}
+
+// ChatAgentListOptions is options for ListChatAgents
+type ChatAgentListOptions struct {
+	Limit   int   `url:"limit,omitempty"`
+	SinceID int64 `url:"since_id,omitempty"`
+	MaxID   int64 `url:"max_id,omitempty"`
+}
+
+// ChatRole is a role of Zendesk Chat, which sets the permissions of its agents
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/roles/
+type ChatRole struct {
+	ID           int64                  `json:"id,omitempty"`
+	Name         string                 `json:"name,omitempty"`
+	Description  string                 `json:"description,omitempty"`
+	Enabled      bool                   `json:"enabled,omitempty"`
+	MembersCount int64                  `json:"members_count,omitempty"`
+	Permissions  map[string]interface{} `json:"permissions,omitempty"`
+}
+
+// ChatAgentAPI an interface containing all Chat agent and role related methods
+type ChatAgentAPI interface {
+	ListChatAgents(ctx context.Context, opts *ChatAgentListOptions) ([]ChatAgent, error)
+	GetChatAgent(ctx context.Context, agentID int64) (ChatAgent, error)
+	GetChatAgentByEmail(ctx context.Context, email string) (ChatAgent, error)
+	GetCurrentChatAgent(ctx context.Context) (ChatAgent, error)
+	UpdateChatAgent(ctx context.Context, agentID int64, agent ChatAgent) (ChatAgent, error)
+	ListChatRoles(ctx context.Context) ([]ChatRole, error)
+	GetChatRole(ctx context.Context, roleID int64) (ChatRole, error)
+}
+
+// ListChatAgents lists the Chat agents. The following page starts after the last id with SinceID.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/agents/#list-agents
+func (z *Client) ListChatAgents(ctx context.Context, opts *ChatAgentListOptions) ([]ChatAgent, error) {
+	var result []ChatAgent
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ChatAgentListOptions{}
+	}
+
+	u, err := addOptions("/agents", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChatAgent gets a Chat agent
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/agents/#show-agent
+func (z *Client) GetChatAgent(ctx context.Context, agentID int64) (ChatAgent, error) {
+	return z.getChatAgent(ctx, fmt.Sprintf("/agents/%d", agentID))
+}
+
+// GetChatAgentByEmail gets a Chat agent by email
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/agents/#show-agent-by-email-id
+func (z *Client) GetChatAgentByEmail(ctx context.Context, email string) (ChatAgent, error) {
+	return z.getChatAgent(ctx, "/agents/email/"+url.PathEscape(email))
+}
+
+// GetCurrentChatAgent gets the Chat agent of the credential
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/agents/#show-requesting-agent
+func (z *Client) GetCurrentChatAgent(ctx context.Context) (ChatAgent, error) {
+	return z.getChatAgent(ctx, "/agents/me")
+}
+
+func (z *Client) getChatAgent(ctx context.Context, path string) (ChatAgent, error) {
+	var result ChatAgent
+
+	body, err := z.chatRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return ChatAgent{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatAgent{}, err
+	}
+	return result, nil
+}
+
+// UpdateChatAgent updates a Chat agent, such as enabling it or setting its skills, departments and role.
+// Departments and Skills replace the current ones when they are set.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/agents/#update-agent
+func (z *Client) UpdateChatAgent(ctx context.Context, agentID int64, agent ChatAgent) (ChatAgent, error) {
+	var result ChatAgent
+
+	body, err := z.chatRequest(ctx, http.MethodPut, fmt.Sprintf("/agents/%d", agentID), agent)
+	if err != nil {
+		return ChatAgent{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatAgent{}, err
+	}
+	return result, nil
+}
+
+// ListChatRoles lists the Chat roles
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/roles/#list-roles
+func (z *Client) ListChatRoles(ctx context.Context) ([]ChatRole, error) {
+	var result []ChatRole
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/roles", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChatRole gets a Chat role
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/roles/#show-role
+func (z *Client) GetChatRole(ctx context.Context, roleID int64) (ChatRole, error) {
+	var result ChatRole
+
+	body, err := z.chatRequest(ctx, http.MethodGet, fmt.Sprintf("/roles/%d", roleID), nil)
+	if err != nil {
+		return ChatRole{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatRole{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListChatAgents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/agents" || r.URL.Query().Get("since_id") != "361089721034" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/chat_agents.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agents, err := client.ListChatAgents(ctx, &ChatAgentListOptions{SinceID: 361089721034})
+	if err != nil {
+		t.Fatalf("Failed to list chat agents: %s", err)
+	}
+
+	if len(agents) != 2 {
+		t.Fatalf("expected length of agents is 2, but got %d", len(agents))
+	}
+	if agents[1].Enabled == nil || *agents[1].Enabled {
+		t.Fatalf("unexpected agent: %+v", agents[1])
+	}
+}
+
+func TestGetChatAgentByEmail(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/agents/email/alice@example.com" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/chat_agent.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agent, err := client.GetChatAgentByEmail(ctx, "alice@example.com")
+	if err != nil {
+		t.Fatalf("Failed to get chat agent: %s", err)
+	}
+
+	if agent.ID != 361089721035 || len(agent.Skills) != 2 {
+		t.Fatalf("unexpected agent: %+v", agent)
+	}
+}
+
+func TestUpdateChatAgent(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"enabled":false,"skills":[101]}`
+		if r.Method != http.MethodPut || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s", r.Method, body)
+		}
+		w.Write(readFixture("PUT/chat_agent.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	enabled := false
+	agent, err := client.UpdateChatAgent(ctx, 361089721035, ChatAgent{Enabled: &enabled, Skills: []int64{101}})
+	if err != nil {
+		t.Fatalf("Failed to update chat agent: %s", err)
+	}
+
+	if *agent.Enabled {
+		t.Fatalf("unexpected agent: %+v", agent)
+	}
+}
+
+func TestListChatRoles(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_roles.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	roles, err := client.ListChatRoles(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list chat roles: %s", err)
+	}
+
+	if len(roles) != 2 {
+		t.Fatalf("expected length of roles is 2, but got %d", len(roles))
+	}
+}
+
+func TestGetChatRole(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_role.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	role, err := client.GetChatRole(ctx, 360002)
+	if err != nil {
+		t.Fatalf("Failed to get chat role: %s", err)
+	}
+
+	if role.Name != "Agent" {
+		t.Fatalf("unexpected role: %+v", role)
+	}
+}
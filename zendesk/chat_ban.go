@@ -0,0 +1,133 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Chat ban types
+const (
+	ChatBanTypeVisitor   = "visitor"
+	ChatBanTypeIPAddress = "ip_address"
+)
+
+// ChatBan is a ban of a visitor or an IP address from Zendesk Chat
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/bans/
+type ChatBan struct {
+	ID          int64      `json:"id,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	VisitorID   string     `json:"visitor_id,omitempty"`
+	VisitorName string     `json:"visitor_name,omitempty"`
+	IPAddress   string     `json:"ip_address,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+// ChatBanList is the bans of Chat by type
+type ChatBanList struct {
+	Visitors    []ChatBan `json:"visitor"`
+	IPAddresses []ChatBan `json:"ip_address"`
+}
+
+// ChatBanListOptions is options for ListChatBans
+type ChatBanListOptions struct {
+	Limit   int   `url:"limit,omitempty"`
+	SinceID int64 `url:"since_id,omitempty"`
+	MaxID   int64 `url:"max_id,omitempty"`
+}
+
+// ChatBanAPI an interface containing all Chat ban related methods
+type ChatBanAPI interface {
+	ListChatBans(ctx context.Context, opts *ChatBanListOptions) (ChatBanList, error)
+	GetChatBan(ctx context.Context, banID int64) (ChatBan, error)
+	BanChatVisitor(ctx context.Context, visitorID string, reason string) (ChatBan, error)
+	BanChatIPAddress(ctx context.Context, ipAddress string, reason string) (ChatBan, error)
+	DeleteChatBan(ctx context.Context, banID int64) error
+}
+
+// ListChatBans lists the bans of visitors and IP addresses
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/bans/#list-bans
+func (z *Client) ListChatBans(ctx context.Context, opts *ChatBanListOptions) (ChatBanList, error) {
+	var result ChatBanList
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ChatBanListOptions{}
+	}
+
+	u, err := addOptions("/bans", tmp)
+	if err != nil {
+		return ChatBanList{}, err
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return ChatBanList{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatBanList{}, err
+	}
+	return result, nil
+}
+
+// GetChatBan gets a ban
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/bans/#show-ban
+func (z *Client) GetChatBan(ctx context.Context, banID int64) (ChatBan, error) {
+	var result ChatBan
+
+	body, err := z.chatRequest(ctx, http.MethodGet, fmt.Sprintf("/bans/%d", banID), nil)
+	if err != nil {
+		return ChatBan{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatBan{}, err
+	}
+	return result, nil
+}
+
+// BanChatVisitor bans a visitor from Chat
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/bans/#create-ban
+func (z *Client) BanChatVisitor(ctx context.Context, visitorID string, reason string) (ChatBan, error) {
+	return z.createChatBan(ctx, ChatBan{VisitorID: visitorID, Reason: reason})
+}
+
+// BanChatIPAddress bans an IP address from Chat
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/bans/#create-ban
+func (z *Client) BanChatIPAddress(ctx context.Context, ipAddress string, reason string) (ChatBan, error) {
+	return z.createChatBan(ctx, ChatBan{IPAddress: ipAddress, Reason: reason})
+}
+
+// DeleteChatBan deletes a ban, which unbans its visitor or its IP address
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/bans/#delete-ban
+func (z *Client) DeleteChatBan(ctx context.Context, banID int64) error {
+	_, err := z.chatRequest(ctx, http.MethodDelete, fmt.Sprintf("/bans/%d", banID), nil)
+	return err
+}
+
+func (z *Client) createChatBan(ctx context.Context, ban ChatBan) (ChatBan, error) {
+	var result ChatBan
+
+	body, err := z.chatRequest(ctx, http.MethodPost, "/bans", ban)
+	if err != nil {
+		return ChatBan{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatBan{}, err
+	}
+	return result, nil
+}
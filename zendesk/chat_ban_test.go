@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListChatBans(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_bans.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	bans, err := client.ListChatBans(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list chat bans: %s", err)
+	}
+
+	if len(bans.Visitors) != 1 || len(bans.IPAddresses) != 1 {
+		t.Fatalf("unexpected bans: %+v", bans)
+	}
+}
+
+func TestGetChatBan(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_ban.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ban, err := client.GetChatBan(ctx, 5002)
+	if err != nil {
+		t.Fatalf("Failed to get chat ban: %s", err)
+	}
+
+	if ban.Type != ChatBanTypeIPAddress || ban.IPAddress != "198.51.100.7" {
+		t.Fatalf("unexpected ban: %+v", ban)
+	}
+}
+
+func TestBanChatIPAddress(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"ip_address":"198.51.100.7","reason":"Abusive language"}`
+		if r.Method != http.MethodPost || r.URL.Path != "/bans" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("GET/chat_ban.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.BanChatIPAddress(ctx, "198.51.100.7", "Abusive language")
+	if err != nil {
+		t.Fatalf("Failed to ban ip address: %s", err)
+	}
+}
+
+func TestDeleteChatBan(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/bans/5002" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteChatBan(ctx, 5002); err != nil {
+		t.Fatalf("Failed to delete chat ban: %s", err)
+	}
+}
@@ -0,0 +1,95 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ChatDepartment is a department of Zendesk Chat, a group of agents chats are routed to
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/departments/
+type ChatDepartment struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	// Members are the ids of the agents of the department, they replace the current members when they are set
+	Members []int64 `json:"members,omitempty"`
+}
+
+// ChatDepartmentAPI an interface containing all Chat department related methods
+type ChatDepartmentAPI interface {
+	ListChatDepartments(ctx context.Context) ([]ChatDepartment, error)
+	GetChatDepartment(ctx context.Context, departmentID int64) (ChatDepartment, error)
+	CreateChatDepartment(ctx context.Context, department ChatDepartment) (ChatDepartment, error)
+	UpdateChatDepartment(ctx context.Context, departmentID int64, department ChatDepartment) (ChatDepartment, error)
+	DeleteChatDepartment(ctx context.Context, departmentID int64) error
+}
+
+// ListChatDepartments lists the Chat departments
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/departments/#list-departments
+func (z *Client) ListChatDepartments(ctx context.Context) ([]ChatDepartment, error) {
+	var result []ChatDepartment
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/departments", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChatDepartment gets a Chat department
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/departments/#show-department
+func (z *Client) GetChatDepartment(ctx context.Context, departmentID int64) (ChatDepartment, error) {
+	return z.sendChatDepartment(ctx, http.MethodGet, fmt.Sprintf("/departments/%d", departmentID), nil)
+}
+
+// CreateChatDepartment creates a Chat department
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/departments/#create-department
+func (z *Client) CreateChatDepartment(ctx context.Context, department ChatDepartment) (ChatDepartment, error) {
+	return z.sendChatDepartment(ctx, http.MethodPost, "/departments", department)
+}
+
+// UpdateChatDepartment updates a Chat department
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/departments/#update-department
+func (z *Client) UpdateChatDepartment(
+	ctx context.Context, departmentID int64, department ChatDepartment,
+) (ChatDepartment, error) {
+	return z.sendChatDepartment(ctx, http.MethodPut, fmt.Sprintf("/departments/%d", departmentID), department)
+}
+
+// DeleteChatDepartment deletes a Chat department
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/departments/#delete-department
+func (z *Client) DeleteChatDepartment(ctx context.Context, departmentID int64) error {
+	_, err := z.chatRequest(ctx, http.MethodDelete, fmt.Sprintf("/departments/%d", departmentID), nil)
+	return err
+}
+
+func (z *Client) sendChatDepartment(
+	ctx context.Context, method, path string, data interface{},
+) (ChatDepartment, error) {
+	var result ChatDepartment
+
+	body, err := z.chatRequest(ctx, method, path, data)
+	if err != nil {
+		return ChatDepartment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatDepartment{}, err
+	}
+	return result, nil
+}
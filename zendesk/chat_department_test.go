@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListChatDepartments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_departments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	departments, err := client.ListChatDepartments(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list chat departments: %s", err)
+	}
+
+	if len(departments) != 2 {
+		t.Fatalf("expected length of departments is 2, but got %d", len(departments))
+	}
+}
+
+func TestCreateChatDepartment(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "chat_department.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	department, err := client.CreateChatDepartment(ctx, ChatDepartment{Name: "Support", Members: []int64{361089721035}})
+	if err != nil {
+		t.Fatalf("Failed to create chat department: %s", err)
+	}
+
+	if department.ID != 360001 {
+		t.Fatalf("unexpected department: %+v", department)
+	}
+}
+
+func TestUpdateChatDepartment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/departments/360002" || string(body) != `{"enabled":false}` {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("GET/chat_department.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	enabled := false
+	if _, err := client.UpdateChatDepartment(ctx, 360002, ChatDepartment{Enabled: &enabled}); err != nil {
+		t.Fatalf("Failed to update chat department: %s", err)
+	}
+}
+
+func TestDeleteChatDepartment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/departments/360002" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteChatDepartment(ctx, 360002); err != nil {
+		t.Fatalf("Failed to delete chat department: %s", err)
+	}
+}
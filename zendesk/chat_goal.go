@@ -0,0 +1,112 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Chat goal attribution models
+const (
+	ChatGoalAttributionFirstTouch = "first_touch"
+	ChatGoalAttributionLastTouch  = "last_touch"
+)
+
+// ChatGoalCondition is a condition of a goal, such as the visitor reaching a url
+type ChatGoalCondition struct {
+	Type     string `json:"type"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// ChatGoalSettings is the settings of a goal
+type ChatGoalSettings struct {
+	Conditions []ChatGoalCondition `json:"conditions"`
+}
+
+// ChatGoal is a goal of Zendesk Chat, a conversion such as a purchase which is attributed to chats
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/goals/
+type ChatGoal struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	// AttributionModel is ChatGoalAttributionFirstTouch or ChatGoalAttributionLastTouch
+	AttributionModel string `json:"attribution_model,omitempty"`
+	// AttributionPeriod is the number of days after a chat a conversion is attributed to it
+	AttributionPeriod int64             `json:"attribution_period,omitempty"`
+	Settings          *ChatGoalSettings `json:"settings,omitempty"`
+}
+
+// ChatGoalAPI an interface containing all Chat goal related methods
+type ChatGoalAPI interface {
+	ListChatGoals(ctx context.Context) ([]ChatGoal, error)
+	GetChatGoal(ctx context.Context, goalID int64) (ChatGoal, error)
+	CreateChatGoal(ctx context.Context, goal ChatGoal) (ChatGoal, error)
+	UpdateChatGoal(ctx context.Context, goalID int64, goal ChatGoal) (ChatGoal, error)
+	DeleteChatGoal(ctx context.Context, goalID int64) error
+}
+
+// ListChatGoals lists the Chat goals
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/goals/#list-goals
+func (z *Client) ListChatGoals(ctx context.Context) ([]ChatGoal, error) {
+	var result []ChatGoal
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/goals", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChatGoal gets a Chat goal
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/goals/#show-goal
+func (z *Client) GetChatGoal(ctx context.Context, goalID int64) (ChatGoal, error) {
+	return z.sendChatGoal(ctx, http.MethodGet, fmt.Sprintf("/goals/%d", goalID), nil)
+}
+
+// CreateChatGoal creates a Chat goal
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/goals/#create-goal
+func (z *Client) CreateChatGoal(ctx context.Context, goal ChatGoal) (ChatGoal, error) {
+	return z.sendChatGoal(ctx, http.MethodPost, "/goals", goal)
+}
+
+// UpdateChatGoal updates a Chat goal
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/goals/#update-goal
+func (z *Client) UpdateChatGoal(ctx context.Context, goalID int64, goal ChatGoal) (ChatGoal, error) {
+	return z.sendChatGoal(ctx, http.MethodPut, fmt.Sprintf("/goals/%d", goalID), goal)
+}
+
+// DeleteChatGoal deletes a Chat goal
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/goals/#delete-goal
+func (z *Client) DeleteChatGoal(ctx context.Context, goalID int64) error {
+	_, err := z.chatRequest(ctx, http.MethodDelete, fmt.Sprintf("/goals/%d", goalID), nil)
+	return err
+}
+
+func (z *Client) sendChatGoal(ctx context.Context, method, path string, data interface{}) (ChatGoal, error) {
+	var result ChatGoal
+
+	body, err := z.chatRequest(ctx, method, path, data)
+	if err != nil {
+		return ChatGoal{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatGoal{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListChatGoals(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_goals.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	goals, err := client.ListChatGoals(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list chat goals: %s", err)
+	}
+
+	if len(goals) != 2 {
+		t.Fatalf("expected length of goals is 2, but got %d", len(goals))
+	}
+}
+
+func TestGetChatGoal(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_goal.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	goal, err := client.GetChatGoal(ctx, 7001)
+	if err != nil {
+		t.Fatalf("Failed to get chat goal: %s", err)
+	}
+
+	if goal.AttributionModel != ChatGoalAttributionLastTouch || len(goal.Settings.Conditions) != 1 {
+		t.Fatalf("unexpected goal: %+v", goal)
+	}
+}
+
+func TestCreateChatGoal(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "chat_goal.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateChatGoal(ctx, ChatGoal{
+		Name:              "Checkout",
+		AttributionModel:  ChatGoalAttributionLastTouch,
+		AttributionPeriod: 30,
+		Settings: &ChatGoalSettings{Conditions: []ChatGoalCondition{
+			{Type: "url", Operator: "equals", Value: "https://example.com/checkout/done"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create chat goal: %s", err)
+	}
+}
+
+func TestDeleteChatGoal(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/goals/7001" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteChatGoal(ctx, 7001); err != nil {
+		t.Fatalf("Failed to delete chat goal: %s", err)
+	}
+}
@@ -0,0 +1,212 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// chatIncrementalExportLimit is the default number of items of a page of the Chat incremental exports
+const chatIncrementalExportLimit = 1000
+
+// ChatIncrementalExportOptions is options for the Chat incremental exports.
+// StartTime is required by the first request, the following requests use the NextPage of the previous page.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/incremental_export/
+type ChatIncrementalExportOptions struct {
+	StartTime int64  `url:"start_time,omitempty"`
+	StartID   string `url:"start_id,omitempty"`
+	Limit     int    `url:"limit,omitempty"`
+	// Fields selects the fields of the items, such as "chats(id,timestamp,agent_ids)"
+	Fields string `url:"fields,omitempty"`
+	// PageURL is the NextPage of a previous page, it takes precedence over the other options
+	PageURL string `url:"-"`
+}
+
+// ChatIncrementalExportMeta contains the information to fetch the next page of a Chat incremental export
+type ChatIncrementalExportMeta struct {
+	Count    int64   `json:"count"`
+	EndID    string  `json:"end_id,omitempty"`
+	EndTime  float64 `json:"end_time,omitempty"`
+	NextPage string  `json:"next_page,omitempty"`
+}
+
+// IncrementalChatsResult is a page of the Chat incremental chat export
+type IncrementalChatsResult struct {
+	Chats []Chat `json:"chats"`
+	ChatIncrementalExportMeta
+}
+
+// ChatAgentTimelineEntry is a period an agent spent in a status
+type ChatAgentTimelineEntry struct {
+	AgentID   int64     `json:"agent_id"`
+	StartTime time.Time `json:"start_time"`
+	Status    string    `json:"status"`
+	// Duration is the time spent in the status in seconds
+	Duration        float64 `json:"duration"`
+	EngagementCount int64   `json:"engagement_count"`
+}
+
+// IncrementalChatAgentTimelineResult is a page of the Chat incremental agent timeline export
+type IncrementalChatAgentTimelineResult struct {
+	AgentTimeline []ChatAgentTimelineEntry `json:"agent_timeline"`
+	ChatIncrementalExportMeta
+}
+
+// ChatIncrementalExportAPI an interface containing all Chat incremental export related methods
+type ChatIncrementalExportAPI interface {
+	GetIncrementalChats(ctx context.Context, opts *ChatIncrementalExportOptions) (*IncrementalChatsResult, error)
+	GetIncrementalChatsIterator(
+		ctx context.Context, opts *ChatIncrementalExportOptions) *ChatIncrementalExportIterator[Chat]
+	GetIncrementalChatAgentTimeline(
+		ctx context.Context, opts *ChatIncrementalExportOptions) (*IncrementalChatAgentTimelineResult, error)
+	GetIncrementalChatAgentTimelineIterator(
+		ctx context.Context, opts *ChatIncrementalExportOptions) *ChatIncrementalExportIterator[ChatAgentTimelineEntry]
+}
+
+// ChatIncrementalExportFunc defines the signature of the function used to fetch a page of a Chat incremental export.
+type ChatIncrementalExportFunc[T any] func(
+	ctx context.Context, opts *ChatIncrementalExportOptions) ([]T, ChatIncrementalExportMeta, error)
+
+// ChatIncrementalExportIterator iterates over the pages of a Chat incremental export until a page is not full.
+// It waits IncrementalExportInterval between requests to stay within the export rate limit.
+type ChatIncrementalExportIterator[T any] struct {
+	opts        ChatIncrementalExportOptions
+	hasMore     bool
+	interval    time.Duration
+	lastRequest time.Time
+	ctx         context.Context
+	fetch       ChatIncrementalExportFunc[T]
+}
+
+func newChatIncrementalExportIterator[T any](
+	ctx context.Context, opts *ChatIncrementalExportOptions, fetch ChatIncrementalExportFunc[T],
+) *ChatIncrementalExportIterator[T] {
+	it := &ChatIncrementalExportIterator[T]{
+		hasMore:  true,
+		interval: IncrementalExportInterval,
+		ctx:      ctx,
+		fetch:    fetch,
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	return it
+}
+
+// HasMore returns a boolean indicating whether the end of the export has not been reached yet.
+func (i *ChatIncrementalExportIterator[T]) HasMore() bool {
+	return i.hasMore
+}
+
+// GetNext retrieves the next page of the export, waiting for the rate limit interval if needed.
+// In case of an error, it sets hasMore to false and returns an error.
+func (i *ChatIncrementalExportIterator[T]) GetNext() ([]T, error) {
+	if wait := i.interval - time.Since(i.lastRequest); !i.lastRequest.IsZero() && wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-i.ctx.Done():
+			timer.Stop()
+			i.hasMore = false
+			return nil, i.ctx.Err()
+		case <-timer.C:
+		}
+	}
+	i.lastRequest = time.Now()
+
+	results, meta, err := i.fetch(i.ctx, &i.opts)
+	if err != nil {
+		i.hasMore = false
+		return nil, err
+	}
+
+	limit := i.opts.Limit
+	if limit == 0 {
+		limit = chatIncrementalExportLimit
+	}
+	// The export ends with a page which is not full
+	if meta.NextPage == "" || len(results) < limit {
+		i.hasMore = false
+	} else {
+		i.opts.PageURL = meta.NextPage
+	}
+	return results, nil
+}
+
+// GetIncrementalChats returns the chats updated since opts.StartTime
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/incremental_export/#incremental-chat-export
+func (z *Client) GetIncrementalChats(
+	ctx context.Context, opts *ChatIncrementalExportOptions,
+) (*IncrementalChatsResult, error) {
+	var result IncrementalChatsResult
+	if err := z.getChatIncrementalExport(ctx, "/incremental/chats", opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetIncrementalChatsIterator returns an iterator over the Chat incremental chat export
+func (z *Client) GetIncrementalChatsIterator(
+	ctx context.Context, opts *ChatIncrementalExportOptions,
+) *ChatIncrementalExportIterator[Chat] {
+	return newChatIncrementalExportIterator(ctx, opts,
+		func(ctx context.Context, opts *ChatIncrementalExportOptions) ([]Chat, ChatIncrementalExportMeta, error) {
+			result, err := z.GetIncrementalChats(ctx, opts)
+			if err != nil {
+				return nil, ChatIncrementalExportMeta{}, err
+			}
+			return result.Chats, result.ChatIncrementalExportMeta, nil
+		})
+}
+
+// GetIncrementalChatAgentTimeline returns the status changes of the agents since opts.StartTime
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/incremental_export/#incremental-agent-timeline-export
+func (z *Client) GetIncrementalChatAgentTimeline(
+	ctx context.Context, opts *ChatIncrementalExportOptions,
+) (*IncrementalChatAgentTimelineResult, error) {
+	var result IncrementalChatAgentTimelineResult
+	if err := z.getChatIncrementalExport(ctx, "/incremental/agent_timeline", opts, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetIncrementalChatAgentTimelineIterator returns an iterator over the Chat incremental agent timeline export
+func (z *Client) GetIncrementalChatAgentTimelineIterator(
+	ctx context.Context, opts *ChatIncrementalExportOptions,
+) *ChatIncrementalExportIterator[ChatAgentTimelineEntry] {
+	return newChatIncrementalExportIterator(ctx, opts,
+		func(
+			ctx context.Context, opts *ChatIncrementalExportOptions,
+		) ([]ChatAgentTimelineEntry, ChatIncrementalExportMeta, error) {
+			result, err := z.GetIncrementalChatAgentTimeline(ctx, opts)
+			if err != nil {
+				return nil, ChatIncrementalExportMeta{}, err
+			}
+			return result.AgentTimeline, result.ChatIncrementalExportMeta, nil
+		})
+}
+
+func (z *Client) getChatIncrementalExport(
+	ctx context.Context, path string, opts *ChatIncrementalExportOptions, result interface{},
+) error {
+	tmp := opts
+	if tmp == nil {
+		tmp = &ChatIncrementalExportOptions{}
+	}
+
+	u, err := z.chatListPath(path, tmp.PageURL, tmp)
+	if err != nil {
+		return err
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, result)
+}
@@ -0,0 +1,92 @@
+package zendesk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetIncrementalChats(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/incremental/chats" || query.Get("start_time") != "1688169600" ||
+			query.Get("fields") != "chats(*)" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		fmt.Fprint(w, `{"chats":[{"id":"2307.1234567.Sabc123","type":"chat"}],"count":1,
+			"end_id":"2307.1234567.Sabc123","end_time":1688202182,"next_page":null}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalChats(ctx, &ChatIncrementalExportOptions{StartTime: 1688169600, Fields: "chats(*)"})
+	if err != nil {
+		t.Fatalf("Failed to get incremental chats: %s", err)
+	}
+
+	if len(result.Chats) != 1 || result.EndID != "2307.1234567.Sabc123" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestGetIncrementalChatsIterator(t *testing.T) {
+	requests := 0
+	var nextPage string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			fmt.Fprintf(w, `{"chats":[{"id":"a"},{"id":"b"}],"count":2,"end_id":"b","end_time":1688200000,"next_page":%q}`,
+				nextPage)
+		case 2:
+			query := r.URL.Query()
+			if query.Get("start_time") != "1688200000" || query.Get("start_id") != "b" {
+				t.Fatalf("unexpected second query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"chats":[{"id":"c"}],"count":1,"end_id":"c","end_time":1688200100,"next_page":"next"}`)
+		default:
+			t.Fatal("iterator requested after a page which is not full")
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+	nextPage = mockAPI.URL + "/incremental/chats?limit=2&start_id=b&start_time=1688200000"
+
+	it := client.GetIncrementalChatsIterator(ctx, &ChatIncrementalExportOptions{StartTime: 1688169600, Limit: 2})
+	it.interval = 0
+
+	chatCount := 0
+	for it.HasMore() {
+		chats, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get incremental chats: %s", err)
+		}
+		chatCount += len(chats)
+	}
+
+	if chatCount != 3 {
+		t.Fatalf("expected length of chats is 3, but got %d", chatCount)
+	}
+}
+
+func TestGetIncrementalChatAgentTimeline(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/agent_timeline" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		fmt.Fprint(w, `{"agent_timeline":[{"agent_id":361089721035,"start_time":"2023-07-01T09:00:00Z",
+			"status":"online","duration":1800.5,"engagement_count":3}],"count":1,"end_time":1688203800.5,"next_page":null}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalChatAgentTimeline(ctx, &ChatIncrementalExportOptions{StartTime: 1688169600})
+	if err != nil {
+		t.Fatalf("Failed to get incremental agent timeline: %s", err)
+	}
+
+	if len(result.AgentTimeline) != 1 || result.AgentTimeline[0].EngagementCount != 3 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
@@ -0,0 +1,199 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	chatRealTimeBaseURL = "https://rtm.zopim.com"
+)
+
+// ChatRealTimePollInterval is the default interval between two polls of PollChatRealTimeMetrics
+const ChatRealTimePollInterval = 10 * time.Second
+
+// SetChatRealTimeEndpointURL replaces the URL of the Chat Real Time API.
+// This is mainly used for testing to point to mock API server.
+func (z *Client) SetChatRealTimeEndpointURL(newURL string) error {
+	realTimeURL, err := url.Parse(newURL)
+	if err != nil {
+		return err
+	}
+
+	z.chatRealTimeURL = realTimeURL
+	return nil
+}
+
+func (z *Client) chatRealTimeEndpoint() string {
+	if z.chatRealTimeURL == nil {
+		return chatRealTimeBaseURL
+	}
+	return z.chatRealTimeURL.String()
+}
+
+// ChatRealTimeChats is the live chat metrics. The metrics over a window, such as MissedChats,
+// are keyed by the length of the window in minutes, such as "30" or "60".
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/real-time-chat-api/rest_api_reference/#chats-metrics
+type ChatRealTimeChats struct {
+	// IncomingChats is the number of chats waiting for an agent
+	IncomingChats    int64            `json:"incoming_chats"`
+	AssignedChats    int64            `json:"assigned_chats"`
+	ActiveChats      int64            `json:"active_chats"`
+	WaitingTimeAvg   float64          `json:"waiting_time_avg"`
+	WaitingTimeMax   float64          `json:"waiting_time_max"`
+	ChatDurationAvg  float64          `json:"chat_duration_avg"`
+	ChatDurationMax  float64          `json:"chat_duration_max"`
+	ResponseTimeAvg  map[string]int64 `json:"response_time_avg"`
+	ResponseTimeMax  map[string]int64 `json:"response_time_max"`
+	MissedChats      map[string]int64 `json:"missed_chats"`
+	SatisfactionGood map[string]int64 `json:"satisfaction_good"`
+	SatisfactionBad  map[string]int64 `json:"satisfaction_bad"`
+}
+
+// ChatRealTimeAgents is the live agent availability
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/real-time-chat-api/rest_api_reference/#agents-metrics
+type ChatRealTimeAgents struct {
+	AgentsOnline    int64 `json:"agents_online"`
+	AgentsAway      int64 `json:"agents_away"`
+	AgentsInvisible int64 `json:"agents_invisible"`
+}
+
+// ChatRealTimeMetrics is a snapshot of the live chat metrics and agent availability
+type ChatRealTimeMetrics struct {
+	Chats  ChatRealTimeChats
+	Agents ChatRealTimeAgents
+	Time   time.Time
+}
+
+// ChatRealTimeUpdate is an update sent by PollChatRealTimeMetrics and StreamChatRealTimeMetrics,
+// Err is set when the poll or the stream failed
+type ChatRealTimeUpdate struct {
+	Metrics ChatRealTimeMetrics
+	Err     error
+}
+
+// ChatRealTimeOptions is options for the Chat Real Time API
+type ChatRealTimeOptions struct {
+	DepartmentID int64 `url:"department_id,omitempty"`
+}
+
+// ChatRealTimeAPI an interface containing all Chat real time metric related methods
+type ChatRealTimeAPI interface {
+	GetChatRealTimeChats(ctx context.Context, opts *ChatRealTimeOptions) (ChatRealTimeChats, error)
+	GetChatRealTimeAgents(ctx context.Context, opts *ChatRealTimeOptions) (ChatRealTimeAgents, error)
+	GetChatRealTimeMetrics(ctx context.Context, opts *ChatRealTimeOptions) (ChatRealTimeMetrics, error)
+	PollChatRealTimeMetrics(
+		ctx context.Context, opts *ChatRealTimeOptions, interval time.Duration) <-chan ChatRealTimeUpdate
+	StreamChatRealTimeMetrics(ctx context.Context, opts *ChatRealTimeOptions) <-chan ChatRealTimeUpdate
+}
+
+// GetChatRealTimeChats gets the live chat metrics
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/real-time-chat-api/rest_api_reference/#get-all-chats-metrics
+func (z *Client) GetChatRealTimeChats(ctx context.Context, opts *ChatRealTimeOptions) (ChatRealTimeChats, error) {
+	var result ChatRealTimeChats
+	if err := z.getChatRealTimeStream(ctx, "/stream/chats", opts, &result); err != nil {
+		return ChatRealTimeChats{}, err
+	}
+	return result, nil
+}
+
+// GetChatRealTimeAgents gets the live agent availability
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/real-time-chat-api/rest_api_reference/#get-all-agents-metrics
+func (z *Client) GetChatRealTimeAgents(ctx context.Context, opts *ChatRealTimeOptions) (ChatRealTimeAgents, error) {
+	var result ChatRealTimeAgents
+	if err := z.getChatRealTimeStream(ctx, "/stream/agents", opts, &result); err != nil {
+		return ChatRealTimeAgents{}, err
+	}
+	return result, nil
+}
+
+// GetChatRealTimeMetrics gets the live chat metrics and agent availability
+func (z *Client) GetChatRealTimeMetrics(ctx context.Context, opts *ChatRealTimeOptions) (ChatRealTimeMetrics, error) {
+	chats, err := z.GetChatRealTimeChats(ctx, opts)
+	if err != nil {
+		return ChatRealTimeMetrics{}, err
+	}
+
+	agents, err := z.GetChatRealTimeAgents(ctx, opts)
+	if err != nil {
+		return ChatRealTimeMetrics{}, err
+	}
+
+	return ChatRealTimeMetrics{Chats: chats, Agents: agents, Time: time.Now()}, nil
+}
+
+// PollChatRealTimeMetrics sends the live metrics to the returned channel every interval, starting immediately.
+// A failed poll sends an update with Err and polling goes on. The channel is closed when ctx is done.
+// A zero interval uses ChatRealTimePollInterval.
+//
+// The metrics are polled from the REST API, StreamChatRealTimeMetrics receives them from the streaming API.
+func (z *Client) PollChatRealTimeMetrics(
+	ctx context.Context, opts *ChatRealTimeOptions, interval time.Duration,
+) <-chan ChatRealTimeUpdate {
+	if interval <= 0 {
+		interval = ChatRealTimePollInterval
+	}
+
+	updates := make(chan ChatRealTimeUpdate)
+	go func() {
+		defer close(updates)
+
+		for {
+			metrics, err := z.GetChatRealTimeMetrics(ctx, opts)
+			if ctx.Err() != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updates <- ChatRealTimeUpdate{Metrics: metrics, Err: err}:
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+	return updates
+}
+
+func (z *Client) getChatRealTimeStream(
+	ctx context.Context, path string, opts *ChatRealTimeOptions, data interface{},
+) error {
+	var result struct {
+		Content struct {
+			Data json.RawMessage `json:"data"`
+		} `json:"content"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ChatRealTimeOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return err
+	}
+
+	body, err := z.sendChatRequest(ctx, http.MethodGet, z.chatRealTimeEndpoint()+u, nil)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(result.Content.Data, data)
+}
@@ -0,0 +1,486 @@
+package zendesk
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// chatRealTimeStreamTopics are the topics StreamChatRealTimeMetrics subscribes to
+var chatRealTimeStreamTopics = []string{
+	"chats.incoming_chats",
+	"chats.assigned_chats",
+	"chats.active_chats",
+	"chats.waiting_time_avg",
+	"chats.waiting_time_max",
+	"chats.chat_duration_avg",
+	"chats.chat_duration_max",
+	"agents.agents_online",
+	"agents.agents_away",
+	"agents.agents_invisible",
+}
+
+// chatRealTimeStreamWindowTopics are the topics over a window, they are subscribed once per window
+var chatRealTimeStreamWindowTopics = []string{
+	"chats.response_time_avg",
+	"chats.response_time_max",
+	"chats.missed_chats",
+	"chats.satisfaction_good",
+	"chats.satisfaction_bad",
+}
+
+// chatRealTimeStreamWindows are the windows in minutes of the topics over a window
+var chatRealTimeStreamWindows = []int{30, 60}
+
+// chatRealTimeStreamMaxMessage is the maximum size of a message of the stream
+const chatRealTimeStreamMaxMessage = 1 << 20
+
+// webSocketGUID is the GUID of the WebSocket handshake
+//
+// ref: https://www.rfc-editor.org/rfc/rfc6455#section-1.3
+const webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// WebSocket opcodes
+const (
+	webSocketContinuation = 0x0
+	webSocketText         = 0x1
+	webSocketBinary       = 0x2
+	webSocketClose        = 0x8
+	webSocketPing         = 0x9
+	webSocketPong         = 0xA
+)
+
+// StreamChatRealTimeMetrics sends the live metrics to the returned channel as they change.
+// It sends a first snapshot from the REST API, then subscribes to the WebSocket streaming API
+// and sends the snapshot updated with every change. The channel is closed when ctx is done.
+// When the stream fails, an update with Err is sent and the channel is closed,
+// PollChatRealTimeMetrics can be used instead.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/real-time-chat-api/streaming/
+func (z *Client) StreamChatRealTimeMetrics(ctx context.Context, opts *ChatRealTimeOptions) <-chan ChatRealTimeUpdate {
+	tmp := opts
+	if tmp == nil {
+		tmp = &ChatRealTimeOptions{}
+	}
+
+	updates := make(chan ChatRealTimeUpdate)
+	send := func(update ChatRealTimeUpdate) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case updates <- update:
+			return true
+		}
+	}
+
+	go func() {
+		defer close(updates)
+
+		metrics, err := z.GetChatRealTimeMetrics(ctx, tmp)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			send(ChatRealTimeUpdate{Err: err})
+			return
+		}
+
+		stream, err := z.dialChatRealTimeStream(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			send(ChatRealTimeUpdate{Err: err})
+			return
+		}
+		defer stream.Close()
+
+		// unblock the reads of the stream when ctx is done
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-ctx.Done():
+				stream.Close()
+			case <-done:
+			}
+		}()
+
+		if err := stream.subscribe(tmp.DepartmentID); err != nil {
+			if ctx.Err() == nil {
+				send(ChatRealTimeUpdate{Err: err})
+			}
+			return
+		}
+		if !send(ChatRealTimeUpdate{Metrics: metrics}) {
+			return
+		}
+
+		for {
+			message, err := stream.ReadMessage()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				send(ChatRealTimeUpdate{Err: err})
+				return
+			}
+
+			updated, err := metrics.applyStreamMessage(message)
+			if err != nil {
+				if !send(ChatRealTimeUpdate{Err: err}) {
+					return
+				}
+				continue
+			}
+			if !updated {
+				continue
+			}
+			metrics.Time = time.Now()
+			if !send(ChatRealTimeUpdate{Metrics: metrics}) {
+				return
+			}
+		}
+	}()
+	return updates
+}
+
+// applyStreamMessage updates the metrics with an update message of the stream.
+// It returns false when the message is not an update, such as the acknowledgement of a subscription.
+func (m *ChatRealTimeMetrics) applyStreamMessage(message []byte) (bool, error) {
+	var result struct {
+		StatusCode int `json:"status_code"`
+		Content    struct {
+			Type  string                     `json:"type"`
+			Topic string                     `json:"topic"`
+			Data  map[string]json.RawMessage `json:"data"`
+		} `json:"content"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(message, &result); err != nil {
+		return false, err
+	}
+	if result.StatusCode >= http.StatusBadRequest {
+		return false, fmt.Errorf("chat real time stream: %d %s", result.StatusCode, result.Message)
+	}
+	if result.Content.Type != "update" {
+		return false, nil
+	}
+
+	// a metric over a window is sent with its window, such as {"missed_chats":2,"window":30}
+	data := result.Content.Data
+	if window, ok := data["window"]; ok {
+		delete(data, "window")
+		for key, value := range data {
+			data[key] = json.RawMessage(fmt.Sprintf(`{%q:%s}`, strings.Trim(string(window), `"`), value))
+		}
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return false, err
+	}
+	switch {
+	case strings.HasPrefix(result.Content.Topic, "chats."):
+		// the maps are copied, so that the metrics already sent do not change
+		m.Chats = m.Chats.copyWindows()
+		err = json.Unmarshal(b, &m.Chats)
+	case strings.HasPrefix(result.Content.Topic, "agents."):
+		err = json.Unmarshal(b, &m.Agents)
+	default:
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// copyWindows returns a copy of c which does not share the maps of the metrics over a window
+func (c ChatRealTimeChats) copyWindows() ChatRealTimeChats {
+	copyWindow := func(window map[string]int64) map[string]int64 {
+		if window == nil {
+			return nil
+		}
+		result := make(map[string]int64, len(window))
+		for key, value := range window {
+			result[key] = value
+		}
+		return result
+	}
+
+	c.ResponseTimeAvg = copyWindow(c.ResponseTimeAvg)
+	c.ResponseTimeMax = copyWindow(c.ResponseTimeMax)
+	c.MissedChats = copyWindow(c.MissedChats)
+	c.SatisfactionGood = copyWindow(c.SatisfactionGood)
+	c.SatisfactionBad = copyWindow(c.SatisfactionBad)
+	return c
+}
+
+// chatRealTimeStream is a WebSocket connection to the streaming API of the Chat Real Time API
+type chatRealTimeStream struct {
+	conn   io.ReadWriteCloser
+	reader *bufio.Reader
+
+	// writeMu serializes the frames written by subscribe and the pongs of ReadMessage
+	writeMu sync.Mutex
+}
+
+// dialChatRealTimeStream opens a WebSocket connection to the streaming API with the http client of z
+func (z *Client) dialChatRealTimeStream(ctx context.Context) (*chatRealTimeStream, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, z.chatRealTimeEndpoint()+"/stream", nil)
+	if err != nil {
+		return nil, err
+	}
+	z.includeHeaders(req)
+	z.includeChatCredential(req)
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", key)
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	// the body of a 101 response is the connection
+	conn, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		resp.Body.Close()
+		return nil, errors.New("chat real time stream: the http client does not support protocol upgrades")
+	}
+	if resp.Header.Get("Sec-WebSocket-Accept") != webSocketAccept(key) {
+		conn.Close()
+		return nil, errors.New("chat real time stream: invalid Sec-WebSocket-Accept")
+	}
+
+	return &chatRealTimeStream{conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+// subscribe subscribes to the topics of the metrics, restricted to a department when departmentID is not zero
+func (s *chatRealTimeStream) subscribe(departmentID int64) error {
+	type subscription struct {
+		Topic        string `json:"topic"`
+		Action       string `json:"action"`
+		Window       int    `json:"window,omitempty"`
+		DepartmentID int64  `json:"department_id,omitempty"`
+	}
+
+	var subscriptions []subscription
+	for _, topic := range chatRealTimeStreamTopics {
+		subscriptions = append(subscriptions, subscription{Topic: topic, Action: "subscribe", DepartmentID: departmentID})
+	}
+	for _, topic := range chatRealTimeStreamWindowTopics {
+		for _, window := range chatRealTimeStreamWindows {
+			subscriptions = append(subscriptions, subscription{
+				Topic:        topic,
+				Action:       "subscribe",
+				Window:       window,
+				DepartmentID: departmentID,
+			})
+		}
+	}
+
+	for _, sub := range subscriptions {
+		b, err := json.Marshal(sub)
+		if err != nil {
+			return err
+		}
+		if err := s.writeFrame(webSocketText, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadMessage reads the next text or binary message of the stream. It answers the pings,
+// and returns io.EOF when the server closes the stream.
+func (s *chatRealTimeStream) ReadMessage() ([]byte, error) {
+	var message []byte
+	fragmented := false
+	for {
+		fin, opcode, payload, err := readWebSocketFrame(s.reader)
+		if err != nil {
+			return nil, err
+		}
+		if opcode >= webSocketClose && (!fin || len(payload) > 125) {
+			return nil, errors.New("chat real time stream: invalid control frame")
+		}
+
+		switch opcode {
+		case webSocketPing:
+			if err := s.writeFrame(webSocketPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case webSocketPong:
+			continue
+		case webSocketClose:
+			// the close frame is echoed with its status code
+			if len(payload) > 2 {
+				payload = payload[:2]
+			}
+			s.writeFrame(webSocketClose, payload)
+			return nil, io.EOF
+		case webSocketText, webSocketBinary:
+			if fragmented {
+				return nil, errors.New("chat real time stream: new message inside a fragmented message")
+			}
+		case webSocketContinuation:
+			if !fragmented {
+				return nil, errors.New("chat real time stream: continuation without a fragmented message")
+			}
+		default:
+			return nil, fmt.Errorf("chat real time stream: unknown opcode %d", opcode)
+		}
+
+		if len(message)+len(payload) > chatRealTimeStreamMaxMessage {
+			return nil, errors.New("chat real time stream: message too large")
+		}
+		message = append(message, payload...)
+		if fin {
+			return message, nil
+		}
+		fragmented = true
+	}
+}
+
+// Close closes the connection of the stream
+func (s *chatRealTimeStream) Close() error {
+	return s.conn.Close()
+}
+
+func (s *chatRealTimeStream) writeFrame(opcode byte, payload []byte) error {
+	mask := make([]byte, 4)
+	if _, err := rand.Read(mask); err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	return writeWebSocketFrame(s.conn, opcode, payload, mask)
+}
+
+// webSocketAccept returns the Sec-WebSocket-Accept of the server for a Sec-WebSocket-Key
+func webSocketAccept(key string) string {
+	h := sha1.New()
+	h.Write([]byte(key + webSocketGUID))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+// writeWebSocketFrame writes a final frame. The payload is masked with mask when it is not nil,
+// the frames sent by a client must be masked with a new random mask.
+//
+// ref: https://www.rfc-editor.org/rfc/rfc6455#section-5.2
+func writeWebSocketFrame(w io.Writer, opcode byte, payload []byte, mask []byte) error {
+	header := make([]byte, 2, 14)
+	header[0] = 0x80 | opcode
+	switch n := len(payload); {
+	case n < 126:
+		header[1] = byte(n)
+	case n <= 0xFFFF:
+		header[1] = 126
+		header = header[:4]
+		binary.BigEndian.PutUint16(header[2:], uint16(n))
+	default:
+		header[1] = 127
+		header = header[:10]
+		binary.BigEndian.PutUint64(header[2:], uint64(n))
+	}
+
+	frame := payload
+	if mask != nil {
+		header[1] |= 0x80
+		header = append(header, mask...)
+
+		frame = make([]byte, len(payload))
+		for i := range payload {
+			frame[i] = payload[i] ^ mask[i%4]
+		}
+	}
+
+	if _, err := w.Write(append(header, frame...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readWebSocketFrame reads a frame and unmasks its payload
+func readWebSocketFrame(r *bufio.Reader) (fin bool, opcode byte, payload []byte, err error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return false, 0, nil, err
+	}
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0F
+	masked := header[1]&0x80 != 0
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		b := make([]byte, 2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(b))
+	case 127:
+		b := make([]byte, 8)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(b)
+	}
+	if length > chatRealTimeStreamMaxMessage {
+		return false, 0, nil, fmt.Errorf("chat real time stream: frame of %d bytes is too large", length)
+	}
+
+	var mask []byte
+	if masked {
+		mask = make([]byte, 4)
+		if _, err := io.ReadFull(r, mask); err != nil {
+			return false, 0, nil, err
+		}
+	}
+
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
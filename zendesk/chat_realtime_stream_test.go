@@ -0,0 +1,294 @@
+package zendesk
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newChatRealTimeStreamMockAPI serves the REST API of newChatRealTimeMockAPI and a WebSocket stream
+// which sends messages once the client subscribed to topics topics
+func newChatRealTimeStreamMockAPI(t *testing.T, topics int, messages ...string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stream/chats":
+			w.Write(readFixture("GET/chat_realtime_chats.json"))
+			return
+		case "/stream/agents":
+			w.Write(readFixture("GET/chat_realtime_agents.json"))
+			return
+		case "/stream":
+		default:
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+
+		if r.Header.Get("Upgrade") != "websocket" || r.Header.Get("Authorization") == "" {
+			t.Fatalf("unexpected handshake: %v", r.Header)
+		}
+		conn, rw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("Failed to hijack: %s", err)
+		}
+		defer conn.Close()
+
+		rw.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n")
+		rw.WriteString("Sec-WebSocket-Accept: " + webSocketAccept(r.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n")
+		rw.Flush()
+
+		for i := 0; i < topics; i++ {
+			_, opcode, payload, err := readWebSocketFrame(rw.Reader)
+			if err != nil {
+				t.Errorf("Failed to read subscription: %s", err)
+				return
+			}
+			var sub struct {
+				Action string `json:"action"`
+			}
+			if opcode != webSocketText || json.Unmarshal(payload, &sub) != nil || sub.Action != "subscribe" {
+				t.Errorf("unexpected subscription: %s", payload)
+				return
+			}
+		}
+
+		writeWebSocketFrame(conn, webSocketPing, []byte("ping"), nil)
+		for _, message := range messages {
+			writeWebSocketFrame(conn, webSocketText, []byte(message), nil)
+		}
+
+		// read the pong until the client closes the connection
+		for {
+			if _, _, _, err := readWebSocketFrame(rw.Reader); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func TestStreamChatRealTimeMetrics(t *testing.T) {
+	topics := len(chatRealTimeStreamTopics) + len(chatRealTimeStreamWindowTopics)*len(chatRealTimeStreamWindows)
+	mockAPI := newChatRealTimeStreamMockAPI(t, topics,
+		`{"status_code":200,"content":{"type":"update","topic":"chats.incoming_chats","data":{"incoming_chats":9}}}`,
+		`{"status_code":200,"content":{"type":"update","topic":"chats.missed_chats","data":{"missed_chats":5,"window":30}}}`,
+		`{"status_code":200,"content":{"type":"update","topic":"agents.agents_online","data":{"agents_online":8}}}`,
+	)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	updates := client.StreamChatRealTimeMetrics(streamCtx, nil)
+
+	var metrics []ChatRealTimeMetrics
+	for i := 0; i < 4; i++ {
+		update := <-updates
+		if update.Err != nil {
+			t.Fatalf("Failed to stream real time metrics: %s", update.Err)
+		}
+		metrics = append(metrics, update.Metrics)
+	}
+
+	if metrics[0].Chats.IncomingChats != 4 || metrics[0].Chats.MissedChats["30"] != 1 || metrics[0].Agents.AgentsOnline != 7 {
+		t.Fatalf("unexpected snapshot: %+v", metrics[0])
+	}
+	if metrics[1].Chats.IncomingChats != 9 || metrics[1].Chats.ActiveChats != 11 {
+		t.Fatalf("unexpected first update: %+v", metrics[1])
+	}
+	if metrics[2].Chats.MissedChats["30"] != 5 || metrics[2].Chats.MissedChats["60"] != 3 {
+		t.Fatalf("unexpected missed chats: %+v", metrics[2].Chats.MissedChats)
+	}
+	if metrics[3].Agents.AgentsOnline != 8 || metrics[3].Agents.AgentsAway != 2 {
+		t.Fatalf("unexpected agents: %+v", metrics[3].Agents)
+	}
+
+	cancel()
+	for range updates {
+	}
+}
+
+func TestStreamChatRealTimeMetricsUnauthorized(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stream/chats":
+			w.Write(readFixture("GET/chat_realtime_chats.json"))
+		case "/stream/agents":
+			w.Write(readFixture("GET/chat_realtime_agents.json"))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var updates []ChatRealTimeUpdate
+	for update := range client.StreamChatRealTimeMetrics(ctx, nil) {
+		updates = append(updates, update)
+	}
+
+	var zerr Error
+	if len(updates) != 1 || !errors.As(updates[0].Err, &zerr) || zerr.Status() != http.StatusUnauthorized {
+		t.Fatalf("expected a single unauthorized error, but got %+v", updates)
+	}
+}
+
+func TestApplyChatRealTimeStreamMessage(t *testing.T) {
+	var metrics ChatRealTimeMetrics
+
+	ack := `{"status_code":200,"content":{"type":"subscription","topic":"chats.incoming_chats"}}`
+	updated, err := metrics.applyStreamMessage([]byte(ack))
+	if err != nil || updated {
+		t.Fatalf("expected the acknowledgement to be ignored, got %v %v", updated, err)
+	}
+
+	_, err = metrics.applyStreamMessage([]byte(`{"status_code":403,"message":"forbidden"}`))
+	if err == nil {
+		t.Fatal("expected an error for a failed status")
+	}
+}
+
+// The wire bytes are the examples of RFC 6455
+//
+// ref: https://www.rfc-editor.org/rfc/rfc6455#section-5.7
+var webSocketFrameExamples = []struct {
+	name    string
+	opcode  byte
+	payload []byte
+	mask    []byte
+	wire    []byte
+}{
+	{
+		name:    "unmasked text",
+		opcode:  webSocketText,
+		payload: []byte("Hello"),
+		wire:    []byte{0x81, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f},
+	},
+	{
+		name:    "masked text",
+		opcode:  webSocketText,
+		payload: []byte("Hello"),
+		mask:    []byte{0x37, 0xfa, 0x21, 0x3d},
+		wire:    []byte{0x81, 0x85, 0x37, 0xfa, 0x21, 0x3d, 0x7f, 0x9f, 0x4d, 0x51, 0x58},
+	},
+	{
+		name:    "unmasked ping",
+		opcode:  webSocketPing,
+		payload: []byte("Hello"),
+		wire:    []byte{0x89, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f},
+	},
+	{
+		name:    "masked pong",
+		opcode:  webSocketPong,
+		payload: []byte("Hello"),
+		mask:    []byte{0x37, 0xfa, 0x21, 0x3d},
+		wire:    []byte{0x8a, 0x85, 0x37, 0xfa, 0x21, 0x3d, 0x7f, 0x9f, 0x4d, 0x51, 0x58},
+	},
+	{
+		name:    "256 bytes binary",
+		opcode:  webSocketBinary,
+		payload: bytes.Repeat([]byte{0x2a}, 256),
+		wire:    append([]byte{0x82, 0x7e, 0x01, 0x00}, bytes.Repeat([]byte{0x2a}, 256)...),
+	},
+	{
+		name:    "64KiB binary",
+		opcode:  webSocketBinary,
+		payload: bytes.Repeat([]byte{0x2a}, 65536),
+		wire: append([]byte{0x82, 0x7f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00},
+			bytes.Repeat([]byte{0x2a}, 65536)...),
+	},
+}
+
+func TestWriteWebSocketFrame(t *testing.T) {
+	for _, example := range webSocketFrameExamples {
+		var buf bytes.Buffer
+		if err := writeWebSocketFrame(&buf, example.opcode, example.payload, example.mask); err != nil {
+			t.Fatalf("%s: failed to write frame: %s", example.name, err)
+		}
+		if !bytes.Equal(buf.Bytes(), example.wire) {
+			t.Fatalf("%s: unexpected wire bytes: % x", example.name, buf.Bytes())
+		}
+	}
+}
+
+func TestReadWebSocketFrame(t *testing.T) {
+	for _, example := range webSocketFrameExamples {
+		fin, opcode, payload, err := readWebSocketFrame(bufio.NewReader(bytes.NewReader(example.wire)))
+		if err != nil {
+			t.Fatalf("%s: failed to read frame: %s", example.name, err)
+		}
+		if !fin || opcode != example.opcode || !bytes.Equal(payload, example.payload) {
+			t.Fatalf("%s: unexpected frame: %v %d %q", example.name, fin, opcode, payload)
+		}
+	}
+}
+
+func TestWebSocketAccept(t *testing.T) {
+	// ref: https://www.rfc-editor.org/rfc/rfc6455#section-1.3
+	if accept := webSocketAccept("dGhlIHNhbXBsZSBub25jZQ=="); accept != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("unexpected accept: %s", accept)
+	}
+}
+
+// webSocketTestConn reads the frames of the server from r and records the frames of the client
+type webSocketTestConn struct {
+	io.Reader
+	written bytes.Buffer
+}
+
+func (c *webSocketTestConn) Write(b []byte) (int, error) { return c.written.Write(b) }
+func (c *webSocketTestConn) Close() error                { return nil }
+
+func TestChatRealTimeStreamReadMessage(t *testing.T) {
+	// "Hel" and "lo" fragments with a ping in between, then a close frame with the status 1000
+	conn := &webSocketTestConn{Reader: bytes.NewReader([]byte{
+		0x01, 0x03, 0x48, 0x65, 0x6c,
+		0x89, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
+		0x80, 0x02, 0x6c, 0x6f,
+		0x88, 0x02, 0x03, 0xe8,
+	})}
+	stream := &chatRealTimeStream{conn: conn, reader: bufio.NewReader(conn)}
+
+	message, err := stream.ReadMessage()
+	if err != nil || string(message) != "Hello" {
+		t.Fatalf("unexpected message: %q %v", message, err)
+	}
+	if _, err := stream.ReadMessage(); err != io.EOF {
+		t.Fatalf("expected io.EOF after the close frame, but got %v", err)
+	}
+
+	// the client answered with a masked pong and a masked close frame echoing the status
+	written := bufio.NewReader(&conn.written)
+	for _, expected := range []struct {
+		opcode  byte
+		payload []byte
+	}{
+		{webSocketPong, []byte("Hello")},
+		{webSocketClose, []byte{0x03, 0xe8}},
+	} {
+		raw, _ := written.Peek(2)
+		if raw[1]&0x80 == 0 {
+			t.Fatalf("the frames of the client must be masked: % x", raw)
+		}
+		fin, opcode, payload, err := readWebSocketFrame(written)
+		if err != nil || !fin || opcode != expected.opcode || !bytes.Equal(payload, expected.payload) {
+			t.Fatalf("unexpected frame: %v %d % x %v", fin, opcode, payload, err)
+		}
+	}
+}
+
+func TestChatRealTimeStreamReadMessageInvalidFrames(t *testing.T) {
+	for name, wire := range map[string][]byte{
+		"continuation without a fragmented message": {0x80, 0x02, 0x6c, 0x6f},
+		"fragmented control frame":                  {0x09, 0x00},
+		"new message inside a fragmented message":   {0x01, 0x01, 0x48, 0x81, 0x01, 0x48},
+	} {
+		conn := &webSocketTestConn{Reader: bytes.NewReader(wire)}
+		stream := &chatRealTimeStream{conn: conn, reader: bufio.NewReader(conn)}
+		if _, err := stream.ReadMessage(); err == nil || err == io.EOF {
+			t.Fatalf("%s: expected a protocol error, but got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newChatRealTimeMockAPI(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stream/chats":
+			w.Write(readFixture("GET/chat_realtime_chats.json"))
+		case "/stream/agents":
+			w.Write(readFixture("GET/chat_realtime_agents.json"))
+		default:
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+	}))
+}
+
+func TestGetChatRealTimeChats(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stream/chats" || r.URL.Query().Get("department_id") != "360001" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/chat_realtime_chats.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	chats, err := client.GetChatRealTimeChats(ctx, &ChatRealTimeOptions{DepartmentID: 360001})
+	if err != nil {
+		t.Fatalf("Failed to get real time chats: %s", err)
+	}
+
+	if chats.IncomingChats != 4 || chats.MissedChats["60"] != 3 {
+		t.Fatalf("unexpected chats: %+v", chats)
+	}
+}
+
+func TestGetChatRealTimeMetrics(t *testing.T) {
+	mockAPI := newChatRealTimeMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	metrics, err := client.GetChatRealTimeMetrics(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get real time metrics: %s", err)
+	}
+
+	if metrics.Agents.AgentsOnline != 7 || metrics.Chats.ActiveChats != 11 {
+		t.Fatalf("unexpected metrics: %+v", metrics)
+	}
+}
+
+func TestPollChatRealTimeMetrics(t *testing.T) {
+	mockAPI := newChatRealTimeMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	pollCtx, cancel := context.WithCancel(ctx)
+	updates := client.PollChatRealTimeMetrics(pollCtx, nil, time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		update := <-updates
+		if update.Err != nil {
+			t.Fatalf("Failed to poll real time metrics: %s", update.Err)
+		}
+		if update.Metrics.Agents.AgentsAway != 2 {
+			t.Fatalf("unexpected metrics: %+v", update.Metrics)
+		}
+	}
+
+	cancel()
+	for range updates {
+	}
+}
@@ -0,0 +1,245 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Chat routing modes
+const (
+	ChatRoutingModeAssigned  = "assigned"
+	ChatRoutingModeBroadcast = "broadcast"
+)
+
+// ChatRoutingChatLimit limits the number of chats an agent serves at a time
+type ChatRoutingChatLimit struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	Limit   int64 `json:"limit,omitempty"`
+	// LimitType is "account" for a limit for all the agents or "agent" for a limit by agent
+	LimitType          string `json:"limit_type,omitempty"`
+	AllowAgentOverride *bool  `json:"allow_agent_override,omitempty"`
+}
+
+// ChatRoutingSkillRouting routes the chats to the agents with their skills
+type ChatRoutingSkillRouting struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// MaxWaitTime is the seconds a chat waits for an agent with its skills before any agent can get it
+	MaxWaitTime int64 `json:"max_wait_time,omitempty"`
+}
+
+// ChatRoutingReassignment reassigns the chats an agent doesn't accept
+type ChatRoutingReassignment struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	Timeout int64 `json:"timeout,omitempty"`
+}
+
+// ChatRoutingAutoIdle changes the status of the agents who miss chats
+type ChatRoutingAutoIdle struct {
+	Enabled                 *bool  `json:"enabled,omitempty"`
+	ReassignmentsBeforeIdle int64  `json:"reassignments_before_idle,omitempty"`
+	NewStatus               string `json:"new_status,omitempty"`
+}
+
+// ChatRoutingAutoAccept accepts the assigned chats for the agents
+type ChatRoutingAutoAccept struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ChatAccountRoutingSettings is the routing settings of the Chat account.
+// The settings which are not set are left unchanged by an update.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/routing_settings/#routing-settings-object
+type ChatAccountRoutingSettings struct {
+	// RoutingMode is ChatRoutingModeAssigned or ChatRoutingModeBroadcast
+	RoutingMode  string                   `json:"routing_mode,omitempty"`
+	ChatLimit    *ChatRoutingChatLimit    `json:"chat_limit,omitempty"`
+	SkillRouting *ChatRoutingSkillRouting `json:"skill_routing,omitempty"`
+	Reassignment *ChatRoutingReassignment `json:"reassignment,omitempty"`
+	AutoIdle     *ChatRoutingAutoIdle     `json:"auto_idle,omitempty"`
+	AutoAccept   *ChatRoutingAutoAccept   `json:"auto_accept,omitempty"`
+}
+
+// ChatAgentRoutingSettings is the routing settings of an agent
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/routing_settings/#agent-routing-settings-object
+type ChatAgentRoutingSettings struct {
+	// Skills are the ids of the skills of the agent, they replace the current skills when they are set
+	Skills []int64 `json:"skills,omitempty"`
+	// ChatLimit is the chat limit of the agent, when the account allows agents to override it
+	ChatLimit int64 `json:"chat_limit,omitempty"`
+}
+
+// ChatSkill is a skill of Zendesk Chat, used to route chats to the agents who have it
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/skills/
+type ChatSkill struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	// Members are the ids of the agents who have the skill
+	Members []int64 `json:"members,omitempty"`
+}
+
+// ChatRoutingAPI an interface containing all Chat routing settings and skill related methods
+type ChatRoutingAPI interface {
+	GetChatAccountRoutingSettings(ctx context.Context) (ChatAccountRoutingSettings, error)
+	UpdateChatAccountRoutingSettings(
+		ctx context.Context, settings ChatAccountRoutingSettings) (ChatAccountRoutingSettings, error)
+	GetChatAgentRoutingSettings(ctx context.Context, agentID int64) (ChatAgentRoutingSettings, error)
+	UpdateChatAgentRoutingSettings(
+		ctx context.Context, agentID int64, settings ChatAgentRoutingSettings) (ChatAgentRoutingSettings, error)
+	ListChatSkills(ctx context.Context) ([]ChatSkill, error)
+	GetChatSkill(ctx context.Context, skillID int64) (ChatSkill, error)
+	CreateChatSkill(ctx context.Context, skill ChatSkill) (ChatSkill, error)
+	UpdateChatSkill(ctx context.Context, skillID int64, skill ChatSkill) (ChatSkill, error)
+	DeleteChatSkill(ctx context.Context, skillID int64) error
+}
+
+// GetChatAccountRoutingSettings gets the routing settings of the Chat account
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/routing_settings/#show-account-routing-settings
+func (z *Client) GetChatAccountRoutingSettings(ctx context.Context) (ChatAccountRoutingSettings, error) {
+	var result struct {
+		Data ChatAccountRoutingSettings `json:"data"`
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/routing_settings/account", nil)
+	if err != nil {
+		return ChatAccountRoutingSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatAccountRoutingSettings{}, err
+	}
+	return result.Data, nil
+}
+
+// UpdateChatAccountRoutingSettings updates the routing settings of the Chat account
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/routing_settings/#update-account-routing-settings
+func (z *Client) UpdateChatAccountRoutingSettings(
+	ctx context.Context, settings ChatAccountRoutingSettings,
+) (ChatAccountRoutingSettings, error) {
+	var result struct {
+		Data ChatAccountRoutingSettings `json:"data"`
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodPut, "/routing_settings/account", settings)
+	if err != nil {
+		return ChatAccountRoutingSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatAccountRoutingSettings{}, err
+	}
+	return result.Data, nil
+}
+
+// GetChatAgentRoutingSettings gets the routing settings of an agent
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/routing_settings/#show-agent-routing-settings
+func (z *Client) GetChatAgentRoutingSettings(ctx context.Context, agentID int64) (ChatAgentRoutingSettings, error) {
+	var result struct {
+		Data ChatAgentRoutingSettings `json:"data"`
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodGet, fmt.Sprintf("/routing_settings/agents/%d", agentID), nil)
+	if err != nil {
+		return ChatAgentRoutingSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatAgentRoutingSettings{}, err
+	}
+	return result.Data, nil
+}
+
+// UpdateChatAgentRoutingSettings updates the skills or the chat limit of an agent
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/routing_settings/#update-agent-routing-settings
+func (z *Client) UpdateChatAgentRoutingSettings(
+	ctx context.Context, agentID int64, settings ChatAgentRoutingSettings,
+) (ChatAgentRoutingSettings, error) {
+	var result struct {
+		Data ChatAgentRoutingSettings `json:"data"`
+	}
+
+	body, err := z.chatRequest(ctx, http.MethodPut, fmt.Sprintf("/routing_settings/agents/%d", agentID), settings)
+	if err != nil {
+		return ChatAgentRoutingSettings{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatAgentRoutingSettings{}, err
+	}
+	return result.Data, nil
+}
+
+// ListChatSkills lists the Chat skills
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/skills/#list-skills
+func (z *Client) ListChatSkills(ctx context.Context) ([]ChatSkill, error) {
+	var result []ChatSkill
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/skills", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChatSkill gets a Chat skill
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/skills/#show-skill
+func (z *Client) GetChatSkill(ctx context.Context, skillID int64) (ChatSkill, error) {
+	return z.sendChatSkill(ctx, http.MethodGet, fmt.Sprintf("/skills/%d", skillID), nil)
+}
+
+// CreateChatSkill creates a Chat skill
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/skills/#create-skill
+func (z *Client) CreateChatSkill(ctx context.Context, skill ChatSkill) (ChatSkill, error) {
+	return z.sendChatSkill(ctx, http.MethodPost, "/skills", skill)
+}
+
+// UpdateChatSkill updates a Chat skill
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/skills/#update-skill
+func (z *Client) UpdateChatSkill(ctx context.Context, skillID int64, skill ChatSkill) (ChatSkill, error) {
+	return z.sendChatSkill(ctx, http.MethodPut, fmt.Sprintf("/skills/%d", skillID), skill)
+}
+
+// DeleteChatSkill deletes a Chat skill
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/skills/#delete-skill
+func (z *Client) DeleteChatSkill(ctx context.Context, skillID int64) error {
+	_, err := z.chatRequest(ctx, http.MethodDelete, fmt.Sprintf("/skills/%d", skillID), nil)
+	return err
+}
+
+func (z *Client) sendChatSkill(ctx context.Context, method, path string, data interface{}) (ChatSkill, error) {
+	var result ChatSkill
+
+	body, err := z.chatRequest(ctx, method, path, data)
+	if err != nil {
+		return ChatSkill{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatSkill{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,113 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetChatAccountRoutingSettings(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_account_routing_settings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	settings, err := client.GetChatAccountRoutingSettings(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get account routing settings: %s", err)
+	}
+
+	if settings.RoutingMode != ChatRoutingModeAssigned || settings.ChatLimit.Limit != 3 {
+		t.Fatalf("unexpected settings: %+v", settings)
+	}
+}
+
+func TestUpdateChatAccountRoutingSettings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"chat_limit":{"limit":5},"skill_routing":{"enabled":false}}`
+		if r.Method != http.MethodPut || r.URL.Path != "/routing_settings/account" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("GET/chat_account_routing_settings.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	enabled := false
+	_, err := client.UpdateChatAccountRoutingSettings(ctx, ChatAccountRoutingSettings{
+		ChatLimit:    &ChatRoutingChatLimit{Limit: 5},
+		SkillRouting: &ChatRoutingSkillRouting{Enabled: &enabled},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update account routing settings: %s", err)
+	}
+}
+
+func TestUpdateChatAgentRoutingSettings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/routing_settings/agents/361089721035" ||
+			string(body) != `{"skills":[101,102]}` {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("GET/chat_agent_routing_settings.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	settings, err := client.UpdateChatAgentRoutingSettings(ctx, 361089721035,
+		ChatAgentRoutingSettings{Skills: []int64{101, 102}})
+	if err != nil {
+		t.Fatalf("Failed to update agent routing settings: %s", err)
+	}
+
+	if settings.ChatLimit != 5 {
+		t.Fatalf("unexpected settings: %+v", settings)
+	}
+}
+
+func TestListChatSkills(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_skills.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	skills, err := client.ListChatSkills(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list chat skills: %s", err)
+	}
+
+	if len(skills) != 2 {
+		t.Fatalf("expected length of skills is 2, but got %d", len(skills))
+	}
+}
+
+func TestCreateChatSkill(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "chat_skill.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	skill, err := client.CreateChatSkill(ctx, ChatSkill{Name: "English", Members: []int64{361089721035}})
+	if err != nil {
+		t.Fatalf("Failed to create chat skill: %s", err)
+	}
+
+	if skill.ID != 101 {
+		t.Fatalf("unexpected skill: %+v", skill)
+	}
+}
+
+func TestDeleteChatSkill(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/skills/101" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteChatSkill(ctx, 101); err != nil {
+		t.Fatalf("Failed to delete chat skill: %s", err)
+	}
+}
@@ -0,0 +1,104 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Chat shortcut scopes
+const (
+	ChatShortcutScopeAll        = "all"
+	ChatShortcutScopeDepartment = "department"
+	ChatShortcutScopePersonal   = "personal"
+)
+
+// ChatShortcut is a canned response of Zendesk Chat, which agents send by typing its name.
+// A shortcut is identified by its name.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/shortcuts/
+type ChatShortcut struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Options are the answers offered to the visitor, separated by "/"
+	Options string   `json:"options,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	// Scope is ChatShortcutScopeAll, ChatShortcutScopeDepartment or ChatShortcutScopePersonal.
+	// Departments are the departments of a department shortcut and Agents the agents of a personal shortcut.
+	Scope       string  `json:"scope,omitempty"`
+	Departments []int64 `json:"departments,omitempty"`
+	Agents      []int64 `json:"agents,omitempty"`
+}
+
+// ChatShortcutAPI an interface containing all Chat shortcut related methods
+type ChatShortcutAPI interface {
+	ListChatShortcuts(ctx context.Context) ([]ChatShortcut, error)
+	GetChatShortcut(ctx context.Context, name string) (ChatShortcut, error)
+	CreateChatShortcut(ctx context.Context, shortcut ChatShortcut) (ChatShortcut, error)
+	UpdateChatShortcut(ctx context.Context, name string, shortcut ChatShortcut) (ChatShortcut, error)
+	DeleteChatShortcut(ctx context.Context, name string) error
+}
+
+// ListChatShortcuts lists the Chat shortcuts
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/shortcuts/#list-shortcuts
+func (z *Client) ListChatShortcuts(ctx context.Context) ([]ChatShortcut, error) {
+	var result []ChatShortcut
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/shortcuts", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChatShortcut gets a Chat shortcut by name
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/shortcuts/#show-shortcut
+func (z *Client) GetChatShortcut(ctx context.Context, name string) (ChatShortcut, error) {
+	return z.sendChatShortcut(ctx, http.MethodGet, "/shortcuts/"+url.PathEscape(name), nil)
+}
+
+// CreateChatShortcut creates a Chat shortcut
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/shortcuts/#create-shortcut
+func (z *Client) CreateChatShortcut(ctx context.Context, shortcut ChatShortcut) (ChatShortcut, error) {
+	return z.sendChatShortcut(ctx, http.MethodPost, "/shortcuts", shortcut)
+}
+
+// UpdateChatShortcut updates a Chat shortcut by name. Setting Name renames the shortcut.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/shortcuts/#update-shortcut
+func (z *Client) UpdateChatShortcut(ctx context.Context, name string, shortcut ChatShortcut) (ChatShortcut, error) {
+	return z.sendChatShortcut(ctx, http.MethodPut, "/shortcuts/"+url.PathEscape(name), shortcut)
+}
+
+// DeleteChatShortcut deletes a Chat shortcut by name
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/shortcuts/#delete-shortcut
+func (z *Client) DeleteChatShortcut(ctx context.Context, name string) error {
+	_, err := z.chatRequest(ctx, http.MethodDelete, "/shortcuts/"+url.PathEscape(name), nil)
+	return err
+}
+
+func (z *Client) sendChatShortcut(ctx context.Context, method, path string, data interface{}) (ChatShortcut, error) {
+	var result ChatShortcut
+
+	body, err := z.chatRequest(ctx, method, path, data)
+	if err != nil {
+		return ChatShortcut{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatShortcut{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,93 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListChatShortcuts(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_shortcuts.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	shortcuts, err := client.ListChatShortcuts(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list chat shortcuts: %s", err)
+	}
+
+	if len(shortcuts) != 2 {
+		t.Fatalf("expected length of shortcuts is 2, but got %d", len(shortcuts))
+	}
+}
+
+func TestGetChatShortcut(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_shortcut.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	shortcut, err := client.GetChatShortcut(ctx, "refund")
+	if err != nil {
+		t.Fatalf("Failed to get chat shortcut: %s", err)
+	}
+
+	if shortcut.Scope != ChatShortcutScopeDepartment || len(shortcut.Departments) != 1 {
+		t.Fatalf("unexpected shortcut: %+v", shortcut)
+	}
+}
+
+func TestCreateChatShortcut(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"name":"refund","message":"I have issued your refund.","scope":"department","departments":[360001]}`
+		if r.Method != http.MethodPost || r.URL.Path != "/shortcuts" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/chat_shortcut.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateChatShortcut(ctx, ChatShortcut{
+		Name:        "refund",
+		Message:     "I have issued your refund.",
+		Scope:       ChatShortcutScopeDepartment,
+		Departments: []int64{360001},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create chat shortcut: %s", err)
+	}
+}
+
+func TestUpdateChatShortcut(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/shortcuts/refund" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("GET/chat_shortcut.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateChatShortcut(ctx, "refund", ChatShortcut{Tags: []string{"refund"}})
+	if err != nil {
+		t.Fatalf("Failed to update chat shortcut: %s", err)
+	}
+}
+
+func TestDeleteChatShortcut(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/shortcuts/refund" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteChatShortcut(ctx, "refund"); err != nil {
+		t.Fatalf("Failed to delete chat shortcut: %s", err)
+	}
+}
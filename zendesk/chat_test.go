@@ -0,0 +1,141 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListChats(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chats.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	chats, page, err := client.ListChats(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list chats: %s", err)
+	}
+
+	if len(chats) != 2 {
+		t.Fatalf("expected length of chats is 2, but got %d", len(chats))
+	}
+	if chats[1].Type != ChatTypeOfflineMessage || page.NextURL == "" {
+		t.Fatalf("unexpected chats: %+v %+v", chats[1], page)
+	}
+}
+
+func TestListChatsWithPageURL(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chats" || r.URL.Query().Get("max_id") != "2307.1234567.Soff456" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/chats.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.ListChats(ctx, &ChatListOptions{PageURL: mockAPI.URL + "/chats?max_id=2307.1234567.Soff456"})
+	if err != nil {
+		t.Fatalf("Failed to list chats: %s", err)
+	}
+
+	_, _, err = client.ListChats(ctx, &ChatListOptions{PageURL: "https://example.com/chats"})
+	if err == nil {
+		t.Fatal("expected an error for a page url on another host")
+	}
+}
+
+func TestGetChat(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	chat, err := client.GetChat(ctx, "2307.1234567.Sabc123")
+	if err != nil {
+		t.Fatalf("Failed to get chat: %s", err)
+	}
+
+	if len(chat.History) != 3 || len(chat.Webpath) != 1 || chat.Session.CountryCode != "JP" {
+		t.Fatalf("unexpected chat: %+v", chat)
+	}
+	if chat.ResponseTime.Avg != 12.5 {
+		t.Fatalf("unexpected average response time: %v", chat.ResponseTime.Avg)
+	}
+}
+
+func TestGetChats(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ids") != "2307.1234567.Sabc123,2307.1234567.Soff456" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/chats_bulk.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	chats, err := client.GetChats(ctx, []string{"2307.1234567.Sabc123", "2307.1234567.Soff456"})
+	if err != nil {
+		t.Fatalf("Failed to get chats: %s", err)
+	}
+
+	if len(chats) != 2 || chats["2307.1234567.Soff456"].Message != "Please call me back" {
+		t.Fatalf("unexpected chats: %+v", chats)
+	}
+}
+
+func TestSearchChats(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := `timestamp:[2023-07-01T00:00:00Z TO *] AND agent_names:"Alice Agent" AND visitor_email:"jane@example.com"`
+		if r.URL.Path != "/chats/search" || r.URL.Query().Get("q") != expected {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/chat_search.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, _, err := client.SearchChats(ctx, &ChatSearchOptions{
+		From:         time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		AgentName:    "Alice Agent",
+		VisitorEmail: "jane@example.com",
+	})
+	if err != nil {
+		t.Fatalf("Failed to search chats: %s", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected length of results is 1, but got %d", len(results))
+	}
+}
+
+func TestDeleteChat(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/chats/2307.1234567.Sabc123" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteChat(ctx, "2307.1234567.Sabc123"); err != nil {
+		t.Fatalf("Failed to delete chat: %s", err)
+	}
+}
+
+func TestChatCredential(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer chat-token" {
+			t.Fatalf("unexpected authorization: %s", r.Header.Get("Authorization"))
+		}
+		w.Write(readFixture("GET/chat.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetChatCredential(NewBearerTokenCredential("chat-token"))
+	if _, err := client.GetChat(ctx, "2307.1234567.Sabc123"); err != nil {
+		t.Fatalf("Failed to get chat: %s", err)
+	}
+}
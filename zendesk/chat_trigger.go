@@ -0,0 +1,232 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Chat trigger events
+const (
+	ChatTriggerEventPageEnter     = "page_enter"
+	ChatTriggerEventChatRequested = "chat_requested"
+	ChatTriggerEventChatMessage   = "chat_message"
+)
+
+// Chat trigger logical operators, the other operators compare a field with values
+const (
+	ChatTriggerOperatorAnd = "and"
+	ChatTriggerOperatorOr  = "or"
+	ChatTriggerOperatorNot = "not"
+)
+
+// ChatTriggerCondition is a condition of a Chat trigger.
+// A logical condition has a logical Operator and Conditions, such as ["and", [...], [...]].
+// A comparison has an Operator, a Field and Values, such as ["eq", "visitor_country_code", "US"].
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/#condition
+type ChatTriggerCondition struct {
+	Operator   string
+	Field      string
+	Values     []interface{}
+	Conditions []ChatTriggerCondition
+}
+
+// IsLogical returns true if the condition combines other conditions
+func (c ChatTriggerCondition) IsLogical() bool {
+	switch c.Operator {
+	case ChatTriggerOperatorAnd, ChatTriggerOperatorOr, ChatTriggerOperatorNot:
+		return true
+	}
+	return false
+}
+
+// MarshalJSON encodes the condition as a JSON array
+func (c ChatTriggerCondition) MarshalJSON() ([]byte, error) {
+	if c.Operator == "" {
+		return []byte("null"), nil
+	}
+
+	list := []interface{}{c.Operator}
+	if c.IsLogical() {
+		for _, condition := range c.Conditions {
+			list = append(list, condition)
+		}
+	} else {
+		if c.Field != "" {
+			list = append(list, c.Field)
+		}
+		list = append(list, c.Values...)
+	}
+	return json.Marshal(list)
+}
+
+// UnmarshalJSON decodes the condition from a JSON array
+func (c *ChatTriggerCondition) UnmarshalJSON(data []byte) error {
+	*c = ChatTriggerCondition{}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	if list == nil {
+		return nil
+	}
+	if len(list) == 0 {
+		return errors.New("chat trigger condition is empty")
+	}
+
+	if err := json.Unmarshal(list[0], &c.Operator); err != nil {
+		return fmt.Errorf("chat trigger condition has no operator: %w", err)
+	}
+
+	args := list[1:]
+	if c.IsLogical() {
+		c.Conditions = make([]ChatTriggerCondition, len(args))
+		for i, arg := range args {
+			if err := json.Unmarshal(arg, &c.Conditions[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(args) > 0 {
+		if err := json.Unmarshal(args[0], &c.Field); err != nil {
+			return fmt.Errorf("chat trigger condition has no field: %w", err)
+		}
+		args = args[1:]
+	}
+	for _, arg := range args {
+		var value interface{}
+		if err := json.Unmarshal(arg, &value); err != nil {
+			return err
+		}
+		c.Values = append(c.Values, value)
+	}
+	return nil
+}
+
+// ChatTriggerAction is an action of a Chat trigger, such as ["addTag", "vip"]
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/#action
+type ChatTriggerAction struct {
+	Name string
+	Args []interface{}
+}
+
+// MarshalJSON encodes the action as a JSON array
+func (a ChatTriggerAction) MarshalJSON() ([]byte, error) {
+	return json.Marshal(append([]interface{}{a.Name}, a.Args...))
+}
+
+// UnmarshalJSON decodes the action from a JSON array
+func (a *ChatTriggerAction) UnmarshalJSON(data []byte) error {
+	var list []interface{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return errors.New("chat trigger action is empty")
+	}
+
+	name, ok := list[0].(string)
+	if !ok {
+		return fmt.Errorf("chat trigger action has no name: %v", list[0])
+	}
+	*a = ChatTriggerAction{Name: name, Args: list[1:]}
+	return nil
+}
+
+// ChatTriggerDefinition is the event, the condition and the actions of a Chat trigger
+type ChatTriggerDefinition struct {
+	Event     string               `json:"event"`
+	Condition ChatTriggerCondition `json:"condition"`
+	Actions   []ChatTriggerAction  `json:"actions"`
+}
+
+// ChatTrigger is a trigger of Zendesk Chat, which runs actions on visitor events.
+// A trigger is identified by its name.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/
+type ChatTrigger struct {
+	ID          int64                  `json:"id,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	Definition  *ChatTriggerDefinition `json:"definition,omitempty"`
+}
+
+// ChatTriggerAPI an interface containing all Chat trigger related methods
+type ChatTriggerAPI interface {
+	ListChatTriggers(ctx context.Context) ([]ChatTrigger, error)
+	GetChatTrigger(ctx context.Context, name string) (ChatTrigger, error)
+	CreateChatTrigger(ctx context.Context, trigger ChatTrigger) (ChatTrigger, error)
+	UpdateChatTrigger(ctx context.Context, name string, trigger ChatTrigger) (ChatTrigger, error)
+	DeleteChatTrigger(ctx context.Context, name string) error
+}
+
+// ListChatTriggers lists the Chat triggers
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/#list-triggers
+func (z *Client) ListChatTriggers(ctx context.Context) ([]ChatTrigger, error) {
+	var result []ChatTrigger
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/triggers", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// GetChatTrigger gets a Chat trigger by name
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/#show-trigger
+func (z *Client) GetChatTrigger(ctx context.Context, name string) (ChatTrigger, error) {
+	return z.sendChatTrigger(ctx, http.MethodGet, "/triggers/"+url.PathEscape(name), nil)
+}
+
+// CreateChatTrigger creates a Chat trigger
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/#create-trigger
+func (z *Client) CreateChatTrigger(ctx context.Context, trigger ChatTrigger) (ChatTrigger, error) {
+	return z.sendChatTrigger(ctx, http.MethodPost, "/triggers", trigger)
+}
+
+// UpdateChatTrigger updates a Chat trigger by name. The definition is replaced when it is set.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/#update-trigger
+func (z *Client) UpdateChatTrigger(ctx context.Context, name string, trigger ChatTrigger) (ChatTrigger, error) {
+	return z.sendChatTrigger(ctx, http.MethodPut, "/triggers/"+url.PathEscape(name), trigger)
+}
+
+// DeleteChatTrigger deletes a Chat trigger by name
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/triggers/#delete-trigger
+func (z *Client) DeleteChatTrigger(ctx context.Context, name string) error {
+	_, err := z.chatRequest(ctx, http.MethodDelete, "/triggers/"+url.PathEscape(name), nil)
+	return err
+}
+
+func (z *Client) sendChatTrigger(ctx context.Context, method, path string, data interface{}) (ChatTrigger, error) {
+	var result ChatTrigger
+
+	body, err := z.chatRequest(ctx, method, path, data)
+	if err != nil {
+		return ChatTrigger{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatTrigger{}, err
+	}
+	return result, nil
+}
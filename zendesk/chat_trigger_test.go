@@ -0,0 +1,113 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListChatTriggers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_triggers.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	triggers, err := client.ListChatTriggers(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list chat triggers: %s", err)
+	}
+
+	if len(triggers) != 2 {
+		t.Fatalf("expected length of triggers is 2, but got %d", len(triggers))
+	}
+	if triggers[1].Definition.Condition.Field != "visitor_tag" || *triggers[1].Enabled {
+		t.Fatalf("unexpected trigger: %+v", triggers[1])
+	}
+}
+
+func TestGetChatTrigger(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_trigger.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	trigger, err := client.GetChatTrigger(ctx, "Greet US visitors")
+	if err != nil {
+		t.Fatalf("Failed to get chat trigger: %s", err)
+	}
+
+	condition := trigger.Definition.Condition
+	if condition.Operator != ChatTriggerOperatorAnd || len(condition.Conditions) != 3 {
+		t.Fatalf("unexpected condition: %+v", condition)
+	}
+	if c := condition.Conditions[0]; c.Field != "visitor_country_code" || c.Values[0] != "US" {
+		t.Fatalf("unexpected comparison: %+v", c)
+	}
+	if c := condition.Conditions[2]; c.Operator != ChatTriggerOperatorNot || c.Conditions[0].Operator != "firedBefore" {
+		t.Fatalf("unexpected not condition: %+v", c)
+	}
+	if a := trigger.Definition.Actions[1]; a.Name != "sendMessageToVisitor" || len(a.Args) != 2 {
+		t.Fatalf("unexpected action: %+v", a)
+	}
+}
+
+func TestChatTriggerDefinitionRoundTrip(t *testing.T) {
+	var trigger ChatTrigger
+	if err := json.Unmarshal(readFixture("GET/chat_trigger.json"), &trigger); err != nil {
+		t.Fatalf("Failed to decode chat trigger: %s", err)
+	}
+
+	encoded, err := json.Marshal(trigger.Definition)
+	if err != nil {
+		t.Fatalf("Failed to encode chat trigger definition: %s", err)
+	}
+
+	expected := `{"event":"page_enter","condition":["and",["eq","visitor_country_code","US"],` +
+		`["icontains","visitor_page_url","/pricing"],["not",["firedBefore"]]],` +
+		`"actions":[["wait",30],["sendMessageToVisitor","Alice","Any questions about our plans?"],["addTag","pricing"]]}`
+	if string(encoded) != expected {
+		t.Fatalf("unexpected definition: %s", encoded)
+	}
+}
+
+func TestCreateChatTrigger(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"name":"Tag VIP","definition":{"event":"chat_requested",` +
+			`"condition":["eq","visitor_tag","vip"],"actions":[["setDepartment",360001]]}}`
+		if r.Method != http.MethodPost || r.URL.Path != "/triggers" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/chat_trigger.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateChatTrigger(ctx, ChatTrigger{
+		Name: "Tag VIP",
+		Definition: &ChatTriggerDefinition{
+			Event:     ChatTriggerEventChatRequested,
+			Condition: ChatTriggerCondition{Operator: "eq", Field: "visitor_tag", Values: []interface{}{"vip"}},
+			Actions:   []ChatTriggerAction{{Name: "setDepartment", Args: []interface{}{360001}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create chat trigger: %s", err)
+	}
+}
+
+func TestDeleteChatTrigger(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/triggers/Tag VIP" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteChatTrigger(ctx, "Tag VIP"); err != nil {
+		t.Fatalf("Failed to delete chat trigger: %s", err)
+	}
+}
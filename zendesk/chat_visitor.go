@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// ChatVisitor is a visitor of Zendesk Chat
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/visitors/
+type ChatVisitor struct {
+	ID         string   `json:"id,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	Email      string   `json:"email,omitempty"`
+	Phone      string   `json:"phone,omitempty"`
+	Notes      string   `json:"notes,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	ExternalID string   `json:"external_id,omitempty"`
+	// Banned is read only, use BanChatVisitor to ban a visitor
+	Banned bool `json:"banned,omitempty"`
+}
+
+// ChatVisitorAPI an interface containing all Chat visitor related methods
+type ChatVisitorAPI interface {
+	GetChatVisitor(ctx context.Context, visitorID string) (ChatVisitor, error)
+	UpdateChatVisitor(ctx context.Context, visitorID string, visitor ChatVisitor) (ChatVisitor, error)
+}
+
+// GetChatVisitor gets a Chat visitor
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/visitors/#show-visitor
+func (z *Client) GetChatVisitor(ctx context.Context, visitorID string) (ChatVisitor, error) {
+	var result ChatVisitor
+
+	body, err := z.chatRequest(ctx, http.MethodGet, "/visitors/"+url.PathEscape(visitorID), nil)
+	if err != nil {
+		return ChatVisitor{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatVisitor{}, err
+	}
+	return result, nil
+}
+
+// UpdateChatVisitor updates the name, the email, the phone, the notes or the tags of a Chat visitor.
+// Tags replace the current tags when they are set.
+//
+// ref: https://developer.zendesk.com/api-reference/live-chat/chat-api/visitors/#update-visitor
+func (z *Client) UpdateChatVisitor(ctx context.Context, visitorID string, visitor ChatVisitor) (ChatVisitor, error) {
+	var result ChatVisitor
+
+	// id and banned can't be updated
+	visitor.ID = ""
+	visitor.Banned = false
+
+	body, err := z.chatRequest(ctx, http.MethodPut, "/visitors/"+url.PathEscape(visitorID), visitor)
+	if err != nil {
+		return ChatVisitor{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ChatVisitor{}, err
+	}
+	return result, nil
+}
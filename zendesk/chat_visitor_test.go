@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetChatVisitor(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "chat_visitor.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	visitor, err := client.GetChatVisitor(ctx, "1234567.abcDEF")
+	if err != nil {
+		t.Fatalf("Failed to get chat visitor: %s", err)
+	}
+
+	if visitor.ExternalID != "crm-42" || len(visitor.Tags) != 1 {
+		t.Fatalf("unexpected visitor: %+v", visitor)
+	}
+}
+
+func TestUpdateChatVisitor(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"notes":"Plan: enterprise","tags":["vip","enterprise"]}`
+		if r.Method != http.MethodPut || r.URL.Path != "/visitors/1234567.abcDEF" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("PUT/chat_visitor.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	visitor, err := client.UpdateChatVisitor(ctx, "1234567.abcDEF", ChatVisitor{
+		ID:     "1234567.abcDEF",
+		Notes:  "Plan: enterprise",
+		Tags:   []string{"vip", "enterprise"},
+		Banned: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update chat visitor: %s", err)
+	}
+
+	if visitor.Notes != "Plan: enterprise" {
+		t.Fatalf("unexpected visitor: %+v", visitor)
+	}
+}
+
+func TestBanChatVisitor(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"visitor_id":"1234567.abcDEF","reason":"Spam"}`
+		if r.Method != http.MethodPost || r.URL.Path != "/bans" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/chat_ban.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ban, err := client.BanChatVisitor(ctx, "1234567.abcDEF", "Spam")
+	if err != nil {
+		t.Fatalf("Failed to ban chat visitor: %s", err)
+	}
+
+	if ban.Type != ChatBanTypeVisitor {
+		t.Fatalf("unexpected ban: %+v", ban)
+	}
+}
@@ -0,0 +1,34 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Count is the value returned by count endpoints.
+// Zendesk caches counts of large collections, and RefreshedAt is the time the value was computed.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#count-tickets
+type Count struct {
+	Value       int64     `json:"value"`
+	RefreshedAt time.Time `json:"refreshed_at"`
+}
+
+// getCount fetches a count endpoint and returns its value
+func (z *Client) getCount(ctx context.Context, path string) (Count, error) {
+	var result struct {
+		Count Count `json:"count"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Count{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Count{}, err
+	}
+	return result.Count, nil
+}
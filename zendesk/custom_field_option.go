@@ -1,5 +1,11 @@
 package zendesk
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
 // CustomFieldOption is struct for value of `custom_field_options`
 type CustomFieldOption struct {
 	ID       int64  `json:"id,omitempty"`
@@ -10,7 +16,9 @@ type CustomFieldOption struct {
 	Value    string `json:"value"`
 }
 
-type relationshipFilterObject struct {
+// RelationshipFilterObject is a condition of a `relationship_filter`, such as
+// {Field: "status", Operator: "is", Value: "active"}
+type RelationshipFilterObject struct {
 	Field    string `json:"field"`
 	Operator string `json:"operator"`
 	Value    string `json:"value"`
@@ -18,6 +26,64 @@ type relationshipFilterObject struct {
 
 // RelationshipFilter is struct for value of `relationship_filter`
 type RelationshipFilter struct {
-	All []relationshipFilterObject `json:"all"`
-	Any []relationshipFilterObject `json:"any"`
+	All []RelationshipFilterObject `json:"all"`
+	Any []RelationshipFilterObject `json:"any"`
+}
+
+// getCustomFieldOptions lists the options of the dropdown field at path
+func (z *Client) getCustomFieldOptions(ctx context.Context, path string) ([]CustomFieldOption, error) {
+	var result struct {
+		CustomFieldOptions []CustomFieldOption `json:"custom_field_options"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.CustomFieldOptions, nil
+}
+
+// getCustomFieldOption gets the option optionID of the dropdown field at path
+func (z *Client) getCustomFieldOption(ctx context.Context, path string, optionID int64) (CustomFieldOption, error) {
+	body, err := z.get(ctx, fmt.Sprintf("%s/%d.json", path, optionID))
+	if err != nil {
+		return CustomFieldOption{}, err
+	}
+
+	return unmarshalCustomFieldOption(body)
+}
+
+// createOrUpdateCustomFieldOption creates the option of the dropdown field at path,
+// or updates it when option.ID is set
+func (z *Client) createOrUpdateCustomFieldOption(
+	ctx context.Context, path string, option CustomFieldOption,
+) (CustomFieldOption, error) {
+	var data struct {
+		CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+	}
+	data.CustomFieldOption = option
+
+	body, err := z.post(ctx, path+".json", data)
+	if err != nil {
+		return CustomFieldOption{}, err
+	}
+
+	return unmarshalCustomFieldOption(body)
+}
+
+func unmarshalCustomFieldOption(body []byte) (CustomFieldOption, error) {
+	var result struct {
+		CustomFieldOption CustomFieldOption `json:"custom_field_option"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomFieldOption{}, err
+	}
+	return result.CustomFieldOption, nil
 }
@@ -7,6 +7,23 @@ import (
 	"time"
 )
 
+// CustomObject is the definition of a custom object, whose records have the fields of the object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/
+type CustomObject struct {
+	URL                string     `json:"url,omitempty"`
+	Key                string     `json:"key,omitempty"`
+	Title              string     `json:"title,omitempty"`
+	RawTitle           string     `json:"raw_title,omitempty"`
+	TitlePluralized    string     `json:"title_pluralized,omitempty"`
+	RawTitlePluralized string     `json:"raw_title_pluralized,omitempty"`
+	Description        string     `json:"description,omitempty"`
+	RawDescription     string     `json:"raw_description,omitempty"`
+	CreatedByUserID    string     `json:"created_by_user_id,omitempty"`
+	UpdatedByUserID    string     `json:"updated_by_user_id,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+}
+
 type CustomObjectRecord struct {
 	Url                string                 `json:"url,omitempty"`
 	Name               string                 `json:"name,omitempty"`
@@ -40,6 +57,19 @@ type CustomObjectAPI interface {
 	UpdateCustomObjectRecord(
 		ctx context.Context, customObjectKey string, customObjectRecordID string, record CustomObjectRecord,
 	) (*CustomObjectRecord, error)
+	ListCustomObjectRecordsCBP(
+		ctx context.Context, customObjectKey string, opts *CustomObjectRecordCBPOptions,
+	) ([]CustomObjectRecord, CursorPaginationMeta, error)
+	UpsertCustomObjectRecord(
+		ctx context.Context, customObjectKey string, externalID string, record CustomObjectRecord,
+	) (*CustomObjectRecord, error)
+	DeleteCustomObjectRecord(ctx context.Context, customObjectKey string, customObjectRecordID string) error
+	DeleteCustomObjectRecordByExternalID(ctx context.Context, customObjectKey string, externalID string) error
+	ListCustomObjects(ctx context.Context) ([]CustomObject, error)
+	GetCustomObject(ctx context.Context, customObjectKey string) (CustomObject, error)
+	CreateCustomObject(ctx context.Context, customObject CustomObject) (CustomObject, error)
+	UpdateCustomObject(ctx context.Context, customObjectKey string, customObject CustomObject) (CustomObject, error)
+	DeleteCustomObject(ctx context.Context, customObjectKey string) error
 }
 
 // CustomObjectAutocompleteOptions custom object search options
@@ -213,3 +243,180 @@ func (z *Client) UpdateCustomObjectRecord(
 	}
 	return &result.CustomObjectRecord, nil
 }
+
+// CustomObjectRecordCBPOptions custom object record list options with cursor pagination
+type CustomObjectRecordCBPOptions struct {
+	CursorPagination
+	Ids         string `url:"filter[ids],omitempty"`
+	ExternalIds string `url:"filter[external_ids],omitempty"`
+
+	// One of id, updated_at, -id, or -updated_at. The - denotes the sort will be descending.
+	Sort string `url:"sort,omitempty"`
+}
+
+// customObjectRecordExternalIDOptions selects a custom object record by its external id
+type customObjectRecordExternalIDOptions struct {
+	ExternalID string `url:"external_id"`
+}
+
+// ListCustomObjectRecordsCBP lists the records of a custom object with cursor pagination
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#list-custom-object-records
+func (z *Client) ListCustomObjectRecordsCBP(
+	ctx context.Context, customObjectKey string, opts *CustomObjectRecordCBPOptions,
+) ([]CustomObjectRecord, CursorPaginationMeta, error) {
+	var result struct {
+		CustomObjectRecords []CustomObjectRecord `json:"custom_object_records"`
+		Meta                CursorPaginationMeta `json:"meta"`
+	}
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomObjectRecordCBPOptions{}
+	}
+	url := fmt.Sprintf("/custom_objects/%s/records", customObjectKey)
+	urlWithOptions, err := addOptions(url, tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, urlWithOptions)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.CustomObjectRecords, result.Meta, nil
+}
+
+// UpsertCustomObjectRecord creates a custom object record, or updates it if a record has the external id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#set-custom-object-record-by-external-id-or-name
+func (z *Client) UpsertCustomObjectRecord(
+	ctx context.Context, customObjectKey string, externalID string, record CustomObjectRecord,
+) (*CustomObjectRecord, error) {
+	var data, result struct {
+		CustomObjectRecord CustomObjectRecord `json:"custom_object_record"`
+	}
+	data.CustomObjectRecord = record
+
+	url := fmt.Sprintf("/custom_objects/%s/records", customObjectKey)
+	urlWithOptions, err := addOptions(url, customObjectRecordExternalIDOptions{ExternalID: externalID})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.patch(ctx, urlWithOptions, data)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.CustomObjectRecord, nil
+}
+
+// DeleteCustomObjectRecord deletes a custom object record by id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#delete-custom-object-record
+func (z *Client) DeleteCustomObjectRecord(
+	ctx context.Context, customObjectKey string, customObjectRecordID string,
+) error {
+	url := fmt.Sprintf("/custom_objects/%s/records/%s", customObjectKey, customObjectRecordID)
+	return z.delete(ctx, url, nil)
+}
+
+// DeleteCustomObjectRecordByExternalID deletes a custom object record by external id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#delete-custom-object-record-by-external-id-or-name
+func (z *Client) DeleteCustomObjectRecordByExternalID(
+	ctx context.Context, customObjectKey string, externalID string,
+) error {
+	url := fmt.Sprintf("/custom_objects/%s/records", customObjectKey)
+	urlWithOptions, err := addOptions(url, customObjectRecordExternalIDOptions{ExternalID: externalID})
+	if err != nil {
+		return err
+	}
+	return z.delete(ctx, urlWithOptions, nil)
+}
+
+// ListCustomObjects lists the custom objects of the account
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#list-custom-objects
+func (z *Client) ListCustomObjects(ctx context.Context) ([]CustomObject, error) {
+	var result struct {
+		CustomObjects []CustomObject `json:"custom_objects"`
+	}
+
+	body, err := z.get(ctx, "/custom_objects")
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.CustomObjects, nil
+}
+
+// GetCustomObject returns the definition of a custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#show-custom-object
+func (z *Client) GetCustomObject(ctx context.Context, customObjectKey string) (CustomObject, error) {
+	var result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_objects/%s", customObjectKey))
+	if err != nil {
+		return CustomObject{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	return result.CustomObject, nil
+}
+
+// CreateCustomObject creates a custom object. Key, Title and TitlePluralized are required,
+// the key cannot be changed afterwards.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#create-custom-object
+func (z *Client) CreateCustomObject(ctx context.Context, customObject CustomObject) (CustomObject, error) {
+	var data, result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+	data.CustomObject = customObject
+
+	body, err := z.post(ctx, "/custom_objects", data)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	return result.CustomObject, nil
+}
+
+// UpdateCustomObject updates the titles and the description of a custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#update-custom-object
+func (z *Client) UpdateCustomObject(
+	ctx context.Context, customObjectKey string, customObject CustomObject,
+) (CustomObject, error) {
+	var data, result struct {
+		CustomObject CustomObject `json:"custom_object"`
+	}
+	data.CustomObject = customObject
+
+	body, err := z.patch(ctx, fmt.Sprintf("/custom_objects/%s", customObjectKey), data)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObject{}, err
+	}
+	return result.CustomObject, nil
+}
+
+// DeleteCustomObject deletes a custom object. It fails while the object has records.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_objects/#delete-custom-object
+func (z *Client) DeleteCustomObject(ctx context.Context, customObjectKey string) error {
+	return z.delete(ctx, fmt.Sprintf("/custom_objects/%s", customObjectKey), nil)
+}
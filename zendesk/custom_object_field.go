@@ -0,0 +1,179 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CustomObjectFieldTypeLookup is the type of a lookup relationship field
+const CustomObjectFieldTypeLookup = "lookup"
+
+// Relationship target types of the lookup relationship fields
+const (
+	RelationshipTargetTypeUser         = "zen:user"
+	RelationshipTargetTypeOrganization = "zen:organization"
+	RelationshipTargetTypeTicket       = "zen:ticket"
+)
+
+// CustomObjectRelationshipTargetType returns the relationship target type of the records of a custom object
+func CustomObjectRelationshipTargetType(customObjectKey string) string {
+	return "zen:custom_object:" + customObjectKey
+}
+
+// CustomObjectField is a field of a custom object.
+// A lookup relationship field has the type CustomObjectFieldTypeLookup and a RelationshipTargetType.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/
+type CustomObjectField struct {
+	ID                     int64               `json:"id,omitempty"`
+	URL                    string              `json:"url,omitempty"`
+	Key                    string              `json:"key,omitempty"`
+	Type                   string              `json:"type,omitempty"`
+	Title                  string              `json:"title,omitempty"`
+	RawTitle               string              `json:"raw_title,omitempty"`
+	Description            string              `json:"description,omitempty"`
+	RawDescription         string              `json:"raw_description,omitempty"`
+	Position               int64               `json:"position,omitempty"`
+	Active                 *bool               `json:"active,omitempty"`
+	System                 bool                `json:"system,omitempty"`
+	RegexpForValidation    string              `json:"regexp_for_validation,omitempty"`
+	Tag                    string              `json:"tag,omitempty"`
+	CustomFieldOptions     []CustomFieldOption `json:"custom_field_options,omitempty"`
+	RelationshipTargetType string              `json:"relationship_target_type,omitempty"`
+	RelationshipFilter     *RelationshipFilter `json:"relationship_filter,omitempty"`
+	CreatedAt              *time.Time          `json:"created_at,omitempty"`
+	UpdatedAt              *time.Time          `json:"updated_at,omitempty"`
+}
+
+// IsLookup returns true if the field is a lookup relationship field
+func (f CustomObjectField) IsLookup() bool {
+	return f.Type == CustomObjectFieldTypeLookup
+}
+
+// CustomObjectFieldListOptions custom object field list options
+type CustomObjectFieldListOptions struct {
+	// IncludeStandardFields includes the standard fields, such as name and external_id
+	IncludeStandardFields bool `url:"include_standard_fields,omitempty"`
+}
+
+// CustomObjectFieldAPI an interface containing all custom object field related methods
+type CustomObjectFieldAPI interface {
+	ListCustomObjectFields(
+		ctx context.Context, customObjectKey string, opts *CustomObjectFieldListOptions,
+	) ([]CustomObjectField, error)
+	GetCustomObjectField(ctx context.Context, customObjectKey string, fieldKeyOrID string) (CustomObjectField, error)
+	CreateCustomObjectField(
+		ctx context.Context, customObjectKey string, field CustomObjectField) (CustomObjectField, error)
+	UpdateCustomObjectField(
+		ctx context.Context, customObjectKey string, fieldKeyOrID string, field CustomObjectField,
+	) (CustomObjectField, error)
+	DeleteCustomObjectField(ctx context.Context, customObjectKey string, fieldKeyOrID string) error
+	ReorderCustomObjectFields(ctx context.Context, customObjectKey string, fieldIDs []int64) error
+}
+
+// ListCustomObjectFields lists the fields of a custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#list-custom-object-fields
+func (z *Client) ListCustomObjectFields(
+	ctx context.Context, customObjectKey string, opts *CustomObjectFieldListOptions,
+) ([]CustomObjectField, error) {
+	var result struct {
+		CustomObjectFields []CustomObjectField `json:"custom_object_fields"`
+	}
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomObjectFieldListOptions{}
+	}
+	url := fmt.Sprintf("/custom_objects/%s/fields", customObjectKey)
+	urlWithOptions, err := addOptions(url, tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, urlWithOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.CustomObjectFields, nil
+}
+
+// GetCustomObjectField returns a field of a custom object by key or id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#show-custom-object-field
+func (z *Client) GetCustomObjectField(
+	ctx context.Context, customObjectKey string, fieldKeyOrID string,
+) (CustomObjectField, error) {
+	body, err := z.get(ctx, fmt.Sprintf("/custom_objects/%s/fields/%s", customObjectKey, fieldKeyOrID))
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	return unmarshalCustomObjectField(body)
+}
+
+// CreateCustomObjectField creates a field of a custom object. Key, Type and Title are required,
+// and a lookup relationship field also requires RelationshipTargetType.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#create-custom-object-field
+func (z *Client) CreateCustomObjectField(
+	ctx context.Context, customObjectKey string, field CustomObjectField,
+) (CustomObjectField, error) {
+	var data struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+	data.CustomObjectField = field
+
+	body, err := z.post(ctx, fmt.Sprintf("/custom_objects/%s/fields", customObjectKey), data)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	return unmarshalCustomObjectField(body)
+}
+
+// UpdateCustomObjectField updates a field of a custom object by key or id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#update-custom-object-field
+func (z *Client) UpdateCustomObjectField(
+	ctx context.Context, customObjectKey string, fieldKeyOrID string, field CustomObjectField,
+) (CustomObjectField, error) {
+	var data struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+	data.CustomObjectField = field
+
+	body, err := z.patch(ctx, fmt.Sprintf("/custom_objects/%s/fields/%s", customObjectKey, fieldKeyOrID), data)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	return unmarshalCustomObjectField(body)
+}
+
+// DeleteCustomObjectField deletes a field of a custom object by key or id
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#delete-custom-object-field
+func (z *Client) DeleteCustomObjectField(ctx context.Context, customObjectKey string, fieldKeyOrID string) error {
+	return z.delete(ctx, fmt.Sprintf("/custom_objects/%s/fields/%s", customObjectKey, fieldKeyOrID), nil)
+}
+
+// ReorderCustomObjectFields sets the order of the fields of a custom object
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_fields/#reorder-custom-fields-of-an-object
+func (z *Client) ReorderCustomObjectFields(ctx context.Context, customObjectKey string, fieldIDs []int64) error {
+	var data struct {
+		CustomObjectFieldIDs []int64 `json:"custom_object_field_ids"`
+	}
+	data.CustomObjectFieldIDs = fieldIDs
+
+	_, err := z.put(ctx, fmt.Sprintf("/custom_objects/%s/fields/reorder", customObjectKey), data)
+	return err
+}
+
+func unmarshalCustomObjectField(body []byte) (CustomObjectField, error) {
+	var result struct {
+		CustomObjectField CustomObjectField `json:"custom_object_field"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectField{}, err
+	}
+	return result.CustomObjectField, nil
+}
@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListCustomObjectFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/custom_objects/asset/fields" || r.URL.Query().Get("include_standard_fields") != "true" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/custom_object_fields.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	fields, err := client.ListCustomObjectFields(ctx, "asset", &CustomObjectFieldListOptions{IncludeStandardFields: true})
+	if err != nil {
+		t.Fatalf("Failed to list custom object fields: %s", err)
+	}
+
+	if len(fields) != 2 || fields[0].IsLookup() || !fields[1].IsLookup() {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+	if fields[1].RelationshipFilter == nil || fields[1].RelationshipFilter.All[0].Value != "agent" {
+		t.Fatalf("unexpected relationship filter: %v", fields[1].RelationshipFilter)
+	}
+}
+
+func TestCreateCustomObjectLookupField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			CustomObjectField map[string]interface{} `json:"custom_object_field"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode body: %s", err)
+		}
+		if data.CustomObjectField["relationship_target_type"] != "zen:custom_object:asset" {
+			t.Fatalf("unexpected body: %v", data)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/custom_object_field.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateCustomObjectField(ctx, "asset", CustomObjectField{
+		Key:                    "parent_asset",
+		Type:                   CustomObjectFieldTypeLookup,
+		Title:                  "Parent asset",
+		RelationshipTargetType: CustomObjectRelationshipTargetType("asset"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create custom object field: %s", err)
+	}
+}
+
+func TestReorderCustomObjectFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/custom_objects/asset/fields/reorder" ||
+			string(body) != `{"custom_object_field_ids":[2,1]}` {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderCustomObjectFields(ctx, "asset", []int64{2, 1})
+	if err != nil {
+		t.Fatalf("Failed to reorder custom object fields: %s", err)
+	}
+}
+
+func TestDeleteCustomObjectField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/custom_objects/asset/fields/serial_number" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteCustomObjectField(ctx, "asset", "serial_number")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object field: %s", err)
+	}
+}
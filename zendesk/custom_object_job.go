@@ -0,0 +1,188 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Custom object records job actions
+const (
+	CustomObjectRecordsJobActionCreate             = "create"
+	CustomObjectRecordsJobActionUpdate             = "update"
+	CustomObjectRecordsJobActionCreateOrUpdate     = "create_or_update"
+	CustomObjectRecordsJobActionDelete             = "delete"
+	CustomObjectRecordsJobActionDeleteByExternalID = "delete_by_external_id"
+)
+
+// CustomObjectRecordsJobLimit is the maximum number of items of a custom object records job
+const CustomObjectRecordsJobLimit = 100
+
+// CustomObjectRecordsJobPollInterval is the default interval between two polls of WaitCustomObjectRecordsJob
+const CustomObjectRecordsJobPollInterval = 2 * time.Second
+
+// CustomObjectRecordsJobStatus is the status of a custom object records job.
+// It is a JobStatus whose results refer to the records by their string ids.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+type CustomObjectRecordsJobStatus struct {
+	ID       string                         `json:"id"`
+	URL      string                         `json:"url,omitempty"`
+	Total    int64                          `json:"total,omitempty"`
+	Progress int64                          `json:"progress,omitempty"`
+	Status   string                         `json:"status"`
+	Message  string                         `json:"message,omitempty"`
+	Results  []CustomObjectRecordsJobResult `json:"results,omitempty"`
+}
+
+// CustomObjectRecordsJobResult is the result of a single item of a custom object records job
+type CustomObjectRecordsJobResult struct {
+	ID         string `json:"id,omitempty"`
+	Index      int64  `json:"index,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+	Success    bool   `json:"success,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Details    string `json:"details,omitempty"`
+}
+
+// Done returns true when the job is not queued nor running anymore
+func (j CustomObjectRecordsJobStatus) Done() bool {
+	return JobStatus{Status: j.Status}.Done()
+}
+
+// CustomObjectRecordsJobAPI an interface containing all custom object records job related methods
+type CustomObjectRecordsJobAPI interface {
+	CreateManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+	) (CustomObjectRecordsJobStatus, error)
+	UpdateManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+	) (CustomObjectRecordsJobStatus, error)
+	CreateOrUpdateManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+	) (CustomObjectRecordsJobStatus, error)
+	DeleteManyCustomObjectRecords(
+		ctx context.Context, customObjectKey string, recordIDs []string,
+	) (CustomObjectRecordsJobStatus, error)
+	DeleteManyCustomObjectRecordsByExternalID(
+		ctx context.Context, customObjectKey string, externalIDs []string,
+	) (CustomObjectRecordsJobStatus, error)
+	GetCustomObjectRecordsJobStatus(ctx context.Context, id string) (CustomObjectRecordsJobStatus, error)
+	WaitCustomObjectRecordsJob(
+		ctx context.Context, id string, interval time.Duration) (CustomObjectRecordsJobStatus, error)
+}
+
+// CreateManyCustomObjectRecords creates up to CustomObjectRecordsJobLimit records in a background job
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) CreateManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+) (CustomObjectRecordsJobStatus, error) {
+	return z.postCustomObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordsJobActionCreate, records)
+}
+
+// UpdateManyCustomObjectRecords updates up to CustomObjectRecordsJobLimit records in a background job.
+// The records are matched by ID.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) UpdateManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+) (CustomObjectRecordsJobStatus, error) {
+	return z.postCustomObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordsJobActionUpdate, records)
+}
+
+// CreateOrUpdateManyCustomObjectRecords creates or updates up to CustomObjectRecordsJobLimit records
+// in a background job. The records are matched by ExternalID.
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) CreateOrUpdateManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, records []CustomObjectRecord,
+) (CustomObjectRecordsJobStatus, error) {
+	return z.postCustomObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordsJobActionCreateOrUpdate, records)
+}
+
+// DeleteManyCustomObjectRecords deletes up to CustomObjectRecordsJobLimit records by id in a background job
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) DeleteManyCustomObjectRecords(
+	ctx context.Context, customObjectKey string, recordIDs []string,
+) (CustomObjectRecordsJobStatus, error) {
+	return z.postCustomObjectRecordsJob(ctx, customObjectKey, CustomObjectRecordsJobActionDelete, recordIDs)
+}
+
+// DeleteManyCustomObjectRecordsByExternalID deletes up to CustomObjectRecordsJobLimit records by external id
+// in a background job
+// https://developer.zendesk.com/api-reference/custom-objects/custom_object_records/#custom-object-record-bulk-jobs
+func (z *Client) DeleteManyCustomObjectRecordsByExternalID(
+	ctx context.Context, customObjectKey string, externalIDs []string,
+) (CustomObjectRecordsJobStatus, error) {
+	return z.postCustomObjectRecordsJob(
+		ctx, customObjectKey, CustomObjectRecordsJobActionDeleteByExternalID, externalIDs)
+}
+
+// GetCustomObjectRecordsJobStatus shows the status of a custom object records job
+// https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-job-status
+func (z *Client) GetCustomObjectRecordsJobStatus(ctx context.Context, id string) (CustomObjectRecordsJobStatus, error) {
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/%s.json", id))
+	if err != nil {
+		return CustomObjectRecordsJobStatus{}, err
+	}
+	return unmarshalCustomObjectRecordsJobStatus(body)
+}
+
+// WaitCustomObjectRecordsJob polls the status of a custom object records job every interval until it is done,
+// and returns its last status. A zero interval uses CustomObjectRecordsJobPollInterval.
+func (z *Client) WaitCustomObjectRecordsJob(
+	ctx context.Context, id string, interval time.Duration,
+) (CustomObjectRecordsJobStatus, error) {
+	if interval <= 0 {
+		interval = CustomObjectRecordsJobPollInterval
+	}
+
+	for {
+		status, err := z.GetCustomObjectRecordsJobStatus(ctx, id)
+		if err != nil {
+			return CustomObjectRecordsJobStatus{}, err
+		}
+		if status.Done() {
+			return status, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// postCustomObjectRecordsJob queues a job of action on items, records or ids depending on the action
+func (z *Client) postCustomObjectRecordsJob(
+	ctx context.Context, customObjectKey string, action string, items interface{},
+) (CustomObjectRecordsJobStatus, error) {
+	var data struct {
+		Job struct {
+			Action string      `json:"action"`
+			Items  interface{} `json:"items"`
+		} `json:"job"`
+	}
+	data.Job.Action = action
+	data.Job.Items = items
+
+	body, err := z.post(ctx, fmt.Sprintf("/custom_objects/%s/jobs", customObjectKey), data)
+	if err != nil {
+		return CustomObjectRecordsJobStatus{}, err
+	}
+	return unmarshalCustomObjectRecordsJobStatus(body)
+}
+
+func unmarshalCustomObjectRecordsJobStatus(body []byte) (CustomObjectRecordsJobStatus, error) {
+	var result struct {
+		JobStatus CustomObjectRecordsJobStatus `json:"job_status"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomObjectRecordsJobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
@@ -0,0 +1,85 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCreateOrUpdateManyCustomObjectRecords(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/custom_objects/asset/jobs" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if !strings.HasPrefix(string(body), `{"job":{"action":"create_or_update","items":[{"name":"Laptop 1"`) ||
+			!strings.Contains(string(body), `"external_id":"asset-1"`) {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("POST/custom_object_records_job.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.CreateOrUpdateManyCustomObjectRecords(ctx, "asset", []CustomObjectRecord{{
+		Name:               "Laptop 1",
+		ExternalID:         "asset-1",
+		CustomObjectFields: map[string]interface{}{"serial_number": "SN-asset-1"},
+	}})
+	if err != nil {
+		t.Fatalf("Failed to queue custom object records job: %s", err)
+	}
+
+	if job.ID != "V3-2a8e9d57c5b3c5e8c9f0a1b2" || job.Done() {
+		t.Fatalf("unexpected job status: %v", job)
+	}
+}
+
+func TestDeleteManyCustomObjectRecordsByExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"job":{"action":"delete_by_external_id","items":["asset-1","asset-2"]}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("POST/custom_object_records_job.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.DeleteManyCustomObjectRecordsByExternalID(ctx, "asset", []string{"asset-1", "asset-2"})
+	if err != nil {
+		t.Fatalf("Failed to queue custom object records job: %s", err)
+	}
+}
+
+func TestWaitCustomObjectRecordsJob(t *testing.T) {
+	polls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/job_statuses/V3-2a8e9d57c5b3c5e8c9f0a1b2.json" {
+			t.Fatalf("unexpected request: %s", r.URL.Path)
+		}
+		polls++
+		if polls == 1 {
+			w.Write(readFixture("POST/custom_object_records_job.json"))
+			return
+		}
+		w.Write(readFixture("GET/custom_object_records_job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.WaitCustomObjectRecordsJob(ctx, "V3-2a8e9d57c5b3c5e8c9f0a1b2", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to wait for custom object records job: %s", err)
+	}
+
+	if polls != 2 || job.Status != JobStatusCompleted {
+		t.Fatalf("unexpected job status after %d polls: %v", polls, job)
+	}
+	if len(job.Results) != 2 || job.Results[1].ID != "01GDXYD7ZTWYP542BA8MDDTE37" {
+		t.Fatalf("unexpected job results: %v", job.Results)
+	}
+}
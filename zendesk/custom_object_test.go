@@ -0,0 +1,142 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListCustomObjectRecordsCBP(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/custom_objects/asset/records" ||
+			query.Get("page[size]") != "2" || query.Get("filter[external_ids]") != "asset-1,asset-2" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/custom_object_records.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	records, meta, err := client.ListCustomObjectRecordsCBP(ctx, "asset", &CustomObjectRecordCBPOptions{
+		CursorPagination: CursorPagination{PageSize: 2},
+		ExternalIds:      "asset-1,asset-2",
+	})
+	if err != nil {
+		t.Fatalf("Failed to list custom object records: %s", err)
+	}
+
+	if len(records) != 2 || records[1].ExternalID != "asset-2" {
+		t.Fatalf("unexpected records: %v", records)
+	}
+	if !meta.HasMore || meta.AfterCursor != "xxx" {
+		t.Fatalf("unexpected meta: %v", meta)
+	}
+}
+
+func TestUpsertCustomObjectRecord(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/custom_objects/asset/records" ||
+			r.URL.Query().Get("external_id") != "asset-1" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("PUT/custom_object_record.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	record, err := client.UpsertCustomObjectRecord(ctx, "asset", "asset-1", CustomObjectRecord{
+		Name:               "Laptop 1",
+		CustomObjectFields: map[string]interface{}{"serial_number": "SN-asset-1"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to upsert custom object record: %s", err)
+	}
+
+	if record.ID != "01GDXYD7ZTWYP542BA8MDDTE36" {
+		t.Fatalf("unexpected record: %v", record)
+	}
+}
+
+func TestDeleteCustomObjectRecord(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/custom_objects/asset/records/01GDXYD7ZTWYP542BA8MDDTE36" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteCustomObjectRecord(ctx, "asset", "01GDXYD7ZTWYP542BA8MDDTE36")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object record: %s", err)
+	}
+}
+
+func TestDeleteCustomObjectRecordByExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("external_id") != "asset-1" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteCustomObjectRecordByExternalID(ctx, "asset", "asset-1")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object record: %s", err)
+	}
+}
+
+func TestListCustomObjects(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_objects.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	objects, err := client.ListCustomObjects(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list custom objects: %s", err)
+	}
+
+	if len(objects) != 1 || objects[0].Key != "asset" {
+		t.Fatalf("unexpected custom objects: %v", objects)
+	}
+}
+
+func TestCreateCustomObject(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "custom_object.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	object, err := client.CreateCustomObject(ctx, CustomObject{
+		Key:             "asset",
+		Title:           "Asset",
+		TitlePluralized: "Assets",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create custom object: %s", err)
+	}
+
+	if object.TitlePluralized != "Assets" {
+		t.Fatalf("unexpected custom object: %v", object)
+	}
+}
+
+func TestDeleteCustomObject(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/custom_objects/asset" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteCustomObject(ctx, "asset")
+	if err != nil {
+		t.Fatalf("Failed to delete custom object: %s", err)
+	}
+}
@@ -0,0 +1,164 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DeletedUser is a user which has been soft deleted and can still be permanently deleted
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-deleted-user
+type DeletedUser struct {
+	ID                int64       `json:"id"`
+	URL               string      `json:"url,omitempty"`
+	Name              string      `json:"name"`
+	Email             string      `json:"email,omitempty"`
+	Active            bool        `json:"active"`
+	Locale            string      `json:"locale,omitempty"`
+	LocaleID          int64       `json:"locale_id,omitempty"`
+	OrganizationID    int64       `json:"organization_id,omitempty"`
+	Phone             string      `json:"phone,omitempty"`
+	Photo             *Attachment `json:"photo,omitempty"`
+	Role              string      `json:"role,omitempty"`
+	SharedPhoneNumber bool        `json:"shared_phone_number,omitempty"`
+	Timezone          string      `json:"time_zone,omitempty"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+}
+
+// ComplianceDeletionStatus is a step of the GDPR deletion of a user in a Zendesk application
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-compliance-deletion-statuses
+type ComplianceDeletionStatus struct {
+	Action           string    `json:"action"`
+	Application      string    `json:"application"`
+	AccountSubdomain string    `json:"account_subdomain"`
+	ExecuterID       int64     `json:"executer_id"`
+	UserID           int64     `json:"user_id"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// DeletedUserListOptions is options for GetDeletedUsers
+type DeletedUserListOptions struct {
+	CursorPagination
+}
+
+// ComplianceDeletionStatusOptions is options for GetComplianceDeletionStatuses
+type ComplianceDeletionStatusOptions struct {
+	// Application can take "all", "chat", "guide", "support" or "talk" among others
+	Application string `url:"application,omitempty"`
+}
+
+// DeletedUserAPI an interface containing all deleted user related methods
+type DeletedUserAPI interface {
+	GetDeletedUsers(ctx context.Context, opts *DeletedUserListOptions) ([]DeletedUser, CursorPaginationMeta, error)
+	GetDeletedUser(ctx context.Context, userID int64) (DeletedUser, error)
+	PermanentlyDeleteUser(ctx context.Context, userID int64) (DeletedUser, error)
+	GetComplianceDeletionStatuses(
+		ctx context.Context, userID int64, opts *ComplianceDeletionStatusOptions) ([]ComplianceDeletionStatus, error)
+	RedactUser(ctx context.Context, userID int64, opts *RedactUserOptions) ([]ComplianceDeletionStatus, error)
+}
+
+// GetDeletedUsers lists the users which have been deleted but not permanently deleted
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-deleted-users
+func (z *Client) GetDeletedUsers(
+	ctx context.Context, opts *DeletedUserListOptions,
+) ([]DeletedUser, CursorPaginationMeta, error) {
+	var result struct {
+		DeletedUsers []DeletedUser        `json:"deleted_users"`
+		Meta         CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &DeletedUserListOptions{}
+	}
+
+	u, err := addOptions("/deleted_users.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.DeletedUsers, result.Meta, nil
+}
+
+// GetDeletedUser returns the deleted user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-deleted-user
+func (z *Client) GetDeletedUser(ctx context.Context, userID int64) (DeletedUser, error) {
+	var result struct {
+		DeletedUser DeletedUser `json:"deleted_user"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return DeletedUser{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return DeletedUser{}, err
+	}
+	return result.DeletedUser, nil
+}
+
+// PermanentlyDeleteUser permanently deletes a deleted user for GDPR compliance.
+// The user must have been deleted before.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#permanently-delete-user
+func (z *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) (DeletedUser, error) {
+	var result struct {
+		DeletedUser DeletedUser `json:"deleted_user"`
+	}
+
+	body, err := z.deleteWithResponse(ctx, fmt.Sprintf("/deleted_users/%d.json", userID))
+	if err != nil {
+		return DeletedUser{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return DeletedUser{}, err
+	}
+	return result.DeletedUser, nil
+}
+
+// GetComplianceDeletionStatuses returns the GDPR deletion statuses of the user in each application
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-compliance-deletion-statuses
+func (z *Client) GetComplianceDeletionStatuses(
+	ctx context.Context, userID int64, opts *ComplianceDeletionStatusOptions,
+) ([]ComplianceDeletionStatus, error) {
+	var result struct {
+		ComplianceDeletionStatuses []ComplianceDeletionStatus `json:"compliance_deletion_statuses"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ComplianceDeletionStatusOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/users/%d/compliance_deletion_statuses.json", userID), tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.ComplianceDeletionStatuses, nil
+}
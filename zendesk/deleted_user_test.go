@@ -0,0 +1,80 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetDeletedUsers(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deleted_users.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.GetDeletedUsers(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get deleted users: %s", err)
+	}
+
+	if len(users) != 1 {
+		t.Fatalf("expected length of deleted users is 1, but got %d", len(users))
+	}
+}
+
+func TestGetDeletedUser(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deleted_user.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.GetDeletedUser(ctx, 189304711533)
+	if err != nil {
+		t.Fatalf("Failed to get deleted user: %s", err)
+	}
+
+	if user.ID != 189304711533 || user.Active {
+		t.Fatalf("unexpected deleted user: %+v", user)
+	}
+}
+
+func TestPermanentlyDeleteUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/deleted_users/189304711533.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("GET/deleted_user.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.PermanentlyDeleteUser(ctx, 189304711533)
+	if err != nil {
+		t.Fatalf("Failed to permanently delete user: %s", err)
+	}
+
+	if user.ID != 189304711533 {
+		t.Fatalf("unexpected deleted user id: %d", user.ID)
+	}
+}
+
+func TestGetComplianceDeletionStatuses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/189304711533/compliance_deletion_statuses.json" ||
+			r.URL.Query().Get("application") != "support" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/compliance_deletion_statuses.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetComplianceDeletionStatuses(ctx, 189304711533, &ComplianceDeletionStatusOptions{
+		Application: "support",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get compliance deletion statuses: %s", err)
+	}
+
+	if len(statuses) != 2 || statuses[1].Action != "complete" {
+		t.Fatalf("unexpected compliance deletion statuses: %+v", statuses)
+	}
+}
@@ -0,0 +1,141 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DeletionScheduleCondition is a condition of a deletion schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/data-deletion/deletion_schedules/#conditions
+type DeletionScheduleCondition struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// DeletionScheduleConditions are the conditions of a deletion schedule, all of All and
+// at least one of Any must match
+type DeletionScheduleConditions struct {
+	All []DeletionScheduleCondition `json:"all,omitempty"`
+	Any []DeletionScheduleCondition `json:"any,omitempty"`
+}
+
+// DeletionSchedule is a rule deleting automatically the objects matching its conditions,
+// such as the tickets closed for more than a year
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/data-deletion/deletion_schedules/#json-format
+type DeletionSchedule struct {
+	ID          int64  `json:"id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	Active      *bool  `json:"active,omitempty"`
+	Default     bool   `json:"default,omitempty"`
+	// Object is the type of the deleted objects, such as "ticket"
+	Object     string                      `json:"object,omitempty"`
+	Conditions *DeletionScheduleConditions `json:"conditions,omitempty"`
+	CreatedAt  *time.Time                  `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time                  `json:"updated_at,omitempty"`
+}
+
+// DeletionScheduleAPI an interface containing all deletion schedule related methods
+type DeletionScheduleAPI interface {
+	GetDeletionSchedules(ctx context.Context) ([]DeletionSchedule, error)
+	CreateDeletionSchedule(ctx context.Context, schedule DeletionSchedule) (DeletionSchedule, error)
+	GetDeletionSchedule(ctx context.Context, id int64) (DeletionSchedule, error)
+	UpdateDeletionSchedule(ctx context.Context, id int64, schedule DeletionSchedule) (DeletionSchedule, error)
+	DeleteDeletionSchedule(ctx context.Context, id int64) error
+}
+
+// GetDeletionSchedules lists the deletion schedules of the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/data-deletion/deletion_schedules/#list-deletion-schedules
+func (z *Client) GetDeletionSchedules(ctx context.Context) ([]DeletionSchedule, error) {
+	var result struct {
+		DeletionSchedules []DeletionSchedule `json:"deletion_schedules"`
+	}
+
+	body, err := z.get(ctx, "/deletion_schedules")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.DeletionSchedules, nil
+}
+
+// CreateDeletionSchedule creates a deletion schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/data-deletion/deletion_schedules/#create-deletion-schedule
+func (z *Client) CreateDeletionSchedule(ctx context.Context, schedule DeletionSchedule) (DeletionSchedule, error) {
+	var data, result struct {
+		DeletionSchedule DeletionSchedule `json:"deletion_schedule"`
+	}
+	data.DeletionSchedule = schedule
+
+	body, err := z.post(ctx, "/deletion_schedules", data)
+	if err != nil {
+		return DeletionSchedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return DeletionSchedule{}, err
+	}
+	return result.DeletionSchedule, nil
+}
+
+// GetDeletionSchedule gets a deletion schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/data-deletion/deletion_schedules/#show-deletion-schedule
+func (z *Client) GetDeletionSchedule(ctx context.Context, id int64) (DeletionSchedule, error) {
+	var result struct {
+		DeletionSchedule DeletionSchedule `json:"deletion_schedule"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/deletion_schedules/%d", id))
+	if err != nil {
+		return DeletionSchedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return DeletionSchedule{}, err
+	}
+	return result.DeletionSchedule, nil
+}
+
+// UpdateDeletionSchedule updates a deletion schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/data-deletion/deletion_schedules/#update-deletion-schedule
+func (z *Client) UpdateDeletionSchedule(
+	ctx context.Context, id int64, schedule DeletionSchedule,
+) (DeletionSchedule, error) {
+	var data, result struct {
+		DeletionSchedule DeletionSchedule `json:"deletion_schedule"`
+	}
+	data.DeletionSchedule = schedule
+
+	body, err := z.put(ctx, fmt.Sprintf("/deletion_schedules/%d", id), data)
+	if err != nil {
+		return DeletionSchedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return DeletionSchedule{}, err
+	}
+	return result.DeletionSchedule, nil
+}
+
+// DeleteDeletionSchedule deletes a deletion schedule
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/data-deletion/deletion_schedules/#delete-deletion-schedule
+func (z *Client) DeleteDeletionSchedule(ctx context.Context, id int64) error {
+	return z.delete(ctx, fmt.Sprintf("/deletion_schedules/%d", id), nil)
+}
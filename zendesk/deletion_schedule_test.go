@@ -0,0 +1,101 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetDeletionSchedules(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deletion_schedules.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedules, err := client.GetDeletionSchedules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get deletion schedules: %s", err)
+	}
+
+	if len(schedules) != 1 || schedules[0].Conditions == nil || len(schedules[0].Conditions.All) != 2 {
+		t.Fatalf("unexpected deletion schedules: %v", schedules)
+	}
+}
+
+func TestCreateDeletionSchedule(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "deletion_schedule.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := true
+	schedule, err := client.CreateDeletionSchedule(ctx, DeletionSchedule{
+		Title:  "Closed tickets older than a year",
+		Active: &active,
+		Object: "ticket",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create deletion schedule: %s", err)
+	}
+
+	if schedule.ID != 35436 {
+		t.Fatalf("expected id is 35436, but got %d", schedule.ID)
+	}
+}
+
+func TestGetDeletionSchedule(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "deletion_schedule.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedule, err := client.GetDeletionSchedule(ctx, 35436)
+	if err != nil {
+		t.Fatalf("Failed to get deletion schedule: %s", err)
+	}
+
+	if schedule.Object != "ticket" {
+		t.Fatalf("expected object is ticket, but got %s", schedule.Object)
+	}
+}
+
+func TestUpdateDeletionSchedule(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "deletion_schedule.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateDeletionSchedule(ctx, 35436, DeletionSchedule{Title: "Closed tickets older than a year"})
+	if err != nil {
+		t.Fatalf("Failed to update deletion schedule: %s", err)
+	}
+}
+
+func TestUpdateDeletionScheduleSendsOnlySetFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"deletion_schedule":{"active":false}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/deletion_schedule.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := false
+	if _, err := client.UpdateDeletionSchedule(ctx, 35436, DeletionSchedule{Active: &active}); err != nil {
+		t.Fatalf("Failed to update deletion schedule: %s", err)
+	}
+}
+
+func TestDeleteDeletionSchedule(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/deletion_schedules/35436" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteDeletionSchedule(ctx, 35436); err != nil {
+		t.Fatalf("Failed to delete deletion schedule: %s", err)
+	}
+}
@@ -57,3 +57,51 @@ type OptionsError struct {
 func (e *OptionsError) Error() string {
 	return fmt.Sprintf("invalid options: %v", e.opts)
 }
+
+// NotFoundError is an error type returned by lookups which found no resource matching the query.
+type NotFoundError struct {
+	// Resource is the type of the resource looked up, such as "user"
+	Resource string
+	// Query is the value looked up, such as an external id
+	Query string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found: %s", e.Resource, e.Query)
+}
+
+// SessionsDeletionError is an error type returned by BulkDeleteSessionsByUser
+// when the sessions of some users could not be deleted.
+type SessionsDeletionError struct {
+	// Errors is the error of each user whose sessions could not be deleted
+	Errors map[int64]error
+}
+
+func (e *SessionsDeletionError) Error() string {
+	return fmt.Sprintf("failed to delete the sessions of %d users", len(e.Errors))
+}
+
+// ArticleImportError is an error type returned by ImportArticles
+// when some items could not be imported.
+type ArticleImportError struct {
+	// Errors is the error of each item which could not be imported, by key
+	Errors map[string]error
+}
+
+func (e *ArticleImportError) Error() string {
+	return fmt.Sprintf("failed to import %d articles", len(e.Errors))
+}
+
+// AmbiguousMatchError is an error type returned by lookups which found several resources matching the query.
+type AmbiguousMatchError struct {
+	// Resource is the type of the resource looked up, such as "organization"
+	Resource string
+	// Query is the value looked up, such as an external id
+	Query string
+	// Count is the number of resources matching the query
+	Count int
+}
+
+func (e *AmbiguousMatchError) Error() string {
+	return fmt.Sprintf("%d %ss match: %s", e.Count, e.Resource, e.Query)
+}
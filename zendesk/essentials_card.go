@@ -0,0 +1,107 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EssentialsCard configures the fields shown for an object in the context panel of the agent workspace
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/objects/essentials_cards/#json-format
+type EssentialsCard struct {
+	ID               string                `json:"id,omitempty"`
+	Key              string                `json:"key,omitempty"`
+	Layout           string                `json:"layout,omitempty"`
+	Default          bool                  `json:"default,omitempty"`
+	MaxFieldsAllowed int64                 `json:"max_fields_allowed,omitempty"`
+	Fields           []EssentialsCardField `json:"fields"`
+	CreatedAt        *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time            `json:"updated_at,omitempty"`
+}
+
+// EssentialsCardField is a field shown by an EssentialsCard.
+// ID is the key of a system field such as "email", or the id of a custom field.
+type EssentialsCardField struct {
+	ID interface{} `json:"id"`
+}
+
+// EssentialsCardAPI an interface containing all essentials card related methods
+type EssentialsCardAPI interface {
+	GetEssentialsCards(ctx context.Context) ([]EssentialsCard, error)
+	GetEssentialsCard(ctx context.Context, objectType string) (EssentialsCard, error)
+	UpdateEssentialsCard(ctx context.Context, objectType string, card EssentialsCard) (EssentialsCard, error)
+	DeleteEssentialsCard(ctx context.Context, objectType string) error
+}
+
+// GetEssentialsCards returns the essentials cards of all objects
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/objects/essentials_cards/#show-essentials-cards
+func (z *Client) GetEssentialsCards(ctx context.Context) ([]EssentialsCard, error) {
+	var result struct {
+		ObjectLayouts []EssentialsCard `json:"object_layouts"`
+	}
+
+	body, err := z.get(ctx, "/object_layouts/essentials_cards.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.ObjectLayouts, nil
+}
+
+// GetEssentialsCard returns the essentials card of the object type such as "zen:user",
+// "zen:organization" or "zen:custom_object:{key}"
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/objects/essentials_cards/#show-essentials-card
+func (z *Client) GetEssentialsCard(ctx context.Context, objectType string) (EssentialsCard, error) {
+	var result struct {
+		EssentialsCard EssentialsCard `json:"essentials_card"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/object_layouts/%s/essentials_card.json", objectType))
+	if err != nil {
+		return EssentialsCard{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return EssentialsCard{}, err
+	}
+	return result.EssentialsCard, nil
+}
+
+// UpdateEssentialsCard updates the fields shown by the essentials card of the object type
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/objects/essentials_cards/#update-essentials-card
+func (z *Client) UpdateEssentialsCard(
+	ctx context.Context, objectType string, card EssentialsCard,
+) (EssentialsCard, error) {
+	var data, result struct {
+		EssentialsCard EssentialsCard `json:"essentials_card"`
+	}
+	data.EssentialsCard = card
+
+	body, err := z.put(ctx, fmt.Sprintf("/object_layouts/%s/essentials_card.json", objectType), data)
+	if err != nil {
+		return EssentialsCard{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return EssentialsCard{}, err
+	}
+	return result.EssentialsCard, nil
+}
+
+// DeleteEssentialsCard resets the essentials card of the object type to the default one
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/objects/essentials_cards/#delete-essentials-card
+func (z *Client) DeleteEssentialsCard(ctx context.Context, objectType string) error {
+	return z.delete(ctx, fmt.Sprintf("/object_layouts/%s/essentials_card.json", objectType), nil)
+}
@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetEssentialsCards(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "essentials_cards.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cards, err := client.GetEssentialsCards(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get essentials cards: %s", err)
+	}
+
+	if len(cards) != 2 {
+		t.Fatalf("expected length of essentials cards is 2, but got %d", len(cards))
+	}
+}
+
+func TestGetEssentialsCard(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/object_layouts/zen:user/essentials_card.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/essentials_card.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	card, err := client.GetEssentialsCard(ctx, "zen:user")
+	if err != nil {
+		t.Fatalf("Failed to get essentials card: %s", err)
+	}
+
+	if card.Key != "zen:user" || len(card.Fields) != 2 {
+		t.Fatalf("unexpected essentials card: %+v", card)
+	}
+}
+
+func TestUpdateEssentialsCard(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "essentials_card.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	card, err := client.UpdateEssentialsCard(ctx, "zen:user", EssentialsCard{
+		Fields: []EssentialsCardField{{ID: "email"}, {ID: 360009863234}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update essentials card: %s", err)
+	}
+
+	if card.ID != "01GBS0ZHM6VNF0QW4G5QCY7EJD" {
+		t.Fatalf("unexpected essentials card id: %s", card.ID)
+	}
+}
+
+func TestDeleteEssentialsCard(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteEssentialsCard(ctx, "zen:user")
+	if err != nil {
+		t.Fatalf("Failed to delete essentials card: %s", err)
+	}
+}
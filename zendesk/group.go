@@ -37,6 +37,8 @@ type GroupAPI interface {
 	CreateGroup(ctx context.Context, group Group) (Group, error)
 	UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
+	ListAssignableGroups(ctx context.Context, opts *GroupListOptions) ([]Group, Page, error)
+	GetGroupsCount(ctx context.Context) (Count, error)
 }
 
 // GetGroups fetches group list
@@ -69,6 +71,42 @@ func (z *Client) GetGroups(ctx context.Context, opts *GroupListOptions) ([]Group
 	return data.Groups, data.Page, nil
 }
 
+// ListAssignableGroups fetches the groups tickets can be assigned to by the current user
+// https://developer.zendesk.com/api-reference/ticketing/groups/groups/#list-assignable-groups
+func (z *Client) ListAssignableGroups(ctx context.Context, opts *GroupListOptions) ([]Group, Page, error) {
+	var data struct {
+		Groups []Group `json:"groups"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &GroupListOptions{}
+	}
+
+	u, err := addOptions("/groups/assignable.json", tmp)
+	if err != nil {
+		return []Group{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return []Group{}, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return []Group{}, Page{}, err
+	}
+	return data.Groups, data.Page, nil
+}
+
+// GetGroupsCount returns an approximate count of groups in the account
+// https://developer.zendesk.com/api-reference/ticketing/groups/groups/#count-groups
+func (z *Client) GetGroupsCount(ctx context.Context) (Count, error) {
+	return z.getCount(ctx, "/groups/count.json")
+}
+
 // CreateGroup creates new group
 // https://developer.zendesk.com/rest_api/docs/support/groups#create-group
 func (z *Client) CreateGroup(ctx context.Context, group Group) (Group, error) {
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -31,6 +32,8 @@ type (
 	// GroupMembershipAPI is an interface containing group membership related methods
 	GroupMembershipAPI interface {
 		GetGroupMemberships(context.Context, *GroupMembershipListOptions) ([]GroupMembership, Page, error)
+		ListAssignableGroupMemberships(
+			ctx context.Context, opts *GroupMembershipListOptions) ([]GroupMembership, Page, error)
 		GetGroupMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership]
 		GetGroupMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error)
 		GetGroupMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, CursorPaginationMeta, error)
@@ -66,3 +69,42 @@ func (z *Client) GetGroupMemberships(ctx context.Context, opts *GroupMembershipL
 
 	return result.GroupMemberships, result.Page, nil
 }
+
+// ListAssignableGroupMemberships gets the memberships of the groups tickets can be assigned to
+// by the current user. When opts.GroupID is set, only the memberships of that group are returned.
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/#list-assignable-memberships
+func (z *Client) ListAssignableGroupMemberships(
+	ctx context.Context, opts *GroupMembershipListOptions,
+) ([]GroupMembership, Page, error) {
+	var result struct {
+		GroupMemberships []GroupMembership `json:"group_memberships"`
+		Page
+	}
+
+	tmp := GroupMembershipListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+
+	path := "/group_memberships/assignable.json"
+	if tmp.GroupID != 0 {
+		path = fmt.Sprintf("/groups/%d/memberships/assignable.json", tmp.GroupID)
+		tmp.GroupID = 0
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, Page{}, err
+	}
+
+	return result.GroupMemberships, result.Page, nil
+}
@@ -2,6 +2,7 @@ package zendesk
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -19,3 +20,26 @@ func TestGetGroupMemberships(t *testing.T) {
 		t.Fatalf("expected length of group memberships is 2, but got %d", len(groupMemberships))
 	}
 }
+
+func TestListAssignableGroupMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/123/memberships/assignable.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("group_id") != "" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/group_memberships.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groupMemberships, _, err := client.ListAssignableGroupMemberships(ctx, &GroupMembershipListOptions{GroupID: 123})
+	if err != nil {
+		t.Fatalf("Failed to list assignable group memberships: %s", err)
+	}
+
+	if len(groupMemberships) != 2 {
+		t.Fatalf("expected length of group memberships is 2, but got %d", len(groupMemberships))
+	}
+}
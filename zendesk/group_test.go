@@ -133,3 +133,43 @@ func TestDeleteGroup(t *testing.T) {
 		t.Fatalf("Failed to delete group: %s", err)
 	}
 }
+
+func TestListAssignableGroups(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/assignable.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/groups.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, _, err := client.ListAssignableGroups(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list assignable groups: %s", err)
+	}
+
+	if len(groups) != 1 {
+		t.Fatalf("expected length of groups is 1, but got %d", len(groups))
+	}
+}
+
+func TestGetGroupsCount(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/count.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/count.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetGroupsCount(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get groups count: %s", err)
+	}
+
+	if count.Value != 102 {
+		t.Fatalf("expected count is 102, but got %d", count.Value)
+	}
+}
@@ -0,0 +1,149 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// ArticleSearchResult is an article found by SearchArticles.
+// Snippet is an excerpt of the body with the matching words wrapped in <em> tags.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#search-articles
+type ArticleSearchResult struct {
+	Article
+	Snippet    string `json:"snippet"`
+	ResultType string `json:"result_type"`
+}
+
+// ArticleSearchOptions is options for SearchArticles.
+// Query or LabelNames must be set.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#search-articles
+type ArticleSearchOptions struct {
+	PageOptions
+	Query  string `url:"query,omitempty"`
+	Locale string `url:"locale,omitempty"`
+	// LabelNames is a comma separated list of labels, the articles must have at least one of them
+	LabelNames string `url:"label_names,omitempty"`
+	CategoryID int64  `url:"category,omitempty"`
+	SectionID  int64  `url:"section,omitempty"`
+	BrandID    int64  `url:"brand_id,omitempty"`
+	// Multibrand searches the articles of all brands
+	Multibrand bool `url:"multibrand,omitempty"`
+
+	// The date filters take dates in the form "2006-01-02"
+	CreatedBefore string `url:"created_before,omitempty"`
+	CreatedAfter  string `url:"created_after,omitempty"`
+	CreatedAt     string `url:"created_at,omitempty"`
+	UpdatedBefore string `url:"updated_before,omitempty"`
+	UpdatedAfter  string `url:"updated_after,omitempty"`
+	UpdatedAt     string `url:"updated_at,omitempty"`
+
+	// SortBy can take "created_at" or "updated_at". Results are sorted by relevance by default.
+	SortBy string `url:"sort_by,omitempty"`
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// HelpCenterSearchResult is a content found by SearchHelpCenter
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/search/#json-format
+type HelpCenterSearchResult struct {
+	ID int64 `json:"id"`
+	// Type can take "article", "post" or "external_record"
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	HTMLURL   string    `json:"html_url,omitempty"`
+	Snippet   string    `json:"snippet,omitempty"`
+	Locale    string    `json:"locale,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HelpCenterSearchOptions is options for SearchHelpCenter.
+// The filters take comma separated lists.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/search/#unified-search
+type HelpCenterSearchOptions struct {
+	CursorPagination
+	Query string `url:"query"`
+	// Locales is required
+	Locales string `url:"filter[locales],omitempty"`
+	// ContentTypes can contain "ARTICLE" and "POST"
+	ContentTypes string `url:"filter[content_types],omitempty"`
+	BrandIDs     string `url:"filter[brand_ids],omitempty"`
+	CategoryIDs  string `url:"filter[category_ids],omitempty"`
+	SectionIDs   string `url:"filter[section_ids],omitempty"`
+	TopicIDs     string `url:"filter[topic_ids],omitempty"`
+	LabelNames   string `url:"filter[label_names],omitempty"`
+}
+
+// HelpCenterSearchAPI an interface containing the Help Center search methods
+type HelpCenterSearchAPI interface {
+	SearchArticles(ctx context.Context, opts *ArticleSearchOptions) ([]ArticleSearchResult, Page, error)
+	SearchHelpCenter(
+		ctx context.Context, opts *HelpCenterSearchOptions) ([]HelpCenterSearchResult, CursorPaginationMeta, error)
+}
+
+// SearchArticles searches the articles of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/articles/#search-articles
+func (z *Client) SearchArticles(ctx context.Context, opts *ArticleSearchOptions) ([]ArticleSearchResult, Page, error) {
+	var result struct {
+		Results []ArticleSearchResult `json:"results"`
+		Page
+	}
+
+	if opts == nil {
+		return nil, Page{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/help_center/articles/search.json", opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return result.Results, result.Page, nil
+}
+
+// SearchHelpCenter searches the articles, the community posts and the external content of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/search/#unified-search
+func (z *Client) SearchHelpCenter(
+	ctx context.Context, opts *HelpCenterSearchOptions,
+) ([]HelpCenterSearchResult, CursorPaginationMeta, error) {
+	var result struct {
+		Results []HelpCenterSearchResult `json:"results"`
+		Meta    CursorPaginationMeta     `json:"meta"`
+	}
+
+	if opts == nil {
+		return nil, CursorPaginationMeta{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/help_center/search", opts)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Results, result.Meta, nil
+}
@@ -0,0 +1,69 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchArticles(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/help_center/articles/search.json" || query.Get("query") != "password" || query.Get("category") != "37486578" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/article_search.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, page, err := client.SearchArticles(ctx, &ArticleSearchOptions{
+		Query:      "password",
+		Locale:     "en-us",
+		CategoryID: 37486578,
+	})
+	if err != nil {
+		t.Fatalf("Failed to search articles: %s", err)
+	}
+
+	if len(results) != 2 || page.Count != 2 {
+		t.Fatalf("unexpected results: %d results, count %d", len(results), page.Count)
+	}
+	if results[0].ID != 37486578 || results[0].Snippet != "Use the gear icon to update your <em>password</em>." {
+		t.Fatalf("unexpected result: %+v", results[0])
+	}
+}
+
+func TestSearchArticlesWithNilOptions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article_search.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, _, err := client.SearchArticles(ctx, nil); err == nil {
+		t.Fatal("expected an error with nil options")
+	}
+}
+
+func TestSearchHelpCenter(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/help_center/search" || query.Get("filter[locales]") != "en-us" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/help_center_search.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, _, err := client.SearchHelpCenter(ctx, &HelpCenterSearchOptions{
+		Query:   "password",
+		Locales: "en-us",
+	})
+	if err != nil {
+		t.Fatalf("Failed to search help center: %s", err)
+	}
+
+	if len(results) != 2 || results[1].Type != "post" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}
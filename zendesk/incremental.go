@@ -0,0 +1,241 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// IncrementalExportInterval is the minimum wait between two requests sent by
+// IncrementalExportIterator. Incremental exports are limited to 10 requests per minute.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#rate-limits
+const IncrementalExportInterval = time.Minute / 10
+
+// IncrementalExportOptions is options for incremental export methods.
+// StartTime is required by the first request. Cursor based exports use Cursor for the following requests.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#query-parameters
+type IncrementalExportOptions struct {
+	StartTime      int64  `url:"start_time,omitempty"`
+	Cursor         string `url:"cursor,omitempty"`
+	PerPage        int    `url:"per_page,omitempty"`
+	Include        string `url:"include,omitempty"`
+	ExcludeDeleted bool   `url:"exclude_deleted,omitempty"`
+}
+
+// IncrementalExportMeta contains the information to fetch the next page of an incremental export.
+// Time based exports set EndTime and NextPage, and cursor based exports set AfterCursor and AfterURL.
+type IncrementalExportMeta struct {
+	Count       int64  `json:"count"`
+	EndOfStream bool   `json:"end_of_stream"`
+	EndTime     int64  `json:"end_time,omitempty"`
+	NextPage    string `json:"next_page,omitempty"`
+	AfterCursor string `json:"after_cursor,omitempty"`
+	AfterURL    string `json:"after_url,omitempty"`
+}
+
+// IncrementalTicketsResult is a page of the incremental ticket export.
+// Users, Groups and Organizations are filled when they are requested with Include.
+type IncrementalTicketsResult struct {
+	Tickets       []Ticket       `json:"tickets"`
+	Users         []User         `json:"users,omitempty"`
+	Groups        []Group        `json:"groups,omitempty"`
+	Organizations []Organization `json:"organizations,omitempty"`
+	IncrementalExportMeta
+}
+
+// IncrementalArticlesResult is a page of the incremental Help Center article export
+type IncrementalArticlesResult struct {
+	Articles []Article `json:"articles"`
+	IncrementalExportMeta
+}
+
+// IncrementalExportAPI an interface containing all incremental export related methods
+type IncrementalExportAPI interface {
+	GetIncrementalTickets(ctx context.Context, opts *IncrementalExportOptions) (*IncrementalTicketsResult, error)
+	GetIncrementalTicketsCursor(ctx context.Context, opts *IncrementalExportOptions) (*IncrementalTicketsResult, error)
+	GetIncrementalTicketsIterator(ctx context.Context, opts *IncrementalExportOptions) *IncrementalExportIterator[Ticket]
+	GetIncrementalArticles(ctx context.Context, opts *IncrementalExportOptions) (*IncrementalArticlesResult, error)
+	GetIncrementalArticlesIterator(
+		ctx context.Context, opts *IncrementalExportOptions) *IncrementalExportIterator[Article]
+}
+
+// IncrementalExportFunc defines the signature of the function used to fetch a page of an incremental export.
+type IncrementalExportFunc[T any] func(ctx context.Context, opts *IncrementalExportOptions) ([]T, IncrementalExportMeta, error)
+
+// IncrementalExportIterator iterates over the pages of an incremental export until the end of stream.
+// It waits IncrementalExportInterval between requests to stay within the export rate limit.
+type IncrementalExportIterator[T any] struct {
+	opts        IncrementalExportOptions
+	hasMore     bool
+	interval    time.Duration
+	lastRequest time.Time
+	ctx         context.Context
+	fetch       IncrementalExportFunc[T]
+}
+
+func newIncrementalExportIterator[T any](
+	ctx context.Context, opts *IncrementalExportOptions, fetch IncrementalExportFunc[T],
+) *IncrementalExportIterator[T] {
+	it := &IncrementalExportIterator[T]{
+		hasMore:  true,
+		interval: IncrementalExportInterval,
+		ctx:      ctx,
+		fetch:    fetch,
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	return it
+}
+
+// HasMore returns a boolean indicating whether the end of stream has not been reached yet.
+func (i *IncrementalExportIterator[T]) HasMore() bool {
+	return i.hasMore
+}
+
+// GetNext retrieves the next page of the export, waiting for the rate limit interval if needed.
+// In case of an error, it sets hasMore to false and returns an error.
+func (i *IncrementalExportIterator[T]) GetNext() ([]T, error) {
+	if wait := i.interval - time.Since(i.lastRequest); !i.lastRequest.IsZero() && wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-i.ctx.Done():
+			timer.Stop()
+			i.hasMore = false
+			return nil, i.ctx.Err()
+		case <-timer.C:
+		}
+	}
+	i.lastRequest = time.Now()
+
+	results, meta, err := i.fetch(i.ctx, &i.opts)
+	if err != nil {
+		i.hasMore = false
+		return nil, err
+	}
+
+	switch {
+	case meta.EndOfStream:
+		i.hasMore = false
+	case meta.AfterCursor != "":
+		i.opts.StartTime = 0
+		i.opts.Cursor = meta.AfterCursor
+	case meta.EndTime != 0:
+		i.opts.StartTime = meta.EndTime
+	default:
+		i.hasMore = false
+	}
+	return results, nil
+}
+
+// GetIncrementalTickets returns the tickets changed since opts.StartTime with time based pagination
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-export-time-based
+func (z *Client) GetIncrementalTickets(
+	ctx context.Context, opts *IncrementalExportOptions,
+) (*IncrementalTicketsResult, error) {
+	return z.getIncrementalTickets(ctx, "/incremental/tickets.json", opts)
+}
+
+// GetIncrementalTicketsCursor returns the tickets changed since opts.StartTime with cursor based pagination
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-export-cursor-based
+func (z *Client) GetIncrementalTicketsCursor(
+	ctx context.Context, opts *IncrementalExportOptions,
+) (*IncrementalTicketsResult, error) {
+	return z.getIncrementalTickets(ctx, "/incremental/tickets/cursor.json", opts)
+}
+
+// GetIncrementalTicketsIterator returns an iterator over the cursor based incremental ticket export
+func (z *Client) GetIncrementalTicketsIterator(
+	ctx context.Context, opts *IncrementalExportOptions,
+) *IncrementalExportIterator[Ticket] {
+	return newIncrementalExportIterator(ctx, opts,
+		func(ctx context.Context, opts *IncrementalExportOptions) ([]Ticket, IncrementalExportMeta, error) {
+			result, err := z.GetIncrementalTicketsCursor(ctx, opts)
+			if err != nil {
+				return nil, IncrementalExportMeta{}, err
+			}
+			return result.Tickets, result.IncrementalExportMeta, nil
+		})
+}
+
+// GetIncrementalArticles returns the Help Center articles changed since opts.StartTime,
+// including the archived articles and the articles whose translations changed.
+// The export has reached its end when NextPage is empty.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/incremental_export/#incremental-article-export
+func (z *Client) GetIncrementalArticles(
+	ctx context.Context, opts *IncrementalExportOptions,
+) (*IncrementalArticlesResult, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &IncrementalExportOptions{}
+	}
+
+	u, err := addOptions("/help_center/incremental/articles.json", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	var result IncrementalArticlesResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetIncrementalArticlesIterator returns an iterator over the incremental Help Center article export
+func (z *Client) GetIncrementalArticlesIterator(
+	ctx context.Context, opts *IncrementalExportOptions,
+) *IncrementalExportIterator[Article] {
+	return newIncrementalExportIterator(ctx, opts,
+		func(ctx context.Context, opts *IncrementalExportOptions) ([]Article, IncrementalExportMeta, error) {
+			result, err := z.GetIncrementalArticles(ctx, opts)
+			if err != nil {
+				return nil, IncrementalExportMeta{}, err
+			}
+			return result.Articles, withEndOfStream(result.IncrementalExportMeta, len(result.Articles)), nil
+		})
+}
+
+// withEndOfStream sets EndOfStream for the time based exports which have no end_of_stream,
+// they end with an empty page or a page without next_page
+func withEndOfStream(meta IncrementalExportMeta, count int) IncrementalExportMeta {
+	meta.EndOfStream = meta.EndOfStream || meta.NextPage == "" || count == 0
+	return meta
+}
+
+func (z *Client) getIncrementalTickets(
+	ctx context.Context, path string, opts *IncrementalExportOptions,
+) (*IncrementalTicketsResult, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &IncrementalExportOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	var result IncrementalTicketsResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,183 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetIncrementalTickets(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/tickets.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if startTime := r.URL.Query().Get("start_time"); startTime != "1559520000" {
+			t.Fatalf("unexpected start_time: %s", startTime)
+		}
+		w.Write(readFixture("GET/incremental_tickets.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalTickets(ctx, &IncrementalExportOptions{
+		StartTime: 1559520000,
+		Include:   "users",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get incremental tickets: %s", err)
+	}
+
+	if len(result.Tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(result.Tickets))
+	}
+	if len(result.Users) != 1 {
+		t.Fatalf("expected length of sideloaded users is 1, but got %d", len(result.Users))
+	}
+	if !result.EndOfStream || result.EndTime != 1559700804 {
+		t.Fatalf("unexpected export meta: %+v", result.IncrementalExportMeta)
+	}
+}
+
+func TestGetIncrementalTicketsCursor(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "incremental_tickets_cursor.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalTicketsCursor(ctx, &IncrementalExportOptions{StartTime: 1559520000})
+	if err != nil {
+		t.Fatalf("Failed to get incremental tickets: %s", err)
+	}
+
+	if len(result.Tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(result.Tickets))
+	}
+	if result.AfterCursor != "MTU1OTcwMDgwNC4wfHwzfA==" {
+		t.Fatalf("unexpected after cursor: %s", result.AfterCursor)
+	}
+}
+
+func TestGetIncrementalTicketsIterator(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query := r.URL.Query()
+		switch requests {
+		case 1:
+			if query.Get("start_time") != "1559520000" || query.Get("cursor") != "" {
+				t.Fatalf("unexpected first query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"tickets":[{"id":1}],"after_cursor":"next","end_of_stream":false}`)
+		case 2:
+			if query.Get("start_time") != "" || query.Get("cursor") != "next" {
+				t.Fatalf("unexpected second query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"tickets":[{"id":2},{"id":3}],"after_cursor":"last","end_of_stream":true}`)
+		default:
+			t.Fatal("iterator requested after end of stream")
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetIncrementalTicketsIterator(ctx, &IncrementalExportOptions{StartTime: 1559520000})
+	it.interval = 0
+
+	ticketCount := 0
+	for it.HasMore() {
+		tickets, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get incremental tickets: %s", err)
+		}
+		ticketCount += len(tickets)
+	}
+
+	if ticketCount != 3 {
+		t.Fatalf("expected length of tickets is 3, but got %d", ticketCount)
+	}
+}
+
+func TestIncrementalExportIteratorWaitsForInterval(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "incremental_tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cctx, cancel := context.WithCancel(ctx)
+	it := client.GetIncrementalTicketsIterator(cctx, nil)
+	it.fetch = func(ctx context.Context, opts *IncrementalExportOptions) ([]Ticket, IncrementalExportMeta, error) {
+		return []Ticket{{ID: 1}}, IncrementalExportMeta{AfterCursor: "next"}, nil
+	}
+
+	if _, err := it.GetNext(); err != nil {
+		t.Fatalf("Failed to get first page: %s", err)
+	}
+
+	cancel()
+	if _, err := it.GetNext(); err != context.Canceled {
+		t.Fatalf("expected the iterator to wait for the rate limit interval, but got %v", err)
+	}
+	if it.HasMore() {
+		t.Fatal("expected the iterator to stop after cancellation")
+	}
+}
+
+func TestGetIncrementalArticles(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/incremental/articles.json" || r.URL.Query().Get("start_time") != "1685577600" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/incremental_articles.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalArticles(ctx, &IncrementalExportOptions{StartTime: 1685577600})
+	if err != nil {
+		t.Fatalf("Failed to get incremental articles: %s", err)
+	}
+
+	if len(result.Articles) != 2 || result.EndTime != 1685696400 {
+		t.Fatalf("unexpected result: %d articles, end time %d", len(result.Articles), result.EndTime)
+	}
+}
+
+func TestGetIncrementalArticlesIterator(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		query := r.URL.Query()
+		switch requests {
+		case 1:
+			if query.Get("start_time") != "1685577600" {
+				t.Fatalf("unexpected first query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"articles":[{"id":1}],"end_time":1685600000,"next_page":"https://example.zendesk.com/next"}`)
+		case 2:
+			if query.Get("start_time") != "1685600000" {
+				t.Fatalf("unexpected second query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"articles":[{"id":2}],"end_time":1685700000,"next_page":null}`)
+		default:
+			t.Fatal("iterator requested after the last page")
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetIncrementalArticlesIterator(ctx, &IncrementalExportOptions{StartTime: 1685577600})
+	it.interval = 0
+
+	articleCount := 0
+	for it.HasMore() {
+		articles, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get incremental articles: %s", err)
+		}
+		articleCount += len(articles)
+	}
+
+	if articleCount != 2 {
+		t.Fatalf("expected length of articles is 2, but got %d", articleCount)
+	}
+}
@@ -22,10 +22,10 @@ func NewPaginationOptions() *PaginationOptions {
 }
 
 type CommonOptions struct {
-	Active        bool     `url:"active,omitempty"`
-	Role          string   `url:"role,omitempty"`
-	Roles         []string `url:"role[],omitempty"`
-	PermissionSet int64    `url:"permission_set,omitempty"`
+	Active        bool       `url:"active,omitempty"`
+	Role          UserRole   `url:"role,omitempty"`
+	Roles         []UserRole `url:"role[],omitempty"`
+	PermissionSet int64      `url:"permission_set,omitempty"`
 
 	// SortBy can take "assignee", "assignee.name", "created_at", "group", "id",
 	// "locale", "requester", "requester.name", "status", "subject", "updated_at"
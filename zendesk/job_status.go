@@ -0,0 +1,128 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Job statuses
+const (
+	JobStatusQueued    = "queued"
+	JobStatusWorking   = "working"
+	JobStatusFailed    = "failed"
+	JobStatusCompleted = "completed"
+	JobStatusKilled    = "killed"
+)
+
+// JobStatus is the status of a background job started by a bulk request
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#json-format
+type JobStatus struct {
+	ID       string            `json:"id"`
+	URL      string            `json:"url,omitempty"`
+	Total    int64             `json:"total,omitempty"`
+	Progress int64             `json:"progress,omitempty"`
+	Status   string            `json:"status"`
+	Message  string            `json:"message,omitempty"`
+	Results  []JobStatusResult `json:"results,omitempty"`
+}
+
+// JobStatusResult is the result of a single item of a background job
+type JobStatusResult struct {
+	ID         int64  `json:"id,omitempty"`
+	Index      int64  `json:"index,omitempty"`
+	Action     string `json:"action,omitempty"`
+	Success    bool   `json:"success,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Error      string `json:"error,omitempty"`
+	Errors     string `json:"errors,omitempty"`
+	Details    string `json:"details,omitempty"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// Done returns true when the job is not queued nor running anymore
+func (j JobStatus) Done() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed || j.Status == JobStatusKilled
+}
+
+// JobStatusAPI an interface containing all job status related methods
+type JobStatusAPI interface {
+	GetJobStatus(ctx context.Context, id string) (JobStatus, error)
+}
+
+// GetJobStatus shows the status of a background job
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-job-status
+func (z *Client) GetJobStatus(ctx context.Context, id string) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/%s.json", id))
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
+
+// postJobStatus sends data to a bulk endpoint and returns the job status of the queued job
+func (z *Client) postJobStatus(ctx context.Context, path string, data interface{}) (JobStatus, error) {
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return unmarshalJobStatus(body)
+}
+
+// putJobStatus sends data to a bulk endpoint and returns the job status of the queued job
+func (z *Client) putJobStatus(ctx context.Context, path string, data interface{}) (JobStatus, error) {
+	body, err := z.put(ctx, path, data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return unmarshalJobStatus(body)
+}
+
+// deleteJobStatus calls a bulk deletion endpoint and returns the job status of the queued job
+func (z *Client) deleteJobStatus(ctx context.Context, path string) (JobStatus, error) {
+	body, err := z.deleteWithResponse(ctx, path)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return unmarshalJobStatus(body)
+}
+
+func unmarshalJobStatus(body []byte) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
+
+// bulkIDsOptions selects the resources of a bulk request by ids or by external ids
+type bulkIDsOptions struct {
+	IDs         string `url:"ids,omitempty"`
+	ExternalIDs string `url:"external_ids,omitempty"`
+}
+
+// joinIDs formats ids as a comma separated list for the ids query parameter
+func joinIDs(ids []int64) string {
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(idStrs, ",")
+}
@@ -0,0 +1,24 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.GetJobStatus(ctx, "82de0b044094f0c67893ac9fe64f1a99")
+	if err != nil {
+		t.Fatalf("Failed to get job status: %s", err)
+	}
+
+	if !jobStatus.Done() {
+		t.Fatalf("expected job to be done, but status is %s", jobStatus.Status)
+	}
+	if len(jobStatus.Results) != 2 || !jobStatus.Results[0].Success {
+		t.Fatalf("unexpected job status results: %+v", jobStatus.Results)
+	}
+}
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 )
 
@@ -17,9 +18,29 @@ type Locale struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// HelpCenterLocales is the locales enabled in the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/help_center_locales/
+type HelpCenterLocales struct {
+	Locales       []string `json:"locales"`
+	DefaultLocale string   `json:"default_locale"`
+}
+
+// Enabled returns true when locale is enabled in the Help Center.
+// Locales are compared case-insensitively, as "en-US" and "en-us" are the same locale.
+func (l HelpCenterLocales) Enabled(locale string) bool {
+	for _, enabled := range l.Locales {
+		if strings.EqualFold(enabled, locale) {
+			return true
+		}
+	}
+	return false
+}
+
 // LocaleAPI an interface containing all of the local related zendesk methods
 type LocaleAPI interface {
 	GetLocales(ctx context.Context) ([]Locale, error)
+	GetHelpCenterLocales(ctx context.Context) (HelpCenterLocales, error)
 }
 
 // GetLocales lists the translation locales available for the account.
@@ -40,3 +61,21 @@ func (z *Client) GetLocales(ctx context.Context) ([]Locale, error) {
 	}
 	return data.Locales, nil
 }
+
+// GetHelpCenterLocales gets the locales enabled in the Help Center and its default locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/help_center_locales/#list-all-enabled-locales-and-default-locale
+func (z *Client) GetHelpCenterLocales(ctx context.Context) (HelpCenterLocales, error) {
+	var result HelpCenterLocales
+
+	body, err := z.get(ctx, "/help_center/locales.json")
+	if err != nil {
+		return HelpCenterLocales{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return HelpCenterLocales{}, err
+	}
+	return result, nil
+}
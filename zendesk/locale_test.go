@@ -19,3 +19,21 @@ func TestGetLocales(t *testing.T) {
 		t.Fatalf("expected length of groups is 3, but got %d", len(locales))
 	}
 }
+
+func TestGetHelpCenterLocales(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "help_center_locales.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	locales, err := client.GetHelpCenterLocales(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get help center locales: %s", err)
+	}
+
+	if locales.DefaultLocale != "en-us" || len(locales.Locales) != 3 {
+		t.Fatalf("unexpected locales: %+v", locales)
+	}
+	if !locales.Enabled("en-US") || locales.Enabled("ja") {
+		t.Fatalf("unexpected enabled locales: %+v", locales)
+	}
+}
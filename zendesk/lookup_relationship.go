@@ -0,0 +1,114 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// LookupRelationshipSources is a page of the resources which refer to a target through a lookup relationship field.
+// Only the list of the source type is set.
+type LookupRelationshipSources struct {
+	CustomObjectRecords []CustomObjectRecord `json:"custom_object_records,omitempty"`
+	Users               []User               `json:"users,omitempty"`
+	Organizations       []Organization       `json:"organizations,omitempty"`
+	Tickets             []Ticket             `json:"tickets,omitempty"`
+	Meta                CursorPaginationMeta `json:"meta"`
+	Count               int64                `json:"count"`
+}
+
+// LookupRelationshipAPI an interface containing all lookup relationship related methods
+type LookupRelationshipAPI interface {
+	GetLookupRelationshipSources(
+		ctx context.Context, targetType string, targetID string, fieldID int64, sourceType string,
+		opts *CursorPagination,
+	) (*LookupRelationshipSources, error)
+}
+
+// GetLookupRelationshipSources lists the resources of sourceType whose lookup relationship field fieldID refers
+// to the target, such as the tickets whose "asset" field is the record targetID of the custom object "asset".
+// The types are relationship target types, such as RelationshipTargetTypeTicket or
+// CustomObjectRelationshipTargetType("asset"). The target of a source is the value of its lookup field,
+// see the LookupField methods of the resources.
+// https://developer.zendesk.com/api-reference/ticketing/lookup_relationships/lookup_relationships/#get-sources-by-target
+func (z *Client) GetLookupRelationshipSources(
+	ctx context.Context, targetType string, targetID string, fieldID int64, sourceType string,
+	opts *CursorPagination,
+) (*LookupRelationshipSources, error) {
+	var result LookupRelationshipSources
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CursorPagination{}
+	}
+
+	path := fmt.Sprintf("/%s/%s/relationship_fields/%d/%s", targetType, targetID, fieldID, sourceType)
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// LookupField returns the id of the target of the lookup relationship field fieldID of the ticket
+func (t Ticket) LookupField(fieldID int64) (string, bool) {
+	for _, field := range t.CustomFields {
+		if field.ID == fieldID {
+			return lookupFieldValue(field.Value)
+		}
+	}
+	return "", false
+}
+
+// SetLookupField sets the target of the lookup relationship field fieldID of the ticket
+func (t *Ticket) SetLookupField(fieldID int64, targetID string) {
+	for i, field := range t.CustomFields {
+		if field.ID == fieldID {
+			t.CustomFields[i].Value = targetID
+			return
+		}
+	}
+	t.CustomFields = append(t.CustomFields, CustomField{ID: fieldID, Value: targetID})
+}
+
+// LookupField returns the id of the target of the lookup relationship field key of the user
+func (u User) LookupField(key string) (string, bool) {
+	return lookupFieldValue(u.UserFields[key])
+}
+
+// LookupField returns the id of the target of the lookup relationship field key of the organization
+func (o Organization) LookupField(key string) (string, bool) {
+	return lookupFieldValue(o.OrganizationFields[key])
+}
+
+// LookupField returns the id of the target of the lookup relationship field key of the record
+func (r CustomObjectRecord) LookupField(key string) (string, bool) {
+	return lookupFieldValue(r.CustomObjectFields[key])
+}
+
+// lookupFieldValue returns the id of a lookup field value, the id of a custom object record is a string
+// and the id of the other resources is a number
+func lookupFieldValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case json.Number:
+		return v.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,64 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetLookupRelationshipSources(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := "/zen:custom_object:asset/01GDXYD7ZTWYP542BA8MDDTE36/relationship_fields/360011/zen:ticket"
+		if r.URL.Path != expected || r.URL.Query().Get("page[size]") != "10" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/lookup_relationship_sources.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sources, err := client.GetLookupRelationshipSources(ctx,
+		CustomObjectRelationshipTargetType("asset"), "01GDXYD7ZTWYP542BA8MDDTE36",
+		360011, RelationshipTargetTypeTicket, &CursorPagination{PageSize: 10})
+	if err != nil {
+		t.Fatalf("Failed to get lookup relationship sources: %s", err)
+	}
+
+	if len(sources.Tickets) != 2 || sources.Count != 2 {
+		t.Fatalf("unexpected sources: %v", sources)
+	}
+
+	target, ok := sources.Tickets[0].LookupField(360011)
+	if !ok || target != "01GDXYD7ZTWYP542BA8MDDTE36" {
+		t.Fatalf("unexpected lookup field value: %s", target)
+	}
+	if _, ok := sources.Tickets[0].LookupField(360012); ok {
+		t.Fatal("expected no value for an empty lookup field")
+	}
+}
+
+func TestLookupField(t *testing.T) {
+	var user User
+	if err := json.Unmarshal([]byte(`{"id":1,"user_fields":{"manager":381626101}}`), &user); err != nil {
+		t.Fatalf("Failed to unmarshal user: %s", err)
+	}
+	if target, ok := user.LookupField("manager"); !ok || target != "381626101" {
+		t.Fatalf("unexpected lookup field value: %s", target)
+	}
+
+	org := Organization{OrganizationFields: map[string]interface{}{"account_manager": nil}}
+	if _, ok := org.LookupField("account_manager"); ok {
+		t.Fatal("expected no value for an empty lookup field")
+	}
+
+	var ticket Ticket
+	ticket.SetLookupField(360011, "01GDXYD7ZTWYP542BA8MDDTE36")
+	ticket.SetLookupField(360011, "01GDXYD7ZTWYP542BA8MDDTE37")
+	if len(ticket.CustomFields) != 1 {
+		t.Fatalf("expected length of custom fields is 1, but got %d", len(ticket.CustomFields))
+	}
+	if target, _ := ticket.LookupField(360011); target != "01GDXYD7ZTWYP542BA8MDDTE37" {
+		t.Fatalf("unexpected lookup field value: %s", target)
+	}
+}
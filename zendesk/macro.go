@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"time"
 )
 
@@ -29,6 +31,58 @@ type MacroAction struct {
 	Value string `json:"value"`
 }
 
+// MacroComment is the comment added to a ticket by a macro
+type MacroComment struct {
+	Body       string     `json:"body,omitempty"`
+	HTMLBody   string     `json:"html_body,omitempty"`
+	ScopedBody [][]string `json:"scoped_body,omitempty"`
+	Public     *bool      `json:"public,omitempty"`
+}
+
+// MacroResult is the ticket resulting from applying a macro.
+// It is not saved and can be used to update the ticket.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-changes-to-ticket
+type MacroResult struct {
+	Ticket MacroResultTicket `json:"ticket"`
+}
+
+// MacroResultTicket is a ticket with the comment added by a macro
+type MacroResultTicket struct {
+	Ticket
+	Comment *MacroComment `json:"comment,omitempty"`
+}
+
+// MacroAttachment is a file attached to a macro.
+// It's added to the comment of the ticket when the macro is applied.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#json-format-for-macro-attachments
+type MacroAttachment struct {
+	ID          int64     `json:"id,omitempty"`
+	ContentType string    `json:"content_type,omitempty"`
+	ContentURL  string    `json:"content_url,omitempty"`
+	FileName    string    `json:"filename,omitempty"`
+	Size        int64     `json:"size,omitempty"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+}
+
+// MacroActionDefinition describes an action supported by macros and the values it accepts
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-action-definitions
+type MacroActionDefinition struct {
+	Group      string `json:"group"`
+	Nullable   bool   `json:"nullable"`
+	Repeatable bool   `json:"repeatable"`
+	Subject    string `json:"subject"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+	Values     []struct {
+		Enabled bool   `json:"enabled"`
+		Title   string `json:"title"`
+		Value   string `json:"value"`
+	} `json:"values,omitempty"`
+}
+
 // MacroListOptions is parameters used of GetMacros
 type MacroListOptions struct {
 	Access       string `json:"access"`
@@ -55,6 +109,12 @@ type MacroAPI interface {
 	CreateMacro(ctx context.Context, macro Macro) (Macro, error)
 	UpdateMacro(ctx context.Context, macroID int64, macro Macro) (Macro, error)
 	DeleteMacro(ctx context.Context, macroID int64) error
+	ShowMacroEffect(ctx context.Context, macroID int64) (MacroResult, error)
+	ShowTicketAfterMacro(ctx context.Context, ticketID int64, macroID int64) (MacroResult, error)
+	CreateMacroAttachment(ctx context.Context, macroID int64, filename string, file io.Reader) (MacroAttachment, error)
+	ListMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error)
+	ListMacroActions(ctx context.Context) ([]MacroActionDefinition, error)
+	ListMacroCategories(ctx context.Context) ([]string, error)
 	GetMacrosIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Macro]
 	GetMacrosOBP(ctx context.Context, opts *OBPOptions) ([]Macro, Page, error)
 	GetMacrosCBP(ctx context.Context, opts *CBPOptions) ([]Macro, CursorPaginationMeta, error)
@@ -166,3 +226,115 @@ func (z *Client) DeleteMacro(ctx context.Context, macroID int64) error {
 
 	return nil
 }
+
+// ShowMacroEffect returns the changes the macro will make to a ticket
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-changes-to-ticket
+func (z *Client) ShowMacroEffect(ctx context.Context, macroID int64) (MacroResult, error) {
+	return z.getMacroResult(ctx, fmt.Sprintf("/macros/%d/apply.json", macroID))
+}
+
+// ShowTicketAfterMacro returns the full ticket object as it would be if the macro was applied to it
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#show-ticket-after-changes
+func (z *Client) ShowTicketAfterMacro(ctx context.Context, ticketID int64, macroID int64) (MacroResult, error) {
+	return z.getMacroResult(ctx, fmt.Sprintf("/tickets/%d/macros/%d/apply.json", ticketID, macroID))
+}
+
+func (z *Client) getMacroResult(ctx context.Context, path string) (MacroResult, error) {
+	var data struct {
+		Result MacroResult `json:"result"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return MacroResult{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return MacroResult{}, err
+	}
+	return data.Result, nil
+}
+
+// CreateMacroAttachment uploads a file and attaches it to the macro.
+// A macro can have up to five attachments.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#create-macro-attachment
+func (z *Client) CreateMacroAttachment(
+	ctx context.Context, macroID int64, filename string, file io.Reader,
+) (MacroAttachment, error) {
+	var result struct {
+		MacroAttachment MacroAttachment `json:"macro_attachment"`
+	}
+
+	path := fmt.Sprintf("/macros/%d/attachments.json", macroID)
+	body, err := z.uploadFile(ctx, http.MethodPost, path, "attachment", filename, file)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+	return result.MacroAttachment, nil
+}
+
+// ListMacroAttachments lists the attachments of the macro
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-attachments
+func (z *Client) ListMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error) {
+	var result struct {
+		MacroAttachments []MacroAttachment `json:"macro_attachments"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/macros/%d/attachments.json", macroID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.MacroAttachments, nil
+}
+
+// ListMacroActions returns the definitions of the actions a macro can perform
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-action-definitions
+func (z *Client) ListMacroActions(ctx context.Context) ([]MacroActionDefinition, error) {
+	var result struct {
+		Definitions struct {
+			Actions []MacroActionDefinition `json:"actions"`
+		} `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, "/macros/definitions.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Definitions.Actions, nil
+}
+
+// ListMacroCategories lists all macro categories available to the current user
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macro-categories
+func (z *Client) ListMacroCategories(ctx context.Context) ([]string, error) {
+	var result struct {
+		Categories []string `json:"categories"`
+	}
+
+	body, err := z.get(ctx, "/macros/categories.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Categories, nil
+}
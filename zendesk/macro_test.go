@@ -1,8 +1,10 @@
 package zendesk
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -106,3 +108,119 @@ func TestDeleteMacro(t *testing.T) {
 		t.Fatalf("Failed to delete macro field: %s", err)
 	}
 }
+
+func TestShowMacroEffect(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_apply.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.ShowMacroEffect(ctx, 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to show macro effect: %s", err)
+	}
+
+	if result.Ticket.Status != "pending" {
+		t.Fatalf("expected ticket status is pending, but got %s", result.Ticket.Status)
+	}
+	comment := result.Ticket.Comment
+	if comment == nil || comment.Body != "Assigned to Agent Uno." || comment.Public == nil || *comment.Public {
+		t.Fatalf("unexpected macro comment: %+v", comment)
+	}
+}
+
+func TestShowTicketAfterMacro(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/35436/macros/360111062754/apply.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/macro_apply.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.ShowTicketAfterMacro(ctx, 35436, 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to show ticket after macro: %s", err)
+	}
+
+	if result.Ticket.ID != 35436 || len(result.Ticket.Tags) != 1 {
+		t.Fatalf("unexpected ticket: %+v", result.Ticket)
+	}
+}
+
+func TestCreateMacroAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/360111062754/attachments.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		file, header, err := r.FormFile("attachment")
+		if err != nil {
+			t.Fatalf("Failed to read form file: %s", err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "foobar.jpg" || string(content) != "image" {
+			t.Fatalf("unexpected uploaded file %s: %s", header.Filename, content)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/macro_attachment.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.CreateMacroAttachment(ctx, 360111062754, "foobar.jpg", strings.NewReader("image"))
+	if err != nil {
+		t.Fatalf("Failed to create macro attachment: %s", err)
+	}
+
+	if attachment.ID != 100 {
+		t.Fatalf("expected macro attachment id is 100, but got %d", attachment.ID)
+	}
+}
+
+func TestListMacroAttachments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_attachments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachments, err := client.ListMacroAttachments(ctx, 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to list macro attachments: %s", err)
+	}
+
+	if len(attachments) != 2 {
+		t.Fatalf("expected length of macro attachments is 2, but got %d", len(attachments))
+	}
+}
+
+func TestListMacroActions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	actions, err := client.ListMacroActions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list macro actions: %s", err)
+	}
+
+	if len(actions) != 2 {
+		t.Fatalf("expected length of macro actions is 2, but got %d", len(actions))
+	}
+	if actions[0].Subject != "status" || len(actions[0].Values) != 2 {
+		t.Fatalf("unexpected macro action: %+v", actions[0])
+	}
+}
+
+func TestListMacroCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, err := client.ListMacroCategories(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list macro categories: %s", err)
+	}
+
+	if len(categories) != 2 || categories[0] != "FAQ" {
+		t.Fatalf("unexpected macro categories: %v", categories)
+	}
+}
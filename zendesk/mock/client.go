@@ -11,7 +11,9 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	zendesk "github.com/harrisonzhao/go-zendesk/zendesk"
 	gomock "go.uber.org/mock/gomock"
@@ -86,6 +88,50 @@ func (mr *ClientMockRecorder) AddUserTags(ctx, userID, tags any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserTags", reflect.TypeOf((*Client)(nil).AddUserTags), ctx, userID, tags)
 }
 
+// ArchiveArticle mocks base method.
+func (m *Client) ArchiveArticle(ctx context.Context, articleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveArticle", ctx, articleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveArticle indicates an expected call of ArchiveArticle.
+func (mr *ClientMockRecorder) ArchiveArticle(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveArticle", reflect.TypeOf((*Client)(nil).ArchiveArticle), ctx, articleID)
+}
+
+// AssociateArticleAttachments mocks base method.
+func (m *Client) AssociateArticleAttachments(ctx context.Context, articleID int64, attachmentIDs []int64) ([]zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateArticleAttachments", ctx, articleID, attachmentIDs)
+	ret0, _ := ret[0].([]zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateArticleAttachments indicates an expected call of AssociateArticleAttachments.
+func (mr *ClientMockRecorder) AssociateArticleAttachments(ctx, articleID, attachmentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateArticleAttachments", reflect.TypeOf((*Client)(nil).AssociateArticleAttachments), ctx, articleID, attachmentIDs)
+}
+
+// AutocompleteOrganizations mocks base method.
+func (m *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutocompleteOrganizations", ctx, name)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutocompleteOrganizations indicates an expected call of AutocompleteOrganizations.
+func (mr *ClientMockRecorder) AutocompleteOrganizations(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteOrganizations", reflect.TypeOf((*Client)(nil).AutocompleteOrganizations), ctx, name)
+}
+
 // AutocompleteSearchCustomObjectRecords mocks base method.
 func (m *Client) AutocompleteSearchCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectAutocompleteOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
 	m.ctrl.T.Helper()
@@ -102,2632 +148,8333 @@ func (mr *ClientMockRecorder) AutocompleteSearchCustomObjectRecords(ctx, customO
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteSearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).AutocompleteSearchCustomObjectRecords), ctx, customObjectKey, opts)
 }
 
-// CreateAutomation mocks base method.
-func (m *Client) CreateAutomation(ctx context.Context, automation zendesk.Automation) (zendesk.Automation, error) {
+// AutocompleteTags mocks base method.
+func (m *Client) AutocompleteTags(ctx context.Context, prefix string) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAutomation", ctx, automation)
-	ret0, _ := ret[0].(zendesk.Automation)
+	ret := m.ctrl.Call(m, "AutocompleteTags", ctx, prefix)
+	ret0, _ := ret[0].([]zendesk.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAutomation indicates an expected call of CreateAutomation.
-func (mr *ClientMockRecorder) CreateAutomation(ctx, automation any) *gomock.Call {
+// AutocompleteTags indicates an expected call of AutocompleteTags.
+func (mr *ClientMockRecorder) AutocompleteTags(ctx, prefix any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutomation", reflect.TypeOf((*Client)(nil).CreateAutomation), ctx, automation)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteTags", reflect.TypeOf((*Client)(nil).AutocompleteTags), ctx, prefix)
 }
 
-// CreateBrand mocks base method.
-func (m *Client) CreateBrand(ctx context.Context, brand zendesk.Brand) (zendesk.Brand, error) {
+// AutocompleteUsers mocks base method.
+func (m *Client) AutocompleteUsers(ctx context.Context, name string) ([]zendesk.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBrand", ctx, brand)
-	ret0, _ := ret[0].(zendesk.Brand)
+	ret := m.ctrl.Call(m, "AutocompleteUsers", ctx, name)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateBrand indicates an expected call of CreateBrand.
-func (mr *ClientMockRecorder) CreateBrand(ctx, brand any) *gomock.Call {
+// AutocompleteUsers indicates an expected call of AutocompleteUsers.
+func (mr *ClientMockRecorder) AutocompleteUsers(ctx, name any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBrand", reflect.TypeOf((*Client)(nil).CreateBrand), ctx, brand)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutocompleteUsers", reflect.TypeOf((*Client)(nil).AutocompleteUsers), ctx, name)
 }
 
-// CreateCustomObjectRecord mocks base method.
-func (m *Client) CreateCustomObjectRecord(ctx context.Context, record zendesk.CustomObjectRecord, customObjectKey string) (zendesk.CustomObjectRecord, error) {
+// BanChatIPAddress mocks base method.
+func (m *Client) BanChatIPAddress(ctx context.Context, ipAddress, reason string) (zendesk.ChatBan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateCustomObjectRecord", ctx, record, customObjectKey)
-	ret0, _ := ret[0].(zendesk.CustomObjectRecord)
+	ret := m.ctrl.Call(m, "BanChatIPAddress", ctx, ipAddress, reason)
+	ret0, _ := ret[0].(zendesk.ChatBan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateCustomObjectRecord indicates an expected call of CreateCustomObjectRecord.
-func (mr *ClientMockRecorder) CreateCustomObjectRecord(ctx, record, customObjectKey any) *gomock.Call {
+// BanChatIPAddress indicates an expected call of BanChatIPAddress.
+func (mr *ClientMockRecorder) BanChatIPAddress(ctx, ipAddress, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomObjectRecord", reflect.TypeOf((*Client)(nil).CreateCustomObjectRecord), ctx, record, customObjectKey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanChatIPAddress", reflect.TypeOf((*Client)(nil).BanChatIPAddress), ctx, ipAddress, reason)
 }
 
-// CreateDynamicContentItem mocks base method.
-func (m *Client) CreateDynamicContentItem(ctx context.Context, item zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
+// BanChatVisitor mocks base method.
+func (m *Client) BanChatVisitor(ctx context.Context, visitorID, reason string) (zendesk.ChatBan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDynamicContentItem", ctx, item)
-	ret0, _ := ret[0].(zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "BanChatVisitor", ctx, visitorID, reason)
+	ret0, _ := ret[0].(zendesk.ChatBan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateDynamicContentItem indicates an expected call of CreateDynamicContentItem.
-func (mr *ClientMockRecorder) CreateDynamicContentItem(ctx, item any) *gomock.Call {
+// BanChatVisitor indicates an expected call of BanChatVisitor.
+func (mr *ClientMockRecorder) BanChatVisitor(ctx, visitorID, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDynamicContentItem", reflect.TypeOf((*Client)(nil).CreateDynamicContentItem), ctx, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanChatVisitor", reflect.TypeOf((*Client)(nil).BanChatVisitor), ctx, visitorID, reason)
 }
 
-// CreateGroup mocks base method.
-func (m *Client) CreateGroup(ctx context.Context, group zendesk.Group) (zendesk.Group, error) {
+// BatchUpdateManyOrganizations mocks base method.
+func (m *Client) BatchUpdateManyOrganizations(ctx context.Context, orgs []zendesk.OrganizationUpdate) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateGroup", ctx, group)
-	ret0, _ := ret[0].(zendesk.Group)
+	ret := m.ctrl.Call(m, "BatchUpdateManyOrganizations", ctx, orgs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateGroup indicates an expected call of CreateGroup.
-func (mr *ClientMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
+// BatchUpdateManyOrganizations indicates an expected call of BatchUpdateManyOrganizations.
+func (mr *ClientMockRecorder) BatchUpdateManyOrganizations(ctx, orgs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*Client)(nil).CreateGroup), ctx, group)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateManyOrganizations", reflect.TypeOf((*Client)(nil).BatchUpdateManyOrganizations), ctx, orgs)
 }
 
-// CreateMacro mocks base method.
-func (m *Client) CreateMacro(ctx context.Context, macro zendesk.Macro) (zendesk.Macro, error) {
+// BatchUpdateManyUsers mocks base method.
+func (m *Client) BatchUpdateManyUsers(ctx context.Context, users []zendesk.User) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMacro", ctx, macro)
-	ret0, _ := ret[0].(zendesk.Macro)
+	ret := m.ctrl.Call(m, "BatchUpdateManyUsers", ctx, users)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMacro indicates an expected call of CreateMacro.
-func (mr *ClientMockRecorder) CreateMacro(ctx, macro any) *gomock.Call {
+// BatchUpdateManyUsers indicates an expected call of BatchUpdateManyUsers.
+func (mr *ClientMockRecorder) BatchUpdateManyUsers(ctx, users any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacro", reflect.TypeOf((*Client)(nil).CreateMacro), ctx, macro)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchUpdateManyUsers", reflect.TypeOf((*Client)(nil).BatchUpdateManyUsers), ctx, users)
 }
 
-// CreateOrUpdateUser mocks base method.
-func (m *Client) CreateOrUpdateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
+// BulkDeleteSessionsByUser mocks base method.
+func (m *Client) BulkDeleteSessionsByUser(ctx context.Context, userIDs []int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdateUser", ctx, user)
-	ret0, _ := ret[0].(zendesk.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "BulkDeleteSessionsByUser", ctx, userIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateOrUpdateUser indicates an expected call of CreateOrUpdateUser.
-func (mr *ClientMockRecorder) CreateOrUpdateUser(ctx, user any) *gomock.Call {
+// BulkDeleteSessionsByUser indicates an expected call of BulkDeleteSessionsByUser.
+func (mr *ClientMockRecorder) BulkDeleteSessionsByUser(ctx, userIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUser", reflect.TypeOf((*Client)(nil).CreateOrUpdateUser), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDeleteSessionsByUser", reflect.TypeOf((*Client)(nil).BulkDeleteSessionsByUser), ctx, userIDs)
 }
 
-// CreateOrganization mocks base method.
-func (m *Client) CreateOrganization(ctx context.Context, org zendesk.Organization) (zendesk.Organization, error) {
+// CheckBrandHostMapping mocks base method.
+func (m *Client) CheckBrandHostMapping(ctx context.Context, brandID int64) (zendesk.HostMappingValidity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrganization", ctx, org)
-	ret0, _ := ret[0].(zendesk.Organization)
+	ret := m.ctrl.Call(m, "CheckBrandHostMapping", ctx, brandID)
+	ret0, _ := ret[0].(zendesk.HostMappingValidity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrganization indicates an expected call of CreateOrganization.
-func (mr *ClientMockRecorder) CreateOrganization(ctx, org any) *gomock.Call {
+// CheckBrandHostMapping indicates an expected call of CheckBrandHostMapping.
+func (mr *ClientMockRecorder) CheckBrandHostMapping(ctx, brandID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*Client)(nil).CreateOrganization), ctx, org)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBrandHostMapping", reflect.TypeOf((*Client)(nil).CheckBrandHostMapping), ctx, brandID)
 }
 
-// CreateOrganizationField mocks base method.
-func (m *Client) CreateOrganizationField(ctx context.Context, organizationField zendesk.OrganizationField) (zendesk.OrganizationField, error) {
+// CheckHostMapping mocks base method.
+func (m *Client) CheckHostMapping(ctx context.Context, hostMapping, subdomain string) (zendesk.HostMappingValidity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrganizationField", ctx, organizationField)
-	ret0, _ := ret[0].(zendesk.OrganizationField)
+	ret := m.ctrl.Call(m, "CheckHostMapping", ctx, hostMapping, subdomain)
+	ret0, _ := ret[0].(zendesk.HostMappingValidity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrganizationField indicates an expected call of CreateOrganizationField.
-func (mr *ClientMockRecorder) CreateOrganizationField(ctx, organizationField any) *gomock.Call {
+// CheckHostMapping indicates an expected call of CheckHostMapping.
+func (mr *ClientMockRecorder) CheckHostMapping(ctx, hostMapping, subdomain any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationField", reflect.TypeOf((*Client)(nil).CreateOrganizationField), ctx, organizationField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckHostMapping", reflect.TypeOf((*Client)(nil).CheckHostMapping), ctx, hostMapping, subdomain)
 }
 
-// CreateOrganizationMembership mocks base method.
-func (m *Client) CreateOrganizationMembership(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
+// CloneWebhook mocks base method.
+func (m *Client) CloneWebhook(ctx context.Context, webhookID string) (*zendesk.Webhook, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrganizationMembership", arg0, arg1)
-	ret0, _ := ret[0].(zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "CloneWebhook", ctx, webhookID)
+	ret0, _ := ret[0].(*zendesk.Webhook)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOrganizationMembership indicates an expected call of CreateOrganizationMembership.
-func (mr *ClientMockRecorder) CreateOrganizationMembership(arg0, arg1 any) *gomock.Call {
+// CloneWebhook indicates an expected call of CloneWebhook.
+func (mr *ClientMockRecorder) CloneWebhook(ctx, webhookID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMembership", reflect.TypeOf((*Client)(nil).CreateOrganizationMembership), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneWebhook", reflect.TypeOf((*Client)(nil).CloneWebhook), ctx, webhookID)
 }
 
-// CreateSLAPolicy mocks base method.
-func (m *Client) CreateSLAPolicy(ctx context.Context, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
+// CreateArticle mocks base method.
+func (m *Client) CreateArticle(ctx context.Context, sectionID int64, article zendesk.Article) (zendesk.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSLAPolicy", ctx, slaPolicy)
-	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret := m.ctrl.Call(m, "CreateArticle", ctx, sectionID, article)
+	ret0, _ := ret[0].(zendesk.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSLAPolicy indicates an expected call of CreateSLAPolicy.
-func (mr *ClientMockRecorder) CreateSLAPolicy(ctx, slaPolicy any) *gomock.Call {
+// CreateArticle indicates an expected call of CreateArticle.
+func (mr *ClientMockRecorder) CreateArticle(ctx, sectionID, article any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLAPolicy", reflect.TypeOf((*Client)(nil).CreateSLAPolicy), ctx, slaPolicy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticle", reflect.TypeOf((*Client)(nil).CreateArticle), ctx, sectionID, article)
 }
 
-// CreateTarget mocks base method.
-func (m *Client) CreateTarget(ctx context.Context, ticketField zendesk.Target) (zendesk.Target, error) {
+// CreateArticleComment mocks base method.
+func (m *Client) CreateArticleComment(ctx context.Context, articleID int64, comment zendesk.ArticleComment, notifySubscribers bool) (zendesk.ArticleComment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTarget", ctx, ticketField)
-	ret0, _ := ret[0].(zendesk.Target)
+	ret := m.ctrl.Call(m, "CreateArticleComment", ctx, articleID, comment, notifySubscribers)
+	ret0, _ := ret[0].(zendesk.ArticleComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTarget indicates an expected call of CreateTarget.
-func (mr *ClientMockRecorder) CreateTarget(ctx, ticketField any) *gomock.Call {
+// CreateArticleComment indicates an expected call of CreateArticleComment.
+func (mr *ClientMockRecorder) CreateArticleComment(ctx, articleID, comment, notifySubscribers any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTarget", reflect.TypeOf((*Client)(nil).CreateTarget), ctx, ticketField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleComment", reflect.TypeOf((*Client)(nil).CreateArticleComment), ctx, articleID, comment, notifySubscribers)
 }
 
-// CreateTicket mocks base method.
-func (m *Client) CreateTicket(ctx context.Context, ticket zendesk.Ticket) (zendesk.Ticket, error) {
+// CreateArticleCommentVote mocks base method.
+func (m *Client) CreateArticleCommentVote(ctx context.Context, articleID, commentID int64, direction string) (zendesk.Vote, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicket", ctx, ticket)
-	ret0, _ := ret[0].(zendesk.Ticket)
+	ret := m.ctrl.Call(m, "CreateArticleCommentVote", ctx, articleID, commentID, direction)
+	ret0, _ := ret[0].(zendesk.Vote)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicket indicates an expected call of CreateTicket.
-func (mr *ClientMockRecorder) CreateTicket(ctx, ticket any) *gomock.Call {
+// CreateArticleCommentVote indicates an expected call of CreateArticleCommentVote.
+func (mr *ClientMockRecorder) CreateArticleCommentVote(ctx, articleID, commentID, direction any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*Client)(nil).CreateTicket), ctx, ticket)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleCommentVote", reflect.TypeOf((*Client)(nil).CreateArticleCommentVote), ctx, articleID, commentID, direction)
 }
 
-// CreateTicketComment mocks base method.
-func (m *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticketComment zendesk.TicketComment) (zendesk.TicketComment, error) {
+// CreateArticleSubscription mocks base method.
+func (m *Client) CreateArticleSubscription(ctx context.Context, articleID int64, subscription zendesk.Subscription) (zendesk.Subscription, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicketComment", ctx, ticketID, ticketComment)
-	ret0, _ := ret[0].(zendesk.TicketComment)
+	ret := m.ctrl.Call(m, "CreateArticleSubscription", ctx, articleID, subscription)
+	ret0, _ := ret[0].(zendesk.Subscription)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicketComment indicates an expected call of CreateTicketComment.
-func (mr *ClientMockRecorder) CreateTicketComment(ctx, ticketID, ticketComment any) *gomock.Call {
+// CreateArticleSubscription indicates an expected call of CreateArticleSubscription.
+func (mr *ClientMockRecorder) CreateArticleSubscription(ctx, articleID, subscription any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketComment", reflect.TypeOf((*Client)(nil).CreateTicketComment), ctx, ticketID, ticketComment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleSubscription", reflect.TypeOf((*Client)(nil).CreateArticleSubscription), ctx, articleID, subscription)
 }
 
-// CreateTicketField mocks base method.
-func (m *Client) CreateTicketField(ctx context.Context, ticketField zendesk.TicketField) (zendesk.TicketField, error) {
+// CreateArticleTranslation mocks base method.
+func (m *Client) CreateArticleTranslation(ctx context.Context, articleID int64, translation zendesk.Translation) (zendesk.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicketField", ctx, ticketField)
-	ret0, _ := ret[0].(zendesk.TicketField)
+	ret := m.ctrl.Call(m, "CreateArticleTranslation", ctx, articleID, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicketField indicates an expected call of CreateTicketField.
-func (mr *ClientMockRecorder) CreateTicketField(ctx, ticketField any) *gomock.Call {
+// CreateArticleTranslation indicates an expected call of CreateArticleTranslation.
+func (mr *ClientMockRecorder) CreateArticleTranslation(ctx, articleID, translation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketField", reflect.TypeOf((*Client)(nil).CreateTicketField), ctx, ticketField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleTranslation", reflect.TypeOf((*Client)(nil).CreateArticleTranslation), ctx, articleID, translation)
 }
 
-// CreateTicketForm mocks base method.
-func (m *Client) CreateTicketForm(ctx context.Context, ticketForm zendesk.TicketForm) (zendesk.TicketForm, error) {
+// CreateArticleVote mocks base method.
+func (m *Client) CreateArticleVote(ctx context.Context, articleID int64, direction string) (zendesk.Vote, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTicketForm", ctx, ticketForm)
-	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret := m.ctrl.Call(m, "CreateArticleVote", ctx, articleID, direction)
+	ret0, _ := ret[0].(zendesk.Vote)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTicketForm indicates an expected call of CreateTicketForm.
-func (mr *ClientMockRecorder) CreateTicketForm(ctx, ticketForm any) *gomock.Call {
+// CreateArticleVote indicates an expected call of CreateArticleVote.
+func (mr *ClientMockRecorder) CreateArticleVote(ctx, articleID, direction any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketForm", reflect.TypeOf((*Client)(nil).CreateTicketForm), ctx, ticketForm)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticleVote", reflect.TypeOf((*Client)(nil).CreateArticleVote), ctx, articleID, direction)
 }
 
-// CreateTrigger mocks base method.
-func (m *Client) CreateTrigger(ctx context.Context, trigger zendesk.Trigger) (zendesk.Trigger, error) {
+// CreateAutomation mocks base method.
+func (m *Client) CreateAutomation(ctx context.Context, automation zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTrigger", ctx, trigger)
-	ret0, _ := ret[0].(zendesk.Trigger)
+	ret := m.ctrl.Call(m, "CreateAutomation", ctx, automation)
+	ret0, _ := ret[0].(zendesk.Automation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTrigger indicates an expected call of CreateTrigger.
-func (mr *ClientMockRecorder) CreateTrigger(ctx, trigger any) *gomock.Call {
+// CreateAutomation indicates an expected call of CreateAutomation.
+func (mr *ClientMockRecorder) CreateAutomation(ctx, automation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrigger", reflect.TypeOf((*Client)(nil).CreateTrigger), ctx, trigger)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutomation", reflect.TypeOf((*Client)(nil).CreateAutomation), ctx, automation)
 }
 
-// CreateUser mocks base method.
-func (m *Client) CreateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
+// CreateBrand mocks base method.
+func (m *Client) CreateBrand(ctx context.Context, brand zendesk.Brand) (zendesk.Brand, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
-	ret0, _ := ret[0].(zendesk.User)
+	ret := m.ctrl.Call(m, "CreateBrand", ctx, brand)
+	ret0, _ := ret[0].(zendesk.Brand)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *ClientMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+// CreateBrand indicates an expected call of CreateBrand.
+func (mr *ClientMockRecorder) CreateBrand(ctx, brand any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*Client)(nil).CreateUser), ctx, user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBrand", reflect.TypeOf((*Client)(nil).CreateBrand), ctx, brand)
 }
 
-// CreateUserField mocks base method.
-func (m *Client) CreateUserField(ctx context.Context, userField zendesk.UserField) (zendesk.UserField, error) {
+// CreateCategory mocks base method.
+func (m *Client) CreateCategory(ctx context.Context, category zendesk.Category) (zendesk.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserField", ctx, userField)
-	ret0, _ := ret[0].(zendesk.UserField)
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
+	ret0, _ := ret[0].(zendesk.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUserField indicates an expected call of CreateUserField.
-func (mr *ClientMockRecorder) CreateUserField(ctx, userField any) *gomock.Call {
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *ClientMockRecorder) CreateCategory(ctx, category any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserField", reflect.TypeOf((*Client)(nil).CreateUserField), ctx, userField)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*Client)(nil).CreateCategory), ctx, category)
 }
 
-// CreateWebhook mocks base method.
-func (m *Client) CreateWebhook(ctx context.Context, hook *zendesk.Webhook) (*zendesk.Webhook, error) {
+// CreateCategoryTranslation mocks base method.
+func (m *Client) CreateCategoryTranslation(ctx context.Context, categoryID int64, translation zendesk.Translation) (zendesk.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWebhook", ctx, hook)
-	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret := m.ctrl.Call(m, "CreateCategoryTranslation", ctx, categoryID, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateWebhook indicates an expected call of CreateWebhook.
-func (mr *ClientMockRecorder) CreateWebhook(ctx, hook any) *gomock.Call {
+// CreateCategoryTranslation indicates an expected call of CreateCategoryTranslation.
+func (mr *ClientMockRecorder) CreateCategoryTranslation(ctx, categoryID, translation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*Client)(nil).CreateWebhook), ctx, hook)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategoryTranslation", reflect.TypeOf((*Client)(nil).CreateCategoryTranslation), ctx, categoryID, translation)
 }
 
-// Delete mocks base method.
-func (m *Client) Delete(ctx context.Context, path string, data any) error {
+// CreateChatDepartment mocks base method.
+func (m *Client) CreateChatDepartment(ctx context.Context, department zendesk.ChatDepartment) (zendesk.ChatDepartment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, path, data)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateChatDepartment", ctx, department)
+	ret0, _ := ret[0].(zendesk.ChatDepartment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *ClientMockRecorder) Delete(ctx, path, data any) *gomock.Call {
+// CreateChatDepartment indicates an expected call of CreateChatDepartment.
+func (mr *ClientMockRecorder) CreateChatDepartment(ctx, department any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Client)(nil).Delete), ctx, path, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatDepartment", reflect.TypeOf((*Client)(nil).CreateChatDepartment), ctx, department)
 }
 
-// DeleteAutomation mocks base method.
-func (m *Client) DeleteAutomation(ctx context.Context, id int64) error {
+// CreateChatGoal mocks base method.
+func (m *Client) CreateChatGoal(ctx context.Context, goal zendesk.ChatGoal) (zendesk.ChatGoal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAutomation", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateChatGoal", ctx, goal)
+	ret0, _ := ret[0].(zendesk.ChatGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAutomation indicates an expected call of DeleteAutomation.
-func (mr *ClientMockRecorder) DeleteAutomation(ctx, id any) *gomock.Call {
+// CreateChatGoal indicates an expected call of CreateChatGoal.
+func (mr *ClientMockRecorder) CreateChatGoal(ctx, goal any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutomation", reflect.TypeOf((*Client)(nil).DeleteAutomation), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatGoal", reflect.TypeOf((*Client)(nil).CreateChatGoal), ctx, goal)
 }
 
-// DeleteBrand mocks base method.
-func (m *Client) DeleteBrand(ctx context.Context, brandID int64) error {
+// CreateChatShortcut mocks base method.
+func (m *Client) CreateChatShortcut(ctx context.Context, shortcut zendesk.ChatShortcut) (zendesk.ChatShortcut, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBrand", ctx, brandID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateChatShortcut", ctx, shortcut)
+	ret0, _ := ret[0].(zendesk.ChatShortcut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteBrand indicates an expected call of DeleteBrand.
-func (mr *ClientMockRecorder) DeleteBrand(ctx, brandID any) *gomock.Call {
+// CreateChatShortcut indicates an expected call of CreateChatShortcut.
+func (mr *ClientMockRecorder) CreateChatShortcut(ctx, shortcut any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrand", reflect.TypeOf((*Client)(nil).DeleteBrand), ctx, brandID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatShortcut", reflect.TypeOf((*Client)(nil).CreateChatShortcut), ctx, shortcut)
 }
 
-// DeleteDynamicContentItem mocks base method.
-func (m *Client) DeleteDynamicContentItem(ctx context.Context, id int64) error {
+// CreateChatSkill mocks base method.
+func (m *Client) CreateChatSkill(ctx context.Context, skill zendesk.ChatSkill) (zendesk.ChatSkill, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDynamicContentItem", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateChatSkill", ctx, skill)
+	ret0, _ := ret[0].(zendesk.ChatSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteDynamicContentItem indicates an expected call of DeleteDynamicContentItem.
-func (mr *ClientMockRecorder) DeleteDynamicContentItem(ctx, id any) *gomock.Call {
+// CreateChatSkill indicates an expected call of CreateChatSkill.
+func (mr *ClientMockRecorder) CreateChatSkill(ctx, skill any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicContentItem", reflect.TypeOf((*Client)(nil).DeleteDynamicContentItem), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatSkill", reflect.TypeOf((*Client)(nil).CreateChatSkill), ctx, skill)
 }
 
-// DeleteGroup mocks base method.
-func (m *Client) DeleteGroup(ctx context.Context, groupID int64) error {
+// CreateChatTrigger mocks base method.
+func (m *Client) CreateChatTrigger(ctx context.Context, trigger zendesk.ChatTrigger) (zendesk.ChatTrigger, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateChatTrigger", ctx, trigger)
+	ret0, _ := ret[0].(zendesk.ChatTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteGroup indicates an expected call of DeleteGroup.
-func (mr *ClientMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
+// CreateChatTrigger indicates an expected call of CreateChatTrigger.
+func (mr *ClientMockRecorder) CreateChatTrigger(ctx, trigger any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*Client)(nil).DeleteGroup), ctx, groupID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatTrigger", reflect.TypeOf((*Client)(nil).CreateChatTrigger), ctx, trigger)
 }
 
-// DeleteMacro mocks base method.
-func (m *Client) DeleteMacro(ctx context.Context, macroID int64) error {
+// CreateCustomObject mocks base method.
+func (m *Client) CreateCustomObject(ctx context.Context, customObject zendesk.CustomObject) (zendesk.CustomObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteMacro", ctx, macroID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateCustomObject", ctx, customObject)
+	ret0, _ := ret[0].(zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteMacro indicates an expected call of DeleteMacro.
-func (mr *ClientMockRecorder) DeleteMacro(ctx, macroID any) *gomock.Call {
+// CreateCustomObject indicates an expected call of CreateCustomObject.
+func (mr *ClientMockRecorder) CreateCustomObject(ctx, customObject any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMacro", reflect.TypeOf((*Client)(nil).DeleteMacro), ctx, macroID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomObject", reflect.TypeOf((*Client)(nil).CreateCustomObject), ctx, customObject)
 }
 
-// DeleteOrganization mocks base method.
-func (m *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
+// CreateCustomObjectField mocks base method.
+func (m *Client) CreateCustomObjectField(ctx context.Context, customObjectKey string, field zendesk.CustomObjectField) (zendesk.CustomObjectField, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, orgID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateCustomObjectField", ctx, customObjectKey, field)
+	ret0, _ := ret[0].(zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteOrganization indicates an expected call of DeleteOrganization.
-func (mr *ClientMockRecorder) DeleteOrganization(ctx, orgID any) *gomock.Call {
+// CreateCustomObjectField indicates an expected call of CreateCustomObjectField.
+func (mr *ClientMockRecorder) CreateCustomObjectField(ctx, customObjectKey, field any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*Client)(nil).DeleteOrganization), ctx, orgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomObjectField", reflect.TypeOf((*Client)(nil).CreateCustomObjectField), ctx, customObjectKey, field)
 }
 
-// DeleteSLAPolicy mocks base method.
-func (m *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
+// CreateCustomObjectRecord mocks base method.
+func (m *Client) CreateCustomObjectRecord(ctx context.Context, record zendesk.CustomObjectRecord, customObjectKey string) (zendesk.CustomObjectRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSLAPolicy", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateCustomObjectRecord", ctx, record, customObjectKey)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteSLAPolicy indicates an expected call of DeleteSLAPolicy.
-func (mr *ClientMockRecorder) DeleteSLAPolicy(ctx, id any) *gomock.Call {
+// CreateCustomObjectRecord indicates an expected call of CreateCustomObjectRecord.
+func (mr *ClientMockRecorder) CreateCustomObjectRecord(ctx, record, customObjectKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomObjectRecord", reflect.TypeOf((*Client)(nil).CreateCustomObjectRecord), ctx, record, customObjectKey)
 }
 
-// DeleteTarget mocks base method.
-func (m *Client) DeleteTarget(ctx context.Context, ticketID int64) error {
+// CreateDeletionSchedule mocks base method.
+func (m *Client) CreateDeletionSchedule(ctx context.Context, schedule zendesk.DeletionSchedule) (zendesk.DeletionSchedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTarget", ctx, ticketID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateDeletionSchedule", ctx, schedule)
+	ret0, _ := ret[0].(zendesk.DeletionSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTarget indicates an expected call of DeleteTarget.
-func (mr *ClientMockRecorder) DeleteTarget(ctx, ticketID any) *gomock.Call {
+// CreateDeletionSchedule indicates an expected call of CreateDeletionSchedule.
+func (mr *ClientMockRecorder) CreateDeletionSchedule(ctx, schedule any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTarget", reflect.TypeOf((*Client)(nil).DeleteTarget), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeletionSchedule", reflect.TypeOf((*Client)(nil).CreateDeletionSchedule), ctx, schedule)
 }
 
-// DeleteTicket mocks base method.
-func (m *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+// CreateDynamicContentItem mocks base method.
+func (m *Client) CreateDynamicContentItem(ctx context.Context, item zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateDynamicContentItem", ctx, item)
+	ret0, _ := ret[0].(zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTicket indicates an expected call of DeleteTicket.
-func (mr *ClientMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+// CreateDynamicContentItem indicates an expected call of CreateDynamicContentItem.
+func (mr *ClientMockRecorder) CreateDynamicContentItem(ctx, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*Client)(nil).DeleteTicket), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDynamicContentItem", reflect.TypeOf((*Client)(nil).CreateDynamicContentItem), ctx, item)
 }
 
-// DeleteTicketField mocks base method.
-func (m *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
+// CreateGroup mocks base method.
+func (m *Client) CreateGroup(ctx context.Context, group zendesk.Group) (zendesk.Group, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicketField", ctx, ticketID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, group)
+	ret0, _ := ret[0].(zendesk.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTicketField indicates an expected call of DeleteTicketField.
-func (mr *ClientMockRecorder) DeleteTicketField(ctx, ticketID any) *gomock.Call {
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *ClientMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketField", reflect.TypeOf((*Client)(nil).DeleteTicketField), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*Client)(nil).CreateGroup), ctx, group)
 }
 
-// DeleteTicketForm mocks base method.
-func (m *Client) DeleteTicketForm(ctx context.Context, id int64) error {
+// CreateMacro mocks base method.
+func (m *Client) CreateMacro(ctx context.Context, macro zendesk.Macro) (zendesk.Macro, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTicketForm", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateMacro", ctx, macro)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTicketForm indicates an expected call of DeleteTicketForm.
-func (mr *ClientMockRecorder) DeleteTicketForm(ctx, id any) *gomock.Call {
+// CreateMacro indicates an expected call of CreateMacro.
+func (mr *ClientMockRecorder) CreateMacro(ctx, macro any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketForm", reflect.TypeOf((*Client)(nil).DeleteTicketForm), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacro", reflect.TypeOf((*Client)(nil).CreateMacro), ctx, macro)
 }
 
-// DeleteTrigger mocks base method.
-func (m *Client) DeleteTrigger(ctx context.Context, id int64) error {
+// CreateMacroAttachment mocks base method.
+func (m *Client) CreateMacroAttachment(ctx context.Context, macroID int64, filename string, file io.Reader) (zendesk.MacroAttachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrigger", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateMacroAttachment", ctx, macroID, filename, file)
+	ret0, _ := ret[0].(zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTrigger indicates an expected call of DeleteTrigger.
-func (mr *ClientMockRecorder) DeleteTrigger(ctx, id any) *gomock.Call {
+// CreateMacroAttachment indicates an expected call of CreateMacroAttachment.
+func (mr *ClientMockRecorder) CreateMacroAttachment(ctx, macroID, filename, file any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*Client)(nil).DeleteTrigger), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMacroAttachment", reflect.TypeOf((*Client)(nil).CreateMacroAttachment), ctx, macroID, filename, file)
 }
 
-// DeleteUpload mocks base method.
-func (m *Client) DeleteUpload(ctx context.Context, token string) error {
+// CreateManyCustomObjectRecords mocks base method.
+func (m *Client) CreateManyCustomObjectRecords(ctx context.Context, customObjectKey string, records []zendesk.CustomObjectRecord) (zendesk.CustomObjectRecordsJobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUpload", ctx, token)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateManyCustomObjectRecords", ctx, customObjectKey, records)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordsJobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteUpload indicates an expected call of DeleteUpload.
-func (mr *ClientMockRecorder) DeleteUpload(ctx, token any) *gomock.Call {
+// CreateManyCustomObjectRecords indicates an expected call of CreateManyCustomObjectRecords.
+func (mr *ClientMockRecorder) CreateManyCustomObjectRecords(ctx, customObjectKey, records any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*Client)(nil).DeleteUpload), ctx, token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).CreateManyCustomObjectRecords), ctx, customObjectKey, records)
 }
 
-// DeleteWebhook mocks base method.
-func (m *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+// CreateManyOrganizations mocks base method.
+func (m *Client) CreateManyOrganizations(ctx context.Context, orgs []zendesk.Organization) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, webhookID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateManyOrganizations", ctx, orgs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteWebhook indicates an expected call of DeleteWebhook.
-func (mr *ClientMockRecorder) DeleteWebhook(ctx, webhookID any) *gomock.Call {
+// CreateManyOrganizations indicates an expected call of CreateManyOrganizations.
+func (mr *ClientMockRecorder) CreateManyOrganizations(ctx, orgs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*Client)(nil).DeleteWebhook), ctx, webhookID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyOrganizations", reflect.TypeOf((*Client)(nil).CreateManyOrganizations), ctx, orgs)
 }
 
-// Get mocks base method.
-func (m *Client) Get(ctx context.Context, path string) ([]byte, error) {
+// CreateManyUsers mocks base method.
+func (m *Client) CreateManyUsers(ctx context.Context, users []zendesk.User) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, path)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "CreateManyUsers", ctx, users)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get.
-func (mr *ClientMockRecorder) Get(ctx, path any) *gomock.Call {
+// CreateManyUsers indicates an expected call of CreateManyUsers.
+func (mr *ClientMockRecorder) CreateManyUsers(ctx, users any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), ctx, path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManyUsers", reflect.TypeOf((*Client)(nil).CreateManyUsers), ctx, users)
 }
 
-// GetAllTicketAudits mocks base method.
-func (m *Client) GetAllTicketAudits(ctx context.Context, opts zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
+// CreateOrUpdateManyCustomObjectRecords mocks base method.
+func (m *Client) CreateOrUpdateManyCustomObjectRecords(ctx context.Context, customObjectKey string, records []zendesk.CustomObjectRecord) (zendesk.CustomObjectRecordsJobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTicketAudits", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketAudit)
-	ret1, _ := ret[1].(zendesk.Cursor)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "CreateOrUpdateManyCustomObjectRecords", ctx, customObjectKey, records)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordsJobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAllTicketAudits indicates an expected call of GetAllTicketAudits.
-func (mr *ClientMockRecorder) GetAllTicketAudits(ctx, opts any) *gomock.Call {
+// CreateOrUpdateManyCustomObjectRecords indicates an expected call of CreateOrUpdateManyCustomObjectRecords.
+func (mr *ClientMockRecorder) CreateOrUpdateManyCustomObjectRecords(ctx, customObjectKey, records any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketAudits", reflect.TypeOf((*Client)(nil).GetAllTicketAudits), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).CreateOrUpdateManyCustomObjectRecords), ctx, customObjectKey, records)
 }
 
-// GetAttachment mocks base method.
-func (m *Client) GetAttachment(ctx context.Context, id int64) (zendesk.Attachment, error) {
+// CreateOrUpdateManyUsers mocks base method.
+func (m *Client) CreateOrUpdateManyUsers(ctx context.Context, users []zendesk.User) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAttachment", ctx, id)
-	ret0, _ := ret[0].(zendesk.Attachment)
+	ret := m.ctrl.Call(m, "CreateOrUpdateManyUsers", ctx, users)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAttachment indicates an expected call of GetAttachment.
-func (mr *ClientMockRecorder) GetAttachment(ctx, id any) *gomock.Call {
+// CreateOrUpdateManyUsers indicates an expected call of CreateOrUpdateManyUsers.
+func (mr *ClientMockRecorder) CreateOrUpdateManyUsers(ctx, users any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*Client)(nil).GetAttachment), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateManyUsers", reflect.TypeOf((*Client)(nil).CreateOrUpdateManyUsers), ctx, users)
 }
 
-// GetAutomation mocks base method.
-func (m *Client) GetAutomation(ctx context.Context, id int64) (zendesk.Automation, error) {
+// CreateOrUpdateOrganizationFieldOption mocks base method.
+func (m *Client) CreateOrUpdateOrganizationFieldOption(ctx context.Context, fieldID int64, option zendesk.CustomFieldOption) (zendesk.CustomFieldOption, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomation", ctx, id)
-	ret0, _ := ret[0].(zendesk.Automation)
+	ret := m.ctrl.Call(m, "CreateOrUpdateOrganizationFieldOption", ctx, fieldID, option)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAutomation indicates an expected call of GetAutomation.
-func (mr *ClientMockRecorder) GetAutomation(ctx, id any) *gomock.Call {
+// CreateOrUpdateOrganizationFieldOption indicates an expected call of CreateOrUpdateOrganizationFieldOption.
+func (mr *ClientMockRecorder) CreateOrUpdateOrganizationFieldOption(ctx, fieldID, option any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomation", reflect.TypeOf((*Client)(nil).GetAutomation), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateOrganizationFieldOption", reflect.TypeOf((*Client)(nil).CreateOrUpdateOrganizationFieldOption), ctx, fieldID, option)
 }
 
-// GetAutomations mocks base method.
-func (m *Client) GetAutomations(ctx context.Context, opts *zendesk.AutomationListOptions) ([]zendesk.Automation, zendesk.Page, error) {
+// CreateOrUpdateUser mocks base method.
+func (m *Client) CreateOrUpdateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomations", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Automation)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "CreateOrUpdateUser", ctx, user)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAutomations indicates an expected call of GetAutomations.
-func (mr *ClientMockRecorder) GetAutomations(ctx, opts any) *gomock.Call {
+// CreateOrUpdateUser indicates an expected call of CreateOrUpdateUser.
+func (mr *ClientMockRecorder) CreateOrUpdateUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomations", reflect.TypeOf((*Client)(nil).GetAutomations), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUser", reflect.TypeOf((*Client)(nil).CreateOrUpdateUser), ctx, user)
 }
 
-// GetAutomationsCBP mocks base method.
-func (m *Client) GetAutomationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Automation, zendesk.CursorPaginationMeta, error) {
+// CreateOrUpdateUserFieldOption mocks base method.
+func (m *Client) CreateOrUpdateUserFieldOption(ctx context.Context, fieldID int64, option zendesk.CustomFieldOption) (zendesk.CustomFieldOption, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomationsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Automation)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "CreateOrUpdateUserFieldOption", ctx, fieldID, option)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAutomationsCBP indicates an expected call of GetAutomationsCBP.
-func (mr *ClientMockRecorder) GetAutomationsCBP(ctx, opts any) *gomock.Call {
+// CreateOrUpdateUserFieldOption indicates an expected call of CreateOrUpdateUserFieldOption.
+func (mr *ClientMockRecorder) CreateOrUpdateUserFieldOption(ctx, fieldID, option any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsCBP", reflect.TypeOf((*Client)(nil).GetAutomationsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUserFieldOption", reflect.TypeOf((*Client)(nil).CreateOrUpdateUserFieldOption), ctx, fieldID, option)
 }
 
-// GetAutomationsIterator mocks base method.
-func (m *Client) GetAutomationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Automation] {
+// CreateOrganization mocks base method.
+func (m *Client) CreateOrganization(ctx context.Context, org zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomationsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Automation])
-	return ret0
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, org)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAutomationsIterator indicates an expected call of GetAutomationsIterator.
-func (mr *ClientMockRecorder) GetAutomationsIterator(ctx, opts any) *gomock.Call {
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *ClientMockRecorder) CreateOrganization(ctx, org any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsIterator", reflect.TypeOf((*Client)(nil).GetAutomationsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*Client)(nil).CreateOrganization), ctx, org)
 }
 
-// GetAutomationsOBP mocks base method.
-func (m *Client) GetAutomationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Automation, zendesk.Page, error) {
+// CreateOrganizationField mocks base method.
+func (m *Client) CreateOrganizationField(ctx context.Context, organizationField zendesk.OrganizationField) (zendesk.OrganizationField, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAutomationsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Automation)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "CreateOrganizationField", ctx, organizationField)
+	ret0, _ := ret[0].(zendesk.OrganizationField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAutomationsOBP indicates an expected call of GetAutomationsOBP.
-func (mr *ClientMockRecorder) GetAutomationsOBP(ctx, opts any) *gomock.Call {
+// CreateOrganizationField indicates an expected call of CreateOrganizationField.
+func (mr *ClientMockRecorder) CreateOrganizationField(ctx, organizationField any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsOBP", reflect.TypeOf((*Client)(nil).GetAutomationsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationField", reflect.TypeOf((*Client)(nil).CreateOrganizationField), ctx, organizationField)
 }
 
-// GetBrand mocks base method.
-func (m *Client) GetBrand(ctx context.Context, brandID int64) (zendesk.Brand, error) {
+// CreateOrganizationMembership mocks base method.
+func (m *Client) CreateOrganizationMembership(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBrand", ctx, brandID)
-	ret0, _ := ret[0].(zendesk.Brand)
+	ret := m.ctrl.Call(m, "CreateOrganizationMembership", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationMembership)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBrand indicates an expected call of GetBrand.
-func (mr *ClientMockRecorder) GetBrand(ctx, brandID any) *gomock.Call {
+// CreateOrganizationMembership indicates an expected call of CreateOrganizationMembership.
+func (mr *ClientMockRecorder) CreateOrganizationMembership(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrand", reflect.TypeOf((*Client)(nil).GetBrand), ctx, brandID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMembership", reflect.TypeOf((*Client)(nil).CreateOrganizationMembership), arg0, arg1)
 }
 
-// GetCountTicketsInViews mocks base method.
-func (m *Client) GetCountTicketsInViews(ctx context.Context, ids []string) ([]zendesk.ViewCount, error) {
+// CreatePermissionGroup mocks base method.
+func (m *Client) CreatePermissionGroup(ctx context.Context, permissionGroup zendesk.PermissionGroup) (zendesk.PermissionGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCountTicketsInViews", ctx, ids)
-	ret0, _ := ret[0].([]zendesk.ViewCount)
+	ret := m.ctrl.Call(m, "CreatePermissionGroup", ctx, permissionGroup)
+	ret0, _ := ret[0].(zendesk.PermissionGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCountTicketsInViews indicates an expected call of GetCountTicketsInViews.
-func (mr *ClientMockRecorder) GetCountTicketsInViews(ctx, ids any) *gomock.Call {
+// CreatePermissionGroup indicates an expected call of CreatePermissionGroup.
+func (mr *ClientMockRecorder) CreatePermissionGroup(ctx, permissionGroup any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountTicketsInViews", reflect.TypeOf((*Client)(nil).GetCountTicketsInViews), ctx, ids)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermissionGroup", reflect.TypeOf((*Client)(nil).CreatePermissionGroup), ctx, permissionGroup)
 }
 
-// GetCustomRoles mocks base method.
-func (m *Client) GetCustomRoles(ctx context.Context) ([]zendesk.CustomRole, error) {
+// CreatePost mocks base method.
+func (m *Client) CreatePost(ctx context.Context, post zendesk.Post, notifySubscribers bool) (zendesk.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomRoles", ctx)
-	ret0, _ := ret[0].([]zendesk.CustomRole)
+	ret := m.ctrl.Call(m, "CreatePost", ctx, post, notifySubscribers)
+	ret0, _ := ret[0].(zendesk.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCustomRoles indicates an expected call of GetCustomRoles.
-func (mr *ClientMockRecorder) GetCustomRoles(ctx any) *gomock.Call {
+// CreatePost indicates an expected call of CreatePost.
+func (mr *ClientMockRecorder) CreatePost(ctx, post, notifySubscribers any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRoles", reflect.TypeOf((*Client)(nil).GetCustomRoles), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*Client)(nil).CreatePost), ctx, post, notifySubscribers)
 }
 
-// GetDynamicContentItem mocks base method.
-func (m *Client) GetDynamicContentItem(ctx context.Context, id int64) (zendesk.DynamicContentItem, error) {
+// CreatePostComment mocks base method.
+func (m *Client) CreatePostComment(ctx context.Context, postID int64, comment zendesk.PostComment, notifySubscribers bool) (zendesk.PostComment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItem", ctx, id)
-	ret0, _ := ret[0].(zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "CreatePostComment", ctx, postID, comment, notifySubscribers)
+	ret0, _ := ret[0].(zendesk.PostComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDynamicContentItem indicates an expected call of GetDynamicContentItem.
-func (mr *ClientMockRecorder) GetDynamicContentItem(ctx, id any) *gomock.Call {
+// CreatePostComment indicates an expected call of CreatePostComment.
+func (mr *ClientMockRecorder) CreatePostComment(ctx, postID, comment, notifySubscribers any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItem", reflect.TypeOf((*Client)(nil).GetDynamicContentItem), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostComment", reflect.TypeOf((*Client)(nil).CreatePostComment), ctx, postID, comment, notifySubscribers)
 }
 
-// GetDynamicContentItems mocks base method.
-func (m *Client) GetDynamicContentItems(ctx context.Context) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+// CreatePostCommentVote mocks base method.
+func (m *Client) CreatePostCommentVote(ctx context.Context, postID, commentID int64, direction string) (zendesk.Vote, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItems", ctx)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "CreatePostCommentVote", ctx, postID, commentID, direction)
+	ret0, _ := ret[0].(zendesk.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetDynamicContentItems indicates an expected call of GetDynamicContentItems.
-func (mr *ClientMockRecorder) GetDynamicContentItems(ctx any) *gomock.Call {
+// CreatePostCommentVote indicates an expected call of CreatePostCommentVote.
+func (mr *ClientMockRecorder) CreatePostCommentVote(ctx, postID, commentID, direction any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItems", reflect.TypeOf((*Client)(nil).GetDynamicContentItems), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostCommentVote", reflect.TypeOf((*Client)(nil).CreatePostCommentVote), ctx, postID, commentID, direction)
 }
 
-// GetDynamicContentItemsCBP mocks base method.
+// CreatePostSubscription mocks base method.
+func (m *Client) CreatePostSubscription(ctx context.Context, postID int64, subscription zendesk.Subscription) (zendesk.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePostSubscription", ctx, postID, subscription)
+	ret0, _ := ret[0].(zendesk.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePostSubscription indicates an expected call of CreatePostSubscription.
+func (mr *ClientMockRecorder) CreatePostSubscription(ctx, postID, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostSubscription", reflect.TypeOf((*Client)(nil).CreatePostSubscription), ctx, postID, subscription)
+}
+
+// CreatePostVote mocks base method.
+func (m *Client) CreatePostVote(ctx context.Context, postID int64, direction string) (zendesk.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePostVote", ctx, postID, direction)
+	ret0, _ := ret[0].(zendesk.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePostVote indicates an expected call of CreatePostVote.
+func (mr *ClientMockRecorder) CreatePostVote(ctx, postID, direction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePostVote", reflect.TypeOf((*Client)(nil).CreatePostVote), ctx, postID, direction)
+}
+
+// CreateSLAPolicy mocks base method.
+func (m *Client) CreateSLAPolicy(ctx context.Context, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSLAPolicy", ctx, slaPolicy)
+	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSLAPolicy indicates an expected call of CreateSLAPolicy.
+func (mr *ClientMockRecorder) CreateSLAPolicy(ctx, slaPolicy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLAPolicy", reflect.TypeOf((*Client)(nil).CreateSLAPolicy), ctx, slaPolicy)
+}
+
+// CreateSection mocks base method.
+func (m *Client) CreateSection(ctx context.Context, categoryID int64, section zendesk.Section) (zendesk.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", ctx, categoryID, section)
+	ret0, _ := ret[0].(zendesk.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *ClientMockRecorder) CreateSection(ctx, categoryID, section any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*Client)(nil).CreateSection), ctx, categoryID, section)
+}
+
+// CreateSectionSubscription mocks base method.
+func (m *Client) CreateSectionSubscription(ctx context.Context, sectionID int64, subscription zendesk.Subscription) (zendesk.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSectionSubscription", ctx, sectionID, subscription)
+	ret0, _ := ret[0].(zendesk.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSectionSubscription indicates an expected call of CreateSectionSubscription.
+func (mr *ClientMockRecorder) CreateSectionSubscription(ctx, sectionID, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSectionSubscription", reflect.TypeOf((*Client)(nil).CreateSectionSubscription), ctx, sectionID, subscription)
+}
+
+// CreateSectionTranslation mocks base method.
+func (m *Client) CreateSectionTranslation(ctx context.Context, sectionID int64, translation zendesk.Translation) (zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSectionTranslation", ctx, sectionID, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSectionTranslation indicates an expected call of CreateSectionTranslation.
+func (mr *ClientMockRecorder) CreateSectionTranslation(ctx, sectionID, translation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSectionTranslation", reflect.TypeOf((*Client)(nil).CreateSectionTranslation), ctx, sectionID, translation)
+}
+
+// CreateTalkDigitalLine mocks base method.
+func (m *Client) CreateTalkDigitalLine(ctx context.Context, digitalLine zendesk.TalkLine) (zendesk.TalkLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTalkDigitalLine", ctx, digitalLine)
+	ret0, _ := ret[0].(zendesk.TalkLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTalkDigitalLine indicates an expected call of CreateTalkDigitalLine.
+func (mr *ClientMockRecorder) CreateTalkDigitalLine(ctx, digitalLine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTalkDigitalLine", reflect.TypeOf((*Client)(nil).CreateTalkDigitalLine), ctx, digitalLine)
+}
+
+// CreateTalkGreeting mocks base method.
+func (m *Client) CreateTalkGreeting(ctx context.Context, greeting zendesk.TalkGreeting) (zendesk.TalkGreeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTalkGreeting", ctx, greeting)
+	ret0, _ := ret[0].(zendesk.TalkGreeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTalkGreeting indicates an expected call of CreateTalkGreeting.
+func (mr *ClientMockRecorder) CreateTalkGreeting(ctx, greeting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTalkGreeting", reflect.TypeOf((*Client)(nil).CreateTalkGreeting), ctx, greeting)
+}
+
+// CreateTalkIVR mocks base method.
+func (m *Client) CreateTalkIVR(ctx context.Context, ivr zendesk.TalkIVR) (zendesk.TalkIVR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTalkIVR", ctx, ivr)
+	ret0, _ := ret[0].(zendesk.TalkIVR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTalkIVR indicates an expected call of CreateTalkIVR.
+func (mr *ClientMockRecorder) CreateTalkIVR(ctx, ivr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTalkIVR", reflect.TypeOf((*Client)(nil).CreateTalkIVR), ctx, ivr)
+}
+
+// CreateTalkIVRMenu mocks base method.
+func (m *Client) CreateTalkIVRMenu(ctx context.Context, ivrID int64, menu zendesk.TalkIVRMenu) (zendesk.TalkIVRMenu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTalkIVRMenu", ctx, ivrID, menu)
+	ret0, _ := ret[0].(zendesk.TalkIVRMenu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTalkIVRMenu indicates an expected call of CreateTalkIVRMenu.
+func (mr *ClientMockRecorder) CreateTalkIVRMenu(ctx, ivrID, menu any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTalkIVRMenu", reflect.TypeOf((*Client)(nil).CreateTalkIVRMenu), ctx, ivrID, menu)
+}
+
+// CreateTalkIVRRoute mocks base method.
+func (m *Client) CreateTalkIVRRoute(ctx context.Context, ivrID, menuID int64, route zendesk.TalkIVRRoute) (zendesk.TalkIVRRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTalkIVRRoute", ctx, ivrID, menuID, route)
+	ret0, _ := ret[0].(zendesk.TalkIVRRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTalkIVRRoute indicates an expected call of CreateTalkIVRRoute.
+func (mr *ClientMockRecorder) CreateTalkIVRRoute(ctx, ivrID, menuID, route any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTalkIVRRoute", reflect.TypeOf((*Client)(nil).CreateTalkIVRRoute), ctx, ivrID, menuID, route)
+}
+
+// CreateTarget mocks base method.
+func (m *Client) CreateTarget(ctx context.Context, ticketField zendesk.Target) (zendesk.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTarget", ctx, ticketField)
+	ret0, _ := ret[0].(zendesk.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTarget indicates an expected call of CreateTarget.
+func (mr *ClientMockRecorder) CreateTarget(ctx, ticketField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTarget", reflect.TypeOf((*Client)(nil).CreateTarget), ctx, ticketField)
+}
+
+// CreateThemeExportJob mocks base method.
+func (m *Client) CreateThemeExportJob(ctx context.Context, themeID string) (zendesk.ThemeJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateThemeExportJob", ctx, themeID)
+	ret0, _ := ret[0].(zendesk.ThemeJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateThemeExportJob indicates an expected call of CreateThemeExportJob.
+func (mr *ClientMockRecorder) CreateThemeExportJob(ctx, themeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateThemeExportJob", reflect.TypeOf((*Client)(nil).CreateThemeExportJob), ctx, themeID)
+}
+
+// CreateThemeImportJob mocks base method.
+func (m *Client) CreateThemeImportJob(ctx context.Context, brandID int64) (zendesk.ThemeJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateThemeImportJob", ctx, brandID)
+	ret0, _ := ret[0].(zendesk.ThemeJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateThemeImportJob indicates an expected call of CreateThemeImportJob.
+func (mr *ClientMockRecorder) CreateThemeImportJob(ctx, brandID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateThemeImportJob", reflect.TypeOf((*Client)(nil).CreateThemeImportJob), ctx, brandID)
+}
+
+// CreateThemeUpdateJob mocks base method.
+func (m *Client) CreateThemeUpdateJob(ctx context.Context, themeID string, replaceSettings bool) (zendesk.ThemeJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateThemeUpdateJob", ctx, themeID, replaceSettings)
+	ret0, _ := ret[0].(zendesk.ThemeJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateThemeUpdateJob indicates an expected call of CreateThemeUpdateJob.
+func (mr *ClientMockRecorder) CreateThemeUpdateJob(ctx, themeID, replaceSettings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateThemeUpdateJob", reflect.TypeOf((*Client)(nil).CreateThemeUpdateJob), ctx, themeID, replaceSettings)
+}
+
+// CreateTicket mocks base method.
+func (m *Client) CreateTicket(ctx context.Context, ticket zendesk.Ticket) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, ticket)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *ClientMockRecorder) CreateTicket(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*Client)(nil).CreateTicket), ctx, ticket)
+}
+
+// CreateTicketComment mocks base method.
+func (m *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticketComment zendesk.TicketComment) (zendesk.TicketComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketComment", ctx, ticketID, ticketComment)
+	ret0, _ := ret[0].(zendesk.TicketComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketComment indicates an expected call of CreateTicketComment.
+func (mr *ClientMockRecorder) CreateTicketComment(ctx, ticketID, ticketComment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketComment", reflect.TypeOf((*Client)(nil).CreateTicketComment), ctx, ticketID, ticketComment)
+}
+
+// CreateTicketField mocks base method.
+func (m *Client) CreateTicketField(ctx context.Context, ticketField zendesk.TicketField) (zendesk.TicketField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketField", ctx, ticketField)
+	ret0, _ := ret[0].(zendesk.TicketField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketField indicates an expected call of CreateTicketField.
+func (mr *ClientMockRecorder) CreateTicketField(ctx, ticketField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketField", reflect.TypeOf((*Client)(nil).CreateTicketField), ctx, ticketField)
+}
+
+// CreateTicketForm mocks base method.
+func (m *Client) CreateTicketForm(ctx context.Context, ticketForm zendesk.TicketForm) (zendesk.TicketForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketForm", ctx, ticketForm)
+	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketForm indicates an expected call of CreateTicketForm.
+func (mr *ClientMockRecorder) CreateTicketForm(ctx, ticketForm any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketForm", reflect.TypeOf((*Client)(nil).CreateTicketForm), ctx, ticketForm)
+}
+
+// CreateTicketFromTweet mocks base method.
+func (m *Client) CreateTicketFromTweet(ctx context.Context, tweetID, handleID int64) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicketFromTweet", ctx, tweetID, handleID)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicketFromTweet indicates an expected call of CreateTicketFromTweet.
+func (mr *ClientMockRecorder) CreateTicketFromTweet(ctx, tweetID, handleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicketFromTweet", reflect.TypeOf((*Client)(nil).CreateTicketFromTweet), ctx, tweetID, handleID)
+}
+
+// CreateTopic mocks base method.
+func (m *Client) CreateTopic(ctx context.Context, topic zendesk.Topic) (zendesk.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTopic", ctx, topic)
+	ret0, _ := ret[0].(zendesk.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTopic indicates an expected call of CreateTopic.
+func (mr *ClientMockRecorder) CreateTopic(ctx, topic any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTopic", reflect.TypeOf((*Client)(nil).CreateTopic), ctx, topic)
+}
+
+// CreateTopicSubscription mocks base method.
+func (m *Client) CreateTopicSubscription(ctx context.Context, topicID int64, subscription zendesk.Subscription) (zendesk.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTopicSubscription", ctx, topicID, subscription)
+	ret0, _ := ret[0].(zendesk.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTopicSubscription indicates an expected call of CreateTopicSubscription.
+func (mr *ClientMockRecorder) CreateTopicSubscription(ctx, topicID, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTopicSubscription", reflect.TypeOf((*Client)(nil).CreateTopicSubscription), ctx, topicID, subscription)
+}
+
+// CreateTrigger mocks base method.
+func (m *Client) CreateTrigger(ctx context.Context, trigger zendesk.Trigger) (zendesk.Trigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrigger", ctx, trigger)
+	ret0, _ := ret[0].(zendesk.Trigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrigger indicates an expected call of CreateTrigger.
+func (mr *ClientMockRecorder) CreateTrigger(ctx, trigger any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrigger", reflect.TypeOf((*Client)(nil).CreateTrigger), ctx, trigger)
+}
+
+// CreateTriggerCategory mocks base method.
+func (m *Client) CreateTriggerCategory(ctx context.Context, category zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTriggerCategory", ctx, category)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTriggerCategory indicates an expected call of CreateTriggerCategory.
+func (mr *ClientMockRecorder) CreateTriggerCategory(ctx, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTriggerCategory", reflect.TypeOf((*Client)(nil).CreateTriggerCategory), ctx, category)
+}
+
+// CreateTriggerCategoryBatchJob mocks base method.
+func (m *Client) CreateTriggerCategoryBatchJob(ctx context.Context, job zendesk.TriggerCategoryBatchJob) (zendesk.TriggerCategoryBatchJobResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTriggerCategoryBatchJob", ctx, job)
+	ret0, _ := ret[0].(zendesk.TriggerCategoryBatchJobResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTriggerCategoryBatchJob indicates an expected call of CreateTriggerCategoryBatchJob.
+func (mr *ClientMockRecorder) CreateTriggerCategoryBatchJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTriggerCategoryBatchJob", reflect.TypeOf((*Client)(nil).CreateTriggerCategoryBatchJob), ctx, job)
+}
+
+// CreateUser mocks base method.
+func (m *Client) CreateUser(ctx context.Context, user zendesk.User) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *ClientMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*Client)(nil).CreateUser), ctx, user)
+}
+
+// CreateUserField mocks base method.
+func (m *Client) CreateUserField(ctx context.Context, userField zendesk.UserField) (zendesk.UserField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserField", ctx, userField)
+	ret0, _ := ret[0].(zendesk.UserField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserField indicates an expected call of CreateUserField.
+func (mr *ClientMockRecorder) CreateUserField(ctx, userField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserField", reflect.TypeOf((*Client)(nil).CreateUserField), ctx, userField)
+}
+
+// CreateUserImage mocks base method.
+func (m *Client) CreateUserImage(ctx context.Context, token string) (zendesk.UserImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserImage", ctx, token)
+	ret0, _ := ret[0].(zendesk.UserImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserImage indicates an expected call of CreateUserImage.
+func (mr *ClientMockRecorder) CreateUserImage(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserImage", reflect.TypeOf((*Client)(nil).CreateUserImage), ctx, token)
+}
+
+// CreateUserImageUpload mocks base method.
+func (m *Client) CreateUserImageUpload(ctx context.Context, contentType string, fileSize int64) (zendesk.UserImageUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserImageUpload", ctx, contentType, fileSize)
+	ret0, _ := ret[0].(zendesk.UserImageUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserImageUpload indicates an expected call of CreateUserImageUpload.
+func (mr *ClientMockRecorder) CreateUserImageUpload(ctx, contentType, fileSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserImageUpload", reflect.TypeOf((*Client)(nil).CreateUserImageUpload), ctx, contentType, fileSize)
+}
+
+// CreateView mocks base method.
+func (m *Client) CreateView(ctx context.Context, view zendesk.View) (zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateView", ctx, view)
+	ret0, _ := ret[0].(zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateView indicates an expected call of CreateView.
+func (mr *ClientMockRecorder) CreateView(ctx, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateView", reflect.TypeOf((*Client)(nil).CreateView), ctx, view)
+}
+
+// CreateWebhook mocks base method.
+func (m *Client) CreateWebhook(ctx context.Context, hook *zendesk.Webhook) (*zendesk.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, hook)
+	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *ClientMockRecorder) CreateWebhook(ctx, hook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*Client)(nil).CreateWebhook), ctx, hook)
+}
+
+// Delete mocks base method.
+func (m *Client) Delete(ctx context.Context, path string, data any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, path, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *ClientMockRecorder) Delete(ctx, path, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*Client)(nil).Delete), ctx, path, data)
+}
+
+// DeleteArticleAttachment mocks base method.
+func (m *Client) DeleteArticleAttachment(ctx context.Context, attachmentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleAttachment indicates an expected call of DeleteArticleAttachment.
+func (mr *ClientMockRecorder) DeleteArticleAttachment(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleAttachment", reflect.TypeOf((*Client)(nil).DeleteArticleAttachment), ctx, attachmentID)
+}
+
+// DeleteArticleComment mocks base method.
+func (m *Client) DeleteArticleComment(ctx context.Context, articleID, commentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleComment", ctx, articleID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleComment indicates an expected call of DeleteArticleComment.
+func (mr *ClientMockRecorder) DeleteArticleComment(ctx, articleID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleComment", reflect.TypeOf((*Client)(nil).DeleteArticleComment), ctx, articleID, commentID)
+}
+
+// DeleteArticleSubscription mocks base method.
+func (m *Client) DeleteArticleSubscription(ctx context.Context, articleID, subscriptionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArticleSubscription", ctx, articleID, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArticleSubscription indicates an expected call of DeleteArticleSubscription.
+func (mr *ClientMockRecorder) DeleteArticleSubscription(ctx, articleID, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArticleSubscription", reflect.TypeOf((*Client)(nil).DeleteArticleSubscription), ctx, articleID, subscriptionID)
+}
+
+// DeleteAutomation mocks base method.
+func (m *Client) DeleteAutomation(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutomation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAutomation indicates an expected call of DeleteAutomation.
+func (mr *ClientMockRecorder) DeleteAutomation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutomation", reflect.TypeOf((*Client)(nil).DeleteAutomation), ctx, id)
+}
+
+// DeleteBrand mocks base method.
+func (m *Client) DeleteBrand(ctx context.Context, brandID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBrand", ctx, brandID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBrand indicates an expected call of DeleteBrand.
+func (mr *ClientMockRecorder) DeleteBrand(ctx, brandID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrand", reflect.TypeOf((*Client)(nil).DeleteBrand), ctx, brandID)
+}
+
+// DeleteCategory mocks base method.
+func (m *Client) DeleteCategory(ctx context.Context, categoryID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, categoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *ClientMockRecorder) DeleteCategory(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*Client)(nil).DeleteCategory), ctx, categoryID)
+}
+
+// DeleteChat mocks base method.
+func (m *Client) DeleteChat(ctx context.Context, chatID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChat", ctx, chatID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChat indicates an expected call of DeleteChat.
+func (mr *ClientMockRecorder) DeleteChat(ctx, chatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChat", reflect.TypeOf((*Client)(nil).DeleteChat), ctx, chatID)
+}
+
+// DeleteChatBan mocks base method.
+func (m *Client) DeleteChatBan(ctx context.Context, banID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatBan", ctx, banID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatBan indicates an expected call of DeleteChatBan.
+func (mr *ClientMockRecorder) DeleteChatBan(ctx, banID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatBan", reflect.TypeOf((*Client)(nil).DeleteChatBan), ctx, banID)
+}
+
+// DeleteChatDepartment mocks base method.
+func (m *Client) DeleteChatDepartment(ctx context.Context, departmentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatDepartment", ctx, departmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatDepartment indicates an expected call of DeleteChatDepartment.
+func (mr *ClientMockRecorder) DeleteChatDepartment(ctx, departmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatDepartment", reflect.TypeOf((*Client)(nil).DeleteChatDepartment), ctx, departmentID)
+}
+
+// DeleteChatGoal mocks base method.
+func (m *Client) DeleteChatGoal(ctx context.Context, goalID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatGoal", ctx, goalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatGoal indicates an expected call of DeleteChatGoal.
+func (mr *ClientMockRecorder) DeleteChatGoal(ctx, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatGoal", reflect.TypeOf((*Client)(nil).DeleteChatGoal), ctx, goalID)
+}
+
+// DeleteChatShortcut mocks base method.
+func (m *Client) DeleteChatShortcut(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatShortcut", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatShortcut indicates an expected call of DeleteChatShortcut.
+func (mr *ClientMockRecorder) DeleteChatShortcut(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatShortcut", reflect.TypeOf((*Client)(nil).DeleteChatShortcut), ctx, name)
+}
+
+// DeleteChatSkill mocks base method.
+func (m *Client) DeleteChatSkill(ctx context.Context, skillID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatSkill", ctx, skillID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatSkill indicates an expected call of DeleteChatSkill.
+func (mr *ClientMockRecorder) DeleteChatSkill(ctx, skillID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatSkill", reflect.TypeOf((*Client)(nil).DeleteChatSkill), ctx, skillID)
+}
+
+// DeleteChatTrigger mocks base method.
+func (m *Client) DeleteChatTrigger(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatTrigger", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatTrigger indicates an expected call of DeleteChatTrigger.
+func (mr *ClientMockRecorder) DeleteChatTrigger(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatTrigger", reflect.TypeOf((*Client)(nil).DeleteChatTrigger), ctx, name)
+}
+
+// DeleteCustomObject mocks base method.
+func (m *Client) DeleteCustomObject(ctx context.Context, customObjectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObject", ctx, customObjectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObject indicates an expected call of DeleteCustomObject.
+func (mr *ClientMockRecorder) DeleteCustomObject(ctx, customObjectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObject", reflect.TypeOf((*Client)(nil).DeleteCustomObject), ctx, customObjectKey)
+}
+
+// DeleteCustomObjectField mocks base method.
+func (m *Client) DeleteCustomObjectField(ctx context.Context, customObjectKey, fieldKeyOrID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObjectField", ctx, customObjectKey, fieldKeyOrID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObjectField indicates an expected call of DeleteCustomObjectField.
+func (mr *ClientMockRecorder) DeleteCustomObjectField(ctx, customObjectKey, fieldKeyOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObjectField", reflect.TypeOf((*Client)(nil).DeleteCustomObjectField), ctx, customObjectKey, fieldKeyOrID)
+}
+
+// DeleteCustomObjectRecord mocks base method.
+func (m *Client) DeleteCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObjectRecord", ctx, customObjectKey, customObjectRecordID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObjectRecord indicates an expected call of DeleteCustomObjectRecord.
+func (mr *ClientMockRecorder) DeleteCustomObjectRecord(ctx, customObjectKey, customObjectRecordID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObjectRecord", reflect.TypeOf((*Client)(nil).DeleteCustomObjectRecord), ctx, customObjectKey, customObjectRecordID)
+}
+
+// DeleteCustomObjectRecordByExternalID mocks base method.
+func (m *Client) DeleteCustomObjectRecordByExternalID(ctx context.Context, customObjectKey, externalID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomObjectRecordByExternalID", ctx, customObjectKey, externalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomObjectRecordByExternalID indicates an expected call of DeleteCustomObjectRecordByExternalID.
+func (mr *ClientMockRecorder) DeleteCustomObjectRecordByExternalID(ctx, customObjectKey, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomObjectRecordByExternalID", reflect.TypeOf((*Client)(nil).DeleteCustomObjectRecordByExternalID), ctx, customObjectKey, externalID)
+}
+
+// DeleteDeletionSchedule mocks base method.
+func (m *Client) DeleteDeletionSchedule(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeletionSchedule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeletionSchedule indicates an expected call of DeleteDeletionSchedule.
+func (mr *ClientMockRecorder) DeleteDeletionSchedule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeletionSchedule", reflect.TypeOf((*Client)(nil).DeleteDeletionSchedule), ctx, id)
+}
+
+// DeleteDynamicContentItem mocks base method.
+func (m *Client) DeleteDynamicContentItem(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDynamicContentItem", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDynamicContentItem indicates an expected call of DeleteDynamicContentItem.
+func (mr *ClientMockRecorder) DeleteDynamicContentItem(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicContentItem", reflect.TypeOf((*Client)(nil).DeleteDynamicContentItem), ctx, id)
+}
+
+// DeleteEssentialsCard mocks base method.
+func (m *Client) DeleteEssentialsCard(ctx context.Context, objectType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEssentialsCard", ctx, objectType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEssentialsCard indicates an expected call of DeleteEssentialsCard.
+func (mr *ClientMockRecorder) DeleteEssentialsCard(ctx, objectType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEssentialsCard", reflect.TypeOf((*Client)(nil).DeleteEssentialsCard), ctx, objectType)
+}
+
+// DeleteGroup mocks base method.
+func (m *Client) DeleteGroup(ctx context.Context, groupID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *ClientMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*Client)(nil).DeleteGroup), ctx, groupID)
+}
+
+// DeleteMacro mocks base method.
+func (m *Client) DeleteMacro(ctx context.Context, macroID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMacro", ctx, macroID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMacro indicates an expected call of DeleteMacro.
+func (mr *ClientMockRecorder) DeleteMacro(ctx, macroID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMacro", reflect.TypeOf((*Client)(nil).DeleteMacro), ctx, macroID)
+}
+
+// DeleteManyCustomObjectRecords mocks base method.
+func (m *Client) DeleteManyCustomObjectRecords(ctx context.Context, customObjectKey string, recordIDs []string) (zendesk.CustomObjectRecordsJobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyCustomObjectRecords", ctx, customObjectKey, recordIDs)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordsJobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyCustomObjectRecords indicates an expected call of DeleteManyCustomObjectRecords.
+func (mr *ClientMockRecorder) DeleteManyCustomObjectRecords(ctx, customObjectKey, recordIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).DeleteManyCustomObjectRecords), ctx, customObjectKey, recordIDs)
+}
+
+// DeleteManyCustomObjectRecordsByExternalID mocks base method.
+func (m *Client) DeleteManyCustomObjectRecordsByExternalID(ctx context.Context, customObjectKey string, externalIDs []string) (zendesk.CustomObjectRecordsJobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyCustomObjectRecordsByExternalID", ctx, customObjectKey, externalIDs)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordsJobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyCustomObjectRecordsByExternalID indicates an expected call of DeleteManyCustomObjectRecordsByExternalID.
+func (mr *ClientMockRecorder) DeleteManyCustomObjectRecordsByExternalID(ctx, customObjectKey, externalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyCustomObjectRecordsByExternalID", reflect.TypeOf((*Client)(nil).DeleteManyCustomObjectRecordsByExternalID), ctx, customObjectKey, externalIDs)
+}
+
+// DeleteManyOrganizations mocks base method.
+func (m *Client) DeleteManyOrganizations(ctx context.Context, opts *zendesk.DeleteManyOrganizationsOptions) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyOrganizations", ctx, opts)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyOrganizations indicates an expected call of DeleteManyOrganizations.
+func (mr *ClientMockRecorder) DeleteManyOrganizations(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyOrganizations", reflect.TypeOf((*Client)(nil).DeleteManyOrganizations), ctx, opts)
+}
+
+// DeleteManyUsers mocks base method.
+func (m *Client) DeleteManyUsers(ctx context.Context, opts *zendesk.DeleteManyUsersOptions) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManyUsers", ctx, opts)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManyUsers indicates an expected call of DeleteManyUsers.
+func (mr *ClientMockRecorder) DeleteManyUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManyUsers", reflect.TypeOf((*Client)(nil).DeleteManyUsers), ctx, opts)
+}
+
+// DeleteOrganization mocks base method.
+func (m *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganization", ctx, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganization indicates an expected call of DeleteOrganization.
+func (mr *ClientMockRecorder) DeleteOrganization(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganization", reflect.TypeOf((*Client)(nil).DeleteOrganization), ctx, orgID)
+}
+
+// DeleteOrganizationField mocks base method.
+func (m *Client) DeleteOrganizationField(ctx context.Context, fieldID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationField", ctx, fieldID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationField indicates an expected call of DeleteOrganizationField.
+func (mr *ClientMockRecorder) DeleteOrganizationField(ctx, fieldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationField", reflect.TypeOf((*Client)(nil).DeleteOrganizationField), ctx, fieldID)
+}
+
+// DeleteOrganizationFieldOption mocks base method.
+func (m *Client) DeleteOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrganizationFieldOption indicates an expected call of DeleteOrganizationFieldOption.
+func (mr *ClientMockRecorder) DeleteOrganizationFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationFieldOption", reflect.TypeOf((*Client)(nil).DeleteOrganizationFieldOption), ctx, fieldID, optionID)
+}
+
+// DeletePermissionGroup mocks base method.
+func (m *Client) DeletePermissionGroup(ctx context.Context, permissionGroupID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermissionGroup", ctx, permissionGroupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePermissionGroup indicates an expected call of DeletePermissionGroup.
+func (mr *ClientMockRecorder) DeletePermissionGroup(ctx, permissionGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermissionGroup", reflect.TypeOf((*Client)(nil).DeletePermissionGroup), ctx, permissionGroupID)
+}
+
+// DeletePost mocks base method.
+func (m *Client) DeletePost(ctx context.Context, postID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePost", ctx, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePost indicates an expected call of DeletePost.
+func (mr *ClientMockRecorder) DeletePost(ctx, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*Client)(nil).DeletePost), ctx, postID)
+}
+
+// DeletePostComment mocks base method.
+func (m *Client) DeletePostComment(ctx context.Context, postID, commentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePostComment", ctx, postID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePostComment indicates an expected call of DeletePostComment.
+func (mr *ClientMockRecorder) DeletePostComment(ctx, postID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePostComment", reflect.TypeOf((*Client)(nil).DeletePostComment), ctx, postID, commentID)
+}
+
+// DeletePostSubscription mocks base method.
+func (m *Client) DeletePostSubscription(ctx context.Context, postID, subscriptionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePostSubscription", ctx, postID, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePostSubscription indicates an expected call of DeletePostSubscription.
+func (mr *ClientMockRecorder) DeletePostSubscription(ctx, postID, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePostSubscription", reflect.TypeOf((*Client)(nil).DeletePostSubscription), ctx, postID, subscriptionID)
+}
+
+// DeleteSLAPolicy mocks base method.
+func (m *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSLAPolicy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSLAPolicy indicates an expected call of DeleteSLAPolicy.
+func (mr *ClientMockRecorder) DeleteSLAPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*Client)(nil).DeleteSLAPolicy), ctx, id)
+}
+
+// DeleteSection mocks base method.
+func (m *Client) DeleteSection(ctx context.Context, sectionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSection", ctx, sectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSection indicates an expected call of DeleteSection.
+func (mr *ClientMockRecorder) DeleteSection(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSection", reflect.TypeOf((*Client)(nil).DeleteSection), ctx, sectionID)
+}
+
+// DeleteSectionSubscription mocks base method.
+func (m *Client) DeleteSectionSubscription(ctx context.Context, sectionID, subscriptionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSectionSubscription", ctx, sectionID, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSectionSubscription indicates an expected call of DeleteSectionSubscription.
+func (mr *ClientMockRecorder) DeleteSectionSubscription(ctx, sectionID, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSectionSubscription", reflect.TypeOf((*Client)(nil).DeleteSectionSubscription), ctx, sectionID, subscriptionID)
+}
+
+// DeleteSession mocks base method.
+func (m *Client) DeleteSession(ctx context.Context, userID, sessionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession.
+func (mr *ClientMockRecorder) DeleteSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*Client)(nil).DeleteSession), ctx, userID, sessionID)
+}
+
+// DeleteTalkDigitalLine mocks base method.
+func (m *Client) DeleteTalkDigitalLine(ctx context.Context, digitalLineID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTalkDigitalLine", ctx, digitalLineID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTalkDigitalLine indicates an expected call of DeleteTalkDigitalLine.
+func (mr *ClientMockRecorder) DeleteTalkDigitalLine(ctx, digitalLineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTalkDigitalLine", reflect.TypeOf((*Client)(nil).DeleteTalkDigitalLine), ctx, digitalLineID)
+}
+
+// DeleteTalkGreeting mocks base method.
+func (m *Client) DeleteTalkGreeting(ctx context.Context, greetingID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTalkGreeting", ctx, greetingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTalkGreeting indicates an expected call of DeleteTalkGreeting.
+func (mr *ClientMockRecorder) DeleteTalkGreeting(ctx, greetingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTalkGreeting", reflect.TypeOf((*Client)(nil).DeleteTalkGreeting), ctx, greetingID)
+}
+
+// DeleteTalkIVR mocks base method.
+func (m *Client) DeleteTalkIVR(ctx context.Context, ivrID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTalkIVR", ctx, ivrID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTalkIVR indicates an expected call of DeleteTalkIVR.
+func (mr *ClientMockRecorder) DeleteTalkIVR(ctx, ivrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTalkIVR", reflect.TypeOf((*Client)(nil).DeleteTalkIVR), ctx, ivrID)
+}
+
+// DeleteTalkIVRMenu mocks base method.
+func (m *Client) DeleteTalkIVRMenu(ctx context.Context, ivrID, menuID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTalkIVRMenu", ctx, ivrID, menuID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTalkIVRMenu indicates an expected call of DeleteTalkIVRMenu.
+func (mr *ClientMockRecorder) DeleteTalkIVRMenu(ctx, ivrID, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTalkIVRMenu", reflect.TypeOf((*Client)(nil).DeleteTalkIVRMenu), ctx, ivrID, menuID)
+}
+
+// DeleteTalkIVRRoute mocks base method.
+func (m *Client) DeleteTalkIVRRoute(ctx context.Context, ivrID, menuID, routeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTalkIVRRoute", ctx, ivrID, menuID, routeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTalkIVRRoute indicates an expected call of DeleteTalkIVRRoute.
+func (mr *ClientMockRecorder) DeleteTalkIVRRoute(ctx, ivrID, menuID, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTalkIVRRoute", reflect.TypeOf((*Client)(nil).DeleteTalkIVRRoute), ctx, ivrID, menuID, routeID)
+}
+
+// DeleteTalkRecording mocks base method.
+func (m *Client) DeleteTalkRecording(ctx context.Context, callID int64, recordingType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTalkRecording", ctx, callID, recordingType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTalkRecording indicates an expected call of DeleteTalkRecording.
+func (mr *ClientMockRecorder) DeleteTalkRecording(ctx, callID, recordingType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTalkRecording", reflect.TypeOf((*Client)(nil).DeleteTalkRecording), ctx, callID, recordingType)
+}
+
+// DeleteTarget mocks base method.
+func (m *Client) DeleteTarget(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTarget", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTarget indicates an expected call of DeleteTarget.
+func (mr *ClientMockRecorder) DeleteTarget(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTarget", reflect.TypeOf((*Client)(nil).DeleteTarget), ctx, ticketID)
+}
+
+// DeleteTheme mocks base method.
+func (m *Client) DeleteTheme(ctx context.Context, themeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTheme", ctx, themeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTheme indicates an expected call of DeleteTheme.
+func (mr *ClientMockRecorder) DeleteTheme(ctx, themeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTheme", reflect.TypeOf((*Client)(nil).DeleteTheme), ctx, themeID)
+}
+
+// DeleteTicket mocks base method.
+func (m *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicket", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicket indicates an expected call of DeleteTicket.
+func (mr *ClientMockRecorder) DeleteTicket(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicket", reflect.TypeOf((*Client)(nil).DeleteTicket), ctx, ticketID)
+}
+
+// DeleteTicketField mocks base method.
+func (m *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketField", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketField indicates an expected call of DeleteTicketField.
+func (mr *ClientMockRecorder) DeleteTicketField(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketField", reflect.TypeOf((*Client)(nil).DeleteTicketField), ctx, ticketID)
+}
+
+// DeleteTicketForm mocks base method.
+func (m *Client) DeleteTicketForm(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTicketForm", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTicketForm indicates an expected call of DeleteTicketForm.
+func (mr *ClientMockRecorder) DeleteTicketForm(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTicketForm", reflect.TypeOf((*Client)(nil).DeleteTicketForm), ctx, id)
+}
+
+// DeleteTopic mocks base method.
+func (m *Client) DeleteTopic(ctx context.Context, topicID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopic", ctx, topicID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTopic indicates an expected call of DeleteTopic.
+func (mr *ClientMockRecorder) DeleteTopic(ctx, topicID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopic", reflect.TypeOf((*Client)(nil).DeleteTopic), ctx, topicID)
+}
+
+// DeleteTopicSubscription mocks base method.
+func (m *Client) DeleteTopicSubscription(ctx context.Context, topicID, subscriptionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTopicSubscription", ctx, topicID, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTopicSubscription indicates an expected call of DeleteTopicSubscription.
+func (mr *ClientMockRecorder) DeleteTopicSubscription(ctx, topicID, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTopicSubscription", reflect.TypeOf((*Client)(nil).DeleteTopicSubscription), ctx, topicID, subscriptionID)
+}
+
+// DeleteTranslation mocks base method.
+func (m *Client) DeleteTranslation(ctx context.Context, translationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTranslation", ctx, translationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTranslation indicates an expected call of DeleteTranslation.
+func (mr *ClientMockRecorder) DeleteTranslation(ctx, translationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTranslation", reflect.TypeOf((*Client)(nil).DeleteTranslation), ctx, translationID)
+}
+
+// DeleteTrigger mocks base method.
+func (m *Client) DeleteTrigger(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrigger", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrigger indicates an expected call of DeleteTrigger.
+func (mr *ClientMockRecorder) DeleteTrigger(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrigger", reflect.TypeOf((*Client)(nil).DeleteTrigger), ctx, id)
+}
+
+// DeleteTriggerCategory mocks base method.
+func (m *Client) DeleteTriggerCategory(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTriggerCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTriggerCategory indicates an expected call of DeleteTriggerCategory.
+func (mr *ClientMockRecorder) DeleteTriggerCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTriggerCategory", reflect.TypeOf((*Client)(nil).DeleteTriggerCategory), ctx, id)
+}
+
+// DeleteUpload mocks base method.
+func (m *Client) DeleteUpload(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpload", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpload indicates an expected call of DeleteUpload.
+func (mr *ClientMockRecorder) DeleteUpload(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*Client)(nil).DeleteUpload), ctx, token)
+}
+
+// DeleteUser mocks base method.
+func (m *Client) DeleteUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *ClientMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*Client)(nil).DeleteUser), ctx, userID)
+}
+
+// DeleteUserField mocks base method.
+func (m *Client) DeleteUserField(ctx context.Context, fieldID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserField", ctx, fieldID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserField indicates an expected call of DeleteUserField.
+func (mr *ClientMockRecorder) DeleteUserField(ctx, fieldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserField", reflect.TypeOf((*Client)(nil).DeleteUserField), ctx, fieldID)
+}
+
+// DeleteUserFieldOption mocks base method.
+func (m *Client) DeleteUserFieldOption(ctx context.Context, fieldID, optionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserFieldOption indicates an expected call of DeleteUserFieldOption.
+func (mr *ClientMockRecorder) DeleteUserFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserFieldOption", reflect.TypeOf((*Client)(nil).DeleteUserFieldOption), ctx, fieldID, optionID)
+}
+
+// DeleteUserIdentity mocks base method.
+func (m *Client) DeleteUserIdentity(ctx context.Context, userID, identityID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserIdentity", ctx, userID, identityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserIdentity indicates an expected call of DeleteUserIdentity.
+func (mr *ClientMockRecorder) DeleteUserIdentity(ctx, userID, identityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserIdentity", reflect.TypeOf((*Client)(nil).DeleteUserIdentity), ctx, userID, identityID)
+}
+
+// DeleteUserSessions mocks base method.
+func (m *Client) DeleteUserSessions(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *ClientMockRecorder) DeleteUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*Client)(nil).DeleteUserSessions), ctx, userID)
+}
+
+// DeleteView mocks base method.
+func (m *Client) DeleteView(ctx context.Context, viewID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteView", ctx, viewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteView indicates an expected call of DeleteView.
+func (mr *ClientMockRecorder) DeleteView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteView", reflect.TypeOf((*Client)(nil).DeleteView), ctx, viewID)
+}
+
+// DeleteVote mocks base method.
+func (m *Client) DeleteVote(ctx context.Context, voteID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVote", ctx, voteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVote indicates an expected call of DeleteVote.
+func (mr *ClientMockRecorder) DeleteVote(ctx, voteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVote", reflect.TypeOf((*Client)(nil).DeleteVote), ctx, voteID)
+}
+
+// DeleteWebhook mocks base method.
+func (m *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *ClientMockRecorder) DeleteWebhook(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*Client)(nil).DeleteWebhook), ctx, webhookID)
+}
+
+// DownloadTalkRecording mocks base method.
+func (m *Client) DownloadTalkRecording(ctx context.Context, recordingURL string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadTalkRecording", ctx, recordingURL, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadTalkRecording indicates an expected call of DownloadTalkRecording.
+func (mr *ClientMockRecorder) DownloadTalkRecording(ctx, recordingURL, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadTalkRecording", reflect.TypeOf((*Client)(nil).DownloadTalkRecording), ctx, recordingURL, w)
+}
+
+// DownloadThemePackage mocks base method.
+func (m *Client) DownloadThemePackage(ctx context.Context, job zendesk.ThemeJob, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadThemePackage", ctx, job, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadThemePackage indicates an expected call of DownloadThemePackage.
+func (mr *ClientMockRecorder) DownloadThemePackage(ctx, job, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadThemePackage", reflect.TypeOf((*Client)(nil).DownloadThemePackage), ctx, job, w)
+}
+
+// ExecuteView mocks base method.
+func (m *Client) ExecuteView(ctx context.Context, viewID int64, opts *zendesk.ExecuteViewOptions) (*zendesk.ExecuteViewResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteView", ctx, viewID, opts)
+	ret0, _ := ret[0].(*zendesk.ExecuteViewResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteView indicates an expected call of ExecuteView.
+func (mr *ClientMockRecorder) ExecuteView(ctx, viewID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteView", reflect.TypeOf((*Client)(nil).ExecuteView), ctx, viewID, opts)
+}
+
+// ExportAuditLogs mocks base method.
+func (m *Client) ExportAuditLogs(ctx context.Context, opts *zendesk.AuditLogListOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAuditLogs", ctx, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportAuditLogs indicates an expected call of ExportAuditLogs.
+func (mr *ClientMockRecorder) ExportAuditLogs(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuditLogs", reflect.TypeOf((*Client)(nil).ExportAuditLogs), ctx, opts)
+}
+
+// ExportView mocks base method.
+func (m *Client) ExportView(ctx context.Context, viewID int64) (zendesk.ViewExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportView", ctx, viewID)
+	ret0, _ := ret[0].(zendesk.ViewExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportView indicates an expected call of ExportView.
+func (mr *ClientMockRecorder) ExportView(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportView", reflect.TypeOf((*Client)(nil).ExportView), ctx, viewID)
+}
+
+// FindOrganizationByExternalID mocks base method.
+func (m *Client) FindOrganizationByExternalID(ctx context.Context, externalID string) (zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrganizationByExternalID", ctx, externalID)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrganizationByExternalID indicates an expected call of FindOrganizationByExternalID.
+func (mr *ClientMockRecorder) FindOrganizationByExternalID(ctx, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrganizationByExternalID", reflect.TypeOf((*Client)(nil).FindOrganizationByExternalID), ctx, externalID)
+}
+
+// FindUserByExternalID mocks base method.
+func (m *Client) FindUserByExternalID(ctx context.Context, externalID string) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUserByExternalID", ctx, externalID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUserByExternalID indicates an expected call of FindUserByExternalID.
+func (mr *ClientMockRecorder) FindUserByExternalID(ctx, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserByExternalID", reflect.TypeOf((*Client)(nil).FindUserByExternalID), ctx, externalID)
+}
+
+// Get mocks base method.
+func (m *Client) Get(ctx context.Context, path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *ClientMockRecorder) Get(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*Client)(nil).Get), ctx, path)
+}
+
+// GetAccountSettings mocks base method.
+func (m *Client) GetAccountSettings(ctx context.Context) (zendesk.AccountSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSettings", ctx)
+	ret0, _ := ret[0].(zendesk.AccountSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSettings indicates an expected call of GetAccountSettings.
+func (mr *ClientMockRecorder) GetAccountSettings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSettings", reflect.TypeOf((*Client)(nil).GetAccountSettings), ctx)
+}
+
+// GetActiveViews mocks base method.
+func (m *Client) GetActiveViews(ctx context.Context) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveViews", ctx)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActiveViews indicates an expected call of GetActiveViews.
+func (mr *ClientMockRecorder) GetActiveViews(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveViews", reflect.TypeOf((*Client)(nil).GetActiveViews), ctx)
+}
+
+// GetAgentAvailabilities mocks base method.
+func (m *Client) GetAgentAvailabilities(ctx context.Context, opts *zendesk.AgentAvailabilityListOptions) ([]zendesk.AgentAvailability, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAvailabilities", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.AgentAvailability)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAgentAvailabilities indicates an expected call of GetAgentAvailabilities.
+func (mr *ClientMockRecorder) GetAgentAvailabilities(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAvailabilities", reflect.TypeOf((*Client)(nil).GetAgentAvailabilities), ctx, opts)
+}
+
+// GetAgentAvailability mocks base method.
+func (m *Client) GetAgentAvailability(ctx context.Context, agentID int64) (zendesk.AgentAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentAvailability", ctx, agentID)
+	ret0, _ := ret[0].(zendesk.AgentAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgentAvailability indicates an expected call of GetAgentAvailability.
+func (mr *ClientMockRecorder) GetAgentAvailability(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentAvailability", reflect.TypeOf((*Client)(nil).GetAgentAvailability), ctx, agentID)
+}
+
+// GetAgentStatuses mocks base method.
+func (m *Client) GetAgentStatuses(ctx context.Context) ([]zendesk.AgentStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgentStatuses", ctx)
+	ret0, _ := ret[0].([]zendesk.AgentStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgentStatuses indicates an expected call of GetAgentStatuses.
+func (mr *ClientMockRecorder) GetAgentStatuses(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgentStatuses", reflect.TypeOf((*Client)(nil).GetAgentStatuses), ctx)
+}
+
+// GetAllTicketAudits mocks base method.
+func (m *Client) GetAllTicketAudits(ctx context.Context, opts zendesk.CursorOption) ([]zendesk.TicketAudit, zendesk.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTicketAudits", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret1, _ := ret[1].(zendesk.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAllTicketAudits indicates an expected call of GetAllTicketAudits.
+func (mr *ClientMockRecorder) GetAllTicketAudits(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTicketAudits", reflect.TypeOf((*Client)(nil).GetAllTicketAudits), ctx, opts)
+}
+
+// GetArticle mocks base method.
+func (m *Client) GetArticle(ctx context.Context, articleID int64) (zendesk.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticle", ctx, articleID)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticle indicates an expected call of GetArticle.
+func (mr *ClientMockRecorder) GetArticle(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticle", reflect.TypeOf((*Client)(nil).GetArticle), ctx, articleID)
+}
+
+// GetArticleAttachment mocks base method.
+func (m *Client) GetArticleAttachment(ctx context.Context, attachmentID int64) (zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleAttachment indicates an expected call of GetArticleAttachment.
+func (mr *ClientMockRecorder) GetArticleAttachment(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleAttachment", reflect.TypeOf((*Client)(nil).GetArticleAttachment), ctx, attachmentID)
+}
+
+// GetArticleComment mocks base method.
+func (m *Client) GetArticleComment(ctx context.Context, articleID, commentID int64) (zendesk.ArticleComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleComment", ctx, articleID, commentID)
+	ret0, _ := ret[0].(zendesk.ArticleComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleComment indicates an expected call of GetArticleComment.
+func (mr *ClientMockRecorder) GetArticleComment(ctx, articleID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleComment", reflect.TypeOf((*Client)(nil).GetArticleComment), ctx, articleID, commentID)
+}
+
+// GetArticleTranslation mocks base method.
+func (m *Client) GetArticleTranslation(ctx context.Context, articleID int64, locale string) (zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleTranslation", ctx, articleID, locale)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleTranslation indicates an expected call of GetArticleTranslation.
+func (mr *ClientMockRecorder) GetArticleTranslation(ctx, articleID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleTranslation", reflect.TypeOf((*Client)(nil).GetArticleTranslation), ctx, articleID, locale)
+}
+
+// GetAttachment mocks base method.
+func (m *Client) GetAttachment(ctx context.Context, id int64) (zendesk.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, id)
+	ret0, _ := ret[0].(zendesk.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *ClientMockRecorder) GetAttachment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*Client)(nil).GetAttachment), ctx, id)
+}
+
+// GetAuditLog mocks base method.
+func (m *Client) GetAuditLog(ctx context.Context, auditLogID int64) (zendesk.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, auditLogID)
+	ret0, _ := ret[0].(zendesk.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *ClientMockRecorder) GetAuditLog(ctx, auditLogID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*Client)(nil).GetAuditLog), ctx, auditLogID)
+}
+
+// GetAutomation mocks base method.
+func (m *Client) GetAutomation(ctx context.Context, id int64) (zendesk.Automation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomation", ctx, id)
+	ret0, _ := ret[0].(zendesk.Automation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomation indicates an expected call of GetAutomation.
+func (mr *ClientMockRecorder) GetAutomation(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomation", reflect.TypeOf((*Client)(nil).GetAutomation), ctx, id)
+}
+
+// GetAutomationDefinitions mocks base method.
+func (m *Client) GetAutomationDefinitions(ctx context.Context) (zendesk.RuleDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationDefinitions", ctx)
+	ret0, _ := ret[0].(zendesk.RuleDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomationDefinitions indicates an expected call of GetAutomationDefinitions.
+func (mr *ClientMockRecorder) GetAutomationDefinitions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationDefinitions", reflect.TypeOf((*Client)(nil).GetAutomationDefinitions), ctx)
+}
+
+// GetAutomations mocks base method.
+func (m *Client) GetAutomations(ctx context.Context, opts *zendesk.AutomationListOptions) ([]zendesk.Automation, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Automation)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutomations indicates an expected call of GetAutomations.
+func (mr *ClientMockRecorder) GetAutomations(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomations", reflect.TypeOf((*Client)(nil).GetAutomations), ctx, opts)
+}
+
+// GetAutomationsCBP mocks base method.
+func (m *Client) GetAutomationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Automation, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Automation)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutomationsCBP indicates an expected call of GetAutomationsCBP.
+func (mr *ClientMockRecorder) GetAutomationsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsCBP", reflect.TypeOf((*Client)(nil).GetAutomationsCBP), ctx, opts)
+}
+
+// GetAutomationsIterator mocks base method.
+func (m *Client) GetAutomationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Automation] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Automation])
+	return ret0
+}
+
+// GetAutomationsIterator indicates an expected call of GetAutomationsIterator.
+func (mr *ClientMockRecorder) GetAutomationsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsIterator", reflect.TypeOf((*Client)(nil).GetAutomationsIterator), ctx, opts)
+}
+
+// GetAutomationsOBP mocks base method.
+func (m *Client) GetAutomationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Automation, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomationsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Automation)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutomationsOBP indicates an expected call of GetAutomationsOBP.
+func (mr *ClientMockRecorder) GetAutomationsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomationsOBP", reflect.TypeOf((*Client)(nil).GetAutomationsOBP), ctx, opts)
+}
+
+// GetBrand mocks base method.
+func (m *Client) GetBrand(ctx context.Context, brandID int64) (zendesk.Brand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrand", ctx, brandID)
+	ret0, _ := ret[0].(zendesk.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrand indicates an expected call of GetBrand.
+func (mr *ClientMockRecorder) GetBrand(ctx, brandID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrand", reflect.TypeOf((*Client)(nil).GetBrand), ctx, brandID)
+}
+
+// GetBrandAgent mocks base method.
+func (m *Client) GetBrandAgent(ctx context.Context, brandAgentID int64) (zendesk.BrandAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrandAgent", ctx, brandAgentID)
+	ret0, _ := ret[0].(zendesk.BrandAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBrandAgent indicates an expected call of GetBrandAgent.
+func (mr *ClientMockRecorder) GetBrandAgent(ctx, brandAgentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrandAgent", reflect.TypeOf((*Client)(nil).GetBrandAgent), ctx, brandAgentID)
+}
+
+// GetBrandAgents mocks base method.
+func (m *Client) GetBrandAgents(ctx context.Context, opts *zendesk.BrandAgentListOptions) ([]zendesk.BrandAgent, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBrandAgents", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.BrandAgent)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBrandAgents indicates an expected call of GetBrandAgents.
+func (mr *ClientMockRecorder) GetBrandAgents(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrandAgents", reflect.TypeOf((*Client)(nil).GetBrandAgents), ctx, opts)
+}
+
+// GetCategory mocks base method.
+func (m *Client) GetCategory(ctx context.Context, categoryID int64) (zendesk.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, categoryID)
+	ret0, _ := ret[0].(zendesk.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *ClientMockRecorder) GetCategory(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*Client)(nil).GetCategory), ctx, categoryID)
+}
+
+// GetCategoryTranslation mocks base method.
+func (m *Client) GetCategoryTranslation(ctx context.Context, categoryID int64, locale string) (zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTranslation", ctx, categoryID, locale)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTranslation indicates an expected call of GetCategoryTranslation.
+func (mr *ClientMockRecorder) GetCategoryTranslation(ctx, categoryID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTranslation", reflect.TypeOf((*Client)(nil).GetCategoryTranslation), ctx, categoryID, locale)
+}
+
+// GetChat mocks base method.
+func (m *Client) GetChat(ctx context.Context, chatID string) (zendesk.Chat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChat", ctx, chatID)
+	ret0, _ := ret[0].(zendesk.Chat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChat indicates an expected call of GetChat.
+func (mr *ClientMockRecorder) GetChat(ctx, chatID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChat", reflect.TypeOf((*Client)(nil).GetChat), ctx, chatID)
+}
+
+// GetChatAccountRoutingSettings mocks base method.
+func (m *Client) GetChatAccountRoutingSettings(ctx context.Context) (zendesk.ChatAccountRoutingSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatAccountRoutingSettings", ctx)
+	ret0, _ := ret[0].(zendesk.ChatAccountRoutingSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatAccountRoutingSettings indicates an expected call of GetChatAccountRoutingSettings.
+func (mr *ClientMockRecorder) GetChatAccountRoutingSettings(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAccountRoutingSettings", reflect.TypeOf((*Client)(nil).GetChatAccountRoutingSettings), ctx)
+}
+
+// GetChatAgent mocks base method.
+func (m *Client) GetChatAgent(ctx context.Context, agentID int64) (zendesk.ChatAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatAgent", ctx, agentID)
+	ret0, _ := ret[0].(zendesk.ChatAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatAgent indicates an expected call of GetChatAgent.
+func (mr *ClientMockRecorder) GetChatAgent(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAgent", reflect.TypeOf((*Client)(nil).GetChatAgent), ctx, agentID)
+}
+
+// GetChatAgentByEmail mocks base method.
+func (m *Client) GetChatAgentByEmail(ctx context.Context, email string) (zendesk.ChatAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatAgentByEmail", ctx, email)
+	ret0, _ := ret[0].(zendesk.ChatAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatAgentByEmail indicates an expected call of GetChatAgentByEmail.
+func (mr *ClientMockRecorder) GetChatAgentByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAgentByEmail", reflect.TypeOf((*Client)(nil).GetChatAgentByEmail), ctx, email)
+}
+
+// GetChatAgentRoutingSettings mocks base method.
+func (m *Client) GetChatAgentRoutingSettings(ctx context.Context, agentID int64) (zendesk.ChatAgentRoutingSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatAgentRoutingSettings", ctx, agentID)
+	ret0, _ := ret[0].(zendesk.ChatAgentRoutingSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatAgentRoutingSettings indicates an expected call of GetChatAgentRoutingSettings.
+func (mr *ClientMockRecorder) GetChatAgentRoutingSettings(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatAgentRoutingSettings", reflect.TypeOf((*Client)(nil).GetChatAgentRoutingSettings), ctx, agentID)
+}
+
+// GetChatBan mocks base method.
+func (m *Client) GetChatBan(ctx context.Context, banID int64) (zendesk.ChatBan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatBan", ctx, banID)
+	ret0, _ := ret[0].(zendesk.ChatBan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatBan indicates an expected call of GetChatBan.
+func (mr *ClientMockRecorder) GetChatBan(ctx, banID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatBan", reflect.TypeOf((*Client)(nil).GetChatBan), ctx, banID)
+}
+
+// GetChatDepartment mocks base method.
+func (m *Client) GetChatDepartment(ctx context.Context, departmentID int64) (zendesk.ChatDepartment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatDepartment", ctx, departmentID)
+	ret0, _ := ret[0].(zendesk.ChatDepartment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatDepartment indicates an expected call of GetChatDepartment.
+func (mr *ClientMockRecorder) GetChatDepartment(ctx, departmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatDepartment", reflect.TypeOf((*Client)(nil).GetChatDepartment), ctx, departmentID)
+}
+
+// GetChatGoal mocks base method.
+func (m *Client) GetChatGoal(ctx context.Context, goalID int64) (zendesk.ChatGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatGoal", ctx, goalID)
+	ret0, _ := ret[0].(zendesk.ChatGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatGoal indicates an expected call of GetChatGoal.
+func (mr *ClientMockRecorder) GetChatGoal(ctx, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatGoal", reflect.TypeOf((*Client)(nil).GetChatGoal), ctx, goalID)
+}
+
+// GetChatRealTimeAgents mocks base method.
+func (m *Client) GetChatRealTimeAgents(ctx context.Context, opts *zendesk.ChatRealTimeOptions) (zendesk.ChatRealTimeAgents, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatRealTimeAgents", ctx, opts)
+	ret0, _ := ret[0].(zendesk.ChatRealTimeAgents)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatRealTimeAgents indicates an expected call of GetChatRealTimeAgents.
+func (mr *ClientMockRecorder) GetChatRealTimeAgents(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatRealTimeAgents", reflect.TypeOf((*Client)(nil).GetChatRealTimeAgents), ctx, opts)
+}
+
+// GetChatRealTimeChats mocks base method.
+func (m *Client) GetChatRealTimeChats(ctx context.Context, opts *zendesk.ChatRealTimeOptions) (zendesk.ChatRealTimeChats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatRealTimeChats", ctx, opts)
+	ret0, _ := ret[0].(zendesk.ChatRealTimeChats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatRealTimeChats indicates an expected call of GetChatRealTimeChats.
+func (mr *ClientMockRecorder) GetChatRealTimeChats(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatRealTimeChats", reflect.TypeOf((*Client)(nil).GetChatRealTimeChats), ctx, opts)
+}
+
+// GetChatRealTimeMetrics mocks base method.
+func (m *Client) GetChatRealTimeMetrics(ctx context.Context, opts *zendesk.ChatRealTimeOptions) (zendesk.ChatRealTimeMetrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatRealTimeMetrics", ctx, opts)
+	ret0, _ := ret[0].(zendesk.ChatRealTimeMetrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatRealTimeMetrics indicates an expected call of GetChatRealTimeMetrics.
+func (mr *ClientMockRecorder) GetChatRealTimeMetrics(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatRealTimeMetrics", reflect.TypeOf((*Client)(nil).GetChatRealTimeMetrics), ctx, opts)
+}
+
+// GetChatRole mocks base method.
+func (m *Client) GetChatRole(ctx context.Context, roleID int64) (zendesk.ChatRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatRole", ctx, roleID)
+	ret0, _ := ret[0].(zendesk.ChatRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatRole indicates an expected call of GetChatRole.
+func (mr *ClientMockRecorder) GetChatRole(ctx, roleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatRole", reflect.TypeOf((*Client)(nil).GetChatRole), ctx, roleID)
+}
+
+// GetChatShortcut mocks base method.
+func (m *Client) GetChatShortcut(ctx context.Context, name string) (zendesk.ChatShortcut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatShortcut", ctx, name)
+	ret0, _ := ret[0].(zendesk.ChatShortcut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatShortcut indicates an expected call of GetChatShortcut.
+func (mr *ClientMockRecorder) GetChatShortcut(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatShortcut", reflect.TypeOf((*Client)(nil).GetChatShortcut), ctx, name)
+}
+
+// GetChatSkill mocks base method.
+func (m *Client) GetChatSkill(ctx context.Context, skillID int64) (zendesk.ChatSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatSkill", ctx, skillID)
+	ret0, _ := ret[0].(zendesk.ChatSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatSkill indicates an expected call of GetChatSkill.
+func (mr *ClientMockRecorder) GetChatSkill(ctx, skillID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatSkill", reflect.TypeOf((*Client)(nil).GetChatSkill), ctx, skillID)
+}
+
+// GetChatTrigger mocks base method.
+func (m *Client) GetChatTrigger(ctx context.Context, name string) (zendesk.ChatTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatTrigger", ctx, name)
+	ret0, _ := ret[0].(zendesk.ChatTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatTrigger indicates an expected call of GetChatTrigger.
+func (mr *ClientMockRecorder) GetChatTrigger(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatTrigger", reflect.TypeOf((*Client)(nil).GetChatTrigger), ctx, name)
+}
+
+// GetChatVisitor mocks base method.
+func (m *Client) GetChatVisitor(ctx context.Context, visitorID string) (zendesk.ChatVisitor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChatVisitor", ctx, visitorID)
+	ret0, _ := ret[0].(zendesk.ChatVisitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChatVisitor indicates an expected call of GetChatVisitor.
+func (mr *ClientMockRecorder) GetChatVisitor(ctx, visitorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChatVisitor", reflect.TypeOf((*Client)(nil).GetChatVisitor), ctx, visitorID)
+}
+
+// GetChats mocks base method.
+func (m *Client) GetChats(ctx context.Context, chatIDs []string) (map[string]zendesk.Chat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChats", ctx, chatIDs)
+	ret0, _ := ret[0].(map[string]zendesk.Chat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChats indicates an expected call of GetChats.
+func (mr *ClientMockRecorder) GetChats(ctx, chatIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChats", reflect.TypeOf((*Client)(nil).GetChats), ctx, chatIDs)
+}
+
+// GetCompactViews mocks base method.
+func (m *Client) GetCompactViews(ctx context.Context) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompactViews", ctx)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCompactViews indicates an expected call of GetCompactViews.
+func (mr *ClientMockRecorder) GetCompactViews(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompactViews", reflect.TypeOf((*Client)(nil).GetCompactViews), ctx)
+}
+
+// GetComplianceDeletionStatuses mocks base method.
+func (m *Client) GetComplianceDeletionStatuses(ctx context.Context, userID int64, opts *zendesk.ComplianceDeletionStatusOptions) ([]zendesk.ComplianceDeletionStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceDeletionStatuses", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.ComplianceDeletionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceDeletionStatuses indicates an expected call of GetComplianceDeletionStatuses.
+func (mr *ClientMockRecorder) GetComplianceDeletionStatuses(ctx, userID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceDeletionStatuses", reflect.TypeOf((*Client)(nil).GetComplianceDeletionStatuses), ctx, userID, opts)
+}
+
+// GetCountTicketsInViews mocks base method.
+func (m *Client) GetCountTicketsInViews(ctx context.Context, ids []string) ([]zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCountTicketsInViews", ctx, ids)
+	ret0, _ := ret[0].([]zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCountTicketsInViews indicates an expected call of GetCountTicketsInViews.
+func (mr *ClientMockRecorder) GetCountTicketsInViews(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCountTicketsInViews", reflect.TypeOf((*Client)(nil).GetCountTicketsInViews), ctx, ids)
+}
+
+// GetCurrentChatAgent mocks base method.
+func (m *Client) GetCurrentChatAgent(ctx context.Context) (zendesk.ChatAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentChatAgent", ctx)
+	ret0, _ := ret[0].(zendesk.ChatAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentChatAgent indicates an expected call of GetCurrentChatAgent.
+func (mr *ClientMockRecorder) GetCurrentChatAgent(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentChatAgent", reflect.TypeOf((*Client)(nil).GetCurrentChatAgent), ctx)
+}
+
+// GetCurrentSession mocks base method.
+func (m *Client) GetCurrentSession(ctx context.Context) (zendesk.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentSession", ctx)
+	ret0, _ := ret[0].(zendesk.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentSession indicates an expected call of GetCurrentSession.
+func (mr *ClientMockRecorder) GetCurrentSession(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentSession", reflect.TypeOf((*Client)(nil).GetCurrentSession), ctx)
+}
+
+// GetCurrentUser mocks base method.
+func (m *Client) GetCurrentUser(ctx context.Context) (zendesk.CurrentUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUser", ctx)
+	ret0, _ := ret[0].(zendesk.CurrentUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUser indicates an expected call of GetCurrentUser.
+func (mr *ClientMockRecorder) GetCurrentUser(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUser", reflect.TypeOf((*Client)(nil).GetCurrentUser), ctx)
+}
+
+// GetCustomObject mocks base method.
+func (m *Client) GetCustomObject(ctx context.Context, customObjectKey string) (zendesk.CustomObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObject", ctx, customObjectKey)
+	ret0, _ := ret[0].(zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObject indicates an expected call of GetCustomObject.
+func (mr *ClientMockRecorder) GetCustomObject(ctx, customObjectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObject", reflect.TypeOf((*Client)(nil).GetCustomObject), ctx, customObjectKey)
+}
+
+// GetCustomObjectField mocks base method.
+func (m *Client) GetCustomObjectField(ctx context.Context, customObjectKey, fieldKeyOrID string) (zendesk.CustomObjectField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectField", ctx, customObjectKey, fieldKeyOrID)
+	ret0, _ := ret[0].(zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObjectField indicates an expected call of GetCustomObjectField.
+func (mr *ClientMockRecorder) GetCustomObjectField(ctx, customObjectKey, fieldKeyOrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectField", reflect.TypeOf((*Client)(nil).GetCustomObjectField), ctx, customObjectKey, fieldKeyOrID)
+}
+
+// GetCustomObjectRecordsJobStatus mocks base method.
+func (m *Client) GetCustomObjectRecordsJobStatus(ctx context.Context, id string) (zendesk.CustomObjectRecordsJobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomObjectRecordsJobStatus", ctx, id)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordsJobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomObjectRecordsJobStatus indicates an expected call of GetCustomObjectRecordsJobStatus.
+func (mr *ClientMockRecorder) GetCustomObjectRecordsJobStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomObjectRecordsJobStatus", reflect.TypeOf((*Client)(nil).GetCustomObjectRecordsJobStatus), ctx, id)
+}
+
+// GetCustomRoles mocks base method.
+func (m *Client) GetCustomRoles(ctx context.Context) ([]zendesk.CustomRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomRoles", ctx)
+	ret0, _ := ret[0].([]zendesk.CustomRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomRoles indicates an expected call of GetCustomRoles.
+func (mr *ClientMockRecorder) GetCustomRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomRoles", reflect.TypeOf((*Client)(nil).GetCustomRoles), ctx)
+}
+
+// GetDeletedUser mocks base method.
+func (m *Client) GetDeletedUser(ctx context.Context, userID int64) (zendesk.DeletedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.DeletedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedUser indicates an expected call of GetDeletedUser.
+func (mr *ClientMockRecorder) GetDeletedUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUser", reflect.TypeOf((*Client)(nil).GetDeletedUser), ctx, userID)
+}
+
+// GetDeletedUsers mocks base method.
+func (m *Client) GetDeletedUsers(ctx context.Context, opts *zendesk.DeletedUserListOptions) ([]zendesk.DeletedUser, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.DeletedUser)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeletedUsers indicates an expected call of GetDeletedUsers.
+func (mr *ClientMockRecorder) GetDeletedUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedUsers", reflect.TypeOf((*Client)(nil).GetDeletedUsers), ctx, opts)
+}
+
+// GetDeletionSchedule mocks base method.
+func (m *Client) GetDeletionSchedule(ctx context.Context, id int64) (zendesk.DeletionSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletionSchedule", ctx, id)
+	ret0, _ := ret[0].(zendesk.DeletionSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletionSchedule indicates an expected call of GetDeletionSchedule.
+func (mr *ClientMockRecorder) GetDeletionSchedule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletionSchedule", reflect.TypeOf((*Client)(nil).GetDeletionSchedule), ctx, id)
+}
+
+// GetDeletionSchedules mocks base method.
+func (m *Client) GetDeletionSchedules(ctx context.Context) ([]zendesk.DeletionSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletionSchedules", ctx)
+	ret0, _ := ret[0].([]zendesk.DeletionSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletionSchedules indicates an expected call of GetDeletionSchedules.
+func (mr *ClientMockRecorder) GetDeletionSchedules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletionSchedules", reflect.TypeOf((*Client)(nil).GetDeletionSchedules), ctx)
+}
+
+// GetDynamicContentItem mocks base method.
+func (m *Client) GetDynamicContentItem(ctx context.Context, id int64) (zendesk.DynamicContentItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItem", ctx, id)
+	ret0, _ := ret[0].(zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicContentItem indicates an expected call of GetDynamicContentItem.
+func (mr *ClientMockRecorder) GetDynamicContentItem(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItem", reflect.TypeOf((*Client)(nil).GetDynamicContentItem), ctx, id)
+}
+
+// GetDynamicContentItems mocks base method.
+func (m *Client) GetDynamicContentItems(ctx context.Context) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItems", ctx)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItems indicates an expected call of GetDynamicContentItems.
+func (mr *ClientMockRecorder) GetDynamicContentItems(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItems", reflect.TypeOf((*Client)(nil).GetDynamicContentItems), ctx)
+}
+
+// GetDynamicContentItemsCBP mocks base method.
 func (m *Client) GetDynamicContentItemsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.DynamicContentItem, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItemsCBP indicates an expected call of GetDynamicContentItemsCBP.
+func (mr *ClientMockRecorder) GetDynamicContentItemsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsCBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsCBP), ctx, opts)
+}
+
+// GetDynamicContentItemsIterator mocks base method.
+func (m *Client) GetDynamicContentItemsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.DynamicContentItem] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.DynamicContentItem])
+	return ret0
+}
+
+// GetDynamicContentItemsIterator indicates an expected call of GetDynamicContentItemsIterator.
+func (mr *ClientMockRecorder) GetDynamicContentItemsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsIterator", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsIterator), ctx, opts)
+}
+
+// GetDynamicContentItemsOBP mocks base method.
+func (m *Client) GetDynamicContentItemsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicContentItemsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDynamicContentItemsOBP indicates an expected call of GetDynamicContentItemsOBP.
+func (mr *ClientMockRecorder) GetDynamicContentItemsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsOBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsOBP), ctx, opts)
+}
+
+// GetEssentialsCard mocks base method.
+func (m *Client) GetEssentialsCard(ctx context.Context, objectType string) (zendesk.EssentialsCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEssentialsCard", ctx, objectType)
+	ret0, _ := ret[0].(zendesk.EssentialsCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEssentialsCard indicates an expected call of GetEssentialsCard.
+func (mr *ClientMockRecorder) GetEssentialsCard(ctx, objectType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEssentialsCard", reflect.TypeOf((*Client)(nil).GetEssentialsCard), ctx, objectType)
+}
+
+// GetEssentialsCards mocks base method.
+func (m *Client) GetEssentialsCards(ctx context.Context) ([]zendesk.EssentialsCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEssentialsCards", ctx)
+	ret0, _ := ret[0].([]zendesk.EssentialsCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEssentialsCards indicates an expected call of GetEssentialsCards.
+func (mr *ClientMockRecorder) GetEssentialsCards(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEssentialsCards", reflect.TypeOf((*Client)(nil).GetEssentialsCards), ctx)
+}
+
+// GetGroup mocks base method.
+func (m *Client) GetGroup(ctx context.Context, groupID int64) (zendesk.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
+	ret0, _ := ret[0].(zendesk.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *ClientMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*Client)(nil).GetGroup), ctx, groupID)
+}
+
+// GetGroupMemberships mocks base method.
+func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMemberships indicates an expected call of GetGroupMemberships.
+func (mr *ClientMockRecorder) GetGroupMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMemberships", reflect.TypeOf((*Client)(nil).GetGroupMemberships), arg0, arg1)
+}
+
+// GetGroupMembershipsCBP mocks base method.
+func (m *Client) GetGroupMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsCBP indicates an expected call of GetGroupMembershipsCBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsCBP), ctx, opts)
+}
+
+// GetGroupMembershipsIterator mocks base method.
+func (m *Client) GetGroupMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
+	return ret0
+}
+
+// GetGroupMembershipsIterator indicates an expected call of GetGroupMembershipsIterator.
+func (mr *ClientMockRecorder) GetGroupMembershipsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsIterator), ctx, opts)
+}
+
+// GetGroupMembershipsOBP mocks base method.
+func (m *Client) GetGroupMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembershipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupMembershipsOBP indicates an expected call of GetGroupMembershipsOBP.
+func (mr *ClientMockRecorder) GetGroupMembershipsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsOBP), ctx, opts)
+}
+
+// GetGroupUsers mocks base method.
+func (m *Client) GetGroupUsers(ctx context.Context, groupID int64, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsers", ctx, groupID, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupUsers indicates an expected call of GetGroupUsers.
+func (mr *ClientMockRecorder) GetGroupUsers(ctx, groupID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsers", reflect.TypeOf((*Client)(nil).GetGroupUsers), ctx, groupID, opts)
+}
+
+// GetGroupUsersCBP mocks base method.
+func (m *Client) GetGroupUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupUsersCBP indicates an expected call of GetGroupUsersCBP.
+func (mr *ClientMockRecorder) GetGroupUsersCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersCBP", reflect.TypeOf((*Client)(nil).GetGroupUsersCBP), ctx, opts)
+}
+
+// GetGroupUsersIterator mocks base method.
+func (m *Client) GetGroupUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	return ret0
+}
+
+// GetGroupUsersIterator indicates an expected call of GetGroupUsersIterator.
+func (mr *ClientMockRecorder) GetGroupUsersIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersIterator", reflect.TypeOf((*Client)(nil).GetGroupUsersIterator), ctx, opts)
+}
+
+// GetGroupUsersOBP mocks base method.
+func (m *Client) GetGroupUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupUsersOBP indicates an expected call of GetGroupUsersOBP.
+func (mr *ClientMockRecorder) GetGroupUsersOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersOBP", reflect.TypeOf((*Client)(nil).GetGroupUsersOBP), ctx, opts)
+}
+
+// GetGroups mocks base method.
+func (m *Client) GetGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroups", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroups indicates an expected call of GetGroups.
+func (mr *ClientMockRecorder) GetGroups(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), ctx, opts)
+}
+
+// GetGroupsCBP mocks base method.
+func (m *Client) GetGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupsCBP indicates an expected call of GetGroupsCBP.
+func (mr *ClientMockRecorder) GetGroupsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsCBP", reflect.TypeOf((*Client)(nil).GetGroupsCBP), ctx, opts)
+}
+
+// GetGroupsCount mocks base method.
+func (m *Client) GetGroupsCount(ctx context.Context) (zendesk.Count, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupsCount", ctx)
+	ret0, _ := ret[0].(zendesk.Count)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupsCount indicates an expected call of GetGroupsCount.
+func (mr *ClientMockRecorder) GetGroupsCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsCount", reflect.TypeOf((*Client)(nil).GetGroupsCount), ctx)
+}
+
+// GetGroupsIterator mocks base method.
+func (m *Client) GetGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
+	return ret0
+}
+
+// GetGroupsIterator indicates an expected call of GetGroupsIterator.
+func (mr *ClientMockRecorder) GetGroupsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsIterator", reflect.TypeOf((*Client)(nil).GetGroupsIterator), ctx, opts)
+}
+
+// GetGroupsOBP mocks base method.
+func (m *Client) GetGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroupsOBP indicates an expected call of GetGroupsOBP.
+func (mr *ClientMockRecorder) GetGroupsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsOBP", reflect.TypeOf((*Client)(nil).GetGroupsOBP), ctx, opts)
+}
+
+// GetHelpCenterLocales mocks base method.
+func (m *Client) GetHelpCenterLocales(ctx context.Context) (zendesk.HelpCenterLocales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelpCenterLocales", ctx)
+	ret0, _ := ret[0].(zendesk.HelpCenterLocales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelpCenterLocales indicates an expected call of GetHelpCenterLocales.
+func (mr *ClientMockRecorder) GetHelpCenterLocales(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpCenterLocales", reflect.TypeOf((*Client)(nil).GetHelpCenterLocales), ctx)
+}
+
+// GetIncrementalArticles mocks base method.
+func (m *Client) GetIncrementalArticles(ctx context.Context, opts *zendesk.IncrementalExportOptions) (*zendesk.IncrementalArticlesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalArticles", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalArticlesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalArticles indicates an expected call of GetIncrementalArticles.
+func (mr *ClientMockRecorder) GetIncrementalArticles(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalArticles", reflect.TypeOf((*Client)(nil).GetIncrementalArticles), ctx, opts)
+}
+
+// GetIncrementalArticlesIterator mocks base method.
+func (m *Client) GetIncrementalArticlesIterator(ctx context.Context, opts *zendesk.IncrementalExportOptions) *zendesk.IncrementalExportIterator[zendesk.Article] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalArticlesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalExportIterator[zendesk.Article])
+	return ret0
+}
+
+// GetIncrementalArticlesIterator indicates an expected call of GetIncrementalArticlesIterator.
+func (mr *ClientMockRecorder) GetIncrementalArticlesIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalArticlesIterator", reflect.TypeOf((*Client)(nil).GetIncrementalArticlesIterator), ctx, opts)
+}
+
+// GetIncrementalChatAgentTimeline mocks base method.
+func (m *Client) GetIncrementalChatAgentTimeline(ctx context.Context, opts *zendesk.ChatIncrementalExportOptions) (*zendesk.IncrementalChatAgentTimelineResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalChatAgentTimeline", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalChatAgentTimelineResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalChatAgentTimeline indicates an expected call of GetIncrementalChatAgentTimeline.
+func (mr *ClientMockRecorder) GetIncrementalChatAgentTimeline(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalChatAgentTimeline", reflect.TypeOf((*Client)(nil).GetIncrementalChatAgentTimeline), ctx, opts)
+}
+
+// GetIncrementalChatAgentTimelineIterator mocks base method.
+func (m *Client) GetIncrementalChatAgentTimelineIterator(ctx context.Context, opts *zendesk.ChatIncrementalExportOptions) *zendesk.ChatIncrementalExportIterator[zendesk.ChatAgentTimelineEntry] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalChatAgentTimelineIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.ChatIncrementalExportIterator[zendesk.ChatAgentTimelineEntry])
+	return ret0
+}
+
+// GetIncrementalChatAgentTimelineIterator indicates an expected call of GetIncrementalChatAgentTimelineIterator.
+func (mr *ClientMockRecorder) GetIncrementalChatAgentTimelineIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalChatAgentTimelineIterator", reflect.TypeOf((*Client)(nil).GetIncrementalChatAgentTimelineIterator), ctx, opts)
+}
+
+// GetIncrementalChats mocks base method.
+func (m *Client) GetIncrementalChats(ctx context.Context, opts *zendesk.ChatIncrementalExportOptions) (*zendesk.IncrementalChatsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalChats", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalChatsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalChats indicates an expected call of GetIncrementalChats.
+func (mr *ClientMockRecorder) GetIncrementalChats(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalChats", reflect.TypeOf((*Client)(nil).GetIncrementalChats), ctx, opts)
+}
+
+// GetIncrementalChatsIterator mocks base method.
+func (m *Client) GetIncrementalChatsIterator(ctx context.Context, opts *zendesk.ChatIncrementalExportOptions) *zendesk.ChatIncrementalExportIterator[zendesk.Chat] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalChatsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.ChatIncrementalExportIterator[zendesk.Chat])
+	return ret0
+}
+
+// GetIncrementalChatsIterator indicates an expected call of GetIncrementalChatsIterator.
+func (mr *ClientMockRecorder) GetIncrementalChatsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalChatsIterator", reflect.TypeOf((*Client)(nil).GetIncrementalChatsIterator), ctx, opts)
+}
+
+// GetIncrementalTalkCallLegs mocks base method.
+func (m *Client) GetIncrementalTalkCallLegs(ctx context.Context, opts *zendesk.IncrementalExportOptions) (*zendesk.IncrementalTalkCallLegsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTalkCallLegs", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalTalkCallLegsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalTalkCallLegs indicates an expected call of GetIncrementalTalkCallLegs.
+func (mr *ClientMockRecorder) GetIncrementalTalkCallLegs(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTalkCallLegs", reflect.TypeOf((*Client)(nil).GetIncrementalTalkCallLegs), ctx, opts)
+}
+
+// GetIncrementalTalkCallLegsIterator mocks base method.
+func (m *Client) GetIncrementalTalkCallLegsIterator(ctx context.Context, opts *zendesk.IncrementalExportOptions) *zendesk.IncrementalExportIterator[zendesk.TalkCallLeg] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTalkCallLegsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalExportIterator[zendesk.TalkCallLeg])
+	return ret0
+}
+
+// GetIncrementalTalkCallLegsIterator indicates an expected call of GetIncrementalTalkCallLegsIterator.
+func (mr *ClientMockRecorder) GetIncrementalTalkCallLegsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTalkCallLegsIterator", reflect.TypeOf((*Client)(nil).GetIncrementalTalkCallLegsIterator), ctx, opts)
+}
+
+// GetIncrementalTalkCalls mocks base method.
+func (m *Client) GetIncrementalTalkCalls(ctx context.Context, opts *zendesk.IncrementalExportOptions) (*zendesk.IncrementalTalkCallsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTalkCalls", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalTalkCallsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalTalkCalls indicates an expected call of GetIncrementalTalkCalls.
+func (mr *ClientMockRecorder) GetIncrementalTalkCalls(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTalkCalls", reflect.TypeOf((*Client)(nil).GetIncrementalTalkCalls), ctx, opts)
+}
+
+// GetIncrementalTalkCallsIterator mocks base method.
+func (m *Client) GetIncrementalTalkCallsIterator(ctx context.Context, opts *zendesk.IncrementalExportOptions) *zendesk.IncrementalExportIterator[zendesk.TalkCall] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTalkCallsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalExportIterator[zendesk.TalkCall])
+	return ret0
+}
+
+// GetIncrementalTalkCallsIterator indicates an expected call of GetIncrementalTalkCallsIterator.
+func (mr *ClientMockRecorder) GetIncrementalTalkCallsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTalkCallsIterator", reflect.TypeOf((*Client)(nil).GetIncrementalTalkCallsIterator), ctx, opts)
+}
+
+// GetIncrementalTicketEvents mocks base method.
+func (m *Client) GetIncrementalTicketEvents(ctx context.Context, opts *zendesk.IncrementalExportOptions) (*zendesk.IncrementalTicketEventsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTicketEvents", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalTicketEventsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalTicketEvents indicates an expected call of GetIncrementalTicketEvents.
+func (mr *ClientMockRecorder) GetIncrementalTicketEvents(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTicketEvents", reflect.TypeOf((*Client)(nil).GetIncrementalTicketEvents), ctx, opts)
+}
+
+// GetIncrementalTicketEventsIterator mocks base method.
+func (m *Client) GetIncrementalTicketEventsIterator(ctx context.Context, opts *zendesk.IncrementalExportOptions) *zendesk.IncrementalExportIterator[zendesk.TicketEvent] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTicketEventsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalExportIterator[zendesk.TicketEvent])
+	return ret0
+}
+
+// GetIncrementalTicketEventsIterator indicates an expected call of GetIncrementalTicketEventsIterator.
+func (mr *ClientMockRecorder) GetIncrementalTicketEventsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTicketEventsIterator", reflect.TypeOf((*Client)(nil).GetIncrementalTicketEventsIterator), ctx, opts)
+}
+
+// GetIncrementalTickets mocks base method.
+func (m *Client) GetIncrementalTickets(ctx context.Context, opts *zendesk.IncrementalExportOptions) (*zendesk.IncrementalTicketsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTickets", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalTicketsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalTickets indicates an expected call of GetIncrementalTickets.
+func (mr *ClientMockRecorder) GetIncrementalTickets(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTickets", reflect.TypeOf((*Client)(nil).GetIncrementalTickets), ctx, opts)
+}
+
+// GetIncrementalTicketsCursor mocks base method.
+func (m *Client) GetIncrementalTicketsCursor(ctx context.Context, opts *zendesk.IncrementalExportOptions) (*zendesk.IncrementalTicketsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTicketsCursor", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalTicketsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncrementalTicketsCursor indicates an expected call of GetIncrementalTicketsCursor.
+func (mr *ClientMockRecorder) GetIncrementalTicketsCursor(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTicketsCursor", reflect.TypeOf((*Client)(nil).GetIncrementalTicketsCursor), ctx, opts)
+}
+
+// GetIncrementalTicketsIterator mocks base method.
+func (m *Client) GetIncrementalTicketsIterator(ctx context.Context, opts *zendesk.IncrementalExportOptions) *zendesk.IncrementalExportIterator[zendesk.Ticket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncrementalTicketsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.IncrementalExportIterator[zendesk.Ticket])
+	return ret0
+}
+
+// GetIncrementalTicketsIterator indicates an expected call of GetIncrementalTicketsIterator.
+func (mr *ClientMockRecorder) GetIncrementalTicketsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncrementalTicketsIterator", reflect.TypeOf((*Client)(nil).GetIncrementalTicketsIterator), ctx, opts)
+}
+
+// GetJobStatus mocks base method.
+func (m *Client) GetJobStatus(ctx context.Context, id string) (zendesk.JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStatus", ctx, id)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStatus indicates an expected call of GetJobStatus.
+func (mr *ClientMockRecorder) GetJobStatus(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatus", reflect.TypeOf((*Client)(nil).GetJobStatus), ctx, id)
+}
+
+// GetLocales mocks base method.
+func (m *Client) GetLocales(ctx context.Context) ([]zendesk.Locale, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocales", ctx)
+	ret0, _ := ret[0].([]zendesk.Locale)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocales indicates an expected call of GetLocales.
+func (mr *ClientMockRecorder) GetLocales(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocales", reflect.TypeOf((*Client)(nil).GetLocales), ctx)
+}
+
+// GetLookupRelationshipSources mocks base method.
+func (m *Client) GetLookupRelationshipSources(ctx context.Context, targetType, targetID string, fieldID int64, sourceType string, opts *zendesk.CursorPagination) (*zendesk.LookupRelationshipSources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLookupRelationshipSources", ctx, targetType, targetID, fieldID, sourceType, opts)
+	ret0, _ := ret[0].(*zendesk.LookupRelationshipSources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLookupRelationshipSources indicates an expected call of GetLookupRelationshipSources.
+func (mr *ClientMockRecorder) GetLookupRelationshipSources(ctx, targetType, targetID, fieldID, sourceType, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLookupRelationshipSources", reflect.TypeOf((*Client)(nil).GetLookupRelationshipSources), ctx, targetType, targetID, fieldID, sourceType, opts)
+}
+
+// GetMacro mocks base method.
+func (m *Client) GetMacro(ctx context.Context, macroID int64) (zendesk.Macro, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacro", ctx, macroID)
+	ret0, _ := ret[0].(zendesk.Macro)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacro indicates an expected call of GetMacro.
+func (mr *ClientMockRecorder) GetMacro(ctx, macroID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), ctx, macroID)
+}
+
+// GetMacros mocks base method.
+func (m *Client) GetMacros(ctx context.Context, opts *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacros", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacros indicates an expected call of GetMacros.
+func (mr *ClientMockRecorder) GetMacros(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacros", reflect.TypeOf((*Client)(nil).GetMacros), ctx, opts)
+}
+
+// GetMacrosCBP mocks base method.
+func (m *Client) GetMacrosCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Macro, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacrosCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacrosCBP indicates an expected call of GetMacrosCBP.
+func (mr *ClientMockRecorder) GetMacrosCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosCBP", reflect.TypeOf((*Client)(nil).GetMacrosCBP), ctx, opts)
+}
+
+// GetMacrosIterator mocks base method.
+func (m *Client) GetMacrosIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Macro] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacrosIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Macro])
+	return ret0
+}
+
+// GetMacrosIterator indicates an expected call of GetMacrosIterator.
+func (mr *ClientMockRecorder) GetMacrosIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosIterator", reflect.TypeOf((*Client)(nil).GetMacrosIterator), ctx, opts)
+}
+
+// GetMacrosOBP mocks base method.
+func (m *Client) GetMacrosOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Macro, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacrosOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Macro)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMacrosOBP indicates an expected call of GetMacrosOBP.
+func (mr *ClientMockRecorder) GetMacrosOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosOBP", reflect.TypeOf((*Client)(nil).GetMacrosOBP), ctx, opts)
+}
+
+// GetManyOrganizations mocks base method.
+func (m *Client) GetManyOrganizations(ctx context.Context, opts *zendesk.GetManyOrganizationsOptions) ([]zendesk.Organization, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyOrganizations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManyOrganizations indicates an expected call of GetManyOrganizations.
+func (mr *ClientMockRecorder) GetManyOrganizations(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyOrganizations", reflect.TypeOf((*Client)(nil).GetManyOrganizations), ctx, opts)
+}
+
+// GetManyUsers mocks base method.
+func (m *Client) GetManyUsers(ctx context.Context, opts *zendesk.GetManyUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManyUsers indicates an expected call of GetManyUsers.
+func (mr *ClientMockRecorder) GetManyUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsers", reflect.TypeOf((*Client)(nil).GetManyUsers), ctx, opts)
+}
+
+// GetManyUsersByExternalIDs mocks base method.
+func (m *Client) GetManyUsersByExternalIDs(ctx context.Context, externalIDs []string) ([]zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyUsersByExternalIDs", ctx, externalIDs)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManyUsersByExternalIDs indicates an expected call of GetManyUsersByExternalIDs.
+func (mr *ClientMockRecorder) GetManyUsersByExternalIDs(ctx, externalIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsersByExternalIDs", reflect.TypeOf((*Client)(nil).GetManyUsersByExternalIDs), ctx, externalIDs)
+}
+
+// GetManyUsersByIDs mocks base method.
+func (m *Client) GetManyUsersByIDs(ctx context.Context, userIDs []int64) ([]zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManyUsersByIDs", ctx, userIDs)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManyUsersByIDs indicates an expected call of GetManyUsersByIDs.
+func (mr *ClientMockRecorder) GetManyUsersByIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsersByIDs", reflect.TypeOf((*Client)(nil).GetManyUsersByIDs), ctx, userIDs)
+}
+
+// GetMonitoredTwitterHandle mocks base method.
+func (m *Client) GetMonitoredTwitterHandle(ctx context.Context, handleID int64) (zendesk.MonitoredTwitterHandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitoredTwitterHandle", ctx, handleID)
+	ret0, _ := ret[0].(zendesk.MonitoredTwitterHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitoredTwitterHandle indicates an expected call of GetMonitoredTwitterHandle.
+func (mr *ClientMockRecorder) GetMonitoredTwitterHandle(ctx, handleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitoredTwitterHandle", reflect.TypeOf((*Client)(nil).GetMonitoredTwitterHandle), ctx, handleID)
+}
+
+// GetMonitoredTwitterHandles mocks base method.
+func (m *Client) GetMonitoredTwitterHandles(ctx context.Context) ([]zendesk.MonitoredTwitterHandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonitoredTwitterHandles", ctx)
+	ret0, _ := ret[0].([]zendesk.MonitoredTwitterHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonitoredTwitterHandles indicates an expected call of GetMonitoredTwitterHandles.
+func (mr *ClientMockRecorder) GetMonitoredTwitterHandles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitoredTwitterHandles", reflect.TypeOf((*Client)(nil).GetMonitoredTwitterHandles), ctx)
+}
+
+// GetMultipleTickets mocks base method.
+func (m *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMultipleTickets", ctx, ticketIDs)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMultipleTickets indicates an expected call of GetMultipleTickets.
+func (mr *ClientMockRecorder) GetMultipleTickets(ctx, ticketIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultipleTickets", reflect.TypeOf((*Client)(nil).GetMultipleTickets), ctx, ticketIDs)
+}
+
+// GetOrganization mocks base method.
+func (m *Client) GetOrganization(ctx context.Context, orgID int64) (zendesk.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, orgID)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization.
+func (mr *ClientMockRecorder) GetOrganization(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), ctx, orgID)
+}
+
+// GetOrganizationByExternalID mocks base method.
+func (m *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]zendesk.Organization, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", ctx, externalID)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
+func (mr *ClientMockRecorder) GetOrganizationByExternalID(ctx, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), ctx, externalID)
+}
+
+// GetOrganizationField mocks base method.
+func (m *Client) GetOrganizationField(ctx context.Context, fieldID int64) (zendesk.OrganizationField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationField", ctx, fieldID)
+	ret0, _ := ret[0].(zendesk.OrganizationField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationField indicates an expected call of GetOrganizationField.
+func (mr *ClientMockRecorder) GetOrganizationField(ctx, fieldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationField", reflect.TypeOf((*Client)(nil).GetOrganizationField), ctx, fieldID)
+}
+
+// GetOrganizationFieldOption mocks base method.
+func (m *Client) GetOrganizationFieldOption(ctx context.Context, fieldID, optionID int64) (zendesk.CustomFieldOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationFieldOption indicates an expected call of GetOrganizationFieldOption.
+func (mr *ClientMockRecorder) GetOrganizationFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldOption", reflect.TypeOf((*Client)(nil).GetOrganizationFieldOption), ctx, fieldID, optionID)
+}
+
+// GetOrganizationFieldOptions mocks base method.
+func (m *Client) GetOrganizationFieldOptions(ctx context.Context, fieldID int64) ([]zendesk.CustomFieldOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldOptions", ctx, fieldID)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationFieldOptions indicates an expected call of GetOrganizationFieldOptions.
+func (mr *ClientMockRecorder) GetOrganizationFieldOptions(ctx, fieldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldOptions", reflect.TypeOf((*Client)(nil).GetOrganizationFieldOptions), ctx, fieldID)
+}
+
+// GetOrganizationFields mocks base method.
+func (m *Client) GetOrganizationFields(ctx context.Context) ([]zendesk.OrganizationField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFields", ctx)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationFields indicates an expected call of GetOrganizationFields.
+func (mr *ClientMockRecorder) GetOrganizationFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFields", reflect.TypeOf((*Client)(nil).GetOrganizationFields), ctx)
+}
+
+// GetOrganizationFieldsCBP mocks base method.
+func (m *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationField, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationFieldsCBP indicates an expected call of GetOrganizationFieldsCBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsCBP), ctx, opts)
+}
+
+// GetOrganizationFieldsIterator mocks base method.
+func (m *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationField] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationField])
+	return ret0
+}
+
+// GetOrganizationFieldsIterator indicates an expected call of GetOrganizationFieldsIterator.
+func (mr *ClientMockRecorder) GetOrganizationFieldsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsIterator), ctx, opts)
+}
+
+// GetOrganizationFieldsOBP mocks base method.
+func (m *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationFieldsOBP indicates an expected call of GetOrganizationFieldsOBP.
+func (mr *ClientMockRecorder) GetOrganizationFieldsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsOBP), ctx, opts)
+}
+
+// GetOrganizationMemberships mocks base method.
+func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
+func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
+}
+
+// GetOrganizationMembershipsCBP mocks base method.
+func (m *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationMembership, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMembershipsCBP indicates an expected call of GetOrganizationMembershipsCBP.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsCBP), ctx, opts)
+}
+
+// GetOrganizationMembershipsIterator mocks base method.
+func (m *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationMembership])
+	return ret0
+}
+
+// GetOrganizationMembershipsIterator indicates an expected call of GetOrganizationMembershipsIterator.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsIterator), ctx, opts)
+}
+
+// GetOrganizationMembershipsOBP mocks base method.
+func (m *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembershipsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMembershipsOBP indicates an expected call of GetOrganizationMembershipsOBP.
+func (mr *ClientMockRecorder) GetOrganizationMembershipsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsOBP), ctx, opts)
+}
+
+// GetOrganizationMerge mocks base method.
+func (m *Client) GetOrganizationMerge(ctx context.Context, mergeID string) (zendesk.OrganizationMerge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMerge", ctx, mergeID)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationMerge indicates an expected call of GetOrganizationMerge.
+func (mr *ClientMockRecorder) GetOrganizationMerge(ctx, mergeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMerge", reflect.TypeOf((*Client)(nil).GetOrganizationMerge), ctx, mergeID)
+}
+
+// GetOrganizationMerges mocks base method.
+func (m *Client) GetOrganizationMerges(ctx context.Context, orgID int64, opts *zendesk.OrganizationMergeListOptions) ([]zendesk.OrganizationMerge, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMerges", ctx, orgID, opts)
+	ret0, _ := ret[0].([]zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationMerges indicates an expected call of GetOrganizationMerges.
+func (mr *ClientMockRecorder) GetOrganizationMerges(ctx, orgID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMerges", reflect.TypeOf((*Client)(nil).GetOrganizationMerges), ctx, orgID, opts)
+}
+
+// GetOrganizationRelated mocks base method.
+func (m *Client) GetOrganizationRelated(ctx context.Context, orgID int64) (zendesk.OrganizationRelated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationRelated", ctx, orgID)
+	ret0, _ := ret[0].(zendesk.OrganizationRelated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationRelated indicates an expected call of GetOrganizationRelated.
+func (mr *ClientMockRecorder) GetOrganizationRelated(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationRelated", reflect.TypeOf((*Client)(nil).GetOrganizationRelated), ctx, orgID)
+}
+
+// GetOrganizationTags mocks base method.
+func (m *Client) GetOrganizationTags(ctx context.Context, organizationID int64) ([]zendesk.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTags", ctx, organizationID)
+	ret0, _ := ret[0].([]zendesk.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationTags indicates an expected call of GetOrganizationTags.
+func (mr *ClientMockRecorder) GetOrganizationTags(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), ctx, organizationID)
+}
+
+// GetOrganizationTickets mocks base method.
+func (m *Client) GetOrganizationTickets(ctx context.Context, organizationID int64, ops *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTickets", ctx, organizationID, ops)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationTickets indicates an expected call of GetOrganizationTickets.
+func (mr *ClientMockRecorder) GetOrganizationTickets(ctx, organizationID, ops any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTickets", reflect.TypeOf((*Client)(nil).GetOrganizationTickets), ctx, organizationID, ops)
+}
+
+// GetOrganizationTicketsCBP mocks base method.
+func (m *Client) GetOrganizationTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationTicketsCBP indicates an expected call of GetOrganizationTicketsCBP.
+func (mr *ClientMockRecorder) GetOrganizationTicketsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsCBP), ctx, opts)
+}
+
+// GetOrganizationTicketsCount mocks base method.
+func (m *Client) GetOrganizationTicketsCount(ctx context.Context, organizationID int64) (zendesk.Count, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsCount", ctx, organizationID)
+	ret0, _ := ret[0].(zendesk.Count)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationTicketsCount indicates an expected call of GetOrganizationTicketsCount.
+func (mr *ClientMockRecorder) GetOrganizationTicketsCount(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsCount", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsCount), ctx, organizationID)
+}
+
+// GetOrganizationTicketsIterator mocks base method.
+func (m *Client) GetOrganizationTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
+	return ret0
+}
+
+// GetOrganizationTicketsIterator indicates an expected call of GetOrganizationTicketsIterator.
+func (mr *ClientMockRecorder) GetOrganizationTicketsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsIterator), ctx, opts)
+}
+
+// GetOrganizationTicketsOBP mocks base method.
+func (m *Client) GetOrganizationTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationTicketsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationTicketsOBP indicates an expected call of GetOrganizationTicketsOBP.
+func (mr *ClientMockRecorder) GetOrganizationTicketsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsOBP), ctx, opts)
+}
+
+// GetOrganizationUsers mocks base method.
+func (m *Client) GetOrganizationUsers(ctx context.Context, orgID int64, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationUsers", ctx, orgID, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationUsers indicates an expected call of GetOrganizationUsers.
+func (mr *ClientMockRecorder) GetOrganizationUsers(ctx, orgID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsers", reflect.TypeOf((*Client)(nil).GetOrganizationUsers), ctx, orgID, opts)
+}
+
+// GetOrganizationUsersCBP mocks base method.
+func (m *Client) GetOrganizationUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationUsersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationUsersCBP indicates an expected call of GetOrganizationUsersCBP.
+func (mr *ClientMockRecorder) GetOrganizationUsersCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersCBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersCBP), ctx, opts)
+}
+
+// GetOrganizationUsersCount mocks base method.
+func (m *Client) GetOrganizationUsersCount(ctx context.Context, orgID int64) (zendesk.Count, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationUsersCount", ctx, orgID)
+	ret0, _ := ret[0].(zendesk.Count)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationUsersCount indicates an expected call of GetOrganizationUsersCount.
+func (mr *ClientMockRecorder) GetOrganizationUsersCount(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersCount", reflect.TypeOf((*Client)(nil).GetOrganizationUsersCount), ctx, orgID)
+}
+
+// GetOrganizationUsersIterator mocks base method.
+func (m *Client) GetOrganizationUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationUsersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	return ret0
+}
+
+// GetOrganizationUsersIterator indicates an expected call of GetOrganizationUsersIterator.
+func (mr *ClientMockRecorder) GetOrganizationUsersIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersIterator", reflect.TypeOf((*Client)(nil).GetOrganizationUsersIterator), ctx, opts)
+}
+
+// GetOrganizationUsersOBP mocks base method.
+func (m *Client) GetOrganizationUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationUsersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationUsersOBP indicates an expected call of GetOrganizationUsersOBP.
+func (mr *ClientMockRecorder) GetOrganizationUsersOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersOBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersOBP), ctx, opts)
+}
+
+// GetOrganizations mocks base method.
+func (m *Client) GetOrganizations(ctx context.Context, opts *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizations indicates an expected call of GetOrganizations.
+func (mr *ClientMockRecorder) GetOrganizations(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), ctx, opts)
+}
+
+// GetOrganizationsCBP mocks base method.
+func (m *Client) GetOrganizationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Organization, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationsCBP indicates an expected call of GetOrganizationsCBP.
+func (mr *ClientMockRecorder) GetOrganizationsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationsCBP), ctx, opts)
+}
+
+// GetOrganizationsCount mocks base method.
+func (m *Client) GetOrganizationsCount(ctx context.Context) (zendesk.Count, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsCount", ctx)
+	ret0, _ := ret[0].(zendesk.Count)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizationsCount indicates an expected call of GetOrganizationsCount.
+func (mr *ClientMockRecorder) GetOrganizationsCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsCount", reflect.TypeOf((*Client)(nil).GetOrganizationsCount), ctx)
+}
+
+// GetOrganizationsIterator mocks base method.
+func (m *Client) GetOrganizationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Organization] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Organization])
+	return ret0
+}
+
+// GetOrganizationsIterator indicates an expected call of GetOrganizationsIterator.
+func (mr *ClientMockRecorder) GetOrganizationsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationsIterator), ctx, opts)
+}
+
+// GetOrganizationsOBP mocks base method.
+func (m *Client) GetOrganizationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Organization, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOrganizationsOBP indicates an expected call of GetOrganizationsOBP.
+func (mr *ClientMockRecorder) GetOrganizationsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationsOBP), ctx, opts)
+}
+
+// GetPermissionGroup mocks base method.
+func (m *Client) GetPermissionGroup(ctx context.Context, permissionGroupID int64) (zendesk.PermissionGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermissionGroup", ctx, permissionGroupID)
+	ret0, _ := ret[0].(zendesk.PermissionGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermissionGroup indicates an expected call of GetPermissionGroup.
+func (mr *ClientMockRecorder) GetPermissionGroup(ctx, permissionGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermissionGroup", reflect.TypeOf((*Client)(nil).GetPermissionGroup), ctx, permissionGroupID)
+}
+
+// GetPost mocks base method.
+func (m *Client) GetPost(ctx context.Context, postID int64) (zendesk.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPost", ctx, postID)
+	ret0, _ := ret[0].(zendesk.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPost indicates an expected call of GetPost.
+func (mr *ClientMockRecorder) GetPost(ctx, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPost", reflect.TypeOf((*Client)(nil).GetPost), ctx, postID)
+}
+
+// GetPostComment mocks base method.
+func (m *Client) GetPostComment(ctx context.Context, postID, commentID int64) (zendesk.PostComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPostComment", ctx, postID, commentID)
+	ret0, _ := ret[0].(zendesk.PostComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPostComment indicates an expected call of GetPostComment.
+func (mr *ClientMockRecorder) GetPostComment(ctx, postID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPostComment", reflect.TypeOf((*Client)(nil).GetPostComment), ctx, postID, commentID)
+}
+
+// GetSLAPolicies mocks base method.
+func (m *Client) GetSLAPolicies(ctx context.Context, opts *zendesk.SLAPolicyListOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLAPolicies", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SLAPolicy)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSLAPolicies indicates an expected call of GetSLAPolicies.
+func (mr *ClientMockRecorder) GetSLAPolicies(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicies", reflect.TypeOf((*Client)(nil).GetSLAPolicies), ctx, opts)
+}
+
+// GetSLAPoliciesCBP mocks base method.
+func (m *Client) GetSLAPoliciesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SLAPolicy, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLAPoliciesCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SLAPolicy)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSLAPoliciesCBP indicates an expected call of GetSLAPoliciesCBP.
+func (mr *ClientMockRecorder) GetSLAPoliciesCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesCBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesCBP), ctx, opts)
+}
+
+// GetSLAPoliciesIterator mocks base method.
+func (m *Client) GetSLAPoliciesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SLAPolicy] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLAPoliciesIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SLAPolicy])
+	return ret0
+}
+
+// GetSLAPoliciesIterator indicates an expected call of GetSLAPoliciesIterator.
+func (mr *ClientMockRecorder) GetSLAPoliciesIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesIterator", reflect.TypeOf((*Client)(nil).GetSLAPoliciesIterator), ctx, opts)
+}
+
+// GetSLAPoliciesOBP mocks base method.
+func (m *Client) GetSLAPoliciesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLAPoliciesOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SLAPolicy)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSLAPoliciesOBP indicates an expected call of GetSLAPoliciesOBP.
+func (mr *ClientMockRecorder) GetSLAPoliciesOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesOBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesOBP), ctx, opts)
+}
+
+// GetSLAPolicy mocks base method.
+func (m *Client) GetSLAPolicy(ctx context.Context, id int64) (zendesk.SLAPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLAPolicy", ctx, id)
+	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSLAPolicy indicates an expected call of GetSLAPolicy.
+func (mr *ClientMockRecorder) GetSLAPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), ctx, id)
+}
+
+// GetSLAPolicyFilterDefinitions mocks base method.
+func (m *Client) GetSLAPolicyFilterDefinitions(ctx context.Context) (zendesk.SLAPolicyFilterDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLAPolicyFilterDefinitions", ctx)
+	ret0, _ := ret[0].(zendesk.SLAPolicyFilterDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSLAPolicyFilterDefinitions indicates an expected call of GetSLAPolicyFilterDefinitions.
+func (mr *ClientMockRecorder) GetSLAPolicyFilterDefinitions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicyFilterDefinitions", reflect.TypeOf((*Client)(nil).GetSLAPolicyFilterDefinitions), ctx)
+}
+
+// GetSearchCBP mocks base method.
+func (m *Client) GetSearchCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SearchResults, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSearchCBP indicates an expected call of GetSearchCBP.
+func (mr *ClientMockRecorder) GetSearchCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCBP", reflect.TypeOf((*Client)(nil).GetSearchCBP), ctx, opts)
+}
+
+// GetSearchIterator mocks base method.
+func (m *Client) GetSearchIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SearchResults] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SearchResults])
+	return ret0
+}
+
+// GetSearchIterator indicates an expected call of GetSearchIterator.
+func (mr *ClientMockRecorder) GetSearchIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchIterator", reflect.TypeOf((*Client)(nil).GetSearchIterator), ctx, opts)
+}
+
+// GetSearchOBP mocks base method.
+func (m *Client) GetSearchOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SearchResults, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSearchOBP indicates an expected call of GetSearchOBP.
+func (mr *ClientMockRecorder) GetSearchOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchOBP", reflect.TypeOf((*Client)(nil).GetSearchOBP), ctx, opts)
+}
+
+// GetSection mocks base method.
+func (m *Client) GetSection(ctx context.Context, sectionID int64) (zendesk.Section, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSection", ctx, sectionID)
+	ret0, _ := ret[0].(zendesk.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSection indicates an expected call of GetSection.
+func (mr *ClientMockRecorder) GetSection(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSection", reflect.TypeOf((*Client)(nil).GetSection), ctx, sectionID)
+}
+
+// GetSectionTranslation mocks base method.
+func (m *Client) GetSectionTranslation(ctx context.Context, sectionID int64, locale string) (zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionTranslation", ctx, sectionID, locale)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSectionTranslation indicates an expected call of GetSectionTranslation.
+func (mr *ClientMockRecorder) GetSectionTranslation(ctx, sectionID, locale any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionTranslation", reflect.TypeOf((*Client)(nil).GetSectionTranslation), ctx, sectionID, locale)
+}
+
+// GetSession mocks base method.
+func (m *Client) GetSession(ctx context.Context, userID, sessionID int64) (zendesk.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(zendesk.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *ClientMockRecorder) GetSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*Client)(nil).GetSession), ctx, userID, sessionID)
+}
+
+// GetSessions mocks base method.
+func (m *Client) GetSessions(ctx context.Context, opts *zendesk.SessionListOptions) ([]zendesk.Session, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *ClientMockRecorder) GetSessions(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*Client)(nil).GetSessions), ctx, opts)
+}
+
+// GetTalkAccountOverview mocks base method.
+func (m *Client) GetTalkAccountOverview(ctx context.Context) (zendesk.TalkAccountOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkAccountOverview", ctx)
+	ret0, _ := ret[0].(zendesk.TalkAccountOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkAccountOverview indicates an expected call of GetTalkAccountOverview.
+func (mr *ClientMockRecorder) GetTalkAccountOverview(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkAccountOverview", reflect.TypeOf((*Client)(nil).GetTalkAccountOverview), ctx)
+}
+
+// GetTalkAvailability mocks base method.
+func (m *Client) GetTalkAvailability(ctx context.Context, agentID int64) (zendesk.TalkAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkAvailability", ctx, agentID)
+	ret0, _ := ret[0].(zendesk.TalkAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkAvailability indicates an expected call of GetTalkAvailability.
+func (mr *ClientMockRecorder) GetTalkAvailability(ctx, agentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkAvailability", reflect.TypeOf((*Client)(nil).GetTalkAvailability), ctx, agentID)
+}
+
+// GetTalkCurrentQueueActivity mocks base method.
+func (m *Client) GetTalkCurrentQueueActivity(ctx context.Context) (zendesk.TalkCurrentQueueActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkCurrentQueueActivity", ctx)
+	ret0, _ := ret[0].(zendesk.TalkCurrentQueueActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkCurrentQueueActivity indicates an expected call of GetTalkCurrentQueueActivity.
+func (mr *ClientMockRecorder) GetTalkCurrentQueueActivity(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkCurrentQueueActivity", reflect.TypeOf((*Client)(nil).GetTalkCurrentQueueActivity), ctx)
+}
+
+// GetTalkDigitalLine mocks base method.
+func (m *Client) GetTalkDigitalLine(ctx context.Context, digitalLineID int64) (zendesk.TalkLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkDigitalLine", ctx, digitalLineID)
+	ret0, _ := ret[0].(zendesk.TalkLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkDigitalLine indicates an expected call of GetTalkDigitalLine.
+func (mr *ClientMockRecorder) GetTalkDigitalLine(ctx, digitalLineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkDigitalLine", reflect.TypeOf((*Client)(nil).GetTalkDigitalLine), ctx, digitalLineID)
+}
+
+// GetTalkGreeting mocks base method.
+func (m *Client) GetTalkGreeting(ctx context.Context, greetingID int64) (zendesk.TalkGreeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkGreeting", ctx, greetingID)
+	ret0, _ := ret[0].(zendesk.TalkGreeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkGreeting indicates an expected call of GetTalkGreeting.
+func (mr *ClientMockRecorder) GetTalkGreeting(ctx, greetingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkGreeting", reflect.TypeOf((*Client)(nil).GetTalkGreeting), ctx, greetingID)
+}
+
+// GetTalkGreetingCategory mocks base method.
+func (m *Client) GetTalkGreetingCategory(ctx context.Context, categoryID int64) (zendesk.TalkGreetingCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkGreetingCategory", ctx, categoryID)
+	ret0, _ := ret[0].(zendesk.TalkGreetingCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkGreetingCategory indicates an expected call of GetTalkGreetingCategory.
+func (mr *ClientMockRecorder) GetTalkGreetingCategory(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkGreetingCategory", reflect.TypeOf((*Client)(nil).GetTalkGreetingCategory), ctx, categoryID)
+}
+
+// GetTalkIVR mocks base method.
+func (m *Client) GetTalkIVR(ctx context.Context, ivrID int64) (zendesk.TalkIVR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkIVR", ctx, ivrID)
+	ret0, _ := ret[0].(zendesk.TalkIVR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkIVR indicates an expected call of GetTalkIVR.
+func (mr *ClientMockRecorder) GetTalkIVR(ctx, ivrID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkIVR", reflect.TypeOf((*Client)(nil).GetTalkIVR), ctx, ivrID)
+}
+
+// GetTalkIVRMenu mocks base method.
+func (m *Client) GetTalkIVRMenu(ctx context.Context, ivrID, menuID int64) (zendesk.TalkIVRMenu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkIVRMenu", ctx, ivrID, menuID)
+	ret0, _ := ret[0].(zendesk.TalkIVRMenu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkIVRMenu indicates an expected call of GetTalkIVRMenu.
+func (mr *ClientMockRecorder) GetTalkIVRMenu(ctx, ivrID, menuID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkIVRMenu", reflect.TypeOf((*Client)(nil).GetTalkIVRMenu), ctx, ivrID, menuID)
+}
+
+// GetTalkIVRRoute mocks base method.
+func (m *Client) GetTalkIVRRoute(ctx context.Context, ivrID, menuID, routeID int64) (zendesk.TalkIVRRoute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkIVRRoute", ctx, ivrID, menuID, routeID)
+	ret0, _ := ret[0].(zendesk.TalkIVRRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkIVRRoute indicates an expected call of GetTalkIVRRoute.
+func (mr *ClientMockRecorder) GetTalkIVRRoute(ctx, ivrID, menuID, routeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkIVRRoute", reflect.TypeOf((*Client)(nil).GetTalkIVRRoute), ctx, ivrID, menuID, routeID)
+}
+
+// GetTalkLineUsage mocks base method.
+func (m *Client) GetTalkLineUsage(ctx context.Context, opts *zendesk.TalkLineUsageOptions) ([]zendesk.TalkLineUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTalkLineUsage", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TalkLineUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTalkLineUsage indicates an expected call of GetTalkLineUsage.
+func (mr *ClientMockRecorder) GetTalkLineUsage(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTalkLineUsage", reflect.TypeOf((*Client)(nil).GetTalkLineUsage), ctx, opts)
+}
+
+// GetTarget mocks base method.
+func (m *Client) GetTarget(ctx context.Context, ticketID int64) (zendesk.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTarget", ctx, ticketID)
+	ret0, _ := ret[0].(zendesk.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTarget indicates an expected call of GetTarget.
+func (mr *ClientMockRecorder) GetTarget(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTarget", reflect.TypeOf((*Client)(nil).GetTarget), ctx, ticketID)
+}
+
+// GetTargets mocks base method.
+func (m *Client) GetTargets(ctx context.Context) ([]zendesk.Target, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTargets", ctx)
+	ret0, _ := ret[0].([]zendesk.Target)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTargets indicates an expected call of GetTargets.
+func (mr *ClientMockRecorder) GetTargets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargets", reflect.TypeOf((*Client)(nil).GetTargets), ctx)
+}
+
+// GetTheme mocks base method.
+func (m *Client) GetTheme(ctx context.Context, themeID string) (zendesk.Theme, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTheme", ctx, themeID)
+	ret0, _ := ret[0].(zendesk.Theme)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTheme indicates an expected call of GetTheme.
+func (mr *ClientMockRecorder) GetTheme(ctx, themeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTheme", reflect.TypeOf((*Client)(nil).GetTheme), ctx, themeID)
+}
+
+// GetThemeJob mocks base method.
+func (m *Client) GetThemeJob(ctx context.Context, jobID string) (zendesk.ThemeJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThemeJob", ctx, jobID)
+	ret0, _ := ret[0].(zendesk.ThemeJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThemeJob indicates an expected call of GetThemeJob.
+func (mr *ClientMockRecorder) GetThemeJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThemeJob", reflect.TypeOf((*Client)(nil).GetThemeJob), ctx, jobID)
+}
+
+// GetTicket mocks base method.
+func (m *Client) GetTicket(ctx context.Context, id int64) (zendesk.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicket", ctx, id)
+	ret0, _ := ret[0].(zendesk.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicket indicates an expected call of GetTicket.
+func (mr *ClientMockRecorder) GetTicket(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*Client)(nil).GetTicket), ctx, id)
+}
+
+// GetTicketAudit mocks base method.
+func (m *Client) GetTicketAudit(ctx context.Context, TicketID, ID int64) (zendesk.TicketAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketAudit", ctx, TicketID, ID)
+	ret0, _ := ret[0].(zendesk.TicketAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketAudit indicates an expected call of GetTicketAudit.
+func (mr *ClientMockRecorder) GetTicketAudit(ctx, TicketID, ID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAudit", reflect.TypeOf((*Client)(nil).GetTicketAudit), ctx, TicketID, ID)
+}
+
+// GetTicketAudits mocks base method.
+func (m *Client) GetTicketAudits(ctx context.Context, ticketID int64, opts zendesk.PageOptions) ([]zendesk.TicketAudit, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketAudits", ctx, ticketID, opts)
+	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketAudits indicates an expected call of GetTicketAudits.
+func (mr *ClientMockRecorder) GetTicketAudits(ctx, ticketID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAudits", reflect.TypeOf((*Client)(nil).GetTicketAudits), ctx, ticketID, opts)
+}
+
+// GetTicketAuditsCBP mocks base method.
+func (m *Client) GetTicketAuditsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketAudit, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketAuditsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketAuditsCBP indicates an expected call of GetTicketAuditsCBP.
+func (mr *ClientMockRecorder) GetTicketAuditsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAuditsCBP", reflect.TypeOf((*Client)(nil).GetTicketAuditsCBP), ctx, opts)
+}
+
+// GetTicketAuditsIterator mocks base method.
+func (m *Client) GetTicketAuditsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketAudit] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketAuditsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketAudit])
+	return ret0
+}
+
+// GetTicketAuditsIterator indicates an expected call of GetTicketAuditsIterator.
+func (mr *ClientMockRecorder) GetTicketAuditsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAuditsIterator", reflect.TypeOf((*Client)(nil).GetTicketAuditsIterator), ctx, opts)
+}
+
+// GetTicketAuditsOBP mocks base method.
+func (m *Client) GetTicketAuditsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketAudit, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketAuditsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketAuditsOBP indicates an expected call of GetTicketAuditsOBP.
+func (mr *ClientMockRecorder) GetTicketAuditsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAuditsOBP", reflect.TypeOf((*Client)(nil).GetTicketAuditsOBP), ctx, opts)
+}
+
+// GetTicketCommentsCBP mocks base method.
+func (m *Client) GetTicketCommentsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketComment, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketCommentsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketComment)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketCommentsCBP indicates an expected call of GetTicketCommentsCBP.
+func (mr *ClientMockRecorder) GetTicketCommentsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCommentsCBP", reflect.TypeOf((*Client)(nil).GetTicketCommentsCBP), ctx, opts)
+}
+
+// GetTicketCommentsIterator mocks base method.
+func (m *Client) GetTicketCommentsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketComment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketCommentsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketComment])
+	return ret0
+}
+
+// GetTicketCommentsIterator indicates an expected call of GetTicketCommentsIterator.
+func (mr *ClientMockRecorder) GetTicketCommentsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCommentsIterator", reflect.TypeOf((*Client)(nil).GetTicketCommentsIterator), ctx, opts)
+}
+
+// GetTicketCommentsOBP mocks base method.
+func (m *Client) GetTicketCommentsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketComment, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketCommentsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketComment)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketCommentsOBP indicates an expected call of GetTicketCommentsOBP.
+func (mr *ClientMockRecorder) GetTicketCommentsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCommentsOBP", reflect.TypeOf((*Client)(nil).GetTicketCommentsOBP), ctx, opts)
+}
+
+// GetTicketField mocks base method.
+func (m *Client) GetTicketField(ctx context.Context, ticketID int64) (zendesk.TicketField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketField", ctx, ticketID)
+	ret0, _ := ret[0].(zendesk.TicketField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketField indicates an expected call of GetTicketField.
+func (mr *ClientMockRecorder) GetTicketField(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketField", reflect.TypeOf((*Client)(nil).GetTicketField), ctx, ticketID)
+}
+
+// GetTicketFields mocks base method.
+func (m *Client) GetTicketFields(ctx context.Context) ([]zendesk.TicketField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFields", ctx)
+	ret0, _ := ret[0].([]zendesk.TicketField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFields indicates an expected call of GetTicketFields.
+func (mr *ClientMockRecorder) GetTicketFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFields", reflect.TypeOf((*Client)(nil).GetTicketFields), ctx)
+}
+
+// GetTicketFieldsCBP mocks base method.
+func (m *Client) GetTicketFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketField, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketField)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFieldsCBP indicates an expected call of GetTicketFieldsCBP.
+func (mr *ClientMockRecorder) GetTicketFieldsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsCBP", reflect.TypeOf((*Client)(nil).GetTicketFieldsCBP), ctx, opts)
+}
+
+// GetTicketFieldsIterator mocks base method.
+func (m *Client) GetTicketFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketField] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketField])
+	return ret0
+}
+
+// GetTicketFieldsIterator indicates an expected call of GetTicketFieldsIterator.
+func (mr *ClientMockRecorder) GetTicketFieldsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsIterator", reflect.TypeOf((*Client)(nil).GetTicketFieldsIterator), ctx, opts)
+}
+
+// GetTicketFieldsOBP mocks base method.
+func (m *Client) GetTicketFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFieldsOBP indicates an expected call of GetTicketFieldsOBP.
+func (mr *ClientMockRecorder) GetTicketFieldsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsOBP", reflect.TypeOf((*Client)(nil).GetTicketFieldsOBP), ctx, opts)
+}
+
+// GetTicketForm mocks base method.
+func (m *Client) GetTicketForm(ctx context.Context, id int64) (zendesk.TicketForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketForm", ctx, id)
+	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketForm indicates an expected call of GetTicketForm.
+func (mr *ClientMockRecorder) GetTicketForm(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForm", reflect.TypeOf((*Client)(nil).GetTicketForm), ctx, id)
+}
+
+// GetTicketForms mocks base method.
+func (m *Client) GetTicketForms(ctx context.Context, options *zendesk.TicketFormListOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketForms", ctx, options)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketForms indicates an expected call of GetTicketForms.
+func (mr *ClientMockRecorder) GetTicketForms(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForms", reflect.TypeOf((*Client)(nil).GetTicketForms), ctx, options)
+}
+
+// GetTicketFormsCBP mocks base method.
+func (m *Client) GetTicketFormsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketForm, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFormsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFormsCBP indicates an expected call of GetTicketFormsCBP.
+func (mr *ClientMockRecorder) GetTicketFormsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsCBP", reflect.TypeOf((*Client)(nil).GetTicketFormsCBP), ctx, opts)
+}
+
+// GetTicketFormsIterator mocks base method.
+func (m *Client) GetTicketFormsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketForm] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFormsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketForm])
+	return ret0
+}
+
+// GetTicketFormsIterator indicates an expected call of GetTicketFormsIterator.
+func (mr *ClientMockRecorder) GetTicketFormsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsIterator", reflect.TypeOf((*Client)(nil).GetTicketFormsIterator), ctx, opts)
+}
+
+// GetTicketFormsOBP mocks base method.
+func (m *Client) GetTicketFormsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketFormsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TicketForm)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketFormsOBP indicates an expected call of GetTicketFormsOBP.
+func (mr *ClientMockRecorder) GetTicketFormsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsOBP", reflect.TypeOf((*Client)(nil).GetTicketFormsOBP), ctx, opts)
+}
+
+// GetTicketTags mocks base method.
+func (m *Client) GetTicketTags(ctx context.Context, ticketID int64) ([]zendesk.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketTags", ctx, ticketID)
+	ret0, _ := ret[0].([]zendesk.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketTags indicates an expected call of GetTicketTags.
+func (mr *ClientMockRecorder) GetTicketTags(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketTags", reflect.TypeOf((*Client)(nil).GetTicketTags), ctx, ticketID)
+}
+
+// GetTickets mocks base method.
+func (m *Client) GetTickets(ctx context.Context, opts *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTickets", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTickets indicates an expected call of GetTickets.
+func (mr *ClientMockRecorder) GetTickets(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickets", reflect.TypeOf((*Client)(nil).GetTickets), ctx, opts)
+}
+
+// GetTicketsCBP mocks base method.
+func (m *Client) GetTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketsCBP indicates an expected call of GetTicketsCBP.
+func (mr *ClientMockRecorder) GetTicketsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsCBP", reflect.TypeOf((*Client)(nil).GetTicketsCBP), ctx, opts)
+}
+
+// GetTicketsCount mocks base method.
+func (m *Client) GetTicketsCount(ctx context.Context) (zendesk.Count, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsCount", ctx)
+	ret0, _ := ret[0].(zendesk.Count)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicketsCount indicates an expected call of GetTicketsCount.
+func (mr *ClientMockRecorder) GetTicketsCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsCount", reflect.TypeOf((*Client)(nil).GetTicketsCount), ctx)
+}
+
+// GetTicketsFromView mocks base method.
+func (m *Client) GetTicketsFromView(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsFromView", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketsFromView indicates an expected call of GetTicketsFromView.
+func (mr *ClientMockRecorder) GetTicketsFromView(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromView", reflect.TypeOf((*Client)(nil).GetTicketsFromView), arg0, arg1, arg2)
+}
+
+// GetTicketsFromViewCBP mocks base method.
+func (m *Client) GetTicketsFromViewCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsFromViewCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketsFromViewCBP indicates an expected call of GetTicketsFromViewCBP.
+func (mr *ClientMockRecorder) GetTicketsFromViewCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromViewCBP", reflect.TypeOf((*Client)(nil).GetTicketsFromViewCBP), ctx, opts)
+}
+
+// GetTicketsFromViewIterator mocks base method.
+func (m *Client) GetTicketsFromViewIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsFromViewIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
+	return ret0
+}
+
+// GetTicketsFromViewIterator indicates an expected call of GetTicketsFromViewIterator.
+func (mr *ClientMockRecorder) GetTicketsFromViewIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromViewIterator", reflect.TypeOf((*Client)(nil).GetTicketsFromViewIterator), ctx, opts)
+}
+
+// GetTicketsFromViewOBP mocks base method.
+func (m *Client) GetTicketsFromViewOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsFromViewOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketsFromViewOBP indicates an expected call of GetTicketsFromViewOBP.
+func (mr *ClientMockRecorder) GetTicketsFromViewOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromViewOBP", reflect.TypeOf((*Client)(nil).GetTicketsFromViewOBP), ctx, opts)
+}
+
+// GetTicketsIterator mocks base method.
+func (m *Client) GetTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
+	return ret0
+}
+
+// GetTicketsIterator indicates an expected call of GetTicketsIterator.
+func (mr *ClientMockRecorder) GetTicketsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsIterator", reflect.TypeOf((*Client)(nil).GetTicketsIterator), ctx, opts)
+}
+
+// GetTicketsOBP mocks base method.
+func (m *Client) GetTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicketsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Ticket)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTicketsOBP indicates an expected call of GetTicketsOBP.
+func (mr *ClientMockRecorder) GetTicketsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsOBP", reflect.TypeOf((*Client)(nil).GetTicketsOBP), ctx, opts)
+}
+
+// GetTopic mocks base method.
+func (m *Client) GetTopic(ctx context.Context, topicID int64) (zendesk.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopic", ctx, topicID)
+	ret0, _ := ret[0].(zendesk.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopic indicates an expected call of GetTopic.
+func (mr *ClientMockRecorder) GetTopic(ctx, topicID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopic", reflect.TypeOf((*Client)(nil).GetTopic), ctx, topicID)
+}
+
+// GetTrigger mocks base method.
+func (m *Client) GetTrigger(ctx context.Context, id int64) (zendesk.Trigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrigger", ctx, id)
+	ret0, _ := ret[0].(zendesk.Trigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrigger indicates an expected call of GetTrigger.
+func (mr *ClientMockRecorder) GetTrigger(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrigger", reflect.TypeOf((*Client)(nil).GetTrigger), ctx, id)
+}
+
+// GetTriggerCategories mocks base method.
+func (m *Client) GetTriggerCategories(ctx context.Context, opts *zendesk.TriggerCategoryListOptions) ([]zendesk.TriggerCategory, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerCategories", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TriggerCategory)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggerCategories indicates an expected call of GetTriggerCategories.
+func (mr *ClientMockRecorder) GetTriggerCategories(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerCategories", reflect.TypeOf((*Client)(nil).GetTriggerCategories), ctx, opts)
+}
+
+// GetTriggerCategory mocks base method.
+func (m *Client) GetTriggerCategory(ctx context.Context, id string) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerCategory", ctx, id)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTriggerCategory indicates an expected call of GetTriggerCategory.
+func (mr *ClientMockRecorder) GetTriggerCategory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerCategory", reflect.TypeOf((*Client)(nil).GetTriggerCategory), ctx, id)
+}
+
+// GetTriggerDefinitions mocks base method.
+func (m *Client) GetTriggerDefinitions(ctx context.Context) (zendesk.RuleDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggerDefinitions", ctx)
+	ret0, _ := ret[0].(zendesk.RuleDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTriggerDefinitions indicates an expected call of GetTriggerDefinitions.
+func (mr *ClientMockRecorder) GetTriggerDefinitions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggerDefinitions", reflect.TypeOf((*Client)(nil).GetTriggerDefinitions), ctx)
+}
+
+// GetTriggers mocks base method.
+func (m *Client) GetTriggers(ctx context.Context, opts *zendesk.TriggerListOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggers indicates an expected call of GetTriggers.
+func (mr *ClientMockRecorder) GetTriggers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggers", reflect.TypeOf((*Client)(nil).GetTriggers), ctx, opts)
+}
+
+// GetTriggersCBP mocks base method.
+func (m *Client) GetTriggersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Trigger, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggersCBP indicates an expected call of GetTriggersCBP.
+func (mr *ClientMockRecorder) GetTriggersCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersCBP", reflect.TypeOf((*Client)(nil).GetTriggersCBP), ctx, opts)
+}
+
+// GetTriggersIterator mocks base method.
+func (m *Client) GetTriggersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Trigger] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Trigger])
+	return ret0
+}
+
+// GetTriggersIterator indicates an expected call of GetTriggersIterator.
+func (mr *ClientMockRecorder) GetTriggersIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersIterator", reflect.TypeOf((*Client)(nil).GetTriggersIterator), ctx, opts)
+}
+
+// GetTriggersOBP mocks base method.
+func (m *Client) GetTriggersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTriggersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTriggersOBP indicates an expected call of GetTriggersOBP.
+func (mr *ClientMockRecorder) GetTriggersOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersOBP", reflect.TypeOf((*Client)(nil).GetTriggersOBP), ctx, opts)
+}
+
+// GetTwitterStatuses mocks base method.
+func (m *Client) GetTwitterStatuses(ctx context.Context, ticketID int64, commentIDs []int64) ([]zendesk.TwitterStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwitterStatuses", ctx, ticketID, commentIDs)
+	ret0, _ := ret[0].([]zendesk.TwitterStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwitterStatuses indicates an expected call of GetTwitterStatuses.
+func (mr *ClientMockRecorder) GetTwitterStatuses(ctx, ticketID, commentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwitterStatuses", reflect.TypeOf((*Client)(nil).GetTwitterStatuses), ctx, ticketID, commentIDs)
+}
+
+// GetUser mocks base method.
+func (m *Client) GetUser(ctx context.Context, userID int64) (zendesk.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *ClientMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Client)(nil).GetUser), ctx, userID)
+}
+
+// GetUserBrandAgents mocks base method.
+func (m *Client) GetUserBrandAgents(ctx context.Context, userID int64) ([]zendesk.BrandAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserBrandAgents", ctx, userID)
+	ret0, _ := ret[0].([]zendesk.BrandAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserBrandAgents indicates an expected call of GetUserBrandAgents.
+func (mr *ClientMockRecorder) GetUserBrandAgents(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserBrandAgents", reflect.TypeOf((*Client)(nil).GetUserBrandAgents), ctx, userID)
+}
+
+// GetUserField mocks base method.
+func (m *Client) GetUserField(ctx context.Context, fieldID int64) (zendesk.UserField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserField", ctx, fieldID)
+	ret0, _ := ret[0].(zendesk.UserField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserField indicates an expected call of GetUserField.
+func (mr *ClientMockRecorder) GetUserField(ctx, fieldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserField", reflect.TypeOf((*Client)(nil).GetUserField), ctx, fieldID)
+}
+
+// GetUserFieldOption mocks base method.
+func (m *Client) GetUserFieldOption(ctx context.Context, fieldID, optionID int64) (zendesk.CustomFieldOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldOption", ctx, fieldID, optionID)
+	ret0, _ := ret[0].(zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFieldOption indicates an expected call of GetUserFieldOption.
+func (mr *ClientMockRecorder) GetUserFieldOption(ctx, fieldID, optionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldOption", reflect.TypeOf((*Client)(nil).GetUserFieldOption), ctx, fieldID, optionID)
+}
+
+// GetUserFieldOptions mocks base method.
+func (m *Client) GetUserFieldOptions(ctx context.Context, fieldID int64) ([]zendesk.CustomFieldOption, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldOptions", ctx, fieldID)
+	ret0, _ := ret[0].([]zendesk.CustomFieldOption)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserFieldOptions indicates an expected call of GetUserFieldOptions.
+func (mr *ClientMockRecorder) GetUserFieldOptions(ctx, fieldID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldOptions", reflect.TypeOf((*Client)(nil).GetUserFieldOptions), ctx, fieldID)
+}
+
+// GetUserFields mocks base method.
+func (m *Client) GetUserFields(ctx context.Context, opts *zendesk.UserFieldListOptions) ([]zendesk.UserField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFields", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFields indicates an expected call of GetUserFields.
+func (mr *ClientMockRecorder) GetUserFields(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), ctx, opts)
+}
+
+// GetUserFieldsCBP mocks base method.
+func (m *Client) GetUserFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserField, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldsCBP indicates an expected call of GetUserFieldsCBP.
+func (mr *ClientMockRecorder) GetUserFieldsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsCBP", reflect.TypeOf((*Client)(nil).GetUserFieldsCBP), ctx, opts)
+}
+
+// GetUserFieldsIterator mocks base method.
+func (m *Client) GetUserFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserField] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserField])
+	return ret0
+}
+
+// GetUserFieldsIterator indicates an expected call of GetUserFieldsIterator.
+func (mr *ClientMockRecorder) GetUserFieldsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsIterator", reflect.TypeOf((*Client)(nil).GetUserFieldsIterator), ctx, opts)
+}
+
+// GetUserFieldsOBP mocks base method.
+func (m *Client) GetUserFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserField, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFieldsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.UserField)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFieldsOBP indicates an expected call of GetUserFieldsOBP.
+func (mr *ClientMockRecorder) GetUserFieldsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsOBP", reflect.TypeOf((*Client)(nil).GetUserFieldsOBP), ctx, opts)
+}
+
+// GetUserIdentities mocks base method.
+func (m *Client) GetUserIdentities(ctx context.Context, userID int64) ([]zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentities", ctx, userID)
+	ret0, _ := ret[0].([]zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentities indicates an expected call of GetUserIdentities.
+func (mr *ClientMockRecorder) GetUserIdentities(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentities", reflect.TypeOf((*Client)(nil).GetUserIdentities), ctx, userID)
+}
+
+// GetUserRelated mocks base method.
+func (m *Client) GetUserRelated(ctx context.Context, userID int64) (zendesk.UserRelated, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRelated", ctx, userID)
+	ret0, _ := ret[0].(zendesk.UserRelated)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRelated indicates an expected call of GetUserRelated.
+func (mr *ClientMockRecorder) GetUserRelated(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRelated", reflect.TypeOf((*Client)(nil).GetUserRelated), ctx, userID)
+}
+
+// GetUserSessions mocks base method.
+func (m *Client) GetUserSessions(ctx context.Context, userID int64, opts *zendesk.SessionListOptions) ([]zendesk.Session, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.Session)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions.
+func (mr *ClientMockRecorder) GetUserSessions(ctx, userID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*Client)(nil).GetUserSessions), ctx, userID, opts)
+}
+
+// GetUserTags mocks base method.
+func (m *Client) GetUserTags(ctx context.Context, userID int64) ([]zendesk.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTags", ctx, userID)
+	ret0, _ := ret[0].([]zendesk.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTags indicates an expected call of GetUserTags.
+func (mr *ClientMockRecorder) GetUserTags(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTags", reflect.TypeOf((*Client)(nil).GetUserTags), ctx, userID)
+}
+
+// GetUsers mocks base method.
+func (m *Client) GetUsers(ctx context.Context, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *ClientMockRecorder) GetUsers(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*Client)(nil).GetUsers), ctx, opts)
+}
+
+// GetUsersCBP mocks base method.
+func (m *Client) GetUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUsersCBP indicates an expected call of GetUsersCBP.
+func (mr *ClientMockRecorder) GetUsersCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersCBP", reflect.TypeOf((*Client)(nil).GetUsersCBP), ctx, opts)
+}
+
+// GetUsersCount mocks base method.
+func (m *Client) GetUsersCount(ctx context.Context) (zendesk.Count, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersCount", ctx)
+	ret0, _ := ret[0].(zendesk.Count)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersCount indicates an expected call of GetUsersCount.
+func (mr *ClientMockRecorder) GetUsersCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersCount", reflect.TypeOf((*Client)(nil).GetUsersCount), ctx)
+}
+
+// GetUsersIterator mocks base method.
+func (m *Client) GetUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	return ret0
+}
+
+// GetUsersIterator indicates an expected call of GetUsersIterator.
+func (mr *ClientMockRecorder) GetUsersIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersIterator", reflect.TypeOf((*Client)(nil).GetUsersIterator), ctx, opts)
+}
+
+// GetUsersOBP mocks base method.
+func (m *Client) GetUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUsersOBP indicates an expected call of GetUsersOBP.
+func (mr *ClientMockRecorder) GetUsersOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersOBP", reflect.TypeOf((*Client)(nil).GetUsersOBP), ctx, opts)
+}
+
+// GetView mocks base method.
+func (m *Client) GetView(arg0 context.Context, arg1 int64) (zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetView", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetView indicates an expected call of GetView.
+func (mr *ClientMockRecorder) GetView(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetView", reflect.TypeOf((*Client)(nil).GetView), arg0, arg1)
+}
+
+// GetViewCount mocks base method.
+func (m *Client) GetViewCount(ctx context.Context, viewID int64) (zendesk.ViewCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewCount", ctx, viewID)
+	ret0, _ := ret[0].(zendesk.ViewCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewCount indicates an expected call of GetViewCount.
+func (mr *ClientMockRecorder) GetViewCount(ctx, viewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewCount", reflect.TypeOf((*Client)(nil).GetViewCount), ctx, viewID)
+}
+
+// GetViewDefinitions mocks base method.
+func (m *Client) GetViewDefinitions(ctx context.Context) (zendesk.RuleDefinitions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewDefinitions", ctx)
+	ret0, _ := ret[0].(zendesk.RuleDefinitions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetViewDefinitions indicates an expected call of GetViewDefinitions.
+func (mr *ClientMockRecorder) GetViewDefinitions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewDefinitions", reflect.TypeOf((*Client)(nil).GetViewDefinitions), ctx)
+}
+
+// GetViews mocks base method.
+func (m *Client) GetViews(arg0 context.Context) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViews", arg0)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetViews indicates an expected call of GetViews.
+func (mr *ClientMockRecorder) GetViews(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViews", reflect.TypeOf((*Client)(nil).GetViews), arg0)
+}
+
+// GetViewsCBP mocks base method.
+func (m *Client) GetViewsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.View, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewsCBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetViewsCBP indicates an expected call of GetViewsCBP.
+func (mr *ClientMockRecorder) GetViewsCBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewsCBP", reflect.TypeOf((*Client)(nil).GetViewsCBP), ctx, opts)
+}
+
+// GetViewsIterator mocks base method.
+func (m *Client) GetViewsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.View] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewsIterator", ctx, opts)
+	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.View])
+	return ret0
+}
+
+// GetViewsIterator indicates an expected call of GetViewsIterator.
+func (mr *ClientMockRecorder) GetViewsIterator(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewsIterator", reflect.TypeOf((*Client)(nil).GetViewsIterator), ctx, opts)
+}
+
+// GetViewsOBP mocks base method.
+func (m *Client) GetViewsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.View, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetViewsOBP", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.View)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetViewsOBP indicates an expected call of GetViewsOBP.
+func (mr *ClientMockRecorder) GetViewsOBP(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewsOBP", reflect.TypeOf((*Client)(nil).GetViewsOBP), ctx, opts)
+}
+
+// GetVote mocks base method.
+func (m *Client) GetVote(ctx context.Context, voteID int64) (zendesk.Vote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVote", ctx, voteID)
+	ret0, _ := ret[0].(zendesk.Vote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVote indicates an expected call of GetVote.
+func (mr *ClientMockRecorder) GetVote(ctx, voteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVote", reflect.TypeOf((*Client)(nil).GetVote), ctx, voteID)
+}
+
+// GetWebhook mocks base method.
+func (m *Client) GetWebhook(ctx context.Context, webhookID string) (*zendesk.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, webhookID)
+	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *ClientMockRecorder) GetWebhook(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*Client)(nil).GetWebhook), ctx, webhookID)
+}
+
+// GetWebhookSigningSecret mocks base method.
+func (m *Client) GetWebhookSigningSecret(ctx context.Context, webhookID string) (*zendesk.WebhookSigningSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSigningSecret", ctx, webhookID)
+	ret0, _ := ret[0].(*zendesk.WebhookSigningSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSigningSecret indicates an expected call of GetWebhookSigningSecret.
+func (mr *ClientMockRecorder) GetWebhookSigningSecret(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).GetWebhookSigningSecret), ctx, webhookID)
+}
+
+// ImportArticles mocks base method.
+func (m *Client) ImportArticles(ctx context.Context, items <-chan zendesk.ArticleImportItem, opts *zendesk.ArticleImportOptions) (*zendesk.ArticleImportProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportArticles", ctx, items, opts)
+	ret0, _ := ret[0].(*zendesk.ArticleImportProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportArticles indicates an expected call of ImportArticles.
+func (mr *ClientMockRecorder) ImportArticles(ctx, items, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportArticles", reflect.TypeOf((*Client)(nil).ImportArticles), ctx, items, opts)
+}
+
+// ListArticleAttachments mocks base method.
+func (m *Client) ListArticleAttachments(ctx context.Context, articleID int64) ([]zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleAttachments", ctx, articleID)
+	ret0, _ := ret[0].([]zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArticleAttachments indicates an expected call of ListArticleAttachments.
+func (mr *ClientMockRecorder) ListArticleAttachments(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleAttachments", reflect.TypeOf((*Client)(nil).ListArticleAttachments), ctx, articleID)
+}
+
+// ListArticleCommentVotes mocks base method.
+func (m *Client) ListArticleCommentVotes(ctx context.Context, articleID, commentID int64, opts *zendesk.VoteListOptions) ([]zendesk.Vote, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleCommentVotes", ctx, articleID, commentID, opts)
+	ret0, _ := ret[0].([]zendesk.Vote)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticleCommentVotes indicates an expected call of ListArticleCommentVotes.
+func (mr *ClientMockRecorder) ListArticleCommentVotes(ctx, articleID, commentID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleCommentVotes", reflect.TypeOf((*Client)(nil).ListArticleCommentVotes), ctx, articleID, commentID, opts)
+}
+
+// ListArticleComments mocks base method.
+func (m *Client) ListArticleComments(ctx context.Context, articleID int64, opts *zendesk.ArticleCommentListOptions) ([]zendesk.ArticleComment, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleComments", ctx, articleID, opts)
+	ret0, _ := ret[0].([]zendesk.ArticleComment)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticleComments indicates an expected call of ListArticleComments.
+func (mr *ClientMockRecorder) ListArticleComments(ctx, articleID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleComments", reflect.TypeOf((*Client)(nil).ListArticleComments), ctx, articleID, opts)
+}
+
+// ListArticleSubscriptions mocks base method.
+func (m *Client) ListArticleSubscriptions(ctx context.Context, articleID int64, opts *zendesk.SubscriptionListOptions) ([]zendesk.Subscription, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleSubscriptions", ctx, articleID, opts)
+	ret0, _ := ret[0].([]zendesk.Subscription)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticleSubscriptions indicates an expected call of ListArticleSubscriptions.
+func (mr *ClientMockRecorder) ListArticleSubscriptions(ctx, articleID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleSubscriptions", reflect.TypeOf((*Client)(nil).ListArticleSubscriptions), ctx, articleID, opts)
+}
+
+// ListArticleTranslations mocks base method.
+func (m *Client) ListArticleTranslations(ctx context.Context, articleID int64) ([]zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleTranslations", ctx, articleID)
+	ret0, _ := ret[0].([]zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArticleTranslations indicates an expected call of ListArticleTranslations.
+func (mr *ClientMockRecorder) ListArticleTranslations(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleTranslations", reflect.TypeOf((*Client)(nil).ListArticleTranslations), ctx, articleID)
+}
+
+// ListArticleVotes mocks base method.
+func (m *Client) ListArticleVotes(ctx context.Context, articleID int64, opts *zendesk.VoteListOptions) ([]zendesk.Vote, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleVotes", ctx, articleID, opts)
+	ret0, _ := ret[0].([]zendesk.Vote)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticleVotes indicates an expected call of ListArticleVotes.
+func (mr *ClientMockRecorder) ListArticleVotes(ctx, articleID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleVotes", reflect.TypeOf((*Client)(nil).ListArticleVotes), ctx, articleID, opts)
+}
+
+// ListArticles mocks base method.
+func (m *Client) ListArticles(ctx context.Context, opts *zendesk.ArticleListOptions) ([]zendesk.Article, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticles", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Article)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListArticles indicates an expected call of ListArticles.
+func (mr *ClientMockRecorder) ListArticles(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticles", reflect.TypeOf((*Client)(nil).ListArticles), ctx, opts)
+}
+
+// ListAssignableGroupMemberships mocks base method.
+func (m *Client) ListAssignableGroupMemberships(ctx context.Context, opts *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignableGroupMemberships", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssignableGroupMemberships indicates an expected call of ListAssignableGroupMemberships.
+func (mr *ClientMockRecorder) ListAssignableGroupMemberships(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignableGroupMemberships", reflect.TypeOf((*Client)(nil).ListAssignableGroupMemberships), ctx, opts)
+}
+
+// ListAssignableGroups mocks base method.
+func (m *Client) ListAssignableGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssignableGroups", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Group)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssignableGroups indicates an expected call of ListAssignableGroups.
+func (mr *ClientMockRecorder) ListAssignableGroups(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssignableGroups", reflect.TypeOf((*Client)(nil).ListAssignableGroups), ctx, opts)
+}
+
+// ListAuditLogs mocks base method.
+func (m *Client) ListAuditLogs(ctx context.Context, opts *zendesk.AuditLogListOptions) ([]zendesk.AuditLog, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogs", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.AuditLog)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAuditLogs indicates an expected call of ListAuditLogs.
+func (mr *ClientMockRecorder) ListAuditLogs(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*Client)(nil).ListAuditLogs), ctx, opts)
+}
+
+// ListCategories mocks base method.
+func (m *Client) ListCategories(ctx context.Context, opts *zendesk.CategoryListOptions) ([]zendesk.Category, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategories", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Category)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCategories indicates an expected call of ListCategories.
+func (mr *ClientMockRecorder) ListCategories(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*Client)(nil).ListCategories), ctx, opts)
+}
+
+// ListCategoryTranslations mocks base method.
+func (m *Client) ListCategoryTranslations(ctx context.Context, categoryID int64) ([]zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCategoryTranslations", ctx, categoryID)
+	ret0, _ := ret[0].([]zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCategoryTranslations indicates an expected call of ListCategoryTranslations.
+func (mr *ClientMockRecorder) ListCategoryTranslations(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategoryTranslations", reflect.TypeOf((*Client)(nil).ListCategoryTranslations), ctx, categoryID)
+}
+
+// ListChatAgents mocks base method.
+func (m *Client) ListChatAgents(ctx context.Context, opts *zendesk.ChatAgentListOptions) ([]zendesk.ChatAgent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatAgents", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.ChatAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatAgents indicates an expected call of ListChatAgents.
+func (mr *ClientMockRecorder) ListChatAgents(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatAgents", reflect.TypeOf((*Client)(nil).ListChatAgents), ctx, opts)
+}
+
+// ListChatBans mocks base method.
+func (m *Client) ListChatBans(ctx context.Context, opts *zendesk.ChatBanListOptions) (zendesk.ChatBanList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatBans", ctx, opts)
+	ret0, _ := ret[0].(zendesk.ChatBanList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatBans indicates an expected call of ListChatBans.
+func (mr *ClientMockRecorder) ListChatBans(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatBans", reflect.TypeOf((*Client)(nil).ListChatBans), ctx, opts)
+}
+
+// ListChatDepartments mocks base method.
+func (m *Client) ListChatDepartments(ctx context.Context) ([]zendesk.ChatDepartment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatDepartments", ctx)
+	ret0, _ := ret[0].([]zendesk.ChatDepartment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatDepartments indicates an expected call of ListChatDepartments.
+func (mr *ClientMockRecorder) ListChatDepartments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatDepartments", reflect.TypeOf((*Client)(nil).ListChatDepartments), ctx)
+}
+
+// ListChatGoals mocks base method.
+func (m *Client) ListChatGoals(ctx context.Context) ([]zendesk.ChatGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatGoals", ctx)
+	ret0, _ := ret[0].([]zendesk.ChatGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatGoals indicates an expected call of ListChatGoals.
+func (mr *ClientMockRecorder) ListChatGoals(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatGoals", reflect.TypeOf((*Client)(nil).ListChatGoals), ctx)
+}
+
+// ListChatRoles mocks base method.
+func (m *Client) ListChatRoles(ctx context.Context) ([]zendesk.ChatRole, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatRoles", ctx)
+	ret0, _ := ret[0].([]zendesk.ChatRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatRoles indicates an expected call of ListChatRoles.
+func (mr *ClientMockRecorder) ListChatRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatRoles", reflect.TypeOf((*Client)(nil).ListChatRoles), ctx)
+}
+
+// ListChatShortcuts mocks base method.
+func (m *Client) ListChatShortcuts(ctx context.Context) ([]zendesk.ChatShortcut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatShortcuts", ctx)
+	ret0, _ := ret[0].([]zendesk.ChatShortcut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatShortcuts indicates an expected call of ListChatShortcuts.
+func (mr *ClientMockRecorder) ListChatShortcuts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatShortcuts", reflect.TypeOf((*Client)(nil).ListChatShortcuts), ctx)
+}
+
+// ListChatSkills mocks base method.
+func (m *Client) ListChatSkills(ctx context.Context) ([]zendesk.ChatSkill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatSkills", ctx)
+	ret0, _ := ret[0].([]zendesk.ChatSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatSkills indicates an expected call of ListChatSkills.
+func (mr *ClientMockRecorder) ListChatSkills(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatSkills", reflect.TypeOf((*Client)(nil).ListChatSkills), ctx)
+}
+
+// ListChatTriggers mocks base method.
+func (m *Client) ListChatTriggers(ctx context.Context) ([]zendesk.ChatTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatTriggers", ctx)
+	ret0, _ := ret[0].([]zendesk.ChatTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatTriggers indicates an expected call of ListChatTriggers.
+func (mr *ClientMockRecorder) ListChatTriggers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatTriggers", reflect.TypeOf((*Client)(nil).ListChatTriggers), ctx)
+}
+
+// ListChats mocks base method.
+func (m *Client) ListChats(ctx context.Context, opts *zendesk.ChatListOptions) ([]zendesk.Chat, zendesk.ChatPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChats", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Chat)
+	ret1, _ := ret[1].(zendesk.ChatPage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListChats indicates an expected call of ListChats.
+func (mr *ClientMockRecorder) ListChats(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChats", reflect.TypeOf((*Client)(nil).ListChats), ctx, opts)
+}
+
+// ListCustomObjectFields mocks base method.
+func (m *Client) ListCustomObjectFields(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectFieldListOptions) ([]zendesk.CustomObjectField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomObjectFields", ctx, customObjectKey, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomObjectFields indicates an expected call of ListCustomObjectFields.
+func (mr *ClientMockRecorder) ListCustomObjectFields(ctx, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomObjectFields", reflect.TypeOf((*Client)(nil).ListCustomObjectFields), ctx, customObjectKey, opts)
+}
+
+// ListCustomObjectRecords mocks base method.
+func (m *Client) ListCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectListOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomObjectRecords", ctx, customObjectKey, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCustomObjectRecords indicates an expected call of ListCustomObjectRecords.
+func (mr *ClientMockRecorder) ListCustomObjectRecords(ctx, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomObjectRecords", reflect.TypeOf((*Client)(nil).ListCustomObjectRecords), ctx, customObjectKey, opts)
+}
+
+// ListCustomObjectRecordsCBP mocks base method.
+func (m *Client) ListCustomObjectRecordsCBP(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectRecordCBPOptions) ([]zendesk.CustomObjectRecord, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomObjectRecordsCBP", ctx, customObjectKey, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCustomObjectRecordsCBP indicates an expected call of ListCustomObjectRecordsCBP.
+func (mr *ClientMockRecorder) ListCustomObjectRecordsCBP(ctx, customObjectKey, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomObjectRecordsCBP", reflect.TypeOf((*Client)(nil).ListCustomObjectRecordsCBP), ctx, customObjectKey, opts)
+}
+
+// ListCustomObjects mocks base method.
+func (m *Client) ListCustomObjects(ctx context.Context) ([]zendesk.CustomObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomObjects", ctx)
+	ret0, _ := ret[0].([]zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomObjects indicates an expected call of ListCustomObjects.
+func (mr *ClientMockRecorder) ListCustomObjects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomObjects", reflect.TypeOf((*Client)(nil).ListCustomObjects), ctx)
+}
+
+// ListGroupUsers mocks base method.
+func (m *Client) ListGroupUsers(ctx context.Context, groupID int64, opts *zendesk.ListUsersOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupUsers", ctx, groupID, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGroupUsers indicates an expected call of ListGroupUsers.
+func (mr *ClientMockRecorder) ListGroupUsers(ctx, groupID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupUsers", reflect.TypeOf((*Client)(nil).ListGroupUsers), ctx, groupID, opts)
+}
+
+// ListInstallations mocks base method.
+func (m *Client) ListInstallations(ctx context.Context) ([]zendesk.AppInstallation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstallations", ctx)
+	ret0, _ := ret[0].([]zendesk.AppInstallation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstallations indicates an expected call of ListInstallations.
+func (mr *ClientMockRecorder) ListInstallations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstallations", reflect.TypeOf((*Client)(nil).ListInstallations), ctx)
+}
+
+// ListInvocationAttempts mocks base method.
+func (m *Client) ListInvocationAttempts(ctx context.Context, webhookID, invocationID string) ([]zendesk.WebhookInvocationAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvocationAttempts", ctx, webhookID, invocationID)
+	ret0, _ := ret[0].([]zendesk.WebhookInvocationAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvocationAttempts indicates an expected call of ListInvocationAttempts.
+func (mr *ClientMockRecorder) ListInvocationAttempts(ctx, webhookID, invocationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvocationAttempts", reflect.TypeOf((*Client)(nil).ListInvocationAttempts), ctx, webhookID, invocationID)
+}
+
+// ListMacroActions mocks base method.
+func (m *Client) ListMacroActions(ctx context.Context) ([]zendesk.MacroActionDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMacroActions", ctx)
+	ret0, _ := ret[0].([]zendesk.MacroActionDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMacroActions indicates an expected call of ListMacroActions.
+func (mr *ClientMockRecorder) ListMacroActions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMacroActions", reflect.TypeOf((*Client)(nil).ListMacroActions), ctx)
+}
+
+// ListMacroAttachments mocks base method.
+func (m *Client) ListMacroAttachments(ctx context.Context, macroID int64) ([]zendesk.MacroAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMacroAttachments", ctx, macroID)
+	ret0, _ := ret[0].([]zendesk.MacroAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMacroAttachments indicates an expected call of ListMacroAttachments.
+func (mr *ClientMockRecorder) ListMacroAttachments(ctx, macroID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMacroAttachments", reflect.TypeOf((*Client)(nil).ListMacroAttachments), ctx, macroID)
+}
+
+// ListMacroCategories mocks base method.
+func (m *Client) ListMacroCategories(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMacroCategories", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMacroCategories indicates an expected call of ListMacroCategories.
+func (mr *ClientMockRecorder) ListMacroCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMacroCategories", reflect.TypeOf((*Client)(nil).ListMacroCategories), ctx)
+}
+
+// ListMissingArticleTranslations mocks base method.
+func (m *Client) ListMissingArticleTranslations(ctx context.Context, articleID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMissingArticleTranslations", ctx, articleID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMissingArticleTranslations indicates an expected call of ListMissingArticleTranslations.
+func (mr *ClientMockRecorder) ListMissingArticleTranslations(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMissingArticleTranslations", reflect.TypeOf((*Client)(nil).ListMissingArticleTranslations), ctx, articleID)
+}
+
+// ListMissingCategoryTranslations mocks base method.
+func (m *Client) ListMissingCategoryTranslations(ctx context.Context, categoryID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMissingCategoryTranslations", ctx, categoryID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMissingCategoryTranslations indicates an expected call of ListMissingCategoryTranslations.
+func (mr *ClientMockRecorder) ListMissingCategoryTranslations(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMissingCategoryTranslations", reflect.TypeOf((*Client)(nil).ListMissingCategoryTranslations), ctx, categoryID)
+}
+
+// ListMissingSectionTranslations mocks base method.
+func (m *Client) ListMissingSectionTranslations(ctx context.Context, sectionID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMissingSectionTranslations", ctx, sectionID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMissingSectionTranslations indicates an expected call of ListMissingSectionTranslations.
+func (mr *ClientMockRecorder) ListMissingSectionTranslations(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMissingSectionTranslations", reflect.TypeOf((*Client)(nil).ListMissingSectionTranslations), ctx, sectionID)
+}
+
+// ListOrganizationUsers mocks base method.
+func (m *Client) ListOrganizationUsers(ctx context.Context, orgID int64, opts *zendesk.ListUsersOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationUsers", ctx, orgID, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListOrganizationUsers indicates an expected call of ListOrganizationUsers.
+func (mr *ClientMockRecorder) ListOrganizationUsers(ctx, orgID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationUsers", reflect.TypeOf((*Client)(nil).ListOrganizationUsers), ctx, orgID, opts)
+}
+
+// ListOutdatedArticleTranslations mocks base method.
+func (m *Client) ListOutdatedArticleTranslations(ctx context.Context, articleID int64) ([]zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutdatedArticleTranslations", ctx, articleID)
+	ret0, _ := ret[0].([]zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutdatedArticleTranslations indicates an expected call of ListOutdatedArticleTranslations.
+func (mr *ClientMockRecorder) ListOutdatedArticleTranslations(ctx, articleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdatedArticleTranslations", reflect.TypeOf((*Client)(nil).ListOutdatedArticleTranslations), ctx, articleID)
+}
+
+// ListOutdatedCategoryTranslations mocks base method.
+func (m *Client) ListOutdatedCategoryTranslations(ctx context.Context, categoryID int64) ([]zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutdatedCategoryTranslations", ctx, categoryID)
+	ret0, _ := ret[0].([]zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutdatedCategoryTranslations indicates an expected call of ListOutdatedCategoryTranslations.
+func (mr *ClientMockRecorder) ListOutdatedCategoryTranslations(ctx, categoryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdatedCategoryTranslations", reflect.TypeOf((*Client)(nil).ListOutdatedCategoryTranslations), ctx, categoryID)
+}
+
+// ListOutdatedSectionTranslations mocks base method.
+func (m *Client) ListOutdatedSectionTranslations(ctx context.Context, sectionID int64) ([]zendesk.Translation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutdatedSectionTranslations", ctx, sectionID)
+	ret0, _ := ret[0].([]zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutdatedSectionTranslations indicates an expected call of ListOutdatedSectionTranslations.
+func (mr *ClientMockRecorder) ListOutdatedSectionTranslations(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutdatedSectionTranslations", reflect.TypeOf((*Client)(nil).ListOutdatedSectionTranslations), ctx, sectionID)
+}
+
+// ListPermissionGroups mocks base method.
+func (m *Client) ListPermissionGroups(ctx context.Context, opts *zendesk.PermissionGroupListOptions) ([]zendesk.PermissionGroup, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPermissionGroups", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.PermissionGroup)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItemsCBP indicates an expected call of GetDynamicContentItemsCBP.
-func (mr *ClientMockRecorder) GetDynamicContentItemsCBP(ctx, opts any) *gomock.Call {
+// ListPermissionGroups indicates an expected call of ListPermissionGroups.
+func (mr *ClientMockRecorder) ListPermissionGroups(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsCBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPermissionGroups", reflect.TypeOf((*Client)(nil).ListPermissionGroups), ctx, opts)
 }
 
-// GetDynamicContentItemsIterator mocks base method.
-func (m *Client) GetDynamicContentItemsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.DynamicContentItem] {
+// ListPostCommentVotes mocks base method.
+func (m *Client) ListPostCommentVotes(ctx context.Context, postID, commentID int64, opts *zendesk.VoteListOptions) ([]zendesk.Vote, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.DynamicContentItem])
-	return ret0
+	ret := m.ctrl.Call(m, "ListPostCommentVotes", ctx, postID, commentID, opts)
+	ret0, _ := ret[0].([]zendesk.Vote)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItemsIterator indicates an expected call of GetDynamicContentItemsIterator.
-func (mr *ClientMockRecorder) GetDynamicContentItemsIterator(ctx, opts any) *gomock.Call {
+// ListPostCommentVotes indicates an expected call of ListPostCommentVotes.
+func (mr *ClientMockRecorder) ListPostCommentVotes(ctx, postID, commentID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsIterator", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPostCommentVotes", reflect.TypeOf((*Client)(nil).ListPostCommentVotes), ctx, postID, commentID, opts)
 }
 
-// GetDynamicContentItemsOBP mocks base method.
-func (m *Client) GetDynamicContentItemsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.DynamicContentItem, zendesk.Page, error) {
+// ListPostComments mocks base method.
+func (m *Client) ListPostComments(ctx context.Context, postID int64, opts *zendesk.PostCommentListOptions) ([]zendesk.PostComment, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDynamicContentItemsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.DynamicContentItem)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListPostComments", ctx, postID, opts)
+	ret0, _ := ret[0].([]zendesk.PostComment)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetDynamicContentItemsOBP indicates an expected call of GetDynamicContentItemsOBP.
-func (mr *ClientMockRecorder) GetDynamicContentItemsOBP(ctx, opts any) *gomock.Call {
+// ListPostComments indicates an expected call of ListPostComments.
+func (mr *ClientMockRecorder) ListPostComments(ctx, postID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicContentItemsOBP", reflect.TypeOf((*Client)(nil).GetDynamicContentItemsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPostComments", reflect.TypeOf((*Client)(nil).ListPostComments), ctx, postID, opts)
 }
 
-// GetGroup mocks base method.
-func (m *Client) GetGroup(ctx context.Context, groupID int64) (zendesk.Group, error) {
+// ListPostSubscriptions mocks base method.
+func (m *Client) ListPostSubscriptions(ctx context.Context, postID int64, opts *zendesk.SubscriptionListOptions) ([]zendesk.Subscription, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
-	ret0, _ := ret[0].(zendesk.Group)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListPostSubscriptions", ctx, postID, opts)
+	ret0, _ := ret[0].([]zendesk.Subscription)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetGroup indicates an expected call of GetGroup.
-func (mr *ClientMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
+// ListPostSubscriptions indicates an expected call of ListPostSubscriptions.
+func (mr *ClientMockRecorder) ListPostSubscriptions(ctx, postID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*Client)(nil).GetGroup), ctx, groupID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPostSubscriptions", reflect.TypeOf((*Client)(nil).ListPostSubscriptions), ctx, postID, opts)
 }
 
-// GetGroupMemberships mocks base method.
-func (m *Client) GetGroupMemberships(arg0 context.Context, arg1 *zendesk.GroupMembershipListOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+// ListPostVotes mocks base method.
+func (m *Client) ListPostVotes(ctx context.Context, postID int64, opts *zendesk.VoteListOptions) ([]zendesk.Vote, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListPostVotes", ctx, postID, opts)
+	ret0, _ := ret[0].([]zendesk.Vote)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMemberships indicates an expected call of GetGroupMemberships.
-func (mr *ClientMockRecorder) GetGroupMemberships(arg0, arg1 any) *gomock.Call {
+// ListPostVotes indicates an expected call of ListPostVotes.
+func (mr *ClientMockRecorder) ListPostVotes(ctx, postID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMemberships", reflect.TypeOf((*Client)(nil).GetGroupMemberships), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPostVotes", reflect.TypeOf((*Client)(nil).ListPostVotes), ctx, postID, opts)
 }
 
-// GetGroupMembershipsCBP mocks base method.
-func (m *Client) GetGroupMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.GroupMembership, zendesk.CursorPaginationMeta, error) {
+// ListPosts mocks base method.
+func (m *Client) ListPosts(ctx context.Context, opts *zendesk.PostListOptions) ([]zendesk.Post, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
+	ret := m.ctrl.Call(m, "ListPosts", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Post)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMembershipsCBP indicates an expected call of GetGroupMembershipsCBP.
-func (mr *ClientMockRecorder) GetGroupMembershipsCBP(ctx, opts any) *gomock.Call {
+// ListPosts indicates an expected call of ListPosts.
+func (mr *ClientMockRecorder) ListPosts(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsCBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPosts", reflect.TypeOf((*Client)(nil).ListPosts), ctx, opts)
 }
 
-// GetGroupMembershipsIterator mocks base method.
-func (m *Client) GetGroupMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.GroupMembership] {
+// ListSectionSubscriptions mocks base method.
+func (m *Client) ListSectionSubscriptions(ctx context.Context, sectionID int64, opts *zendesk.SubscriptionListOptions) ([]zendesk.Subscription, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.GroupMembership])
-	return ret0
+	ret := m.ctrl.Call(m, "ListSectionSubscriptions", ctx, sectionID, opts)
+	ret0, _ := ret[0].([]zendesk.Subscription)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetGroupMembershipsIterator indicates an expected call of GetGroupMembershipsIterator.
-func (mr *ClientMockRecorder) GetGroupMembershipsIterator(ctx, opts any) *gomock.Call {
+// ListSectionSubscriptions indicates an expected call of ListSectionSubscriptions.
+func (mr *ClientMockRecorder) ListSectionSubscriptions(ctx, sectionID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsIterator", reflect.TypeOf((*Client)(nil).GetGroupMembershipsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSectionSubscriptions", reflect.TypeOf((*Client)(nil).ListSectionSubscriptions), ctx, sectionID, opts)
 }
 
-// GetGroupMembershipsOBP mocks base method.
-func (m *Client) GetGroupMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.GroupMembership, zendesk.Page, error) {
+// ListSectionTranslations mocks base method.
+func (m *Client) ListSectionTranslations(ctx context.Context, sectionID int64) ([]zendesk.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupMembershipsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.GroupMembership)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListSectionTranslations", ctx, sectionID)
+	ret0, _ := ret[0].([]zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSectionTranslations indicates an expected call of ListSectionTranslations.
+func (mr *ClientMockRecorder) ListSectionTranslations(ctx, sectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSectionTranslations", reflect.TypeOf((*Client)(nil).ListSectionTranslations), ctx, sectionID)
+}
+
+// ListSections mocks base method.
+func (m *Client) ListSections(ctx context.Context, opts *zendesk.SectionListOptions) ([]zendesk.Section, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSections", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Section)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupMembershipsOBP indicates an expected call of GetGroupMembershipsOBP.
-func (mr *ClientMockRecorder) GetGroupMembershipsOBP(ctx, opts any) *gomock.Call {
+// ListSections indicates an expected call of ListSections.
+func (mr *ClientMockRecorder) ListSections(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembershipsOBP", reflect.TypeOf((*Client)(nil).GetGroupMembershipsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSections", reflect.TypeOf((*Client)(nil).ListSections), ctx, opts)
 }
 
-// GetGroups mocks base method.
-func (m *Client) GetGroups(ctx context.Context, opts *zendesk.GroupListOptions) ([]zendesk.Group, zendesk.Page, error) {
+// ListTags mocks base method.
+func (m *Client) ListTags(ctx context.Context, opts *zendesk.TagListOptions) ([]zendesk.TagCount, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroups", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListTags", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TagCount)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroups indicates an expected call of GetGroups.
-func (mr *ClientMockRecorder) GetGroups(ctx, opts any) *gomock.Call {
+// ListTags indicates an expected call of ListTags.
+func (mr *ClientMockRecorder) ListTags(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*Client)(nil).GetGroups), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*Client)(nil).ListTags), ctx, opts)
 }
 
-// GetGroupsCBP mocks base method.
-func (m *Client) GetGroupsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Group, zendesk.CursorPaginationMeta, error) {
+// ListTalkAgentsActivity mocks base method.
+func (m *Client) ListTalkAgentsActivity(ctx context.Context, opts *zendesk.TalkAgentActivityListOptions) ([]zendesk.TalkAgentActivity, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
+	ret := m.ctrl.Call(m, "ListTalkAgentsActivity", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TalkAgentActivity)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupsCBP indicates an expected call of GetGroupsCBP.
-func (mr *ClientMockRecorder) GetGroupsCBP(ctx, opts any) *gomock.Call {
+// ListTalkAgentsActivity indicates an expected call of ListTalkAgentsActivity.
+func (mr *ClientMockRecorder) ListTalkAgentsActivity(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsCBP", reflect.TypeOf((*Client)(nil).GetGroupsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkAgentsActivity", reflect.TypeOf((*Client)(nil).ListTalkAgentsActivity), ctx, opts)
 }
 
-// GetGroupsIterator mocks base method.
-func (m *Client) GetGroupsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Group] {
+// ListTalkDigitalLines mocks base method.
+func (m *Client) ListTalkDigitalLines(ctx context.Context, opts *zendesk.TalkLineListOptions) ([]zendesk.TalkLine, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Group])
-	return ret0
+	ret := m.ctrl.Call(m, "ListTalkDigitalLines", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TalkLine)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetGroupsIterator indicates an expected call of GetGroupsIterator.
-func (mr *ClientMockRecorder) GetGroupsIterator(ctx, opts any) *gomock.Call {
+// ListTalkDigitalLines indicates an expected call of ListTalkDigitalLines.
+func (mr *ClientMockRecorder) ListTalkDigitalLines(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsIterator", reflect.TypeOf((*Client)(nil).GetGroupsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkDigitalLines", reflect.TypeOf((*Client)(nil).ListTalkDigitalLines), ctx, opts)
 }
 
-// GetGroupsOBP mocks base method.
-func (m *Client) GetGroupsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Group, zendesk.Page, error) {
+// ListTalkGreetingCategories mocks base method.
+func (m *Client) ListTalkGreetingCategories(ctx context.Context) ([]zendesk.TalkGreetingCategory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGroupsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Group)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListTalkGreetingCategories", ctx)
+	ret0, _ := ret[0].([]zendesk.TalkGreetingCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTalkGreetingCategories indicates an expected call of ListTalkGreetingCategories.
+func (mr *ClientMockRecorder) ListTalkGreetingCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkGreetingCategories", reflect.TypeOf((*Client)(nil).ListTalkGreetingCategories), ctx)
+}
+
+// ListTalkGreetings mocks base method.
+func (m *Client) ListTalkGreetings(ctx context.Context, opts *zendesk.TalkGreetingListOptions) ([]zendesk.TalkGreeting, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTalkGreetings", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TalkGreeting)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetGroupsOBP indicates an expected call of GetGroupsOBP.
-func (mr *ClientMockRecorder) GetGroupsOBP(ctx, opts any) *gomock.Call {
+// ListTalkGreetings indicates an expected call of ListTalkGreetings.
+func (mr *ClientMockRecorder) ListTalkGreetings(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsOBP", reflect.TypeOf((*Client)(nil).GetGroupsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkGreetings", reflect.TypeOf((*Client)(nil).ListTalkGreetings), ctx, opts)
 }
 
-// GetLocales mocks base method.
-func (m *Client) GetLocales(ctx context.Context) ([]zendesk.Locale, error) {
+// ListTalkIVRMenus mocks base method.
+func (m *Client) ListTalkIVRMenus(ctx context.Context, ivrID int64) ([]zendesk.TalkIVRMenu, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLocales", ctx)
-	ret0, _ := ret[0].([]zendesk.Locale)
+	ret := m.ctrl.Call(m, "ListTalkIVRMenus", ctx, ivrID)
+	ret0, _ := ret[0].([]zendesk.TalkIVRMenu)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLocales indicates an expected call of GetLocales.
-func (mr *ClientMockRecorder) GetLocales(ctx any) *gomock.Call {
+// ListTalkIVRMenus indicates an expected call of ListTalkIVRMenus.
+func (mr *ClientMockRecorder) ListTalkIVRMenus(ctx, ivrID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocales", reflect.TypeOf((*Client)(nil).GetLocales), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkIVRMenus", reflect.TypeOf((*Client)(nil).ListTalkIVRMenus), ctx, ivrID)
 }
 
-// GetMacro mocks base method.
-func (m *Client) GetMacro(ctx context.Context, macroID int64) (zendesk.Macro, error) {
+// ListTalkIVRRoutes mocks base method.
+func (m *Client) ListTalkIVRRoutes(ctx context.Context, ivrID, menuID int64) ([]zendesk.TalkIVRRoute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacro", ctx, macroID)
-	ret0, _ := ret[0].(zendesk.Macro)
+	ret := m.ctrl.Call(m, "ListTalkIVRRoutes", ctx, ivrID, menuID)
+	ret0, _ := ret[0].([]zendesk.TalkIVRRoute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMacro indicates an expected call of GetMacro.
-func (mr *ClientMockRecorder) GetMacro(ctx, macroID any) *gomock.Call {
+// ListTalkIVRRoutes indicates an expected call of ListTalkIVRRoutes.
+func (mr *ClientMockRecorder) ListTalkIVRRoutes(ctx, ivrID, menuID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacro", reflect.TypeOf((*Client)(nil).GetMacro), ctx, macroID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkIVRRoutes", reflect.TypeOf((*Client)(nil).ListTalkIVRRoutes), ctx, ivrID, menuID)
 }
 
-// GetMacros mocks base method.
-func (m *Client) GetMacros(ctx context.Context, opts *zendesk.MacroListOptions) ([]zendesk.Macro, zendesk.Page, error) {
+// ListTalkIVRs mocks base method.
+func (m *Client) ListTalkIVRs(ctx context.Context, opts *zendesk.TalkIVRListOptions) ([]zendesk.TalkIVR, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacros", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListTalkIVRs", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TalkIVR)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacros indicates an expected call of GetMacros.
-func (mr *ClientMockRecorder) GetMacros(ctx, opts any) *gomock.Call {
+// ListTalkIVRs indicates an expected call of ListTalkIVRs.
+func (mr *ClientMockRecorder) ListTalkIVRs(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacros", reflect.TypeOf((*Client)(nil).GetMacros), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkIVRs", reflect.TypeOf((*Client)(nil).ListTalkIVRs), ctx, opts)
 }
 
-// GetMacrosCBP mocks base method.
-func (m *Client) GetMacrosCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Macro, zendesk.CursorPaginationMeta, error) {
+// ListTalkLines mocks base method.
+func (m *Client) ListTalkLines(ctx context.Context, opts *zendesk.TalkLineListOptions) ([]zendesk.TalkLine, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
+	ret := m.ctrl.Call(m, "ListTalkLines", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.TalkLine)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacrosCBP indicates an expected call of GetMacrosCBP.
-func (mr *ClientMockRecorder) GetMacrosCBP(ctx, opts any) *gomock.Call {
+// ListTalkLines indicates an expected call of ListTalkLines.
+func (mr *ClientMockRecorder) ListTalkLines(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosCBP", reflect.TypeOf((*Client)(nil).GetMacrosCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTalkLines", reflect.TypeOf((*Client)(nil).ListTalkLines), ctx, opts)
 }
 
-// GetMacrosIterator mocks base method.
-func (m *Client) GetMacrosIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Macro] {
+// ListThemes mocks base method.
+func (m *Client) ListThemes(ctx context.Context, opts *zendesk.ThemeListOptions) ([]zendesk.Theme, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Macro])
-	return ret0
+	ret := m.ctrl.Call(m, "ListThemes", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Theme)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetMacrosIterator indicates an expected call of GetMacrosIterator.
-func (mr *ClientMockRecorder) GetMacrosIterator(ctx, opts any) *gomock.Call {
+// ListThemes indicates an expected call of ListThemes.
+func (mr *ClientMockRecorder) ListThemes(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosIterator", reflect.TypeOf((*Client)(nil).GetMacrosIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListThemes", reflect.TypeOf((*Client)(nil).ListThemes), ctx, opts)
 }
 
-// GetMacrosOBP mocks base method.
-func (m *Client) GetMacrosOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Macro, zendesk.Page, error) {
+// ListTicketComments mocks base method.
+func (m *Client) ListTicketComments(ctx context.Context, ticketID int64, opts *zendesk.ListTicketCommentsOptions) (*zendesk.ListTicketCommentsResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMacrosOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Macro)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListTicketComments", ctx, ticketID, opts)
+	ret0, _ := ret[0].(*zendesk.ListTicketCommentsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketComments indicates an expected call of ListTicketComments.
+func (mr *ClientMockRecorder) ListTicketComments(ctx, ticketID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketComments", reflect.TypeOf((*Client)(nil).ListTicketComments), ctx, ticketID, opts)
+}
+
+// ListTicketEmailNotifications mocks base method.
+func (m *Client) ListTicketEmailNotifications(ctx context.Context, ticketID int64) ([]zendesk.EmailNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTicketEmailNotifications", ctx, ticketID)
+	ret0, _ := ret[0].([]zendesk.EmailNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTicketEmailNotifications indicates an expected call of ListTicketEmailNotifications.
+func (mr *ClientMockRecorder) ListTicketEmailNotifications(ctx, ticketID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketEmailNotifications", reflect.TypeOf((*Client)(nil).ListTicketEmailNotifications), ctx, ticketID)
+}
+
+// ListTopicSubscriptions mocks base method.
+func (m *Client) ListTopicSubscriptions(ctx context.Context, topicID int64, opts *zendesk.SubscriptionListOptions) ([]zendesk.Subscription, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopicSubscriptions", ctx, topicID, opts)
+	ret0, _ := ret[0].([]zendesk.Subscription)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetMacrosOBP indicates an expected call of GetMacrosOBP.
-func (mr *ClientMockRecorder) GetMacrosOBP(ctx, opts any) *gomock.Call {
+// ListTopicSubscriptions indicates an expected call of ListTopicSubscriptions.
+func (mr *ClientMockRecorder) ListTopicSubscriptions(ctx, topicID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacrosOBP", reflect.TypeOf((*Client)(nil).GetMacrosOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopicSubscriptions", reflect.TypeOf((*Client)(nil).ListTopicSubscriptions), ctx, topicID, opts)
 }
 
-// GetManyUsers mocks base method.
-func (m *Client) GetManyUsers(ctx context.Context, opts *zendesk.GetManyUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+// ListTopics mocks base method.
+func (m *Client) ListTopics(ctx context.Context, opts *zendesk.TopicListOptions) ([]zendesk.Topic, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetManyUsers", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListTopics", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Topic)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetManyUsers indicates an expected call of GetManyUsers.
-func (mr *ClientMockRecorder) GetManyUsers(ctx, opts any) *gomock.Call {
+// ListTopics indicates an expected call of ListTopics.
+func (mr *ClientMockRecorder) ListTopics(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManyUsers", reflect.TypeOf((*Client)(nil).GetManyUsers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopics", reflect.TypeOf((*Client)(nil).ListTopics), ctx, opts)
 }
 
-// GetMultipleTickets mocks base method.
-func (m *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]zendesk.Ticket, error) {
+// ListUserArticleComments mocks base method.
+func (m *Client) ListUserArticleComments(ctx context.Context, userID int64, opts *zendesk.ArticleCommentListOptions) ([]zendesk.ArticleComment, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMultipleTickets", ctx, ticketIDs)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListUserArticleComments", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.ArticleComment)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetMultipleTickets indicates an expected call of GetMultipleTickets.
-func (mr *ClientMockRecorder) GetMultipleTickets(ctx, ticketIDs any) *gomock.Call {
+// ListUserArticleComments indicates an expected call of ListUserArticleComments.
+func (mr *ClientMockRecorder) ListUserArticleComments(ctx, userID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMultipleTickets", reflect.TypeOf((*Client)(nil).GetMultipleTickets), ctx, ticketIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserArticleComments", reflect.TypeOf((*Client)(nil).ListUserArticleComments), ctx, userID, opts)
 }
 
-// GetOrganization mocks base method.
-func (m *Client) GetOrganization(ctx context.Context, orgID int64) (zendesk.Organization, error) {
+// ListUserPostComments mocks base method.
+func (m *Client) ListUserPostComments(ctx context.Context, userID int64, opts *zendesk.PostCommentListOptions) ([]zendesk.PostComment, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganization", ctx, orgID)
-	ret0, _ := ret[0].(zendesk.Organization)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ListUserPostComments", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.PostComment)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUserPostComments indicates an expected call of ListUserPostComments.
+func (mr *ClientMockRecorder) ListUserPostComments(ctx, userID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserPostComments", reflect.TypeOf((*Client)(nil).ListUserPostComments), ctx, userID, opts)
+}
+
+// ListUserSubscriptions mocks base method.
+func (m *Client) ListUserSubscriptions(ctx context.Context, userID int64, opts *zendesk.SubscriptionListOptions) ([]zendesk.Subscription, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserSubscriptions", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.Subscription)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUserSubscriptions indicates an expected call of ListUserSubscriptions.
+func (mr *ClientMockRecorder) ListUserSubscriptions(ctx, userID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserSubscriptions", reflect.TypeOf((*Client)(nil).ListUserSubscriptions), ctx, userID, opts)
+}
+
+// ListUserVotes mocks base method.
+func (m *Client) ListUserVotes(ctx context.Context, userID int64, opts *zendesk.VoteListOptions) ([]zendesk.Vote, zendesk.CursorPaginationMeta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserVotes", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.Vote)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetOrganization indicates an expected call of GetOrganization.
-func (mr *ClientMockRecorder) GetOrganization(ctx, orgID any) *gomock.Call {
+// ListUserVotes indicates an expected call of ListUserVotes.
+func (mr *ClientMockRecorder) ListUserVotes(ctx, userID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*Client)(nil).GetOrganization), ctx, orgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserVotes", reflect.TypeOf((*Client)(nil).ListUserVotes), ctx, userID, opts)
 }
 
-// GetOrganizationByExternalID mocks base method.
-func (m *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]zendesk.Organization, zendesk.Page, error) {
+// ListUsers mocks base method.
+func (m *Client) ListUsers(ctx context.Context, opts *zendesk.ListUsersOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationByExternalID", ctx, externalID)
-	ret0, _ := ret[0].([]zendesk.Organization)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationByExternalID indicates an expected call of GetOrganizationByExternalID.
-func (mr *ClientMockRecorder) GetOrganizationByExternalID(ctx, externalID any) *gomock.Call {
+// ListUsers indicates an expected call of ListUsers.
+func (mr *ClientMockRecorder) ListUsers(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationByExternalID", reflect.TypeOf((*Client)(nil).GetOrganizationByExternalID), ctx, externalID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*Client)(nil).ListUsers), ctx, opts)
 }
 
-// GetOrganizationFields mocks base method.
-func (m *Client) GetOrganizationFields(ctx context.Context) ([]zendesk.OrganizationField, zendesk.Page, error) {
+// ListWebhookInvocations mocks base method.
+func (m *Client) ListWebhookInvocations(ctx context.Context, webhookID string, opts *zendesk.WebhookInvocationListOptions) ([]zendesk.WebhookInvocation, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFields", ctx)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
-	ret1, _ := ret[1].(zendesk.Page)
+	ret := m.ctrl.Call(m, "ListWebhookInvocations", ctx, webhookID, opts)
+	ret0, _ := ret[0].([]zendesk.WebhookInvocation)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationFields indicates an expected call of GetOrganizationFields.
-func (mr *ClientMockRecorder) GetOrganizationFields(ctx any) *gomock.Call {
+// ListWebhookInvocations indicates an expected call of ListWebhookInvocations.
+func (mr *ClientMockRecorder) ListWebhookInvocations(ctx, webhookID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFields", reflect.TypeOf((*Client)(nil).GetOrganizationFields), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookInvocations", reflect.TypeOf((*Client)(nil).ListWebhookInvocations), ctx, webhookID, opts)
 }
 
-// GetOrganizationFieldsCBP mocks base method.
-func (m *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationField, zendesk.CursorPaginationMeta, error) {
+// ListWebhooks mocks base method.
+func (m *Client) ListWebhooks(ctx context.Context, opts *zendesk.WebhookListOptions) ([]zendesk.Webhook, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Webhook)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetOrganizationFieldsCBP indicates an expected call of GetOrganizationFieldsCBP.
-func (mr *ClientMockRecorder) GetOrganizationFieldsCBP(ctx, opts any) *gomock.Call {
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *ClientMockRecorder) ListWebhooks(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*Client)(nil).ListWebhooks), ctx, opts)
 }
 
-// GetOrganizationFieldsIterator mocks base method.
-func (m *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationField] {
+// LogoutCurrentSession mocks base method.
+func (m *Client) LogoutCurrentSession(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationField])
+	ret := m.ctrl.Call(m, "LogoutCurrentSession", ctx)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetOrganizationFieldsIterator indicates an expected call of GetOrganizationFieldsIterator.
-func (mr *ClientMockRecorder) GetOrganizationFieldsIterator(ctx, opts any) *gomock.Call {
+// LogoutCurrentSession indicates an expected call of LogoutCurrentSession.
+func (mr *ClientMockRecorder) LogoutCurrentSession(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutCurrentSession", reflect.TypeOf((*Client)(nil).LogoutCurrentSession), ctx)
 }
 
-// GetOrganizationFieldsOBP mocks base method.
-func (m *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationField, zendesk.Page, error) {
+// MakeCommentPrivate mocks base method.
+func (m *Client) MakeCommentPrivate(ctx context.Context, ticketID, ticketCommentID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationField)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "MakeCommentPrivate", ctx, ticketID, ticketCommentID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrganizationFieldsOBP indicates an expected call of GetOrganizationFieldsOBP.
-func (mr *ClientMockRecorder) GetOrganizationFieldsOBP(ctx, opts any) *gomock.Call {
+// MakeCommentPrivate indicates an expected call of MakeCommentPrivate.
+func (mr *ClientMockRecorder) MakeCommentPrivate(ctx, ticketID, ticketCommentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationFieldsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationFieldsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeCommentPrivate", reflect.TypeOf((*Client)(nil).MakeCommentPrivate), ctx, ticketID, ticketCommentID)
 }
 
-// GetOrganizationMemberships mocks base method.
-func (m *Client) GetOrganizationMemberships(arg0 context.Context, arg1 *zendesk.OrganizationMembershipListOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+// MarkManyTicketsAsSpam mocks base method.
+func (m *Client) MarkManyTicketsAsSpam(ctx context.Context, ticketIDs []int64) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMemberships", arg0, arg1)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "MarkManyTicketsAsSpam", ctx, ticketIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationMemberships indicates an expected call of GetOrganizationMemberships.
-func (mr *ClientMockRecorder) GetOrganizationMemberships(arg0, arg1 any) *gomock.Call {
+// MarkManyTicketsAsSpam indicates an expected call of MarkManyTicketsAsSpam.
+func (mr *ClientMockRecorder) MarkManyTicketsAsSpam(ctx, ticketIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMemberships", reflect.TypeOf((*Client)(nil).GetOrganizationMemberships), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkManyTicketsAsSpam", reflect.TypeOf((*Client)(nil).MarkManyTicketsAsSpam), ctx, ticketIDs)
 }
 
-// GetOrganizationMembershipsCBP mocks base method.
-func (m *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.OrganizationMembership, zendesk.CursorPaginationMeta, error) {
+// MarkTicketAsSpam mocks base method.
+func (m *Client) MarkTicketAsSpam(ctx context.Context, ticketID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "MarkTicketAsSpam", ctx, ticketID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrganizationMembershipsCBP indicates an expected call of GetOrganizationMembershipsCBP.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsCBP(ctx, opts any) *gomock.Call {
+// MarkTicketAsSpam indicates an expected call of MarkTicketAsSpam.
+func (mr *ClientMockRecorder) MarkTicketAsSpam(ctx, ticketID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTicketAsSpam", reflect.TypeOf((*Client)(nil).MarkTicketAsSpam), ctx, ticketID)
 }
 
-// GetOrganizationMembershipsIterator mocks base method.
-func (m *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.OrganizationMembership] {
+// MergeOrganizations mocks base method.
+func (m *Client) MergeOrganizations(ctx context.Context, orgID, intoOrgID int64) (zendesk.OrganizationMerge, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.OrganizationMembership])
-	return ret0
+	ret := m.ctrl.Call(m, "MergeOrganizations", ctx, orgID, intoOrgID)
+	ret0, _ := ret[0].(zendesk.OrganizationMerge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationMembershipsIterator indicates an expected call of GetOrganizationMembershipsIterator.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsIterator(ctx, opts any) *gomock.Call {
+// MergeOrganizations indicates an expected call of MergeOrganizations.
+func (mr *ClientMockRecorder) MergeOrganizations(ctx, orgID, intoOrgID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeOrganizations", reflect.TypeOf((*Client)(nil).MergeOrganizations), ctx, orgID, intoOrgID)
 }
 
-// GetOrganizationMembershipsOBP mocks base method.
-func (m *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.OrganizationMembership, zendesk.Page, error) {
+// MergeUsers mocks base method.
+func (m *Client) MergeUsers(ctx context.Context, userID, intoUserID int64) (zendesk.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationMembershipsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.OrganizationMembership)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "MergeUsers", ctx, userID, intoUserID)
+	ret0, _ := ret[0].(zendesk.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationMembershipsOBP indicates an expected call of GetOrganizationMembershipsOBP.
-func (mr *ClientMockRecorder) GetOrganizationMembershipsOBP(ctx, opts any) *gomock.Call {
+// MergeUsers indicates an expected call of MergeUsers.
+func (mr *ClientMockRecorder) MergeUsers(ctx, userID, intoUserID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembershipsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationMembershipsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUsers", reflect.TypeOf((*Client)(nil).MergeUsers), ctx, userID, intoUserID)
 }
 
-// GetOrganizationTags mocks base method.
-func (m *Client) GetOrganizationTags(ctx context.Context, organizationID int64) ([]zendesk.Tag, error) {
+// MovePost mocks base method.
+func (m *Client) MovePost(ctx context.Context, postID, topicID int64) (zendesk.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTags", ctx, organizationID)
-	ret0, _ := ret[0].([]zendesk.Tag)
+	ret := m.ctrl.Call(m, "MovePost", ctx, postID, topicID)
+	ret0, _ := ret[0].(zendesk.Post)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrganizationTags indicates an expected call of GetOrganizationTags.
-func (mr *ClientMockRecorder) GetOrganizationTags(ctx, organizationID any) *gomock.Call {
+// MovePost indicates an expected call of MovePost.
+func (mr *ClientMockRecorder) MovePost(ctx, postID, topicID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTags", reflect.TypeOf((*Client)(nil).GetOrganizationTags), ctx, organizationID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePost", reflect.TypeOf((*Client)(nil).MovePost), ctx, postID, topicID)
 }
 
-// GetOrganizationTickets mocks base method.
-func (m *Client) GetOrganizationTickets(ctx context.Context, organizationID int64, ops *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// MoveSection mocks base method.
+func (m *Client) MoveSection(ctx context.Context, sectionID, categoryID int64) (zendesk.Section, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTickets", ctx, organizationID, ops)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "MoveSection", ctx, sectionID, categoryID)
+	ret0, _ := ret[0].(zendesk.Section)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTickets indicates an expected call of GetOrganizationTickets.
-func (mr *ClientMockRecorder) GetOrganizationTickets(ctx, organizationID, ops any) *gomock.Call {
+// MoveSection indicates an expected call of MoveSection.
+func (mr *ClientMockRecorder) MoveSection(ctx, sectionID, categoryID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTickets", reflect.TypeOf((*Client)(nil).GetOrganizationTickets), ctx, organizationID, ops)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSection", reflect.TypeOf((*Client)(nil).MoveSection), ctx, sectionID, categoryID)
 }
 
-// GetOrganizationTicketsCBP mocks base method.
-func (m *Client) GetOrganizationTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+// PermanentlyDeleteUser mocks base method.
+func (m *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) (zendesk.DeletedUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "PermanentlyDeleteUser", ctx, userID)
+	ret0, _ := ret[0].(zendesk.DeletedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsCBP indicates an expected call of GetOrganizationTicketsCBP.
-func (mr *ClientMockRecorder) GetOrganizationTicketsCBP(ctx, opts any) *gomock.Call {
+// PermanentlyDeleteUser indicates an expected call of PermanentlyDeleteUser.
+func (mr *ClientMockRecorder) PermanentlyDeleteUser(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentlyDeleteUser", reflect.TypeOf((*Client)(nil).PermanentlyDeleteUser), ctx, userID)
 }
 
-// GetOrganizationTicketsIterator mocks base method.
-func (m *Client) GetOrganizationTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+// PollChatRealTimeMetrics mocks base method.
+func (m *Client) PollChatRealTimeMetrics(ctx context.Context, opts *zendesk.ChatRealTimeOptions, interval time.Duration) <-chan zendesk.ChatRealTimeUpdate {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
+	ret := m.ctrl.Call(m, "PollChatRealTimeMetrics", ctx, opts, interval)
+	ret0, _ := ret[0].(<-chan zendesk.ChatRealTimeUpdate)
 	return ret0
 }
 
-// GetOrganizationTicketsIterator indicates an expected call of GetOrganizationTicketsIterator.
-func (mr *ClientMockRecorder) GetOrganizationTicketsIterator(ctx, opts any) *gomock.Call {
+// PollChatRealTimeMetrics indicates an expected call of PollChatRealTimeMetrics.
+func (mr *ClientMockRecorder) PollChatRealTimeMetrics(ctx, opts, interval any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PollChatRealTimeMetrics", reflect.TypeOf((*Client)(nil).PollChatRealTimeMetrics), ctx, opts, interval)
 }
 
-// GetOrganizationTicketsOBP mocks base method.
-func (m *Client) GetOrganizationTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// Post mocks base method.
+func (m *Client) Post(ctx context.Context, path string, data any) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationTicketsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "Post", ctx, path, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationTicketsOBP indicates an expected call of GetOrganizationTicketsOBP.
-func (mr *ClientMockRecorder) GetOrganizationTicketsOBP(ctx, opts any) *gomock.Call {
+// Post indicates an expected call of Post.
+func (mr *ClientMockRecorder) Post(ctx, path, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationTicketsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationTicketsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*Client)(nil).Post), ctx, path, data)
 }
 
-// GetOrganizationUsers mocks base method.
-func (m *Client) GetOrganizationUsers(ctx context.Context, orgID int64, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+// PublishTheme mocks base method.
+func (m *Client) PublishTheme(ctx context.Context, themeID string) (zendesk.Theme, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsers", ctx, orgID, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "PublishTheme", ctx, themeID)
+	ret0, _ := ret[0].(zendesk.Theme)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsers indicates an expected call of GetOrganizationUsers.
-func (mr *ClientMockRecorder) GetOrganizationUsers(ctx, orgID, opts any) *gomock.Call {
+// PublishTheme indicates an expected call of PublishTheme.
+func (mr *ClientMockRecorder) PublishTheme(ctx, themeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsers", reflect.TypeOf((*Client)(nil).GetOrganizationUsers), ctx, orgID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTheme", reflect.TypeOf((*Client)(nil).PublishTheme), ctx, themeID)
 }
 
-// GetOrganizationUsersCBP mocks base method.
-func (m *Client) GetOrganizationUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+// Put mocks base method.
+func (m *Client) Put(ctx context.Context, path string, data any) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "Put", ctx, path, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsersCBP indicates an expected call of GetOrganizationUsersCBP.
-func (mr *ClientMockRecorder) GetOrganizationUsersCBP(ctx, opts any) *gomock.Call {
+// Put indicates an expected call of Put.
+func (mr *ClientMockRecorder) Put(ctx, path, data any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersCBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*Client)(nil).Put), ctx, path, data)
 }
 
-// GetOrganizationUsersIterator mocks base method.
-func (m *Client) GetOrganizationUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+// RedactCommentAttachment mocks base method.
+func (m *Client) RedactCommentAttachment(ctx context.Context, ticketID, commentID, attachmentID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
+	ret := m.ctrl.Call(m, "RedactCommentAttachment", ctx, ticketID, commentID, attachmentID)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetOrganizationUsersIterator indicates an expected call of GetOrganizationUsersIterator.
-func (mr *ClientMockRecorder) GetOrganizationUsersIterator(ctx, opts any) *gomock.Call {
+// RedactCommentAttachment indicates an expected call of RedactCommentAttachment.
+func (mr *ClientMockRecorder) RedactCommentAttachment(ctx, ticketID, commentID, attachmentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersIterator", reflect.TypeOf((*Client)(nil).GetOrganizationUsersIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactCommentAttachment", reflect.TypeOf((*Client)(nil).RedactCommentAttachment), ctx, ticketID, commentID, attachmentID)
 }
 
-// GetOrganizationUsersOBP mocks base method.
-func (m *Client) GetOrganizationUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+// RedactUser mocks base method.
+func (m *Client) RedactUser(ctx context.Context, userID int64, opts *zendesk.RedactUserOptions) ([]zendesk.ComplianceDeletionStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationUsersOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "RedactUser", ctx, userID, opts)
+	ret0, _ := ret[0].([]zendesk.ComplianceDeletionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetOrganizationUsersOBP indicates an expected call of GetOrganizationUsersOBP.
-func (mr *ClientMockRecorder) GetOrganizationUsersOBP(ctx, opts any) *gomock.Call {
+// RedactUser indicates an expected call of RedactUser.
+func (mr *ClientMockRecorder) RedactUser(ctx, userID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationUsersOBP", reflect.TypeOf((*Client)(nil).GetOrganizationUsersOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactUser", reflect.TypeOf((*Client)(nil).RedactUser), ctx, userID, opts)
 }
 
-// GetOrganizations mocks base method.
-func (m *Client) GetOrganizations(ctx context.Context, opts *zendesk.OrganizationListOptions) ([]zendesk.Organization, zendesk.Page, error) {
+// RejectAnswerBotArticle mocks base method.
+func (m *Client) RejectAnswerBotArticle(ctx context.Context, rejection zendesk.AnswerBotRejection) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizations", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "RejectAnswerBotArticle", ctx, rejection)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrganizations indicates an expected call of GetOrganizations.
-func (mr *ClientMockRecorder) GetOrganizations(ctx, opts any) *gomock.Call {
+// RejectAnswerBotArticle indicates an expected call of RejectAnswerBotArticle.
+func (mr *ClientMockRecorder) RejectAnswerBotArticle(ctx, rejection any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*Client)(nil).GetOrganizations), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectAnswerBotArticle", reflect.TypeOf((*Client)(nil).RejectAnswerBotArticle), ctx, rejection)
 }
 
-// GetOrganizationsCBP mocks base method.
-func (m *Client) GetOrganizationsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Organization, zendesk.CursorPaginationMeta, error) {
+// RemoveOrganizationTags mocks base method.
+func (m *Client) RemoveOrganizationTags(ctx context.Context, organizationID int64, tags []zendesk.Tag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "RemoveOrganizationTags", ctx, organizationID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrganizationsCBP indicates an expected call of GetOrganizationsCBP.
-func (mr *ClientMockRecorder) GetOrganizationsCBP(ctx, opts any) *gomock.Call {
+// RemoveOrganizationTags indicates an expected call of RemoveOrganizationTags.
+func (mr *ClientMockRecorder) RemoveOrganizationTags(ctx, organizationID, tags any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsCBP", reflect.TypeOf((*Client)(nil).GetOrganizationsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrganizationTags", reflect.TypeOf((*Client)(nil).RemoveOrganizationTags), ctx, organizationID, tags)
 }
 
-// GetOrganizationsIterator mocks base method.
-func (m *Client) GetOrganizationsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Organization] {
+// RemoveTicketTags mocks base method.
+func (m *Client) RemoveTicketTags(ctx context.Context, ticketID int64, tags []zendesk.Tag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Organization])
+	ret := m.ctrl.Call(m, "RemoveTicketTags", ctx, ticketID, tags)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetOrganizationsIterator indicates an expected call of GetOrganizationsIterator.
-func (mr *ClientMockRecorder) GetOrganizationsIterator(ctx, opts any) *gomock.Call {
+// RemoveTicketTags indicates an expected call of RemoveTicketTags.
+func (mr *ClientMockRecorder) RemoveTicketTags(ctx, ticketID, tags any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsIterator", reflect.TypeOf((*Client)(nil).GetOrganizationsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTicketTags", reflect.TypeOf((*Client)(nil).RemoveTicketTags), ctx, ticketID, tags)
 }
 
-// GetOrganizationsOBP mocks base method.
-func (m *Client) GetOrganizationsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Organization, zendesk.Page, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrganizationsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Organization)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+// RemoveUserTags mocks base method.
+func (m *Client) RemoveUserTags(ctx context.Context, userID int64, tags []zendesk.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserTags", ctx, userID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrganizationsOBP indicates an expected call of GetOrganizationsOBP.
-func (mr *ClientMockRecorder) GetOrganizationsOBP(ctx, opts any) *gomock.Call {
+// RemoveUserTags indicates an expected call of RemoveUserTags.
+func (mr *ClientMockRecorder) RemoveUserTags(ctx, userID, tags any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsOBP", reflect.TypeOf((*Client)(nil).GetOrganizationsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserTags", reflect.TypeOf((*Client)(nil).RemoveUserTags), ctx, userID, tags)
 }
 
-// GetSLAPolicies mocks base method.
-func (m *Client) GetSLAPolicies(ctx context.Context, opts *zendesk.SLAPolicyListOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+// ReorderAutomations mocks base method.
+func (m *Client) ReorderAutomations(ctx context.Context, automationIDs []int64) ([]zendesk.Automation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPolicies", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SLAPolicy)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ReorderAutomations", ctx, automationIDs)
+	ret0, _ := ret[0].([]zendesk.Automation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetSLAPolicies indicates an expected call of GetSLAPolicies.
-func (mr *ClientMockRecorder) GetSLAPolicies(ctx, opts any) *gomock.Call {
+// ReorderAutomations indicates an expected call of ReorderAutomations.
+func (mr *ClientMockRecorder) ReorderAutomations(ctx, automationIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicies", reflect.TypeOf((*Client)(nil).GetSLAPolicies), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderAutomations", reflect.TypeOf((*Client)(nil).ReorderAutomations), ctx, automationIDs)
 }
 
-// GetSLAPoliciesCBP mocks base method.
-func (m *Client) GetSLAPoliciesCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SLAPolicy, zendesk.CursorPaginationMeta, error) {
+// ReorderCustomObjectFields mocks base method.
+func (m *Client) ReorderCustomObjectFields(ctx context.Context, customObjectKey string, fieldIDs []int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPoliciesCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SLAPolicy)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ReorderCustomObjectFields", ctx, customObjectKey, fieldIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSLAPoliciesCBP indicates an expected call of GetSLAPoliciesCBP.
-func (mr *ClientMockRecorder) GetSLAPoliciesCBP(ctx, opts any) *gomock.Call {
+// ReorderCustomObjectFields indicates an expected call of ReorderCustomObjectFields.
+func (mr *ClientMockRecorder) ReorderCustomObjectFields(ctx, customObjectKey, fieldIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesCBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderCustomObjectFields", reflect.TypeOf((*Client)(nil).ReorderCustomObjectFields), ctx, customObjectKey, fieldIDs)
 }
 
-// GetSLAPoliciesIterator mocks base method.
-func (m *Client) GetSLAPoliciesIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SLAPolicy] {
+// ReorderOrganizationFields mocks base method.
+func (m *Client) ReorderOrganizationFields(ctx context.Context, fieldIDs []int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPoliciesIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SLAPolicy])
+	ret := m.ctrl.Call(m, "ReorderOrganizationFields", ctx, fieldIDs)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetSLAPoliciesIterator indicates an expected call of GetSLAPoliciesIterator.
-func (mr *ClientMockRecorder) GetSLAPoliciesIterator(ctx, opts any) *gomock.Call {
+// ReorderOrganizationFields indicates an expected call of ReorderOrganizationFields.
+func (mr *ClientMockRecorder) ReorderOrganizationFields(ctx, fieldIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesIterator", reflect.TypeOf((*Client)(nil).GetSLAPoliciesIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderOrganizationFields", reflect.TypeOf((*Client)(nil).ReorderOrganizationFields), ctx, fieldIDs)
 }
 
-// GetSLAPoliciesOBP mocks base method.
-func (m *Client) GetSLAPoliciesOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SLAPolicy, zendesk.Page, error) {
+// ReorderSLAPolicies mocks base method.
+func (m *Client) ReorderSLAPolicies(ctx context.Context, slaPolicyIDs []int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPoliciesOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SLAPolicy)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ReorderSLAPolicies", ctx, slaPolicyIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSLAPoliciesOBP indicates an expected call of GetSLAPoliciesOBP.
-func (mr *ClientMockRecorder) GetSLAPoliciesOBP(ctx, opts any) *gomock.Call {
+// ReorderSLAPolicies indicates an expected call of ReorderSLAPolicies.
+func (mr *ClientMockRecorder) ReorderSLAPolicies(ctx, slaPolicyIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPoliciesOBP", reflect.TypeOf((*Client)(nil).GetSLAPoliciesOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSLAPolicies", reflect.TypeOf((*Client)(nil).ReorderSLAPolicies), ctx, slaPolicyIDs)
 }
 
-// GetSLAPolicy mocks base method.
-func (m *Client) GetSLAPolicy(ctx context.Context, id int64) (zendesk.SLAPolicy, error) {
+// ReorderUserFields mocks base method.
+func (m *Client) ReorderUserFields(ctx context.Context, fieldIDs []int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSLAPolicy", ctx, id)
-	ret0, _ := ret[0].(zendesk.SLAPolicy)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ReorderUserFields", ctx, fieldIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetSLAPolicy indicates an expected call of GetSLAPolicy.
-func (mr *ClientMockRecorder) GetSLAPolicy(ctx, id any) *gomock.Call {
+// ReorderUserFields indicates an expected call of ReorderUserFields.
+func (mr *ClientMockRecorder) ReorderUserFields(ctx, fieldIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*Client)(nil).GetSLAPolicy), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderUserFields", reflect.TypeOf((*Client)(nil).ReorderUserFields), ctx, fieldIDs)
 }
 
-// GetSearchCBP mocks base method.
-func (m *Client) GetSearchCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.SearchResults, zendesk.CursorPaginationMeta, error) {
+// ResetWebhookSigningSecret mocks base method.
+func (m *Client) ResetWebhookSigningSecret(ctx context.Context, webhookID string) (*zendesk.WebhookSigningSecret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SearchResults)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ResetWebhookSigningSecret", ctx, webhookID)
+	ret0, _ := ret[0].(*zendesk.WebhookSigningSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetSearchCBP indicates an expected call of GetSearchCBP.
-func (mr *ClientMockRecorder) GetSearchCBP(ctx, opts any) *gomock.Call {
+// ResetWebhookSigningSecret indicates an expected call of ResetWebhookSigningSecret.
+func (mr *ClientMockRecorder) ResetWebhookSigningSecret(ctx, webhookID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCBP", reflect.TypeOf((*Client)(nil).GetSearchCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).ResetWebhookSigningSecret), ctx, webhookID)
 }
 
-// GetSearchIterator mocks base method.
-func (m *Client) GetSearchIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.SearchResults] {
+// ResolveAnswerBotEnquiry mocks base method.
+func (m *Client) ResolveAnswerBotEnquiry(ctx context.Context, resolution zendesk.AnswerBotResolution) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.SearchResults])
+	ret := m.ctrl.Call(m, "ResolveAnswerBotEnquiry", ctx, resolution)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetSearchIterator indicates an expected call of GetSearchIterator.
-func (mr *ClientMockRecorder) GetSearchIterator(ctx, opts any) *gomock.Call {
+// ResolveAnswerBotEnquiry indicates an expected call of ResolveAnswerBotEnquiry.
+func (mr *ClientMockRecorder) ResolveAnswerBotEnquiry(ctx, resolution any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchIterator", reflect.TypeOf((*Client)(nil).GetSearchIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAnswerBotEnquiry", reflect.TypeOf((*Client)(nil).ResolveAnswerBotEnquiry), ctx, resolution)
 }
 
-// GetSearchOBP mocks base method.
-func (m *Client) GetSearchOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.SearchResults, zendesk.Page, error) {
+// Search mocks base method.
+func (m *Client) Search(ctx context.Context, opts *zendesk.SearchOptions) (zendesk.SearchResults, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSearchOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.SearchResults)
+	ret := m.ctrl.Call(m, "Search", ctx, opts)
+	ret0, _ := ret[0].(zendesk.SearchResults)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetSearchOBP indicates an expected call of GetSearchOBP.
-func (mr *ClientMockRecorder) GetSearchOBP(ctx, opts any) *gomock.Call {
+// Search indicates an expected call of Search.
+func (mr *ClientMockRecorder) Search(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchOBP", reflect.TypeOf((*Client)(nil).GetSearchOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*Client)(nil).Search), ctx, opts)
 }
 
-// GetTarget mocks base method.
-func (m *Client) GetTarget(ctx context.Context, ticketID int64) (zendesk.Target, error) {
+// SearchArticles mocks base method.
+func (m *Client) SearchArticles(ctx context.Context, opts *zendesk.ArticleSearchOptions) ([]zendesk.ArticleSearchResult, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTarget", ctx, ticketID)
-	ret0, _ := ret[0].(zendesk.Target)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SearchArticles", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.ArticleSearchResult)
+	ret1, _ := ret[1].(zendesk.Page)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetTarget indicates an expected call of GetTarget.
-func (mr *ClientMockRecorder) GetTarget(ctx, ticketID any) *gomock.Call {
+// SearchArticles indicates an expected call of SearchArticles.
+func (mr *ClientMockRecorder) SearchArticles(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTarget", reflect.TypeOf((*Client)(nil).GetTarget), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArticles", reflect.TypeOf((*Client)(nil).SearchArticles), ctx, opts)
 }
 
-// GetTargets mocks base method.
-func (m *Client) GetTargets(ctx context.Context) ([]zendesk.Target, zendesk.Page, error) {
+// SearchAutomations mocks base method.
+func (m *Client) SearchAutomations(ctx context.Context, opts *zendesk.AutomationSearchOptions) ([]zendesk.Automation, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTargets", ctx)
-	ret0, _ := ret[0].([]zendesk.Target)
+	ret := m.ctrl.Call(m, "SearchAutomations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Automation)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTargets indicates an expected call of GetTargets.
-func (mr *ClientMockRecorder) GetTargets(ctx any) *gomock.Call {
+// SearchAutomations indicates an expected call of SearchAutomations.
+func (mr *ClientMockRecorder) SearchAutomations(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTargets", reflect.TypeOf((*Client)(nil).GetTargets), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAutomations", reflect.TypeOf((*Client)(nil).SearchAutomations), ctx, opts)
 }
 
-// GetTicket mocks base method.
-func (m *Client) GetTicket(ctx context.Context, id int64) (zendesk.Ticket, error) {
+// SearchChats mocks base method.
+func (m *Client) SearchChats(ctx context.Context, opts *zendesk.ChatSearchOptions) ([]zendesk.ChatSearchResult, zendesk.ChatPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicket", ctx, id)
-	ret0, _ := ret[0].(zendesk.Ticket)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SearchChats", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.ChatSearchResult)
+	ret1, _ := ret[1].(zendesk.ChatPage)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetTicket indicates an expected call of GetTicket.
-func (mr *ClientMockRecorder) GetTicket(ctx, id any) *gomock.Call {
+// SearchChats indicates an expected call of SearchChats.
+func (mr *ClientMockRecorder) SearchChats(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*Client)(nil).GetTicket), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChats", reflect.TypeOf((*Client)(nil).SearchChats), ctx, opts)
 }
 
-// GetTicketAudit mocks base method.
-func (m *Client) GetTicketAudit(ctx context.Context, TicketID, ID int64) (zendesk.TicketAudit, error) {
+// SearchCount mocks base method.
+func (m *Client) SearchCount(ctx context.Context, opts *zendesk.CountOptions) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketAudit", ctx, TicketID, ID)
-	ret0, _ := ret[0].(zendesk.TicketAudit)
+	ret := m.ctrl.Call(m, "SearchCount", ctx, opts)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketAudit indicates an expected call of GetTicketAudit.
-func (mr *ClientMockRecorder) GetTicketAudit(ctx, TicketID, ID any) *gomock.Call {
+// SearchCount indicates an expected call of SearchCount.
+func (mr *ClientMockRecorder) SearchCount(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAudit", reflect.TypeOf((*Client)(nil).GetTicketAudit), ctx, TicketID, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCount", reflect.TypeOf((*Client)(nil).SearchCount), ctx, opts)
 }
 
-// GetTicketAudits mocks base method.
-func (m *Client) GetTicketAudits(ctx context.Context, ticketID int64, opts zendesk.PageOptions) ([]zendesk.TicketAudit, zendesk.Page, error) {
+// SearchCustomObjectRecords mocks base method.
+func (m *Client) SearchCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.SearchCustomObjectRecordsOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketAudits", ctx, ticketID, opts)
-	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret := m.ctrl.Call(m, "SearchCustomObjectRecords", ctx, customObjectKey, opts)
+	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketAudits indicates an expected call of GetTicketAudits.
-func (mr *ClientMockRecorder) GetTicketAudits(ctx, ticketID, opts any) *gomock.Call {
+// SearchCustomObjectRecords indicates an expected call of SearchCustomObjectRecords.
+func (mr *ClientMockRecorder) SearchCustomObjectRecords(ctx, customObjectKey, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAudits", reflect.TypeOf((*Client)(nil).GetTicketAudits), ctx, ticketID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).SearchCustomObjectRecords), ctx, customObjectKey, opts)
 }
 
-// GetTicketAuditsCBP mocks base method.
-func (m *Client) GetTicketAuditsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketAudit, zendesk.CursorPaginationMeta, error) {
+// SearchExport mocks base method.
+func (m *Client) SearchExport(ctx context.Context, opts *zendesk.SearchExportOptions) (zendesk.SearchResults, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketAuditsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret := m.ctrl.Call(m, "SearchExport", ctx, opts)
+	ret0, _ := ret[0].(zendesk.SearchResults)
 	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketAuditsCBP indicates an expected call of GetTicketAuditsCBP.
-func (mr *ClientMockRecorder) GetTicketAuditsCBP(ctx, opts any) *gomock.Call {
+// SearchExport indicates an expected call of SearchExport.
+func (mr *ClientMockRecorder) SearchExport(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAuditsCBP", reflect.TypeOf((*Client)(nil).GetTicketAuditsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchExport", reflect.TypeOf((*Client)(nil).SearchExport), ctx, opts)
 }
 
-// GetTicketAuditsIterator mocks base method.
-func (m *Client) GetTicketAuditsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketAudit] {
+// SearchHelpCenter mocks base method.
+func (m *Client) SearchHelpCenter(ctx context.Context, opts *zendesk.HelpCenterSearchOptions) ([]zendesk.HelpCenterSearchResult, zendesk.CursorPaginationMeta, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketAuditsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketAudit])
-	return ret0
+	ret := m.ctrl.Call(m, "SearchHelpCenter", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.HelpCenterSearchResult)
+	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// GetTicketAuditsIterator indicates an expected call of GetTicketAuditsIterator.
-func (mr *ClientMockRecorder) GetTicketAuditsIterator(ctx, opts any) *gomock.Call {
+// SearchHelpCenter indicates an expected call of SearchHelpCenter.
+func (mr *ClientMockRecorder) SearchHelpCenter(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAuditsIterator", reflect.TypeOf((*Client)(nil).GetTicketAuditsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchHelpCenter", reflect.TypeOf((*Client)(nil).SearchHelpCenter), ctx, opts)
 }
 
-// GetTicketAuditsOBP mocks base method.
-func (m *Client) GetTicketAuditsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketAudit, zendesk.Page, error) {
+// SearchOrganizations mocks base method.
+func (m *Client) SearchOrganizations(ctx context.Context, opts *zendesk.OrganizationSearchOptions) ([]zendesk.Organization, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketAuditsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketAudit)
+	ret := m.ctrl.Call(m, "SearchOrganizations", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Organization)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketAuditsOBP indicates an expected call of GetTicketAuditsOBP.
-func (mr *ClientMockRecorder) GetTicketAuditsOBP(ctx, opts any) *gomock.Call {
+// SearchOrganizations indicates an expected call of SearchOrganizations.
+func (mr *ClientMockRecorder) SearchOrganizations(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketAuditsOBP", reflect.TypeOf((*Client)(nil).GetTicketAuditsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrganizations", reflect.TypeOf((*Client)(nil).SearchOrganizations), ctx, opts)
 }
 
-// GetTicketCommentsCBP mocks base method.
-func (m *Client) GetTicketCommentsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketComment, zendesk.CursorPaginationMeta, error) {
+// SearchTriggers mocks base method.
+func (m *Client) SearchTriggers(ctx context.Context, opts *zendesk.TriggerSearchOptions) ([]zendesk.Trigger, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketCommentsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketComment)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
+	ret := m.ctrl.Call(m, "SearchTriggers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.Trigger)
+	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketCommentsCBP indicates an expected call of GetTicketCommentsCBP.
-func (mr *ClientMockRecorder) GetTicketCommentsCBP(ctx, opts any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCommentsCBP", reflect.TypeOf((*Client)(nil).GetTicketCommentsCBP), ctx, opts)
-}
-
-// GetTicketCommentsIterator mocks base method.
-func (m *Client) GetTicketCommentsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketComment] {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketCommentsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketComment])
-	return ret0
-}
-
-// GetTicketCommentsIterator indicates an expected call of GetTicketCommentsIterator.
-func (mr *ClientMockRecorder) GetTicketCommentsIterator(ctx, opts any) *gomock.Call {
+// SearchTriggers indicates an expected call of SearchTriggers.
+func (mr *ClientMockRecorder) SearchTriggers(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCommentsIterator", reflect.TypeOf((*Client)(nil).GetTicketCommentsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTriggers", reflect.TypeOf((*Client)(nil).SearchTriggers), ctx, opts)
 }
 
-// GetTicketCommentsOBP mocks base method.
-func (m *Client) GetTicketCommentsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketComment, zendesk.Page, error) {
+// SearchUsers mocks base method.
+func (m *Client) SearchUsers(ctx context.Context, opts *zendesk.SearchUsersOptions) ([]zendesk.User, zendesk.Page, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketCommentsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketComment)
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, opts)
+	ret0, _ := ret[0].([]zendesk.User)
 	ret1, _ := ret[1].(zendesk.Page)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetTicketCommentsOBP indicates an expected call of GetTicketCommentsOBP.
-func (mr *ClientMockRecorder) GetTicketCommentsOBP(ctx, opts any) *gomock.Call {
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *ClientMockRecorder) SearchUsers(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketCommentsOBP", reflect.TypeOf((*Client)(nil).GetTicketCommentsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*Client)(nil).SearchUsers), ctx, opts)
 }
 
-// GetTicketField mocks base method.
-func (m *Client) GetTicketField(ctx context.Context, ticketID int64) (zendesk.TicketField, error) {
+// SendUserImageContent mocks base method.
+func (m *Client) SendUserImageContent(ctx context.Context, upload zendesk.UserImageUpload, file io.Reader, fileSize int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketField", ctx, ticketID)
-	ret0, _ := ret[0].(zendesk.TicketField)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendUserImageContent", ctx, upload, file, fileSize)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTicketField indicates an expected call of GetTicketField.
-func (mr *ClientMockRecorder) GetTicketField(ctx, ticketID any) *gomock.Call {
+// SendUserImageContent indicates an expected call of SendUserImageContent.
+func (mr *ClientMockRecorder) SendUserImageContent(ctx, upload, file, fileSize any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketField", reflect.TypeOf((*Client)(nil).GetTicketField), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendUserImageContent", reflect.TypeOf((*Client)(nil).SendUserImageContent), ctx, upload, file, fileSize)
 }
 
-// GetTicketFields mocks base method.
-func (m *Client) GetTicketFields(ctx context.Context) ([]zendesk.TicketField, zendesk.Page, error) {
+// SetAttachmentMalwareAccessOverride mocks base method.
+func (m *Client) SetAttachmentMalwareAccessOverride(ctx context.Context, id int64, override bool) (zendesk.Attachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFields", ctx)
-	ret0, _ := ret[0].([]zendesk.TicketField)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SetAttachmentMalwareAccessOverride", ctx, id, override)
+	ret0, _ := ret[0].(zendesk.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketFields indicates an expected call of GetTicketFields.
-func (mr *ClientMockRecorder) GetTicketFields(ctx any) *gomock.Call {
+// SetAttachmentMalwareAccessOverride indicates an expected call of SetAttachmentMalwareAccessOverride.
+func (mr *ClientMockRecorder) SetAttachmentMalwareAccessOverride(ctx, id, override any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFields", reflect.TypeOf((*Client)(nil).GetTicketFields), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttachmentMalwareAccessOverride", reflect.TypeOf((*Client)(nil).SetAttachmentMalwareAccessOverride), ctx, id, override)
 }
 
-// GetTicketFieldsCBP mocks base method.
-func (m *Client) GetTicketFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketField, zendesk.CursorPaginationMeta, error) {
+// SetDefaultOrganization mocks base method.
+func (m *Client) SetDefaultOrganization(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFieldsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketField)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SetDefaultOrganization", arg0, arg1)
+	ret0, _ := ret[0].(zendesk.OrganizationMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketFieldsCBP indicates an expected call of GetTicketFieldsCBP.
-func (mr *ClientMockRecorder) GetTicketFieldsCBP(ctx, opts any) *gomock.Call {
+// SetDefaultOrganization indicates an expected call of SetDefaultOrganization.
+func (mr *ClientMockRecorder) SetDefaultOrganization(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsCBP", reflect.TypeOf((*Client)(nil).GetTicketFieldsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultOrganization", reflect.TypeOf((*Client)(nil).SetDefaultOrganization), arg0, arg1)
 }
 
-// GetTicketFieldsIterator mocks base method.
-func (m *Client) GetTicketFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketField] {
+// SetOrganizationTags mocks base method.
+func (m *Client) SetOrganizationTags(ctx context.Context, organizationID int64, tags []zendesk.Tag) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFieldsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketField])
-	return ret0
+	ret := m.ctrl.Call(m, "SetOrganizationTags", ctx, organizationID, tags)
+	ret0, _ := ret[0].([]zendesk.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketFieldsIterator indicates an expected call of GetTicketFieldsIterator.
-func (mr *ClientMockRecorder) GetTicketFieldsIterator(ctx, opts any) *gomock.Call {
+// SetOrganizationTags indicates an expected call of SetOrganizationTags.
+func (mr *ClientMockRecorder) SetOrganizationTags(ctx, organizationID, tags any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsIterator", reflect.TypeOf((*Client)(nil).GetTicketFieldsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOrganizationTags", reflect.TypeOf((*Client)(nil).SetOrganizationTags), ctx, organizationID, tags)
 }
 
-// GetTicketFieldsOBP mocks base method.
-func (m *Client) GetTicketFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketField, zendesk.Page, error) {
+// SetPostClosed mocks base method.
+func (m *Client) SetPostClosed(ctx context.Context, postID int64, closed bool) (zendesk.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketField)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SetPostClosed", ctx, postID, closed)
+	ret0, _ := ret[0].(zendesk.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketFieldsOBP indicates an expected call of GetTicketFieldsOBP.
-func (mr *ClientMockRecorder) GetTicketFieldsOBP(ctx, opts any) *gomock.Call {
+// SetPostClosed indicates an expected call of SetPostClosed.
+func (mr *ClientMockRecorder) SetPostClosed(ctx, postID, closed any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFieldsOBP", reflect.TypeOf((*Client)(nil).GetTicketFieldsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostClosed", reflect.TypeOf((*Client)(nil).SetPostClosed), ctx, postID, closed)
 }
 
-// GetTicketForm mocks base method.
-func (m *Client) GetTicketForm(ctx context.Context, id int64) (zendesk.TicketForm, error) {
+// SetPostCommentOfficial mocks base method.
+func (m *Client) SetPostCommentOfficial(ctx context.Context, postID, commentID int64, official bool) (zendesk.PostComment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketForm", ctx, id)
-	ret0, _ := ret[0].(zendesk.TicketForm)
+	ret := m.ctrl.Call(m, "SetPostCommentOfficial", ctx, postID, commentID, official)
+	ret0, _ := ret[0].(zendesk.PostComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketForm indicates an expected call of GetTicketForm.
-func (mr *ClientMockRecorder) GetTicketForm(ctx, id any) *gomock.Call {
+// SetPostCommentOfficial indicates an expected call of SetPostCommentOfficial.
+func (mr *ClientMockRecorder) SetPostCommentOfficial(ctx, postID, commentID, official any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForm", reflect.TypeOf((*Client)(nil).GetTicketForm), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostCommentOfficial", reflect.TypeOf((*Client)(nil).SetPostCommentOfficial), ctx, postID, commentID, official)
 }
 
-// GetTicketForms mocks base method.
-func (m *Client) GetTicketForms(ctx context.Context, options *zendesk.TicketFormListOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+// SetPostFeatured mocks base method.
+func (m *Client) SetPostFeatured(ctx context.Context, postID int64, featured bool) (zendesk.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketForms", ctx, options)
-	ret0, _ := ret[0].([]zendesk.TicketForm)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SetPostFeatured", ctx, postID, featured)
+	ret0, _ := ret[0].(zendesk.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketForms indicates an expected call of GetTicketForms.
-func (mr *ClientMockRecorder) GetTicketForms(ctx, options any) *gomock.Call {
+// SetPostFeatured indicates an expected call of SetPostFeatured.
+func (mr *ClientMockRecorder) SetPostFeatured(ctx, postID, featured any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketForms", reflect.TypeOf((*Client)(nil).GetTicketForms), ctx, options)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostFeatured", reflect.TypeOf((*Client)(nil).SetPostFeatured), ctx, postID, featured)
 }
 
-// GetTicketFormsCBP mocks base method.
-func (m *Client) GetTicketFormsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.TicketForm, zendesk.CursorPaginationMeta, error) {
+// SetPostPinned mocks base method.
+func (m *Client) SetPostPinned(ctx context.Context, postID int64, pinned bool) (zendesk.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFormsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketForm)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SetPostPinned", ctx, postID, pinned)
+	ret0, _ := ret[0].(zendesk.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketFormsCBP indicates an expected call of GetTicketFormsCBP.
-func (mr *ClientMockRecorder) GetTicketFormsCBP(ctx, opts any) *gomock.Call {
+// SetPostPinned indicates an expected call of SetPostPinned.
+func (mr *ClientMockRecorder) SetPostPinned(ctx, postID, pinned any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsCBP", reflect.TypeOf((*Client)(nil).GetTicketFormsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostPinned", reflect.TypeOf((*Client)(nil).SetPostPinned), ctx, postID, pinned)
 }
 
-// GetTicketFormsIterator mocks base method.
-func (m *Client) GetTicketFormsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.TicketForm] {
+// SetPostStatus mocks base method.
+func (m *Client) SetPostStatus(ctx context.Context, postID int64, status string) (zendesk.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFormsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.TicketForm])
-	return ret0
+	ret := m.ctrl.Call(m, "SetPostStatus", ctx, postID, status)
+	ret0, _ := ret[0].(zendesk.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketFormsIterator indicates an expected call of GetTicketFormsIterator.
-func (mr *ClientMockRecorder) GetTicketFormsIterator(ctx, opts any) *gomock.Call {
+// SetPostStatus indicates an expected call of SetPostStatus.
+func (mr *ClientMockRecorder) SetPostStatus(ctx, postID, status any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsIterator", reflect.TypeOf((*Client)(nil).GetTicketFormsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPostStatus", reflect.TypeOf((*Client)(nil).SetPostStatus), ctx, postID, status)
 }
 
-// GetTicketFormsOBP mocks base method.
-func (m *Client) GetTicketFormsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.TicketForm, zendesk.Page, error) {
+// SetUserTags mocks base method.
+func (m *Client) SetUserTags(ctx context.Context, userID int64, tags []zendesk.Tag) ([]zendesk.Tag, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketFormsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.TicketForm)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SetUserTags", ctx, userID, tags)
+	ret0, _ := ret[0].([]zendesk.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketFormsOBP indicates an expected call of GetTicketFormsOBP.
-func (mr *ClientMockRecorder) GetTicketFormsOBP(ctx, opts any) *gomock.Call {
+// SetUserTags indicates an expected call of SetUserTags.
+func (mr *ClientMockRecorder) SetUserTags(ctx, userID, tags any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketFormsOBP", reflect.TypeOf((*Client)(nil).GetTicketFormsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserTags", reflect.TypeOf((*Client)(nil).SetUserTags), ctx, userID, tags)
 }
 
-// GetTicketTags mocks base method.
-func (m *Client) GetTicketTags(ctx context.Context, ticketID int64) ([]zendesk.Tag, error) {
+// ShowCustomObjectRecord mocks base method.
+func (m *Client) ShowCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string) (*zendesk.CustomObjectRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketTags", ctx, ticketID)
-	ret0, _ := ret[0].([]zendesk.Tag)
+	ret := m.ctrl.Call(m, "ShowCustomObjectRecord", ctx, customObjectKey, customObjectRecordID)
+	ret0, _ := ret[0].(*zendesk.CustomObjectRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTicketTags indicates an expected call of GetTicketTags.
-func (mr *ClientMockRecorder) GetTicketTags(ctx, ticketID any) *gomock.Call {
+// ShowCustomObjectRecord indicates an expected call of ShowCustomObjectRecord.
+func (mr *ClientMockRecorder) ShowCustomObjectRecord(ctx, customObjectKey, customObjectRecordID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketTags", reflect.TypeOf((*Client)(nil).GetTicketTags), ctx, ticketID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowCustomObjectRecord", reflect.TypeOf((*Client)(nil).ShowCustomObjectRecord), ctx, customObjectKey, customObjectRecordID)
 }
 
-// GetTickets mocks base method.
-func (m *Client) GetTickets(ctx context.Context, opts *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// ShowMacroEffect mocks base method.
+func (m *Client) ShowMacroEffect(ctx context.Context, macroID int64) (zendesk.MacroResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTickets", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ShowMacroEffect", ctx, macroID)
+	ret0, _ := ret[0].(zendesk.MacroResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTickets indicates an expected call of GetTickets.
-func (mr *ClientMockRecorder) GetTickets(ctx, opts any) *gomock.Call {
+// ShowMacroEffect indicates an expected call of ShowMacroEffect.
+func (mr *ClientMockRecorder) ShowMacroEffect(ctx, macroID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTickets", reflect.TypeOf((*Client)(nil).GetTickets), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowMacroEffect", reflect.TypeOf((*Client)(nil).ShowMacroEffect), ctx, macroID)
 }
 
-// GetTicketsCBP mocks base method.
-func (m *Client) GetTicketsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+// ShowTicketAfterMacro mocks base method.
+func (m *Client) ShowTicketAfterMacro(ctx context.Context, ticketID, macroID int64) (zendesk.MacroResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ShowTicketAfterMacro", ctx, ticketID, macroID)
+	ret0, _ := ret[0].(zendesk.MacroResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketsCBP indicates an expected call of GetTicketsCBP.
-func (mr *ClientMockRecorder) GetTicketsCBP(ctx, opts any) *gomock.Call {
+// ShowTicketAfterMacro indicates an expected call of ShowTicketAfterMacro.
+func (mr *ClientMockRecorder) ShowTicketAfterMacro(ctx, ticketID, macroID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsCBP", reflect.TypeOf((*Client)(nil).GetTicketsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowTicketAfterMacro", reflect.TypeOf((*Client)(nil).ShowTicketAfterMacro), ctx, ticketID, macroID)
 }
 
-// GetTicketsFromView mocks base method.
-func (m *Client) GetTicketsFromView(arg0 context.Context, arg1 int64, arg2 *zendesk.TicketListOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// StreamChatRealTimeMetrics mocks base method.
+func (m *Client) StreamChatRealTimeMetrics(ctx context.Context, opts *zendesk.ChatRealTimeOptions) <-chan zendesk.ChatRealTimeUpdate {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsFromView", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "StreamChatRealTimeMetrics", ctx, opts)
+	ret0, _ := ret[0].(<-chan zendesk.ChatRealTimeUpdate)
+	return ret0
 }
 
-// GetTicketsFromView indicates an expected call of GetTicketsFromView.
-func (mr *ClientMockRecorder) GetTicketsFromView(arg0, arg1, arg2 any) *gomock.Call {
+// StreamChatRealTimeMetrics indicates an expected call of StreamChatRealTimeMetrics.
+func (mr *ClientMockRecorder) StreamChatRealTimeMetrics(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromView", reflect.TypeOf((*Client)(nil).GetTicketsFromView), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamChatRealTimeMetrics", reflect.TypeOf((*Client)(nil).StreamChatRealTimeMetrics), ctx, opts)
 }
 
-// GetTicketsFromViewCBP mocks base method.
-func (m *Client) GetTicketsFromViewCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Ticket, zendesk.CursorPaginationMeta, error) {
+// SuspendTicketRequesters mocks base method.
+func (m *Client) SuspendTicketRequesters(ctx context.Context, ticketIDs []int64) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsFromViewCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "SuspendTicketRequesters", ctx, ticketIDs)
+	ret0, _ := ret[0].(zendesk.JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketsFromViewCBP indicates an expected call of GetTicketsFromViewCBP.
-func (mr *ClientMockRecorder) GetTicketsFromViewCBP(ctx, opts any) *gomock.Call {
+// SuspendTicketRequesters indicates an expected call of SuspendTicketRequesters.
+func (mr *ClientMockRecorder) SuspendTicketRequesters(ctx, ticketIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromViewCBP", reflect.TypeOf((*Client)(nil).GetTicketsFromViewCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendTicketRequesters", reflect.TypeOf((*Client)(nil).SuspendTicketRequesters), ctx, ticketIDs)
 }
 
-// GetTicketsFromViewIterator mocks base method.
-func (m *Client) GetTicketsFromViewIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+// TestWebhook mocks base method.
+func (m *Client) TestWebhook(ctx context.Context, req zendesk.WebhookTestRequest) (zendesk.WebhookTestResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsFromViewIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
-	return ret0
+	ret := m.ctrl.Call(m, "TestWebhook", ctx, req)
+	ret0, _ := ret[0].(zendesk.WebhookTestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketsFromViewIterator indicates an expected call of GetTicketsFromViewIterator.
-func (mr *ClientMockRecorder) GetTicketsFromViewIterator(ctx, opts any) *gomock.Call {
+// TestWebhook indicates an expected call of TestWebhook.
+func (mr *ClientMockRecorder) TestWebhook(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromViewIterator", reflect.TypeOf((*Client)(nil).GetTicketsFromViewIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestWebhook", reflect.TypeOf((*Client)(nil).TestWebhook), ctx, req)
 }
 
-// GetTicketsFromViewOBP mocks base method.
-func (m *Client) GetTicketsFromViewOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// UpdateAccountSettings mocks base method.
+func (m *Client) UpdateAccountSettings(ctx context.Context, settings zendesk.AccountSettings) (zendesk.AccountSettings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsFromViewOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateAccountSettings", ctx, settings)
+	ret0, _ := ret[0].(zendesk.AccountSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketsFromViewOBP indicates an expected call of GetTicketsFromViewOBP.
-func (mr *ClientMockRecorder) GetTicketsFromViewOBP(ctx, opts any) *gomock.Call {
+// UpdateAccountSettings indicates an expected call of UpdateAccountSettings.
+func (mr *ClientMockRecorder) UpdateAccountSettings(ctx, settings any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsFromViewOBP", reflect.TypeOf((*Client)(nil).GetTicketsFromViewOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountSettings", reflect.TypeOf((*Client)(nil).UpdateAccountSettings), ctx, settings)
 }
 
-// GetTicketsIterator mocks base method.
-func (m *Client) GetTicketsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Ticket] {
+// UpdateAgentStatus mocks base method.
+func (m *Client) UpdateAgentStatus(ctx context.Context, agentID, statusID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Ticket])
+	ret := m.ctrl.Call(m, "UpdateAgentStatus", ctx, agentID, statusID)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// GetTicketsIterator indicates an expected call of GetTicketsIterator.
-func (mr *ClientMockRecorder) GetTicketsIterator(ctx, opts any) *gomock.Call {
+// UpdateAgentStatus indicates an expected call of UpdateAgentStatus.
+func (mr *ClientMockRecorder) UpdateAgentStatus(ctx, agentID, statusID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsIterator", reflect.TypeOf((*Client)(nil).GetTicketsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAgentStatus", reflect.TypeOf((*Client)(nil).UpdateAgentStatus), ctx, agentID, statusID)
 }
 
-// GetTicketsOBP mocks base method.
-func (m *Client) GetTicketsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Ticket, zendesk.Page, error) {
+// UpdateArticle mocks base method.
+func (m *Client) UpdateArticle(ctx context.Context, articleID int64, article zendesk.Article) (zendesk.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTicketsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Ticket)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateArticle", ctx, articleID, article)
+	ret0, _ := ret[0].(zendesk.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTicketsOBP indicates an expected call of GetTicketsOBP.
-func (mr *ClientMockRecorder) GetTicketsOBP(ctx, opts any) *gomock.Call {
+// UpdateArticle indicates an expected call of UpdateArticle.
+func (mr *ClientMockRecorder) UpdateArticle(ctx, articleID, article any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicketsOBP", reflect.TypeOf((*Client)(nil).GetTicketsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticle", reflect.TypeOf((*Client)(nil).UpdateArticle), ctx, articleID, article)
 }
 
-// GetTrigger mocks base method.
-func (m *Client) GetTrigger(ctx context.Context, id int64) (zendesk.Trigger, error) {
+// UpdateArticleComment mocks base method.
+func (m *Client) UpdateArticleComment(ctx context.Context, articleID, commentID int64, comment zendesk.ArticleComment) (zendesk.ArticleComment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrigger", ctx, id)
-	ret0, _ := ret[0].(zendesk.Trigger)
+	ret := m.ctrl.Call(m, "UpdateArticleComment", ctx, articleID, commentID, comment)
+	ret0, _ := ret[0].(zendesk.ArticleComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTrigger indicates an expected call of GetTrigger.
-func (mr *ClientMockRecorder) GetTrigger(ctx, id any) *gomock.Call {
+// UpdateArticleComment indicates an expected call of UpdateArticleComment.
+func (mr *ClientMockRecorder) UpdateArticleComment(ctx, articleID, commentID, comment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrigger", reflect.TypeOf((*Client)(nil).GetTrigger), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleComment", reflect.TypeOf((*Client)(nil).UpdateArticleComment), ctx, articleID, commentID, comment)
 }
 
-// GetTriggers mocks base method.
-func (m *Client) GetTriggers(ctx context.Context, opts *zendesk.TriggerListOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+// UpdateArticleTranslation mocks base method.
+func (m *Client) UpdateArticleTranslation(ctx context.Context, articleID int64, locale string, translation zendesk.Translation) (zendesk.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggers", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Trigger)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateArticleTranslation", ctx, articleID, locale, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTriggers indicates an expected call of GetTriggers.
-func (mr *ClientMockRecorder) GetTriggers(ctx, opts any) *gomock.Call {
+// UpdateArticleTranslation indicates an expected call of UpdateArticleTranslation.
+func (mr *ClientMockRecorder) UpdateArticleTranslation(ctx, articleID, locale, translation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggers", reflect.TypeOf((*Client)(nil).GetTriggers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleTranslation", reflect.TypeOf((*Client)(nil).UpdateArticleTranslation), ctx, articleID, locale, translation)
 }
 
-// GetTriggersCBP mocks base method.
-func (m *Client) GetTriggersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.Trigger, zendesk.CursorPaginationMeta, error) {
+// UpdateAutomation mocks base method.
+func (m *Client) UpdateAutomation(ctx context.Context, id int64, automation zendesk.Automation) (zendesk.Automation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggersCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Trigger)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateAutomation", ctx, id, automation)
+	ret0, _ := ret[0].(zendesk.Automation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTriggersCBP indicates an expected call of GetTriggersCBP.
-func (mr *ClientMockRecorder) GetTriggersCBP(ctx, opts any) *gomock.Call {
+// UpdateAutomation indicates an expected call of UpdateAutomation.
+func (mr *ClientMockRecorder) UpdateAutomation(ctx, id, automation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersCBP", reflect.TypeOf((*Client)(nil).GetTriggersCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutomation", reflect.TypeOf((*Client)(nil).UpdateAutomation), ctx, id, automation)
 }
 
-// GetTriggersIterator mocks base method.
-func (m *Client) GetTriggersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.Trigger] {
+// UpdateBrand mocks base method.
+func (m *Client) UpdateBrand(ctx context.Context, brandID int64, brand zendesk.Brand) (zendesk.Brand, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggersIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.Trigger])
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateBrand", ctx, brandID, brand)
+	ret0, _ := ret[0].(zendesk.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTriggersIterator indicates an expected call of GetTriggersIterator.
-func (mr *ClientMockRecorder) GetTriggersIterator(ctx, opts any) *gomock.Call {
+// UpdateBrand indicates an expected call of UpdateBrand.
+func (mr *ClientMockRecorder) UpdateBrand(ctx, brandID, brand any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersIterator", reflect.TypeOf((*Client)(nil).GetTriggersIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBrand", reflect.TypeOf((*Client)(nil).UpdateBrand), ctx, brandID, brand)
 }
 
-// GetTriggersOBP mocks base method.
-func (m *Client) GetTriggersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.Trigger, zendesk.Page, error) {
+// UpdateBrandLogo mocks base method.
+func (m *Client) UpdateBrandLogo(ctx context.Context, brandID int64, filename string, logo io.Reader) (zendesk.Brand, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTriggersOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.Trigger)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateBrandLogo", ctx, brandID, filename, logo)
+	ret0, _ := ret[0].(zendesk.Brand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetTriggersOBP indicates an expected call of GetTriggersOBP.
-func (mr *ClientMockRecorder) GetTriggersOBP(ctx, opts any) *gomock.Call {
+// UpdateBrandLogo indicates an expected call of UpdateBrandLogo.
+func (mr *ClientMockRecorder) UpdateBrandLogo(ctx, brandID, filename, logo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTriggersOBP", reflect.TypeOf((*Client)(nil).GetTriggersOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBrandLogo", reflect.TypeOf((*Client)(nil).UpdateBrandLogo), ctx, brandID, filename, logo)
 }
 
-// GetUser mocks base method.
-func (m *Client) GetUser(ctx context.Context, userID int64) (zendesk.User, error) {
+// UpdateCategory mocks base method.
+func (m *Client) UpdateCategory(ctx context.Context, categoryID int64, category zendesk.Category) (zendesk.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
-	ret0, _ := ret[0].(zendesk.User)
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, categoryID, category)
+	ret0, _ := ret[0].(zendesk.Category)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *ClientMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *ClientMockRecorder) UpdateCategory(ctx, categoryID, category any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Client)(nil).GetUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*Client)(nil).UpdateCategory), ctx, categoryID, category)
 }
 
-// GetUserFields mocks base method.
-func (m *Client) GetUserFields(ctx context.Context, opts *zendesk.UserFieldListOptions) ([]zendesk.UserField, zendesk.Page, error) {
+// UpdateCategoryPosition mocks base method.
+func (m *Client) UpdateCategoryPosition(ctx context.Context, categoryID, position int64) (zendesk.Category, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFields", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateCategoryPosition", ctx, categoryID, position)
+	ret0, _ := ret[0].(zendesk.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUserFields indicates an expected call of GetUserFields.
-func (mr *ClientMockRecorder) GetUserFields(ctx, opts any) *gomock.Call {
+// UpdateCategoryPosition indicates an expected call of UpdateCategoryPosition.
+func (mr *ClientMockRecorder) UpdateCategoryPosition(ctx, categoryID, position any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFields", reflect.TypeOf((*Client)(nil).GetUserFields), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryPosition", reflect.TypeOf((*Client)(nil).UpdateCategoryPosition), ctx, categoryID, position)
 }
 
-// GetUserFieldsCBP mocks base method.
-func (m *Client) GetUserFieldsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.UserField, zendesk.CursorPaginationMeta, error) {
+// UpdateCategoryTranslation mocks base method.
+func (m *Client) UpdateCategoryTranslation(ctx context.Context, categoryID int64, locale string, translation zendesk.Translation) (zendesk.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateCategoryTranslation", ctx, categoryID, locale, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUserFieldsCBP indicates an expected call of GetUserFieldsCBP.
-func (mr *ClientMockRecorder) GetUserFieldsCBP(ctx, opts any) *gomock.Call {
+// UpdateCategoryTranslation indicates an expected call of UpdateCategoryTranslation.
+func (mr *ClientMockRecorder) UpdateCategoryTranslation(ctx, categoryID, locale, translation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsCBP", reflect.TypeOf((*Client)(nil).GetUserFieldsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryTranslation", reflect.TypeOf((*Client)(nil).UpdateCategoryTranslation), ctx, categoryID, locale, translation)
 }
 
-// GetUserFieldsIterator mocks base method.
-func (m *Client) GetUserFieldsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.UserField] {
+// UpdateChatAccountRoutingSettings mocks base method.
+func (m *Client) UpdateChatAccountRoutingSettings(ctx context.Context, settings zendesk.ChatAccountRoutingSettings) (zendesk.ChatAccountRoutingSettings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.UserField])
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateChatAccountRoutingSettings", ctx, settings)
+	ret0, _ := ret[0].(zendesk.ChatAccountRoutingSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUserFieldsIterator indicates an expected call of GetUserFieldsIterator.
-func (mr *ClientMockRecorder) GetUserFieldsIterator(ctx, opts any) *gomock.Call {
+// UpdateChatAccountRoutingSettings indicates an expected call of UpdateChatAccountRoutingSettings.
+func (mr *ClientMockRecorder) UpdateChatAccountRoutingSettings(ctx, settings any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsIterator", reflect.TypeOf((*Client)(nil).GetUserFieldsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatAccountRoutingSettings", reflect.TypeOf((*Client)(nil).UpdateChatAccountRoutingSettings), ctx, settings)
 }
 
-// GetUserFieldsOBP mocks base method.
-func (m *Client) GetUserFieldsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.UserField, zendesk.Page, error) {
+// UpdateChatAgent mocks base method.
+func (m *Client) UpdateChatAgent(ctx context.Context, agentID int64, agent zendesk.ChatAgent) (zendesk.ChatAgent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserFieldsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.UserField)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateChatAgent", ctx, agentID, agent)
+	ret0, _ := ret[0].(zendesk.ChatAgent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUserFieldsOBP indicates an expected call of GetUserFieldsOBP.
-func (mr *ClientMockRecorder) GetUserFieldsOBP(ctx, opts any) *gomock.Call {
+// UpdateChatAgent indicates an expected call of UpdateChatAgent.
+func (mr *ClientMockRecorder) UpdateChatAgent(ctx, agentID, agent any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFieldsOBP", reflect.TypeOf((*Client)(nil).GetUserFieldsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatAgent", reflect.TypeOf((*Client)(nil).UpdateChatAgent), ctx, agentID, agent)
 }
 
-// GetUserRelated mocks base method.
-func (m *Client) GetUserRelated(ctx context.Context, userID int64) (zendesk.UserRelated, error) {
+// UpdateChatAgentRoutingSettings mocks base method.
+func (m *Client) UpdateChatAgentRoutingSettings(ctx context.Context, agentID int64, settings zendesk.ChatAgentRoutingSettings) (zendesk.ChatAgentRoutingSettings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserRelated", ctx, userID)
-	ret0, _ := ret[0].(zendesk.UserRelated)
+	ret := m.ctrl.Call(m, "UpdateChatAgentRoutingSettings", ctx, agentID, settings)
+	ret0, _ := ret[0].(zendesk.ChatAgentRoutingSettings)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserRelated indicates an expected call of GetUserRelated.
-func (mr *ClientMockRecorder) GetUserRelated(ctx, userID any) *gomock.Call {
+// UpdateChatAgentRoutingSettings indicates an expected call of UpdateChatAgentRoutingSettings.
+func (mr *ClientMockRecorder) UpdateChatAgentRoutingSettings(ctx, agentID, settings any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRelated", reflect.TypeOf((*Client)(nil).GetUserRelated), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatAgentRoutingSettings", reflect.TypeOf((*Client)(nil).UpdateChatAgentRoutingSettings), ctx, agentID, settings)
 }
 
-// GetUserTags mocks base method.
-func (m *Client) GetUserTags(ctx context.Context, userID int64) ([]zendesk.Tag, error) {
+// UpdateChatDepartment mocks base method.
+func (m *Client) UpdateChatDepartment(ctx context.Context, departmentID int64, department zendesk.ChatDepartment) (zendesk.ChatDepartment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserTags", ctx, userID)
-	ret0, _ := ret[0].([]zendesk.Tag)
+	ret := m.ctrl.Call(m, "UpdateChatDepartment", ctx, departmentID, department)
+	ret0, _ := ret[0].(zendesk.ChatDepartment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserTags indicates an expected call of GetUserTags.
-func (mr *ClientMockRecorder) GetUserTags(ctx, userID any) *gomock.Call {
+// UpdateChatDepartment indicates an expected call of UpdateChatDepartment.
+func (mr *ClientMockRecorder) UpdateChatDepartment(ctx, departmentID, department any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTags", reflect.TypeOf((*Client)(nil).GetUserTags), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatDepartment", reflect.TypeOf((*Client)(nil).UpdateChatDepartment), ctx, departmentID, department)
 }
 
-// GetUsers mocks base method.
-func (m *Client) GetUsers(ctx context.Context, opts *zendesk.UserListOptions) ([]zendesk.User, zendesk.Page, error) {
+// UpdateChatGoal mocks base method.
+func (m *Client) UpdateChatGoal(ctx context.Context, goalID int64, goal zendesk.ChatGoal) (zendesk.ChatGoal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsers", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateChatGoal", ctx, goalID, goal)
+	ret0, _ := ret[0].(zendesk.ChatGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUsers indicates an expected call of GetUsers.
-func (mr *ClientMockRecorder) GetUsers(ctx, opts any) *gomock.Call {
+// UpdateChatGoal indicates an expected call of UpdateChatGoal.
+func (mr *ClientMockRecorder) UpdateChatGoal(ctx, goalID, goal any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*Client)(nil).GetUsers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatGoal", reflect.TypeOf((*Client)(nil).UpdateChatGoal), ctx, goalID, goal)
 }
 
-// GetUsersCBP mocks base method.
-func (m *Client) GetUsersCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.User, zendesk.CursorPaginationMeta, error) {
+// UpdateChatShortcut mocks base method.
+func (m *Client) UpdateChatShortcut(ctx context.Context, name string, shortcut zendesk.ChatShortcut) (zendesk.ChatShortcut, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateChatShortcut", ctx, name, shortcut)
+	ret0, _ := ret[0].(zendesk.ChatShortcut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUsersCBP indicates an expected call of GetUsersCBP.
-func (mr *ClientMockRecorder) GetUsersCBP(ctx, opts any) *gomock.Call {
+// UpdateChatShortcut indicates an expected call of UpdateChatShortcut.
+func (mr *ClientMockRecorder) UpdateChatShortcut(ctx, name, shortcut any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersCBP", reflect.TypeOf((*Client)(nil).GetUsersCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatShortcut", reflect.TypeOf((*Client)(nil).UpdateChatShortcut), ctx, name, shortcut)
 }
 
-// GetUsersIterator mocks base method.
-func (m *Client) GetUsersIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.User] {
+// UpdateChatSkill mocks base method.
+func (m *Client) UpdateChatSkill(ctx context.Context, skillID int64, skill zendesk.ChatSkill) (zendesk.ChatSkill, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.User])
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateChatSkill", ctx, skillID, skill)
+	ret0, _ := ret[0].(zendesk.ChatSkill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUsersIterator indicates an expected call of GetUsersIterator.
-func (mr *ClientMockRecorder) GetUsersIterator(ctx, opts any) *gomock.Call {
+// UpdateChatSkill indicates an expected call of UpdateChatSkill.
+func (mr *ClientMockRecorder) UpdateChatSkill(ctx, skillID, skill any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersIterator", reflect.TypeOf((*Client)(nil).GetUsersIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatSkill", reflect.TypeOf((*Client)(nil).UpdateChatSkill), ctx, skillID, skill)
 }
 
-// GetUsersOBP mocks base method.
-func (m *Client) GetUsersOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.User, zendesk.Page, error) {
+// UpdateChatTrigger mocks base method.
+func (m *Client) UpdateChatTrigger(ctx context.Context, name string, trigger zendesk.ChatTrigger) (zendesk.ChatTrigger, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsersOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateChatTrigger", ctx, name, trigger)
+	ret0, _ := ret[0].(zendesk.ChatTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetUsersOBP indicates an expected call of GetUsersOBP.
-func (mr *ClientMockRecorder) GetUsersOBP(ctx, opts any) *gomock.Call {
+// UpdateChatTrigger indicates an expected call of UpdateChatTrigger.
+func (mr *ClientMockRecorder) UpdateChatTrigger(ctx, name, trigger any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersOBP", reflect.TypeOf((*Client)(nil).GetUsersOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatTrigger", reflect.TypeOf((*Client)(nil).UpdateChatTrigger), ctx, name, trigger)
 }
 
-// GetView mocks base method.
-func (m *Client) GetView(arg0 context.Context, arg1 int64) (zendesk.View, error) {
+// UpdateChatVisitor mocks base method.
+func (m *Client) UpdateChatVisitor(ctx context.Context, visitorID string, visitor zendesk.ChatVisitor) (zendesk.ChatVisitor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetView", arg0, arg1)
-	ret0, _ := ret[0].(zendesk.View)
+	ret := m.ctrl.Call(m, "UpdateChatVisitor", ctx, visitorID, visitor)
+	ret0, _ := ret[0].(zendesk.ChatVisitor)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetView indicates an expected call of GetView.
-func (mr *ClientMockRecorder) GetView(arg0, arg1 any) *gomock.Call {
+// UpdateChatVisitor indicates an expected call of UpdateChatVisitor.
+func (mr *ClientMockRecorder) UpdateChatVisitor(ctx, visitorID, visitor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetView", reflect.TypeOf((*Client)(nil).GetView), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChatVisitor", reflect.TypeOf((*Client)(nil).UpdateChatVisitor), ctx, visitorID, visitor)
 }
 
-// GetViews mocks base method.
-func (m *Client) GetViews(arg0 context.Context) ([]zendesk.View, zendesk.Page, error) {
+// UpdateCustomObject mocks base method.
+func (m *Client) UpdateCustomObject(ctx context.Context, customObjectKey string, customObject zendesk.CustomObject) (zendesk.CustomObject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetViews", arg0)
-	ret0, _ := ret[0].([]zendesk.View)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateCustomObject", ctx, customObjectKey, customObject)
+	ret0, _ := ret[0].(zendesk.CustomObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetViews indicates an expected call of GetViews.
-func (mr *ClientMockRecorder) GetViews(arg0 any) *gomock.Call {
+// UpdateCustomObject indicates an expected call of UpdateCustomObject.
+func (mr *ClientMockRecorder) UpdateCustomObject(ctx, customObjectKey, customObject any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViews", reflect.TypeOf((*Client)(nil).GetViews), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomObject", reflect.TypeOf((*Client)(nil).UpdateCustomObject), ctx, customObjectKey, customObject)
 }
 
-// GetViewsCBP mocks base method.
-func (m *Client) GetViewsCBP(ctx context.Context, opts *zendesk.CBPOptions) ([]zendesk.View, zendesk.CursorPaginationMeta, error) {
+// UpdateCustomObjectField mocks base method.
+func (m *Client) UpdateCustomObjectField(ctx context.Context, customObjectKey, fieldKeyOrID string, field zendesk.CustomObjectField) (zendesk.CustomObjectField, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetViewsCBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.View)
-	ret1, _ := ret[1].(zendesk.CursorPaginationMeta)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateCustomObjectField", ctx, customObjectKey, fieldKeyOrID, field)
+	ret0, _ := ret[0].(zendesk.CustomObjectField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetViewsCBP indicates an expected call of GetViewsCBP.
-func (mr *ClientMockRecorder) GetViewsCBP(ctx, opts any) *gomock.Call {
+// UpdateCustomObjectField indicates an expected call of UpdateCustomObjectField.
+func (mr *ClientMockRecorder) UpdateCustomObjectField(ctx, customObjectKey, fieldKeyOrID, field any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewsCBP", reflect.TypeOf((*Client)(nil).GetViewsCBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomObjectField", reflect.TypeOf((*Client)(nil).UpdateCustomObjectField), ctx, customObjectKey, fieldKeyOrID, field)
 }
 
-// GetViewsIterator mocks base method.
-func (m *Client) GetViewsIterator(ctx context.Context, opts *zendesk.PaginationOptions) *zendesk.Iterator[zendesk.View] {
+// UpdateCustomObjectRecord mocks base method.
+func (m *Client) UpdateCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string, record zendesk.CustomObjectRecord) (*zendesk.CustomObjectRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetViewsIterator", ctx, opts)
-	ret0, _ := ret[0].(*zendesk.Iterator[zendesk.View])
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateCustomObjectRecord", ctx, customObjectKey, customObjectRecordID, record)
+	ret0, _ := ret[0].(*zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetViewsIterator indicates an expected call of GetViewsIterator.
-func (mr *ClientMockRecorder) GetViewsIterator(ctx, opts any) *gomock.Call {
+// UpdateCustomObjectRecord indicates an expected call of UpdateCustomObjectRecord.
+func (mr *ClientMockRecorder) UpdateCustomObjectRecord(ctx, customObjectKey, customObjectRecordID, record any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewsIterator", reflect.TypeOf((*Client)(nil).GetViewsIterator), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomObjectRecord", reflect.TypeOf((*Client)(nil).UpdateCustomObjectRecord), ctx, customObjectKey, customObjectRecordID, record)
 }
 
-// GetViewsOBP mocks base method.
-func (m *Client) GetViewsOBP(ctx context.Context, opts *zendesk.OBPOptions) ([]zendesk.View, zendesk.Page, error) {
+// UpdateDeletionSchedule mocks base method.
+func (m *Client) UpdateDeletionSchedule(ctx context.Context, id int64, schedule zendesk.DeletionSchedule) (zendesk.DeletionSchedule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetViewsOBP", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.View)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateDeletionSchedule", ctx, id, schedule)
+	ret0, _ := ret[0].(zendesk.DeletionSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetViewsOBP indicates an expected call of GetViewsOBP.
-func (mr *ClientMockRecorder) GetViewsOBP(ctx, opts any) *gomock.Call {
+// UpdateDeletionSchedule indicates an expected call of UpdateDeletionSchedule.
+func (mr *ClientMockRecorder) UpdateDeletionSchedule(ctx, id, schedule any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetViewsOBP", reflect.TypeOf((*Client)(nil).GetViewsOBP), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeletionSchedule", reflect.TypeOf((*Client)(nil).UpdateDeletionSchedule), ctx, id, schedule)
 }
 
-// GetWebhook mocks base method.
-func (m *Client) GetWebhook(ctx context.Context, webhookID string) (*zendesk.Webhook, error) {
+// UpdateDynamicContentItem mocks base method.
+func (m *Client) UpdateDynamicContentItem(ctx context.Context, id int64, item zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWebhook", ctx, webhookID)
-	ret0, _ := ret[0].(*zendesk.Webhook)
+	ret := m.ctrl.Call(m, "UpdateDynamicContentItem", ctx, id, item)
+	ret0, _ := ret[0].(zendesk.DynamicContentItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWebhook indicates an expected call of GetWebhook.
-func (mr *ClientMockRecorder) GetWebhook(ctx, webhookID any) *gomock.Call {
+// UpdateDynamicContentItem indicates an expected call of UpdateDynamicContentItem.
+func (mr *ClientMockRecorder) UpdateDynamicContentItem(ctx, id, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*Client)(nil).GetWebhook), ctx, webhookID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicContentItem", reflect.TypeOf((*Client)(nil).UpdateDynamicContentItem), ctx, id, item)
 }
 
-// GetWebhookSigningSecret mocks base method.
-func (m *Client) GetWebhookSigningSecret(ctx context.Context, webhookID string) (*zendesk.WebhookSigningSecret, error) {
+// UpdateEssentialsCard mocks base method.
+func (m *Client) UpdateEssentialsCard(ctx context.Context, objectType string, card zendesk.EssentialsCard) (zendesk.EssentialsCard, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetWebhookSigningSecret", ctx, webhookID)
-	ret0, _ := ret[0].(*zendesk.WebhookSigningSecret)
+	ret := m.ctrl.Call(m, "UpdateEssentialsCard", ctx, objectType, card)
+	ret0, _ := ret[0].(zendesk.EssentialsCard)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWebhookSigningSecret indicates an expected call of GetWebhookSigningSecret.
-func (mr *ClientMockRecorder) GetWebhookSigningSecret(ctx, webhookID any) *gomock.Call {
+// UpdateEssentialsCard indicates an expected call of UpdateEssentialsCard.
+func (mr *ClientMockRecorder) UpdateEssentialsCard(ctx, objectType, card any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSigningSecret", reflect.TypeOf((*Client)(nil).GetWebhookSigningSecret), ctx, webhookID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEssentialsCard", reflect.TypeOf((*Client)(nil).UpdateEssentialsCard), ctx, objectType, card)
 }
 
-// ListCustomObjectRecords mocks base method.
-func (m *Client) ListCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.CustomObjectListOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
+// UpdateGroup mocks base method.
+func (m *Client) UpdateGroup(ctx context.Context, groupID int64, group zendesk.Group) (zendesk.Group, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCustomObjectRecords", ctx, customObjectKey, opts)
-	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateGroup", ctx, groupID, group)
+	ret0, _ := ret[0].(zendesk.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ListCustomObjectRecords indicates an expected call of ListCustomObjectRecords.
-func (mr *ClientMockRecorder) ListCustomObjectRecords(ctx, customObjectKey, opts any) *gomock.Call {
+// UpdateGroup indicates an expected call of UpdateGroup.
+func (mr *ClientMockRecorder) UpdateGroup(ctx, groupID, group any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomObjectRecords", reflect.TypeOf((*Client)(nil).ListCustomObjectRecords), ctx, customObjectKey, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*Client)(nil).UpdateGroup), ctx, groupID, group)
 }
 
-// ListInstallations mocks base method.
-func (m *Client) ListInstallations(ctx context.Context) ([]zendesk.AppInstallation, error) {
+// UpdateMacro mocks base method.
+func (m *Client) UpdateMacro(ctx context.Context, macroID int64, macro zendesk.Macro) (zendesk.Macro, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListInstallations", ctx)
-	ret0, _ := ret[0].([]zendesk.AppInstallation)
+	ret := m.ctrl.Call(m, "UpdateMacro", ctx, macroID, macro)
+	ret0, _ := ret[0].(zendesk.Macro)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListInstallations indicates an expected call of ListInstallations.
-func (mr *ClientMockRecorder) ListInstallations(ctx any) *gomock.Call {
+// UpdateMacro indicates an expected call of UpdateMacro.
+func (mr *ClientMockRecorder) UpdateMacro(ctx, macroID, macro any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstallations", reflect.TypeOf((*Client)(nil).ListInstallations), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMacro", reflect.TypeOf((*Client)(nil).UpdateMacro), ctx, macroID, macro)
 }
 
-// ListTicketComments mocks base method.
-func (m *Client) ListTicketComments(ctx context.Context, ticketID int64, opts *zendesk.ListTicketCommentsOptions) (*zendesk.ListTicketCommentsResult, error) {
+// UpdateManyAutomations mocks base method.
+func (m *Client) UpdateManyAutomations(ctx context.Context, updates []zendesk.AutomationUpdate) ([]zendesk.Automation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTicketComments", ctx, ticketID, opts)
-	ret0, _ := ret[0].(*zendesk.ListTicketCommentsResult)
+	ret := m.ctrl.Call(m, "UpdateManyAutomations", ctx, updates)
+	ret0, _ := ret[0].([]zendesk.Automation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTicketComments indicates an expected call of ListTicketComments.
-func (mr *ClientMockRecorder) ListTicketComments(ctx, ticketID, opts any) *gomock.Call {
+// UpdateManyAutomations indicates an expected call of UpdateManyAutomations.
+func (mr *ClientMockRecorder) UpdateManyAutomations(ctx, updates any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTicketComments", reflect.TypeOf((*Client)(nil).ListTicketComments), ctx, ticketID, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyAutomations", reflect.TypeOf((*Client)(nil).UpdateManyAutomations), ctx, updates)
 }
 
-// MakeCommentPrivate mocks base method.
-func (m *Client) MakeCommentPrivate(ctx context.Context, ticketID, ticketCommentID int64) error {
+// UpdateManyCustomObjectRecords mocks base method.
+func (m *Client) UpdateManyCustomObjectRecords(ctx context.Context, customObjectKey string, records []zendesk.CustomObjectRecord) (zendesk.CustomObjectRecordsJobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MakeCommentPrivate", ctx, ticketID, ticketCommentID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateManyCustomObjectRecords", ctx, customObjectKey, records)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordsJobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MakeCommentPrivate indicates an expected call of MakeCommentPrivate.
-func (mr *ClientMockRecorder) MakeCommentPrivate(ctx, ticketID, ticketCommentID any) *gomock.Call {
+// UpdateManyCustomObjectRecords indicates an expected call of UpdateManyCustomObjectRecords.
+func (mr *ClientMockRecorder) UpdateManyCustomObjectRecords(ctx, customObjectKey, records any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeCommentPrivate", reflect.TypeOf((*Client)(nil).MakeCommentPrivate), ctx, ticketID, ticketCommentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyCustomObjectRecords", reflect.TypeOf((*Client)(nil).UpdateManyCustomObjectRecords), ctx, customObjectKey, records)
 }
 
-// Post mocks base method.
-func (m *Client) Post(ctx context.Context, path string, data any) ([]byte, error) {
+// UpdateManyOrganizations mocks base method.
+func (m *Client) UpdateManyOrganizations(ctx context.Context, opts *zendesk.UpdateManyOrganizationsOptions, org zendesk.OrganizationUpdate) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Post", ctx, path, data)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "UpdateManyOrganizations", ctx, opts, org)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Post indicates an expected call of Post.
-func (mr *ClientMockRecorder) Post(ctx, path, data any) *gomock.Call {
+// UpdateManyOrganizations indicates an expected call of UpdateManyOrganizations.
+func (mr *ClientMockRecorder) UpdateManyOrganizations(ctx, opts, org any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Post", reflect.TypeOf((*Client)(nil).Post), ctx, path, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyOrganizations", reflect.TypeOf((*Client)(nil).UpdateManyOrganizations), ctx, opts, org)
 }
 
-// Put mocks base method.
-func (m *Client) Put(ctx context.Context, path string, data any) ([]byte, error) {
+// UpdateManyUsers mocks base method.
+func (m *Client) UpdateManyUsers(ctx context.Context, opts *zendesk.UpdateManyUsersOptions, user zendesk.User) (zendesk.JobStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, path, data)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "UpdateManyUsers", ctx, opts, user)
+	ret0, _ := ret[0].(zendesk.JobStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Put indicates an expected call of Put.
-func (mr *ClientMockRecorder) Put(ctx, path, data any) *gomock.Call {
+// UpdateManyUsers indicates an expected call of UpdateManyUsers.
+func (mr *ClientMockRecorder) UpdateManyUsers(ctx, opts, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*Client)(nil).Put), ctx, path, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateManyUsers", reflect.TypeOf((*Client)(nil).UpdateManyUsers), ctx, opts, user)
 }
 
-// RemoveTicketTags mocks base method.
-func (m *Client) RemoveTicketTags(ctx context.Context, ticketID int64, tags []zendesk.Tag) error {
+// UpdateOrganization mocks base method.
+func (m *Client) UpdateOrganization(ctx context.Context, orgID int64, org zendesk.Organization) (zendesk.Organization, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveTicketTags", ctx, ticketID, tags)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateOrganization", ctx, orgID, org)
+	ret0, _ := ret[0].(zendesk.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveTicketTags indicates an expected call of RemoveTicketTags.
-func (mr *ClientMockRecorder) RemoveTicketTags(ctx, ticketID, tags any) *gomock.Call {
+// UpdateOrganization indicates an expected call of UpdateOrganization.
+func (mr *ClientMockRecorder) UpdateOrganization(ctx, orgID, org any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTicketTags", reflect.TypeOf((*Client)(nil).RemoveTicketTags), ctx, ticketID, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*Client)(nil).UpdateOrganization), ctx, orgID, org)
 }
 
-// Search mocks base method.
-func (m *Client) Search(ctx context.Context, opts *zendesk.SearchOptions) (zendesk.SearchResults, zendesk.Page, error) {
+// UpdateOrganizationField mocks base method.
+func (m *Client) UpdateOrganizationField(ctx context.Context, fieldID int64, organizationField zendesk.OrganizationField) (zendesk.OrganizationField, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Search", ctx, opts)
-	ret0, _ := ret[0].(zendesk.SearchResults)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdateOrganizationField", ctx, fieldID, organizationField)
+	ret0, _ := ret[0].(zendesk.OrganizationField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Search indicates an expected call of Search.
-func (mr *ClientMockRecorder) Search(ctx, opts any) *gomock.Call {
+// UpdateOrganizationField indicates an expected call of UpdateOrganizationField.
+func (mr *ClientMockRecorder) UpdateOrganizationField(ctx, fieldID, organizationField any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*Client)(nil).Search), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganizationField", reflect.TypeOf((*Client)(nil).UpdateOrganizationField), ctx, fieldID, organizationField)
 }
 
-// SearchCount mocks base method.
-func (m *Client) SearchCount(ctx context.Context, opts *zendesk.CountOptions) (int, error) {
+// UpdatePermissionGroup mocks base method.
+func (m *Client) UpdatePermissionGroup(ctx context.Context, permissionGroupID int64, permissionGroup zendesk.PermissionGroup) (zendesk.PermissionGroup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchCount", ctx, opts)
-	ret0, _ := ret[0].(int)
+	ret := m.ctrl.Call(m, "UpdatePermissionGroup", ctx, permissionGroupID, permissionGroup)
+	ret0, _ := ret[0].(zendesk.PermissionGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchCount indicates an expected call of SearchCount.
-func (mr *ClientMockRecorder) SearchCount(ctx, opts any) *gomock.Call {
+// UpdatePermissionGroup indicates an expected call of UpdatePermissionGroup.
+func (mr *ClientMockRecorder) UpdatePermissionGroup(ctx, permissionGroupID, permissionGroup any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCount", reflect.TypeOf((*Client)(nil).SearchCount), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePermissionGroup", reflect.TypeOf((*Client)(nil).UpdatePermissionGroup), ctx, permissionGroupID, permissionGroup)
 }
 
-// SearchCustomObjectRecords mocks base method.
-func (m *Client) SearchCustomObjectRecords(ctx context.Context, customObjectKey string, opts *zendesk.SearchCustomObjectRecordsOptions) ([]zendesk.CustomObjectRecord, zendesk.Page, error) {
+// UpdatePost mocks base method.
+func (m *Client) UpdatePost(ctx context.Context, postID int64, post zendesk.Post) (zendesk.Post, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchCustomObjectRecords", ctx, customObjectKey, opts)
-	ret0, _ := ret[0].([]zendesk.CustomObjectRecord)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdatePost", ctx, postID, post)
+	ret0, _ := ret[0].(zendesk.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SearchCustomObjectRecords indicates an expected call of SearchCustomObjectRecords.
-func (mr *ClientMockRecorder) SearchCustomObjectRecords(ctx, customObjectKey, opts any) *gomock.Call {
+// UpdatePost indicates an expected call of UpdatePost.
+func (mr *ClientMockRecorder) UpdatePost(ctx, postID, post any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomObjectRecords", reflect.TypeOf((*Client)(nil).SearchCustomObjectRecords), ctx, customObjectKey, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*Client)(nil).UpdatePost), ctx, postID, post)
 }
 
-// SearchUsers mocks base method.
-func (m *Client) SearchUsers(ctx context.Context, opts *zendesk.SearchUsersOptions) ([]zendesk.User, zendesk.Page, error) {
+// UpdatePostComment mocks base method.
+func (m *Client) UpdatePostComment(ctx context.Context, postID, commentID int64, comment zendesk.PostComment) (zendesk.PostComment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchUsers", ctx, opts)
-	ret0, _ := ret[0].([]zendesk.User)
-	ret1, _ := ret[1].(zendesk.Page)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UpdatePostComment", ctx, postID, commentID, comment)
+	ret0, _ := ret[0].(zendesk.PostComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SearchUsers indicates an expected call of SearchUsers.
-func (mr *ClientMockRecorder) SearchUsers(ctx, opts any) *gomock.Call {
+// UpdatePostComment indicates an expected call of UpdatePostComment.
+func (mr *ClientMockRecorder) UpdatePostComment(ctx, postID, commentID, comment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*Client)(nil).SearchUsers), ctx, opts)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePostComment", reflect.TypeOf((*Client)(nil).UpdatePostComment), ctx, postID, commentID, comment)
 }
 
-// SetDefaultOrganization mocks base method.
-func (m *Client) SetDefaultOrganization(arg0 context.Context, arg1 zendesk.OrganizationMembershipOptions) (zendesk.OrganizationMembership, error) {
+// UpdateSLAPolicy mocks base method.
+func (m *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetDefaultOrganization", arg0, arg1)
-	ret0, _ := ret[0].(zendesk.OrganizationMembership)
+	ret := m.ctrl.Call(m, "UpdateSLAPolicy", ctx, id, slaPolicy)
+	ret0, _ := ret[0].(zendesk.SLAPolicy)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SetDefaultOrganization indicates an expected call of SetDefaultOrganization.
-func (mr *ClientMockRecorder) SetDefaultOrganization(arg0, arg1 any) *gomock.Call {
+// UpdateSLAPolicy indicates an expected call of UpdateSLAPolicy.
+func (mr *ClientMockRecorder) UpdateSLAPolicy(ctx, id, slaPolicy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultOrganization", reflect.TypeOf((*Client)(nil).SetDefaultOrganization), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSLAPolicy", reflect.TypeOf((*Client)(nil).UpdateSLAPolicy), ctx, id, slaPolicy)
 }
 
-// ShowCustomObjectRecord mocks base method.
-func (m *Client) ShowCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string) (*zendesk.CustomObjectRecord, error) {
+// UpdateSection mocks base method.
+func (m *Client) UpdateSection(ctx context.Context, sectionID int64, section zendesk.Section) (zendesk.Section, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ShowCustomObjectRecord", ctx, customObjectKey, customObjectRecordID)
-	ret0, _ := ret[0].(*zendesk.CustomObjectRecord)
+	ret := m.ctrl.Call(m, "UpdateSection", ctx, sectionID, section)
+	ret0, _ := ret[0].(zendesk.Section)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ShowCustomObjectRecord indicates an expected call of ShowCustomObjectRecord.
-func (mr *ClientMockRecorder) ShowCustomObjectRecord(ctx, customObjectKey, customObjectRecordID any) *gomock.Call {
+// UpdateSection indicates an expected call of UpdateSection.
+func (mr *ClientMockRecorder) UpdateSection(ctx, sectionID, section any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowCustomObjectRecord", reflect.TypeOf((*Client)(nil).ShowCustomObjectRecord), ctx, customObjectKey, customObjectRecordID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSection", reflect.TypeOf((*Client)(nil).UpdateSection), ctx, sectionID, section)
 }
 
-// UpdateAutomation mocks base method.
-func (m *Client) UpdateAutomation(ctx context.Context, id int64, automation zendesk.Automation) (zendesk.Automation, error) {
+// UpdateSectionPosition mocks base method.
+func (m *Client) UpdateSectionPosition(ctx context.Context, sectionID, position int64) (zendesk.Section, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAutomation", ctx, id, automation)
-	ret0, _ := ret[0].(zendesk.Automation)
+	ret := m.ctrl.Call(m, "UpdateSectionPosition", ctx, sectionID, position)
+	ret0, _ := ret[0].(zendesk.Section)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAutomation indicates an expected call of UpdateAutomation.
-func (mr *ClientMockRecorder) UpdateAutomation(ctx, id, automation any) *gomock.Call {
+// UpdateSectionPosition indicates an expected call of UpdateSectionPosition.
+func (mr *ClientMockRecorder) UpdateSectionPosition(ctx, sectionID, position any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutomation", reflect.TypeOf((*Client)(nil).UpdateAutomation), ctx, id, automation)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSectionPosition", reflect.TypeOf((*Client)(nil).UpdateSectionPosition), ctx, sectionID, position)
 }
 
-// UpdateBrand mocks base method.
-func (m *Client) UpdateBrand(ctx context.Context, brandID int64, brand zendesk.Brand) (zendesk.Brand, error) {
+// UpdateSectionTranslation mocks base method.
+func (m *Client) UpdateSectionTranslation(ctx context.Context, sectionID int64, locale string, translation zendesk.Translation) (zendesk.Translation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBrand", ctx, brandID, brand)
-	ret0, _ := ret[0].(zendesk.Brand)
+	ret := m.ctrl.Call(m, "UpdateSectionTranslation", ctx, sectionID, locale, translation)
+	ret0, _ := ret[0].(zendesk.Translation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateBrand indicates an expected call of UpdateBrand.
-func (mr *ClientMockRecorder) UpdateBrand(ctx, brandID, brand any) *gomock.Call {
+// UpdateSectionTranslation indicates an expected call of UpdateSectionTranslation.
+func (mr *ClientMockRecorder) UpdateSectionTranslation(ctx, sectionID, locale, translation any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBrand", reflect.TypeOf((*Client)(nil).UpdateBrand), ctx, brandID, brand)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSectionTranslation", reflect.TypeOf((*Client)(nil).UpdateSectionTranslation), ctx, sectionID, locale, translation)
 }
 
-// UpdateCustomObjectRecord mocks base method.
-func (m *Client) UpdateCustomObjectRecord(ctx context.Context, customObjectKey, customObjectRecordID string, record zendesk.CustomObjectRecord) (*zendesk.CustomObjectRecord, error) {
+// UpdateTalkAvailability mocks base method.
+func (m *Client) UpdateTalkAvailability(ctx context.Context, agentID int64, availability zendesk.TalkAvailability) (zendesk.TalkAvailability, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateCustomObjectRecord", ctx, customObjectKey, customObjectRecordID, record)
-	ret0, _ := ret[0].(*zendesk.CustomObjectRecord)
+	ret := m.ctrl.Call(m, "UpdateTalkAvailability", ctx, agentID, availability)
+	ret0, _ := ret[0].(zendesk.TalkAvailability)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateCustomObjectRecord indicates an expected call of UpdateCustomObjectRecord.
-func (mr *ClientMockRecorder) UpdateCustomObjectRecord(ctx, customObjectKey, customObjectRecordID, record any) *gomock.Call {
+// UpdateTalkAvailability indicates an expected call of UpdateTalkAvailability.
+func (mr *ClientMockRecorder) UpdateTalkAvailability(ctx, agentID, availability any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomObjectRecord", reflect.TypeOf((*Client)(nil).UpdateCustomObjectRecord), ctx, customObjectKey, customObjectRecordID, record)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTalkAvailability", reflect.TypeOf((*Client)(nil).UpdateTalkAvailability), ctx, agentID, availability)
 }
 
-// UpdateDynamicContentItem mocks base method.
-func (m *Client) UpdateDynamicContentItem(ctx context.Context, id int64, item zendesk.DynamicContentItem) (zendesk.DynamicContentItem, error) {
+// UpdateTalkDigitalLine mocks base method.
+func (m *Client) UpdateTalkDigitalLine(ctx context.Context, digitalLineID int64, digitalLine zendesk.TalkLine) (zendesk.TalkLine, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateDynamicContentItem", ctx, id, item)
-	ret0, _ := ret[0].(zendesk.DynamicContentItem)
+	ret := m.ctrl.Call(m, "UpdateTalkDigitalLine", ctx, digitalLineID, digitalLine)
+	ret0, _ := ret[0].(zendesk.TalkLine)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateDynamicContentItem indicates an expected call of UpdateDynamicContentItem.
-func (mr *ClientMockRecorder) UpdateDynamicContentItem(ctx, id, item any) *gomock.Call {
+// UpdateTalkDigitalLine indicates an expected call of UpdateTalkDigitalLine.
+func (mr *ClientMockRecorder) UpdateTalkDigitalLine(ctx, digitalLineID, digitalLine any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDynamicContentItem", reflect.TypeOf((*Client)(nil).UpdateDynamicContentItem), ctx, id, item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTalkDigitalLine", reflect.TypeOf((*Client)(nil).UpdateTalkDigitalLine), ctx, digitalLineID, digitalLine)
 }
 
-// UpdateGroup mocks base method.
-func (m *Client) UpdateGroup(ctx context.Context, groupID int64, group zendesk.Group) (zendesk.Group, error) {
+// UpdateTalkGreeting mocks base method.
+func (m *Client) UpdateTalkGreeting(ctx context.Context, greetingID int64, greeting zendesk.TalkGreeting) (zendesk.TalkGreeting, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateGroup", ctx, groupID, group)
-	ret0, _ := ret[0].(zendesk.Group)
+	ret := m.ctrl.Call(m, "UpdateTalkGreeting", ctx, greetingID, greeting)
+	ret0, _ := ret[0].(zendesk.TalkGreeting)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateGroup indicates an expected call of UpdateGroup.
-func (mr *ClientMockRecorder) UpdateGroup(ctx, groupID, group any) *gomock.Call {
+// UpdateTalkGreeting indicates an expected call of UpdateTalkGreeting.
+func (mr *ClientMockRecorder) UpdateTalkGreeting(ctx, greetingID, greeting any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*Client)(nil).UpdateGroup), ctx, groupID, group)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTalkGreeting", reflect.TypeOf((*Client)(nil).UpdateTalkGreeting), ctx, greetingID, greeting)
 }
 
-// UpdateMacro mocks base method.
-func (m *Client) UpdateMacro(ctx context.Context, macroID int64, macro zendesk.Macro) (zendesk.Macro, error) {
+// UpdateTalkIVR mocks base method.
+func (m *Client) UpdateTalkIVR(ctx context.Context, ivrID int64, ivr zendesk.TalkIVR) (zendesk.TalkIVR, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateMacro", ctx, macroID, macro)
-	ret0, _ := ret[0].(zendesk.Macro)
+	ret := m.ctrl.Call(m, "UpdateTalkIVR", ctx, ivrID, ivr)
+	ret0, _ := ret[0].(zendesk.TalkIVR)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateMacro indicates an expected call of UpdateMacro.
-func (mr *ClientMockRecorder) UpdateMacro(ctx, macroID, macro any) *gomock.Call {
+// UpdateTalkIVR indicates an expected call of UpdateTalkIVR.
+func (mr *ClientMockRecorder) UpdateTalkIVR(ctx, ivrID, ivr any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMacro", reflect.TypeOf((*Client)(nil).UpdateMacro), ctx, macroID, macro)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTalkIVR", reflect.TypeOf((*Client)(nil).UpdateTalkIVR), ctx, ivrID, ivr)
 }
 
-// UpdateOrganization mocks base method.
-func (m *Client) UpdateOrganization(ctx context.Context, orgID int64, org zendesk.Organization) (zendesk.Organization, error) {
+// UpdateTalkIVRMenu mocks base method.
+func (m *Client) UpdateTalkIVRMenu(ctx context.Context, ivrID, menuID int64, menu zendesk.TalkIVRMenu) (zendesk.TalkIVRMenu, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrganization", ctx, orgID, org)
-	ret0, _ := ret[0].(zendesk.Organization)
+	ret := m.ctrl.Call(m, "UpdateTalkIVRMenu", ctx, ivrID, menuID, menu)
+	ret0, _ := ret[0].(zendesk.TalkIVRMenu)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateOrganization indicates an expected call of UpdateOrganization.
-func (mr *ClientMockRecorder) UpdateOrganization(ctx, orgID, org any) *gomock.Call {
+// UpdateTalkIVRMenu indicates an expected call of UpdateTalkIVRMenu.
+func (mr *ClientMockRecorder) UpdateTalkIVRMenu(ctx, ivrID, menuID, menu any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrganization", reflect.TypeOf((*Client)(nil).UpdateOrganization), ctx, orgID, org)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTalkIVRMenu", reflect.TypeOf((*Client)(nil).UpdateTalkIVRMenu), ctx, ivrID, menuID, menu)
 }
 
-// UpdateSLAPolicy mocks base method.
-func (m *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy zendesk.SLAPolicy) (zendesk.SLAPolicy, error) {
+// UpdateTalkIVRRoute mocks base method.
+func (m *Client) UpdateTalkIVRRoute(ctx context.Context, ivrID, menuID, routeID int64, route zendesk.TalkIVRRoute) (zendesk.TalkIVRRoute, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSLAPolicy", ctx, id, slaPolicy)
-	ret0, _ := ret[0].(zendesk.SLAPolicy)
+	ret := m.ctrl.Call(m, "UpdateTalkIVRRoute", ctx, ivrID, menuID, routeID, route)
+	ret0, _ := ret[0].(zendesk.TalkIVRRoute)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateSLAPolicy indicates an expected call of UpdateSLAPolicy.
-func (mr *ClientMockRecorder) UpdateSLAPolicy(ctx, id, slaPolicy any) *gomock.Call {
+// UpdateTalkIVRRoute indicates an expected call of UpdateTalkIVRRoute.
+func (mr *ClientMockRecorder) UpdateTalkIVRRoute(ctx, ivrID, menuID, routeID, route any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSLAPolicy", reflect.TypeOf((*Client)(nil).UpdateSLAPolicy), ctx, id, slaPolicy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTalkIVRRoute", reflect.TypeOf((*Client)(nil).UpdateTalkIVRRoute), ctx, ivrID, menuID, routeID, route)
 }
 
 // UpdateTarget mocks base method.
@@ -2790,6 +8537,36 @@ func (mr *ClientMockRecorder) UpdateTicketForm(ctx, id, form any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTicketForm", reflect.TypeOf((*Client)(nil).UpdateTicketForm), ctx, id, form)
 }
 
+// UpdateTopic mocks base method.
+func (m *Client) UpdateTopic(ctx context.Context, topicID int64, topic zendesk.Topic) (zendesk.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTopic", ctx, topicID, topic)
+	ret0, _ := ret[0].(zendesk.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTopic indicates an expected call of UpdateTopic.
+func (mr *ClientMockRecorder) UpdateTopic(ctx, topicID, topic any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTopic", reflect.TypeOf((*Client)(nil).UpdateTopic), ctx, topicID, topic)
+}
+
+// UpdateTopicUserSegment mocks base method.
+func (m *Client) UpdateTopicUserSegment(ctx context.Context, topicID int64, userSegmentID *int64) (zendesk.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTopicUserSegment", ctx, topicID, userSegmentID)
+	ret0, _ := ret[0].(zendesk.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTopicUserSegment indicates an expected call of UpdateTopicUserSegment.
+func (mr *ClientMockRecorder) UpdateTopicUserSegment(ctx, topicID, userSegmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTopicUserSegment", reflect.TypeOf((*Client)(nil).UpdateTopicUserSegment), ctx, topicID, userSegmentID)
+}
+
 // UpdateTrigger mocks base method.
 func (m *Client) UpdateTrigger(ctx context.Context, id int64, trigger zendesk.Trigger) (zendesk.Trigger, error) {
 	m.ctrl.T.Helper()
@@ -2805,6 +8582,21 @@ func (mr *ClientMockRecorder) UpdateTrigger(ctx, id, trigger any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrigger", reflect.TypeOf((*Client)(nil).UpdateTrigger), ctx, id, trigger)
 }
 
+// UpdateTriggerCategory mocks base method.
+func (m *Client) UpdateTriggerCategory(ctx context.Context, id string, category zendesk.TriggerCategory) (zendesk.TriggerCategory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTriggerCategory", ctx, id, category)
+	ret0, _ := ret[0].(zendesk.TriggerCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTriggerCategory indicates an expected call of UpdateTriggerCategory.
+func (mr *ClientMockRecorder) UpdateTriggerCategory(ctx, id, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerCategory", reflect.TypeOf((*Client)(nil).UpdateTriggerCategory), ctx, id, category)
+}
+
 // UpdateUser mocks base method.
 func (m *Client) UpdateUser(ctx context.Context, userID int64, user zendesk.User) (zendesk.User, error) {
 	m.ctrl.T.Helper()
@@ -2820,6 +8612,51 @@ func (mr *ClientMockRecorder) UpdateUser(ctx, userID, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*Client)(nil).UpdateUser), ctx, userID, user)
 }
 
+// UpdateUserField mocks base method.
+func (m *Client) UpdateUserField(ctx context.Context, fieldID int64, userField zendesk.UserField) (zendesk.UserField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserField", ctx, fieldID, userField)
+	ret0, _ := ret[0].(zendesk.UserField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserField indicates an expected call of UpdateUserField.
+func (mr *ClientMockRecorder) UpdateUserField(ctx, fieldID, userField any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserField", reflect.TypeOf((*Client)(nil).UpdateUserField), ctx, fieldID, userField)
+}
+
+// UpdateUserIdentity mocks base method.
+func (m *Client) UpdateUserIdentity(ctx context.Context, userID, identityID int64, identity zendesk.UserIdentity) (zendesk.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserIdentity", ctx, userID, identityID, identity)
+	ret0, _ := ret[0].(zendesk.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserIdentity indicates an expected call of UpdateUserIdentity.
+func (mr *ClientMockRecorder) UpdateUserIdentity(ctx, userID, identityID, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserIdentity", reflect.TypeOf((*Client)(nil).UpdateUserIdentity), ctx, userID, identityID, identity)
+}
+
+// UpdateView mocks base method.
+func (m *Client) UpdateView(ctx context.Context, viewID int64, view zendesk.View) (zendesk.View, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateView", ctx, viewID, view)
+	ret0, _ := ret[0].(zendesk.View)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateView indicates an expected call of UpdateView.
+func (mr *ClientMockRecorder) UpdateView(ctx, viewID, view any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateView", reflect.TypeOf((*Client)(nil).UpdateView), ctx, viewID, view)
+}
+
 // UpdateWebhook mocks base method.
 func (m *Client) UpdateWebhook(ctx context.Context, webhookID string, hook *zendesk.Webhook) error {
 	m.ctrl.T.Helper()
@@ -2834,6 +8671,21 @@ func (mr *ClientMockRecorder) UpdateWebhook(ctx, webhookID, hook any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*Client)(nil).UpdateWebhook), ctx, webhookID, hook)
 }
 
+// UploadArticleAttachment mocks base method.
+func (m *Client) UploadArticleAttachment(ctx context.Context, articleID int64, filename string, file io.Reader, inline bool) (zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadArticleAttachment", ctx, articleID, filename, file, inline)
+	ret0, _ := ret[0].(zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadArticleAttachment indicates an expected call of UploadArticleAttachment.
+func (mr *ClientMockRecorder) UploadArticleAttachment(ctx, articleID, filename, file, inline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArticleAttachment", reflect.TypeOf((*Client)(nil).UploadArticleAttachment), ctx, articleID, filename, file, inline)
+}
+
 // UploadAttachment mocks base method.
 func (m *Client) UploadAttachment(ctx context.Context, filename, token string) zendesk.UploadWriter {
 	m.ctrl.T.Helper()
@@ -2847,3 +8699,107 @@ func (mr *ClientMockRecorder) UploadAttachment(ctx, filename, token any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*Client)(nil).UploadAttachment), ctx, filename, token)
 }
+
+// UploadTalkGreetingAudio mocks base method.
+func (m *Client) UploadTalkGreetingAudio(ctx context.Context, greetingID int64, filename string, file io.Reader) (zendesk.TalkGreeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTalkGreetingAudio", ctx, greetingID, filename, file)
+	ret0, _ := ret[0].(zendesk.TalkGreeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadTalkGreetingAudio indicates an expected call of UploadTalkGreetingAudio.
+func (mr *ClientMockRecorder) UploadTalkGreetingAudio(ctx, greetingID, filename, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTalkGreetingAudio", reflect.TypeOf((*Client)(nil).UploadTalkGreetingAudio), ctx, greetingID, filename, file)
+}
+
+// UploadThemePackage mocks base method.
+func (m *Client) UploadThemePackage(ctx context.Context, job zendesk.ThemeJob, filename string, file io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadThemePackage", ctx, job, filename, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadThemePackage indicates an expected call of UploadThemePackage.
+func (mr *ClientMockRecorder) UploadThemePackage(ctx, job, filename, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadThemePackage", reflect.TypeOf((*Client)(nil).UploadThemePackage), ctx, job, filename, file)
+}
+
+// UploadUnassociatedArticleAttachment mocks base method.
+func (m *Client) UploadUnassociatedArticleAttachment(ctx context.Context, filename string, file io.Reader, inline bool) (zendesk.ArticleAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadUnassociatedArticleAttachment", ctx, filename, file, inline)
+	ret0, _ := ret[0].(zendesk.ArticleAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadUnassociatedArticleAttachment indicates an expected call of UploadUnassociatedArticleAttachment.
+func (mr *ClientMockRecorder) UploadUnassociatedArticleAttachment(ctx, filename, file, inline any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadUnassociatedArticleAttachment", reflect.TypeOf((*Client)(nil).UploadUnassociatedArticleAttachment), ctx, filename, file, inline)
+}
+
+// UploadUserImage mocks base method.
+func (m *Client) UploadUserImage(ctx context.Context, contentType string, fileSize int64, file io.Reader) (zendesk.UserImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadUserImage", ctx, contentType, fileSize, file)
+	ret0, _ := ret[0].(zendesk.UserImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadUserImage indicates an expected call of UploadUserImage.
+func (mr *ClientMockRecorder) UploadUserImage(ctx, contentType, fileSize, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadUserImage", reflect.TypeOf((*Client)(nil).UploadUserImage), ctx, contentType, fileSize, file)
+}
+
+// UpsertCustomObjectRecord mocks base method.
+func (m *Client) UpsertCustomObjectRecord(ctx context.Context, customObjectKey, externalID string, record zendesk.CustomObjectRecord) (*zendesk.CustomObjectRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCustomObjectRecord", ctx, customObjectKey, externalID, record)
+	ret0, _ := ret[0].(*zendesk.CustomObjectRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertCustomObjectRecord indicates an expected call of UpsertCustomObjectRecord.
+func (mr *ClientMockRecorder) UpsertCustomObjectRecord(ctx, customObjectKey, externalID, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCustomObjectRecord", reflect.TypeOf((*Client)(nil).UpsertCustomObjectRecord), ctx, customObjectKey, externalID, record)
+}
+
+// WaitCustomObjectRecordsJob mocks base method.
+func (m *Client) WaitCustomObjectRecordsJob(ctx context.Context, id string, interval time.Duration) (zendesk.CustomObjectRecordsJobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitCustomObjectRecordsJob", ctx, id, interval)
+	ret0, _ := ret[0].(zendesk.CustomObjectRecordsJobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitCustomObjectRecordsJob indicates an expected call of WaitCustomObjectRecordsJob.
+func (mr *ClientMockRecorder) WaitCustomObjectRecordsJob(ctx, id, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitCustomObjectRecordsJob", reflect.TypeOf((*Client)(nil).WaitCustomObjectRecordsJob), ctx, id, interval)
+}
+
+// WaitThemeJob mocks base method.
+func (m *Client) WaitThemeJob(ctx context.Context, jobID string, interval time.Duration) (zendesk.ThemeJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitThemeJob", ctx, jobID, interval)
+	ret0, _ := ret[0].(zendesk.ThemeJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitThemeJob indicates an expected call of WaitThemeJob.
+func (mr *ClientMockRecorder) WaitThemeJob(ctx, jobID, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitThemeJob", reflect.TypeOf((*Client)(nil).WaitThemeJob), ctx, jobID, interval)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -13,7 +14,7 @@ type Organization struct {
 	ID                 int64                  `json:"id,omitempty"`
 	ExternalID         string                 `json:"external_id,omitempty"`
 	URL                string                 `json:"url,omitempty"`
-	Name               string                 `json:"name"`
+	Name               string                 `json:"name,omitempty"`
 	Details            string                 `json:"details,omitempty"`
 	DomainNames        []string               `json:"domain_names"`
 	GroupID            int64                  `json:"group_id"`
@@ -33,14 +34,87 @@ type OrganizationListOptions struct {
 	PageOptions
 }
 
+// OrganizationRelated contains the number of tickets and users of an organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organizations-related-information
+type OrganizationRelated struct {
+	TicketsCount int64 `json:"tickets_count"`
+	UsersCount   int64 `json:"users_count"`
+}
+
+// OrganizationSearchOptions is options for SearchOrganizations.
+// Either ExternalID or Name must be set.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations
+type OrganizationSearchOptions struct {
+	ExternalID string `url:"external_id,omitempty"`
+	Name       string `url:"name,omitempty"`
+}
+
+// GetManyOrganizationsOptions is options for GetManyOrganizations
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-many-organizations
+type GetManyOrganizationsOptions struct {
+	ExternalIDs string `json:"external_ids,omitempty" url:"external_ids,omitempty"`
+	IDs         string `json:"ids,omitempty" url:"ids,omitempty"`
+}
+
+// UpdateManyOrganizationsOptions selects the organizations updated by UpdateManyOrganizations.
+// Either IDs or ExternalIDs is required.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+type UpdateManyOrganizationsOptions struct {
+	IDs         []int64
+	ExternalIDs []string
+}
+
+// OrganizationUpdate is the change of an organization sent by UpdateManyOrganizations and
+// BatchUpdateManyOrganizations. Only the fields which are set are changed.
+// ID or ExternalID identifies the organization in BatchUpdateManyOrganizations.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+type OrganizationUpdate struct {
+	ID                 int64                  `json:"id,omitempty"`
+	ExternalID         string                 `json:"external_id,omitempty"`
+	Name               string                 `json:"name,omitempty"`
+	Details            *string                `json:"details,omitempty"`
+	Notes              *string                `json:"notes,omitempty"`
+	DomainNames        []string               `json:"domain_names,omitempty"`
+	GroupID            *int64                 `json:"group_id,omitempty"`
+	SharedTickets      *bool                  `json:"shared_tickets,omitempty"`
+	SharedComments     *bool                  `json:"shared_comments,omitempty"`
+	Tags               []string               `json:"tags,omitempty"`
+	OrganizationFields map[string]interface{} `json:"organization_fields,omitempty"`
+}
+
+// DeleteManyOrganizationsOptions selects the organizations deleted by DeleteManyOrganizations.
+// Either IDs or ExternalIDs is required.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#bulk-delete-organizations
+type DeleteManyOrganizationsOptions struct {
+	IDs         []int64
+	ExternalIDs []string
+}
+
 // OrganizationAPI an interface containing all methods associated with zendesk organizations
 type OrganizationAPI interface {
 	GetOrganizations(ctx context.Context, opts *OrganizationListOptions) ([]Organization, Page, error)
 	CreateOrganization(ctx context.Context, org Organization) (Organization, error)
 	GetOrganization(ctx context.Context, orgID int64) (Organization, error)
+	GetOrganizationsCount(ctx context.Context) (Count, error)
+	GetOrganizationRelated(ctx context.Context, orgID int64) (OrganizationRelated, error)
 	GetOrganizationByExternalID(ctx context.Context, externalID string) ([]Organization, Page, error)
+	FindOrganizationByExternalID(ctx context.Context, externalID string) (Organization, error)
+	SearchOrganizations(ctx context.Context, opts *OrganizationSearchOptions) ([]Organization, Page, error)
+	AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error)
+	GetManyOrganizations(ctx context.Context, opts *GetManyOrganizationsOptions) ([]Organization, Page, error)
 	UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error)
 	DeleteOrganization(ctx context.Context, orgID int64) error
+	CreateManyOrganizations(ctx context.Context, orgs []Organization) (JobStatus, error)
+	UpdateManyOrganizations(
+		ctx context.Context, opts *UpdateManyOrganizationsOptions, org OrganizationUpdate) (JobStatus, error)
+	BatchUpdateManyOrganizations(ctx context.Context, orgs []OrganizationUpdate) (JobStatus, error)
+	DeleteManyOrganizations(ctx context.Context, opts *DeleteManyOrganizationsOptions) (JobStatus, error)
 	GetOrganizationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Organization]
 	GetOrganizationsOBP(ctx context.Context, opts *OBPOptions) ([]Organization, Page, error)
 	GetOrganizationsCBP(ctx context.Context, opts *CBPOptions) ([]Organization, CursorPaginationMeta, error)
@@ -119,15 +193,87 @@ func (z *Client) GetOrganization(ctx context.Context, orgID int64) (Organization
 	return result.Organization, err
 }
 
-// GetOrganizationByExternalID gets a specified organization by external ID
+// GetOrganizationsCount returns an approximate count of organizations in the account
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#count-organizations
+func (z *Client) GetOrganizationsCount(ctx context.Context) (Count, error) {
+	return z.getCount(ctx, "/organizations/count.json")
+}
+
+// GetOrganizationRelated returns the number of tickets and users of the organization.
+// Use GetOrganizationUsersCBP and GetOrganizationTicketsCBP to list them.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-organizations-related-information
+func (z *Client) GetOrganizationRelated(ctx context.Context, orgID int64) (OrganizationRelated, error) {
+	var result struct {
+		OrganizationRelated OrganizationRelated `json:"organization_related"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organizations/%d/related.json", orgID))
+	if err != nil {
+		return OrganizationRelated{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationRelated{}, err
+	}
+
+	return result.OrganizationRelated, nil
+}
+
+// GetOrganizationByExternalID gets the organizations with the external ID.
+// It returns a *NotFoundError when no organization has the external ID.
+// Use FindOrganizationByExternalID to get a single organization.
 // ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations-by-external-id
 func (z *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]Organization, Page, error) {
+	orgs, page, err := z.SearchOrganizations(ctx, &OrganizationSearchOptions{ExternalID: externalID})
+	if err != nil {
+		return orgs, page, err
+	}
+
+	if len(orgs) == 0 {
+		return orgs, page, &NotFoundError{Resource: "organization", Query: externalID}
+	}
+	return orgs, page, nil
+}
+
+// FindOrganizationByExternalID gets the organization with the external ID.
+// It returns a *NotFoundError when no organization has the external ID,
+// and an *AmbiguousMatchError when several organizations share it.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations-by-external-id
+func (z *Client) FindOrganizationByExternalID(ctx context.Context, externalID string) (Organization, error) {
+	orgs, _, err := z.SearchOrganizations(ctx, &OrganizationSearchOptions{ExternalID: externalID})
+	if err != nil {
+		return Organization{}, err
+	}
+
+	switch len(orgs) {
+	case 0:
+		return Organization{}, &NotFoundError{Resource: "organization", Query: externalID}
+	case 1:
+		return orgs[0], nil
+	default:
+		return Organization{}, &AmbiguousMatchError{Resource: "organization", Query: externalID, Count: len(orgs)}
+	}
+}
+
+// SearchOrganizations returns the organizations matching the exact external id or name
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations
+func (z *Client) SearchOrganizations(ctx context.Context, opts *OrganizationSearchOptions) ([]Organization, Page, error) {
 	var result struct {
 		Organizations []Organization `json:"organizations"`
 		Page
 	}
 
-	body, err := z.get(ctx, fmt.Sprintf("/organizations/search?external_id=%s", externalID))
+	if opts == nil {
+		return []Organization{}, Page{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/organizations/search.json", opts)
+	if err != nil {
+		return []Organization{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
 	if err != nil {
 		return []Organization{}, Page{}, err
 	}
@@ -140,6 +286,67 @@ func (z *Client) GetOrganizationByExternalID(ctx context.Context, externalID str
 	return result.Organizations, result.Page, err
 }
 
+// AutocompleteOrganizations returns the organizations whose name starts with name.
+// The name must have at least two characters.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#autocomplete-organizations
+func (z *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	var result struct {
+		Organizations []Organization `json:"organizations"`
+	}
+
+	u, err := addOptions("/organizations/autocomplete.json", struct {
+		Name string `url:"name"`
+	}{name})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Organizations, nil
+}
+
+// GetManyOrganizations gets up to 100 organizations by ids or external ids
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#show-many-organizations
+func (z *Client) GetManyOrganizations(
+	ctx context.Context, opts *GetManyOrganizationsOptions,
+) ([]Organization, Page, error) {
+	var result struct {
+		Organizations []Organization `json:"organizations"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = new(GetManyOrganizationsOptions)
+	}
+
+	u, err := addOptions("/organizations/show_many.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	return result.Organizations, result.Page, nil
+}
+
 // UpdateOrganization updates a organization with the specified organization
 // ref: https://developer.zendesk.com/rest_api/docs/support/organizations#update-organization
 func (z *Client) UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error) {
@@ -172,3 +379,69 @@ func (z *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
 
 	return nil
 }
+
+// CreateManyOrganizations creates up to 100 organizations in a background job
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#create-many-organizations
+func (z *Client) CreateManyOrganizations(ctx context.Context, orgs []Organization) (JobStatus, error) {
+	var data struct {
+		Organizations []Organization `json:"organizations"`
+	}
+	data.Organizations = orgs
+
+	return z.postJobStatus(ctx, "/organizations/create_many.json", data)
+}
+
+// UpdateManyOrganizations applies the same update to up to 100 organizations in a background job
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+func (z *Client) UpdateManyOrganizations(
+	ctx context.Context, opts *UpdateManyOrganizationsOptions, org OrganizationUpdate,
+) (JobStatus, error) {
+	if opts == nil || (len(opts.IDs) == 0 && len(opts.ExternalIDs) == 0) {
+		return JobStatus{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/organizations/update_many.json", bulkIDsOptions{
+		IDs:         joinIDs(opts.IDs),
+		ExternalIDs: strings.Join(opts.ExternalIDs, ","),
+	})
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	var data struct {
+		Organization OrganizationUpdate `json:"organization"`
+	}
+	data.Organization = org
+
+	return z.putJobStatus(ctx, u, data)
+}
+
+// BatchUpdateManyOrganizations applies a different update to each of up to 100 organizations
+// in a background job. Each organization must have an ID or an ExternalID.
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-many-organizations
+func (z *Client) BatchUpdateManyOrganizations(ctx context.Context, orgs []OrganizationUpdate) (JobStatus, error) {
+	var data struct {
+		Organizations []OrganizationUpdate `json:"organizations"`
+	}
+	data.Organizations = orgs
+
+	return z.putJobStatus(ctx, "/organizations/update_many.json", data)
+}
+
+// DeleteManyOrganizations deletes up to 100 organizations in a background job
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#bulk-delete-organizations
+func (z *Client) DeleteManyOrganizations(ctx context.Context, opts *DeleteManyOrganizationsOptions) (JobStatus, error) {
+	if opts == nil || (len(opts.IDs) == 0 && len(opts.ExternalIDs) == 0) {
+		return JobStatus{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/organizations/destroy_many.json", bulkIDsOptions{
+		IDs:         joinIDs(opts.IDs),
+		ExternalIDs: strings.Join(opts.ExternalIDs, ","),
+	})
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return z.deleteJobStatus(ctx, u)
+}
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -32,6 +33,16 @@ type OrganizationField struct {
 type OrganizationFieldAPI interface {
 	GetOrganizationFields(ctx context.Context) ([]OrganizationField, Page, error)
 	CreateOrganizationField(ctx context.Context, organizationField OrganizationField) (OrganizationField, error)
+	GetOrganizationField(ctx context.Context, fieldID int64) (OrganizationField, error)
+	UpdateOrganizationField(
+		ctx context.Context, fieldID int64, organizationField OrganizationField) (OrganizationField, error)
+	DeleteOrganizationField(ctx context.Context, fieldID int64) error
+	ReorderOrganizationFields(ctx context.Context, fieldIDs []int64) error
+	GetOrganizationFieldOptions(ctx context.Context, fieldID int64) ([]CustomFieldOption, error)
+	GetOrganizationFieldOption(ctx context.Context, fieldID int64, optionID int64) (CustomFieldOption, error)
+	CreateOrUpdateOrganizationFieldOption(
+		ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error)
+	DeleteOrganizationFieldOption(ctx context.Context, fieldID int64, optionID int64) error
 	GetOrganizationFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationField]
 	GetOrganizationFieldsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationField, Page, error)
 	GetOrganizationFieldsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationField, CursorPaginationMeta, error)
@@ -76,3 +87,91 @@ func (z *Client) CreateOrganizationField(ctx context.Context, organizationField
 	}
 	return result.OrganizationField, nil
 }
+
+// GetOrganizationField gets a specified organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#show-organization-field
+func (z *Client) GetOrganizationField(ctx context.Context, fieldID int64) (OrganizationField, error) {
+	var result struct {
+		OrganizationField OrganizationField `json:"organization_field"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/organization_fields/%d.json", fieldID))
+	if err != nil {
+		return OrganizationField{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationField{}, err
+	}
+	return result.OrganizationField, nil
+}
+
+// UpdateOrganizationField updates a organization field with the specified organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#update-organization-field
+func (z *Client) UpdateOrganizationField(
+	ctx context.Context, fieldID int64, organizationField OrganizationField,
+) (OrganizationField, error) {
+	var data, result struct {
+		OrganizationField OrganizationField `json:"organization_field"`
+	}
+	data.OrganizationField = organizationField
+
+	body, err := z.put(ctx, fmt.Sprintf("/organization_fields/%d.json", fieldID), data)
+	if err != nil {
+		return OrganizationField{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationField{}, err
+	}
+	return result.OrganizationField, nil
+}
+
+// DeleteOrganizationField deletes the specified organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#delete-organization-field
+func (z *Client) DeleteOrganizationField(ctx context.Context, fieldID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/organization_fields/%d.json", fieldID), nil)
+}
+
+// ReorderOrganizationFields sets the position of the organization fields to their order in fieldIDs
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#reorder-organization-field
+func (z *Client) ReorderOrganizationFields(ctx context.Context, fieldIDs []int64) error {
+	var data struct {
+		OrganizationFieldIDs []int64 `json:"organization_field_ids"`
+	}
+	data.OrganizationFieldIDs = fieldIDs
+
+	_, err := z.put(ctx, "/organization_fields/reorder.json", data)
+	return err
+}
+
+// GetOrganizationFieldOptions lists the options of a dropdown organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#list-organization-field-options
+func (z *Client) GetOrganizationFieldOptions(ctx context.Context, fieldID int64) ([]CustomFieldOption, error) {
+	return z.getCustomFieldOptions(ctx, fmt.Sprintf("/organization_fields/%d/options.json", fieldID))
+}
+
+// GetOrganizationFieldOption gets an option of a dropdown organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#show-an-organization-field-option
+func (z *Client) GetOrganizationFieldOption(
+	ctx context.Context, fieldID int64, optionID int64,
+) (CustomFieldOption, error) {
+	return z.getCustomFieldOption(ctx, fmt.Sprintf("/organization_fields/%d/options", fieldID), optionID)
+}
+
+// CreateOrUpdateOrganizationFieldOption creates an option of a dropdown organization field,
+// or updates it when option.ID is set
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#create-or-update-an-organization-field-option
+func (z *Client) CreateOrUpdateOrganizationFieldOption(
+	ctx context.Context, fieldID int64, option CustomFieldOption,
+) (CustomFieldOption, error) {
+	return z.createOrUpdateCustomFieldOption(ctx, fmt.Sprintf("/organization_fields/%d/options", fieldID), option)
+}
+
+// DeleteOrganizationFieldOption deletes an option of a dropdown organization field
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_fields/#delete-organization-field-option
+func (z *Client) DeleteOrganizationFieldOption(ctx context.Context, fieldID int64, optionID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/organization_fields/%d/options/%d.json", fieldID, optionID), nil)
+}
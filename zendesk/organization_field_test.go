@@ -1,7 +1,9 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -30,3 +32,155 @@ func TestOrganizationField(t *testing.T) {
 		t.Fatalf("Failed to send request to create organization field: %s", err)
 	}
 }
+
+func TestGetOrganizationField(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_field.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	field, err := client.GetOrganizationField(ctx, 1110988024701)
+	if err != nil {
+		t.Fatalf("Failed to get organization field: %s", err)
+	}
+
+	if field.Key != "test_key" {
+		t.Fatalf("unexpected organization field key: %s", field.Key)
+	}
+}
+
+func TestUpdateOrganizationField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/organization_fields/1110988024701.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("GET/organization_field.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateOrganizationField(ctx, 1110988024701, OrganizationField{Title: "Title"})
+	if err != nil {
+		t.Fatalf("Failed to update organization field: %s", err)
+	}
+}
+
+func TestDeleteOrganizationField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/organization_fields/1110988024701.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteOrganizationField(ctx, 1110988024701)
+	if err != nil {
+		t.Fatalf("Failed to delete organization field: %s", err)
+	}
+}
+
+func TestReorderOrganizationFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/organization_fields/reorder.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			OrganizationFieldIDs []int64 `json:"organization_field_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.OrganizationFieldIDs) != 2 || data.OrganizationFieldIDs[0] != 2 {
+			t.Fatalf("unexpected organization field ids: %v", data.OrganizationFieldIDs)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderOrganizationFields(ctx, []int64{2, 1})
+	if err != nil {
+		t.Fatalf("Failed to reorder organization fields: %s", err)
+	}
+}
+
+func TestGetOrganizationFieldOptions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organization_fields/1/options.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/custom_field_options.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	options, err := client.GetOrganizationFieldOptions(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get organization field options: %s", err)
+	}
+
+	if len(options) != 2 {
+		t.Fatalf("expected length of options is 2, but got %d", len(options))
+	}
+}
+
+func TestGetOrganizationFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organization_fields/1/options/10001.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/custom_field_option.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	option, err := client.GetOrganizationFieldOption(ctx, 1, 10001)
+	if err != nil {
+		t.Fatalf("Failed to get organization field option: %s", err)
+	}
+
+	if option.Value != "banana" {
+		t.Fatalf("unexpected option value: %s", option.Value)
+	}
+}
+
+func TestCreateOrUpdateOrganizationFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/organization_fields/1/options.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("GET/custom_field_option.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	option, err := client.CreateOrUpdateOrganizationFieldOption(ctx, 1, CustomFieldOption{
+		Name:  "Bananas",
+		Value: "banana",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create organization field option: %s", err)
+	}
+
+	if option.ID != 10001 {
+		t.Fatalf("unexpected option id: %d", option.ID)
+	}
+}
+
+func TestDeleteOrganizationFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/organization_fields/1/options/10001.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteOrganizationFieldOption(ctx, 1, 10001)
+	if err != nil {
+		t.Fatalf("Failed to delete organization field option: %s", err)
+	}
+}
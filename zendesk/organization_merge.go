@@ -0,0 +1,124 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Organization merge statuses
+const (
+	OrganizationMergeNew        = "new"
+	OrganizationMergeInProgress = "in_progress"
+	OrganizationMergeError      = "error"
+	OrganizationMergeComplete   = "complete"
+)
+
+// OrganizationMerge is a job merging the loser organization into the winner organization.
+// Users, tickets and domain names of the loser are moved to the winner, then the loser is deleted.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_merges/#json-format
+type OrganizationMerge struct {
+	ID       string `json:"id"`
+	URL      string `json:"url,omitempty"`
+	LoserID  int64  `json:"loser_id"`
+	WinnerID int64  `json:"winner_id"`
+	Status   string `json:"status"`
+}
+
+// Done returns true if the merge has completed or failed
+func (m OrganizationMerge) Done() bool {
+	return m.Status == OrganizationMergeComplete || m.Status == OrganizationMergeError
+}
+
+// OrganizationMergeListOptions is options for GetOrganizationMerges
+type OrganizationMergeListOptions struct {
+	CursorPagination
+}
+
+// OrganizationMergeAPI an interface containing all organization merge related methods
+type OrganizationMergeAPI interface {
+	MergeOrganizations(ctx context.Context, orgID int64, intoOrgID int64) (OrganizationMerge, error)
+	GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error)
+	GetOrganizationMerges(
+		ctx context.Context, orgID int64, opts *OrganizationMergeListOptions,
+	) ([]OrganizationMerge, CursorPaginationMeta, error)
+}
+
+// MergeOrganizations starts a job merging the organization orgID into intoOrgID.
+// Use GetOrganizationMerge to follow the status of the returned merge.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_merges/#create-organization-merge
+func (z *Client) MergeOrganizations(ctx context.Context, orgID int64, intoOrgID int64) (OrganizationMerge, error) {
+	var data struct {
+		OrganizationMerge struct {
+			WinnerID int64 `json:"winner_id"`
+		} `json:"organization_merge"`
+	}
+	data.OrganizationMerge.WinnerID = intoOrgID
+
+	body, err := z.post(ctx, fmt.Sprintf("/organizations/%d/merge.json", orgID), data)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	return unmarshalOrganizationMerge(body)
+}
+
+// GetOrganizationMerge gets the status of an organization merge
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_merges/#show-organization-merge
+func (z *Client) GetOrganizationMerge(ctx context.Context, mergeID string) (OrganizationMerge, error) {
+	body, err := z.get(ctx, fmt.Sprintf("/organization_merges/%s.json", mergeID))
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+
+	return unmarshalOrganizationMerge(body)
+}
+
+// GetOrganizationMerges lists the merges involving the organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organization_merges/#list-organization-merges
+func (z *Client) GetOrganizationMerges(
+	ctx context.Context, orgID int64, opts *OrganizationMergeListOptions,
+) ([]OrganizationMerge, CursorPaginationMeta, error) {
+	var result struct {
+		OrganizationMerges []OrganizationMerge  `json:"organization_merges"`
+		Meta               CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OrganizationMergeListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/organizations/%d/merges.json", orgID), tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	return result.OrganizationMerges, result.Meta, nil
+}
+
+func unmarshalOrganizationMerge(body []byte) (OrganizationMerge, error) {
+	var result struct {
+		OrganizationMerge OrganizationMerge `json:"organization_merge"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return OrganizationMerge{}, err
+	}
+	return result.OrganizationMerge, nil
+}
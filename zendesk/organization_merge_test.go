@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMergeOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/organizations/123/merge.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			OrganizationMerge struct {
+				WinnerID int64 `json:"winner_id"`
+			} `json:"organization_merge"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if data.OrganizationMerge.WinnerID != 456 {
+			t.Fatalf("unexpected winner id: %d", data.OrganizationMerge.WinnerID)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/organization_merge.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.MergeOrganizations(ctx, 123, 456)
+	if err != nil {
+		t.Fatalf("Failed to merge organizations: %s", err)
+	}
+
+	if merge.ID != "01HPZM6206BF4G63783E5349AD" || merge.Done() {
+		t.Fatalf("unexpected organization merge: %+v", merge)
+	}
+}
+
+func TestGetOrganizationMerge(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_merge.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merge, err := client.GetOrganizationMerge(ctx, "01HPZM6206BF4G63783E5349AD")
+	if err != nil {
+		t.Fatalf("Failed to get organization merge: %s", err)
+	}
+
+	if !merge.Done() || merge.WinnerID != 456 {
+		t.Fatalf("unexpected organization merge: %+v", merge)
+	}
+}
+
+func TestGetOrganizationMerges(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organization_merges.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	merges, _, err := client.GetOrganizationMerges(ctx, 456, nil)
+	if err != nil {
+		t.Fatalf("Failed to get organization merges: %s", err)
+	}
+
+	if len(merges) != 2 {
+		t.Fatalf("expected length of organization merges is 2, but got %d", len(merges))
+	}
+}
@@ -1,6 +1,9 @@
 package zendesk
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -76,3 +79,270 @@ func TestDeleteOrganization(t *testing.T) {
 		t.Fatalf("Failed to delete organization: %s", err)
 	}
 }
+
+func TestGetOrganizationsCount(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetOrganizationsCount(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get organizations count: %s", err)
+	}
+
+	if count.Value != 102 {
+		t.Fatalf("expected count is 102, but got %d", count.Value)
+	}
+}
+
+func TestSearchOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/search.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if externalID := r.URL.Query().Get("external_id"); externalID != "acme&co" {
+			t.Fatalf("unexpected external_id: %s", externalID)
+		}
+		w.Write(readFixture("GET/organizations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, _, err := client.SearchOrganizations(ctx, &OrganizationSearchOptions{ExternalID: "acme&co"})
+	if err != nil {
+		t.Fatalf("Failed to search organizations: %s", err)
+	}
+
+	if len(orgs) != 2 {
+		t.Fatalf("expected length of organizations is 2, but got %d", len(orgs))
+	}
+
+	if _, _, err := client.SearchOrganizations(ctx, nil); err == nil {
+		t.Fatal("expected an error for nil options")
+	}
+}
+
+func TestAutocompleteOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/autocomplete.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if name := r.URL.Query().Get("name"); name != "ac" {
+			t.Fatalf("unexpected name: %s", name)
+		}
+		w.Write(readFixture("GET/organizations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, err := client.AutocompleteOrganizations(ctx, "ac")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete organizations: %s", err)
+	}
+
+	if len(orgs) != 2 {
+		t.Fatalf("expected length of organizations is 2, but got %d", len(orgs))
+	}
+}
+
+func TestGetManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/show_many.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if ids := r.URL.Query().Get("ids"); ids != "1,2" {
+			t.Fatalf("unexpected ids: %s", ids)
+		}
+		w.Write(readFixture("GET/organizations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, _, err := client.GetManyOrganizations(ctx, &GetManyOrganizationsOptions{IDs: "1,2"})
+	if err != nil {
+		t.Fatalf("Failed to get many organizations: %s", err)
+	}
+
+	if len(orgs) != 2 {
+		t.Fatalf("expected length of organizations is 2, but got %d", len(orgs))
+	}
+}
+
+func TestCreateManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/organizations/create_many.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("POST/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.CreateManyOrganizations(ctx, []Organization{{Name: "Acme"}, {Name: "Initech"}})
+	if err != nil {
+		t.Fatalf("Failed to create many organizations: %s", err)
+	}
+
+	if jobStatus.ID != "8b726e606741012ffc2d782bcb7848fe" {
+		t.Fatalf("unexpected job status id: %s", jobStatus.ID)
+	}
+}
+
+func TestUpdateManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/update_many.json" || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"organization":{"notes":"Priority customer","shared_tickets":false}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	notes, sharedTickets := "Priority customer", false
+	_, err := client.UpdateManyOrganizations(ctx, &UpdateManyOrganizationsOptions{IDs: []int64{1, 2}}, OrganizationUpdate{
+		Notes:         &notes,
+		SharedTickets: &sharedTickets,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update many organizations: %s", err)
+	}
+
+	_, err = client.UpdateManyOrganizations(ctx, nil, OrganizationUpdate{})
+	if _, ok := err.(*OptionsError); !ok {
+		t.Fatalf("unexpected error type: %v", err)
+	}
+}
+
+func TestBatchUpdateManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/update_many.json" || r.URL.RawQuery != "" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"organizations":[{"id":1,"notes":"a"},{"external_id":"acme","notes":"b"}]}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	a, b := "a", "b"
+	_, err := client.BatchUpdateManyOrganizations(ctx, []OrganizationUpdate{
+		{ID: 1, Notes: &a},
+		{ExternalID: "acme", Notes: &b},
+	})
+	if err != nil {
+		t.Fatalf("Failed to batch update many organizations: %s", err)
+	}
+}
+
+func TestDeleteManyOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("external_ids") != "a,b" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.DeleteManyOrganizations(ctx, &DeleteManyOrganizationsOptions{ExternalIDs: []string{"a", "b"}})
+	if err != nil {
+		t.Fatalf("Failed to delete many organizations: %s", err)
+	}
+
+	if jobStatus.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", jobStatus.Status)
+	}
+}
+
+func TestGetOrganizationRelated(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/123/related.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/organization_related.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	related, err := client.GetOrganizationRelated(ctx, 123)
+	if err != nil {
+		t.Fatalf("Failed to get organization related: %s", err)
+	}
+
+	if related.TicketsCount != 12 || related.UsersCount != 4 {
+		t.Fatalf("unexpected organization related: %+v", related)
+	}
+}
+
+func TestGetOrganizationByExternalID(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "organizations.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, _, err := client.GetOrganizationByExternalID(ctx, "acme")
+	if err != nil {
+		t.Fatalf("Failed to get organization by external id: %s", err)
+	}
+	if len(orgs) == 0 {
+		t.Fatal("expected organizations")
+	}
+}
+
+func TestGetOrganizationByExternalIDNotFound(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"organizations":[],"count":0}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.GetOrganizationByExternalID(ctx, "missing")
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) || notFound.Resource != "organization" || notFound.Query != "missing" {
+		t.Fatalf("expected a not found error, but got %v", err)
+	}
+}
+
+func TestFindOrganizationByExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/search.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("external_id") {
+		case "missing":
+			fmt.Fprint(w, `{"organizations":[],"count":0}`)
+		case "shared":
+			fmt.Fprint(w, `{"organizations":[{"id":1,"external_id":"shared"},{"id":2,"external_id":"shared"}],"count":2}`)
+		default:
+			fmt.Fprint(w, `{"organizations":[{"id":1,"external_id":"acme"}],"count":1}`)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	org, err := client.FindOrganizationByExternalID(ctx, "acme")
+	if err != nil {
+		t.Fatalf("Failed to find organization by external id: %s", err)
+	}
+	if org.ID != 1 {
+		t.Fatalf("unexpected organization: %v", org)
+	}
+
+	_, err = client.FindOrganizationByExternalID(ctx, "missing")
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) || notFound.Query != "missing" {
+		t.Fatalf("expected a not found error, but got %v", err)
+	}
+
+	_, err = client.FindOrganizationByExternalID(ctx, "shared")
+	var ambiguous *AmbiguousMatchError
+	if !errors.As(err, &ambiguous) || ambiguous.Count != 2 {
+		t.Fatalf("expected an ambiguous match error, but got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PermissionGroup is a Guide permission group, which sets the agent groups who can edit
+// and publish the articles of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#json-format
+type PermissionGroup struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	// BuiltIn is true for the permission groups created by Zendesk, which can't be deleted
+	BuiltIn bool `json:"built_in,omitempty"`
+	// Edit is the ids of the groups who can create and edit articles
+	Edit []int64 `json:"edit"`
+	// Publish is the ids of the groups who can publish articles
+	Publish   []int64   `json:"publish"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// PermissionGroupListOptions is options for ListPermissionGroups
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#list-permission-groups
+type PermissionGroupListOptions struct {
+	CursorPagination
+}
+
+// PermissionGroupAPI an interface containing all Guide permission group related methods
+type PermissionGroupAPI interface {
+	ListPermissionGroups(
+		ctx context.Context, opts *PermissionGroupListOptions) ([]PermissionGroup, CursorPaginationMeta, error)
+	GetPermissionGroup(ctx context.Context, permissionGroupID int64) (PermissionGroup, error)
+	CreatePermissionGroup(ctx context.Context, permissionGroup PermissionGroup) (PermissionGroup, error)
+	UpdatePermissionGroup(
+		ctx context.Context, permissionGroupID int64, permissionGroup PermissionGroup) (PermissionGroup, error)
+	DeletePermissionGroup(ctx context.Context, permissionGroupID int64) error
+}
+
+// ListPermissionGroups lists the permission groups of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#list-permission-groups
+func (z *Client) ListPermissionGroups(
+	ctx context.Context, opts *PermissionGroupListOptions,
+) ([]PermissionGroup, CursorPaginationMeta, error) {
+	var result struct {
+		PermissionGroups []PermissionGroup    `json:"permission_groups"`
+		Meta             CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PermissionGroupListOptions{}
+	}
+
+	u, err := addOptions("/guide/permission_groups.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.PermissionGroups, result.Meta, nil
+}
+
+// GetPermissionGroup gets a permission group
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#show-permission-group
+func (z *Client) GetPermissionGroup(ctx context.Context, permissionGroupID int64) (PermissionGroup, error) {
+	var result struct {
+		PermissionGroup PermissionGroup `json:"permission_group"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/guide/permission_groups/%d.json", permissionGroupID))
+	if err != nil {
+		return PermissionGroup{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return PermissionGroup{}, err
+	}
+	return result.PermissionGroup, nil
+}
+
+// CreatePermissionGroup creates a permission group
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#create-permission-group
+func (z *Client) CreatePermissionGroup(ctx context.Context, permissionGroup PermissionGroup) (PermissionGroup, error) {
+	var data, result struct {
+		PermissionGroup PermissionGroup `json:"permission_group"`
+	}
+	data.PermissionGroup = permissionGroup
+
+	body, err := z.post(ctx, "/guide/permission_groups.json", data)
+	if err != nil {
+		return PermissionGroup{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return PermissionGroup{}, err
+	}
+	return result.PermissionGroup, nil
+}
+
+// UpdatePermissionGroup updates a permission group.
+// Edit and Publish replace the groups of the permission group.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#update-permission-group
+func (z *Client) UpdatePermissionGroup(
+	ctx context.Context, permissionGroupID int64, permissionGroup PermissionGroup,
+) (PermissionGroup, error) {
+	var data, result struct {
+		PermissionGroup PermissionGroup `json:"permission_group"`
+	}
+	data.PermissionGroup = permissionGroup
+
+	body, err := z.put(ctx, fmt.Sprintf("/guide/permission_groups/%d.json", permissionGroupID), data)
+	if err != nil {
+		return PermissionGroup{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return PermissionGroup{}, err
+	}
+	return result.PermissionGroup, nil
+}
+
+// DeletePermissionGroup deletes a permission group.
+// The articles of the permission group must be moved to another permission group first.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/permission_groups/#delete-permission-group
+func (z *Client) DeletePermissionGroup(ctx context.Context, permissionGroupID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/guide/permission_groups/%d.json", permissionGroupID), nil)
+}
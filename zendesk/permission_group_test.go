@@ -0,0 +1,86 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListPermissionGroups(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "permission_groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, _, err := client.ListPermissionGroups(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list permission groups: %s", err)
+	}
+
+	if len(groups) != 2 || !groups[0].BuiltIn {
+		t.Fatalf("unexpected permission groups: %+v", groups)
+	}
+}
+
+func TestGetPermissionGroup(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "permission_group.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	group, err := client.GetPermissionGroup(ctx, 1627)
+	if err != nil {
+		t.Fatalf("Failed to get permission group: %s", err)
+	}
+
+	if len(group.Edit) != 2 || len(group.Publish) != 1 {
+		t.Fatalf("unexpected permission group: %+v", group)
+	}
+}
+
+func TestCreatePermissionGroup(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "permission_group.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreatePermissionGroup(ctx, PermissionGroup{
+		Name:    "Docs team",
+		Edit:    []int64{360001234, 360001235},
+		Publish: []int64{360001234},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create permission group: %s", err)
+	}
+}
+
+func TestUpdatePermissionGroup(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"edit":[360001234],"publish":[]`) {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/permission_group.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdatePermissionGroup(ctx, 1627, PermissionGroup{Edit: []int64{360001234}, Publish: []int64{}})
+	if err != nil {
+		t.Fatalf("Failed to update permission group: %s", err)
+	}
+}
+
+func TestDeletePermissionGroup(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/guide/permission_groups/1627.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeletePermissionGroup(ctx, 1627); err != nil {
+		t.Fatalf("Failed to delete permission group: %s", err)
+	}
+}
@@ -0,0 +1,267 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Values of Post.Status
+const (
+	PostStatusNone       = "none"
+	PostStatusPlanned    = "planned"
+	PostStatusNotPlanned = "not_planned"
+	PostStatusCompleted  = "completed"
+	PostStatusAnswered   = "answered"
+)
+
+// Post is a community post in a topic.
+// Featured, Pinned and Closed are pointers so that UpdatePost can set them to false.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#json-format
+type Post struct {
+	ID      int64  `json:"id,omitempty"`
+	URL     string `json:"url,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+	Title   string `json:"title,omitempty"`
+	Details string `json:"details,omitempty"`
+	TopicID int64  `json:"topic_id,omitempty"`
+	// AuthorID can be set by agents to post on behalf of another user
+	AuthorID int64 `json:"author_id,omitempty"`
+	// Status is one of the PostStatus values
+	Status   string `json:"status,omitempty"`
+	Featured *bool  `json:"featured,omitempty"`
+	Pinned   *bool  `json:"pinned,omitempty"`
+	// Closed posts can't receive new comments
+	Closed        *bool     `json:"closed,omitempty"`
+	ContentTagIDs []string  `json:"content_tag_ids,omitempty"`
+	VoteSum       int64     `json:"vote_sum,omitempty"`
+	VoteCount     int64     `json:"vote_count,omitempty"`
+	CommentCount  int64     `json:"comment_count,omitempty"`
+	FollowerCount int64     `json:"follower_count,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
+
+// PostListOptions is options for ListPosts.
+// The posts of a topic or of a user are listed when TopicID or UserID is set.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#list-posts
+type PostListOptions struct {
+	CursorPagination
+	TopicID int64 `url:"-"`
+	UserID  int64 `url:"-"`
+
+	// FilterBy is one of the PostStatus values
+	FilterBy string `url:"filter_by,omitempty"`
+	// SortBy can take "created_at", "edited_at", "updated_at", "recent_activity", "votes" or "comments"
+	SortBy string `url:"sort_by,omitempty"`
+}
+
+// PostAPI an interface containing all community post related methods
+type PostAPI interface {
+	ListPosts(ctx context.Context, opts *PostListOptions) ([]Post, CursorPaginationMeta, error)
+	GetPost(ctx context.Context, postID int64) (Post, error)
+	CreatePost(ctx context.Context, post Post, notifySubscribers bool) (Post, error)
+	UpdatePost(ctx context.Context, postID int64, post Post) (Post, error)
+	SetPostStatus(ctx context.Context, postID int64, status string) (Post, error)
+	SetPostPinned(ctx context.Context, postID int64, pinned bool) (Post, error)
+	SetPostFeatured(ctx context.Context, postID int64, featured bool) (Post, error)
+	SetPostClosed(ctx context.Context, postID int64, closed bool) (Post, error)
+	MovePost(ctx context.Context, postID int64, topicID int64) (Post, error)
+	DeletePost(ctx context.Context, postID int64) error
+}
+
+// ListPosts lists the community posts
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#list-posts
+func (z *Client) ListPosts(ctx context.Context, opts *PostListOptions) ([]Post, CursorPaginationMeta, error) {
+	var result struct {
+		Posts []Post               `json:"posts"`
+		Meta  CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PostListOptions{}
+	}
+
+	path := "/help_center/community"
+	if tmp.TopicID != 0 {
+		path += fmt.Sprintf("/topics/%d", tmp.TopicID)
+	} else if tmp.UserID != 0 {
+		path += fmt.Sprintf("/users/%d", tmp.UserID)
+	}
+
+	u, err := addOptions(path+"/posts.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Posts, result.Meta, nil
+}
+
+// GetPost gets a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#show-post
+func (z *Client) GetPost(ctx context.Context, postID int64) (Post, error) {
+	var result struct {
+		Post Post `json:"post"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/community/posts/%d.json", postID))
+	if err != nil {
+		return Post{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Post{}, err
+	}
+	return result.Post, nil
+}
+
+// CreatePost creates a community post in the topic of the post.
+// The subscribers of the topic are notified when notifySubscribers is true.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#create-post
+func (z *Client) CreatePost(ctx context.Context, post Post, notifySubscribers bool) (Post, error) {
+	var data struct {
+		Post              Post `json:"post"`
+		NotifySubscribers bool `json:"notify_subscribers"`
+	}
+	data.Post = post
+	data.NotifySubscribers = notifySubscribers
+
+	var result struct {
+		Post Post `json:"post"`
+	}
+
+	body, err := z.post(ctx, "/help_center/community/posts.json", data)
+	if err != nil {
+		return Post{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Post{}, err
+	}
+	return result.Post, nil
+}
+
+// UpdatePost updates a community post.
+// Moderators can change its status and feature, pin or close it.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#update-post
+func (z *Client) UpdatePost(ctx context.Context, postID int64, post Post) (Post, error) {
+	var data struct {
+		Post Post `json:"post"`
+	}
+	data.Post = post
+
+	return z.updatePost(ctx, postID, data)
+}
+
+// SetPostStatus sets the status of a community post, such as PostStatusAnswered
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#update-post
+func (z *Client) SetPostStatus(ctx context.Context, postID int64, status string) (Post, error) {
+	var data struct {
+		Post struct {
+			Status string `json:"status"`
+		} `json:"post"`
+	}
+	data.Post.Status = status
+
+	return z.updatePost(ctx, postID, data)
+}
+
+// SetPostPinned pins a community post at the top of its topic, or unpins it
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#update-post
+func (z *Client) SetPostPinned(ctx context.Context, postID int64, pinned bool) (Post, error) {
+	var data struct {
+		Post struct {
+			Pinned bool `json:"pinned"`
+		} `json:"post"`
+	}
+	data.Post.Pinned = pinned
+
+	return z.updatePost(ctx, postID, data)
+}
+
+// SetPostFeatured features a community post on the community home page, or unfeatures it
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#update-post
+func (z *Client) SetPostFeatured(ctx context.Context, postID int64, featured bool) (Post, error) {
+	var data struct {
+		Post struct {
+			Featured bool `json:"featured"`
+		} `json:"post"`
+	}
+	data.Post.Featured = featured
+
+	return z.updatePost(ctx, postID, data)
+}
+
+// SetPostClosed closes a community post for comments, or reopens it
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#update-post
+func (z *Client) SetPostClosed(ctx context.Context, postID int64, closed bool) (Post, error) {
+	var data struct {
+		Post struct {
+			Closed bool `json:"closed"`
+		} `json:"post"`
+	}
+	data.Post.Closed = closed
+
+	return z.updatePost(ctx, postID, data)
+}
+
+// MovePost moves a community post to another topic
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#update-post
+func (z *Client) MovePost(ctx context.Context, postID int64, topicID int64) (Post, error) {
+	var data struct {
+		Post struct {
+			TopicID int64 `json:"topic_id"`
+		} `json:"post"`
+	}
+	data.Post.TopicID = topicID
+
+	return z.updatePost(ctx, postID, data)
+}
+
+func (z *Client) updatePost(ctx context.Context, postID int64, data interface{}) (Post, error) {
+	var result struct {
+		Post Post `json:"post"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/community/posts/%d.json", postID), data)
+	if err != nil {
+		return Post{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Post{}, err
+	}
+	return result.Post, nil
+}
+
+// DeletePost deletes a community post with its comments
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/posts/#delete-post
+func (z *Client) DeletePost(ctx context.Context, postID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/community/posts/%d.json", postID), nil)
+}
@@ -0,0 +1,203 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PostComment is a comment on a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#json-format
+type PostComment struct {
+	ID      int64  `json:"id,omitempty"`
+	URL     string `json:"url,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+	Body    string `json:"body,omitempty"`
+	PostID  int64  `json:"post_id,omitempty"`
+	// AuthorID can be set by agents to comment on behalf of another user
+	AuthorID int64 `json:"author_id,omitempty"`
+	// Official is true when the comment is the official answer of the post
+	Official  bool      `json:"official,omitempty"`
+	VoteSum   int64     `json:"vote_sum,omitempty"`
+	VoteCount int64     `json:"vote_count,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// PostCommentListOptions is options for ListPostComments and ListUserPostComments
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#list-comments
+type PostCommentListOptions struct {
+	CursorPagination
+}
+
+// PostCommentAPI an interface containing all community post comment related methods
+type PostCommentAPI interface {
+	ListPostComments(
+		ctx context.Context, postID int64, opts *PostCommentListOptions) ([]PostComment, CursorPaginationMeta, error)
+	ListUserPostComments(
+		ctx context.Context, userID int64, opts *PostCommentListOptions) ([]PostComment, CursorPaginationMeta, error)
+	GetPostComment(ctx context.Context, postID int64, commentID int64) (PostComment, error)
+	CreatePostComment(
+		ctx context.Context, postID int64, comment PostComment, notifySubscribers bool) (PostComment, error)
+	UpdatePostComment(ctx context.Context, postID int64, commentID int64, comment PostComment) (PostComment, error)
+	SetPostCommentOfficial(ctx context.Context, postID int64, commentID int64, official bool) (PostComment, error)
+	DeletePostComment(ctx context.Context, postID int64, commentID int64) error
+}
+
+// ListPostComments lists the comments of a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#list-comments
+func (z *Client) ListPostComments(
+	ctx context.Context, postID int64, opts *PostCommentListOptions,
+) ([]PostComment, CursorPaginationMeta, error) {
+	return z.listPostComments(ctx, fmt.Sprintf("/help_center/community/posts/%d/comments.json", postID), opts)
+}
+
+// ListUserPostComments lists the comments a user made on community posts
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#list-comments
+func (z *Client) ListUserPostComments(
+	ctx context.Context, userID int64, opts *PostCommentListOptions,
+) ([]PostComment, CursorPaginationMeta, error) {
+	return z.listPostComments(ctx, fmt.Sprintf("/help_center/community/users/%d/comments.json", userID), opts)
+}
+
+func (z *Client) listPostComments(
+	ctx context.Context, path string, opts *PostCommentListOptions,
+) ([]PostComment, CursorPaginationMeta, error) {
+	var result struct {
+		Comments []PostComment        `json:"comments"`
+		Meta     CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PostCommentListOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Comments, result.Meta, nil
+}
+
+// GetPostComment gets a comment of a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#show-comment
+func (z *Client) GetPostComment(ctx context.Context, postID int64, commentID int64) (PostComment, error) {
+	var result struct {
+		Comment PostComment `json:"comment"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/community/posts/%d/comments/%d.json", postID, commentID))
+	if err != nil {
+		return PostComment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return PostComment{}, err
+	}
+	return result.Comment, nil
+}
+
+// CreatePostComment adds a comment to a community post.
+// The subscribers of the post are notified when notifySubscribers is true.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#create-comment
+func (z *Client) CreatePostComment(
+	ctx context.Context, postID int64, comment PostComment, notifySubscribers bool,
+) (PostComment, error) {
+	var data struct {
+		Comment           PostComment `json:"comment"`
+		NotifySubscribers bool        `json:"notify_subscribers"`
+	}
+	data.Comment = comment
+	data.NotifySubscribers = notifySubscribers
+
+	var result struct {
+		Comment PostComment `json:"comment"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/community/posts/%d/comments.json", postID), data)
+	if err != nil {
+		return PostComment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return PostComment{}, err
+	}
+	return result.Comment, nil
+}
+
+// UpdatePostComment updates a comment of a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#update-comment
+func (z *Client) UpdatePostComment(
+	ctx context.Context, postID int64, commentID int64, comment PostComment,
+) (PostComment, error) {
+	var data struct {
+		Comment PostComment `json:"comment"`
+	}
+	data.Comment = comment
+
+	return z.updatePostComment(ctx, postID, commentID, data)
+}
+
+// SetPostCommentOfficial marks a comment as the official answer of a community post,
+// or unmarks it when official is false
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#update-comment
+func (z *Client) SetPostCommentOfficial(
+	ctx context.Context, postID int64, commentID int64, official bool,
+) (PostComment, error) {
+	var data struct {
+		Comment struct {
+			Official bool `json:"official"`
+		} `json:"comment"`
+	}
+	data.Comment.Official = official
+
+	return z.updatePostComment(ctx, postID, commentID, data)
+}
+
+func (z *Client) updatePostComment(
+	ctx context.Context, postID int64, commentID int64, data interface{},
+) (PostComment, error) {
+	var result struct {
+		Comment PostComment `json:"comment"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/community/posts/%d/comments/%d.json", postID, commentID), data)
+	if err != nil {
+		return PostComment{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return PostComment{}, err
+	}
+	return result.Comment, nil
+}
+
+// DeletePostComment deletes a comment of a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/post_comments/#delete-comment
+func (z *Client) DeletePostComment(ctx context.Context, postID int64, commentID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/community/posts/%d/comments/%d.json", postID, commentID), nil)
+}
@@ -0,0 +1,80 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListPostComments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "post_comments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comments, _, err := client.ListPostComments(ctx, 900, nil)
+	if err != nil {
+		t.Fatalf("Failed to list post comments: %s", err)
+	}
+
+	if len(comments) != 2 {
+		t.Fatalf("expected length of comments is 2, but got %d", len(comments))
+	}
+}
+
+func TestGetPostComment(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "post_comment.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comment, err := client.GetPostComment(ctx, 900, 1200)
+	if err != nil {
+		t.Fatalf("Failed to get post comment: %s", err)
+	}
+
+	if !comment.Official {
+		t.Fatal("expected the comment to be official")
+	}
+}
+
+func TestCreatePostComment(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "post_comment.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreatePostComment(ctx, 900, PostComment{Body: "This is on our roadmap for next quarter."}, false)
+	if err != nil {
+		t.Fatalf("Failed to create post comment: %s", err)
+	}
+}
+
+func TestSetPostCommentOfficial(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path != "/help_center/community/posts/900/comments/1200.json" || string(body) != `{"comment":{"official":true}}` {
+			t.Fatalf("unexpected request: %s %s", r.URL.Path, body)
+		}
+		w.Write(readFixture("PUT/post_comment.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.SetPostCommentOfficial(ctx, 900, 1200, true); err != nil {
+		t.Fatalf("Failed to mark post comment as official: %s", err)
+	}
+}
+
+func TestDeletePostComment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/community/posts/900/comments/1200.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeletePostComment(ctx, 900, 1200); err != nil {
+		t.Fatalf("Failed to delete post comment: %s", err)
+	}
+}
@@ -0,0 +1,126 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListPosts(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/community/topics/115000553548/posts.json" || r.URL.Query().Get("filter_by") != "planned" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/posts.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	posts, _, err := client.ListPosts(ctx, &PostListOptions{TopicID: 115000553548, FilterBy: PostStatusPlanned})
+	if err != nil {
+		t.Fatalf("Failed to list posts: %s", err)
+	}
+
+	if len(posts) != 2 {
+		t.Fatalf("expected length of posts is 2, but got %d", len(posts))
+	}
+}
+
+func TestGetPost(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "post.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	post, err := client.GetPost(ctx, 900)
+	if err != nil {
+		t.Fatalf("Failed to get post: %s", err)
+	}
+
+	if post.Status != PostStatusPlanned || post.Closed == nil || *post.Closed {
+		t.Fatalf("unexpected post: %+v", post)
+	}
+}
+
+func TestCreatePost(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "post.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreatePost(ctx, Post{Title: "Export reports as CSV", TopicID: 115000553548}, true)
+	if err != nil {
+		t.Fatalf("Failed to create post: %s", err)
+	}
+}
+
+func TestUpdatePost(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"featured":false,"closed":true`) {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/post.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	featured, closed := false, true
+	if _, err := client.UpdatePost(ctx, 900, Post{Featured: &featured, Closed: &closed}); err != nil {
+		t.Fatalf("Failed to update post: %s", err)
+	}
+}
+
+func TestDeletePost(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/community/posts/900.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeletePost(ctx, 900); err != nil {
+		t.Fatalf("Failed to delete post: %s", err)
+	}
+}
+
+func TestPostModerationHelpers(t *testing.T) {
+	var body string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/help_center/community/posts/900.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.Write(readFixture("PUT/post.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tests := []struct {
+		name     string
+		update   func() (Post, error)
+		expected string
+	}{
+		{"status", func() (Post, error) { return client.SetPostStatus(ctx, 900, PostStatusAnswered) },
+			`{"post":{"status":"answered"}}`},
+		{"pinned", func() (Post, error) { return client.SetPostPinned(ctx, 900, false) },
+			`{"post":{"pinned":false}}`},
+		{"featured", func() (Post, error) { return client.SetPostFeatured(ctx, 900, true) },
+			`{"post":{"featured":true}}`},
+		{"closed", func() (Post, error) { return client.SetPostClosed(ctx, 900, true) },
+			`{"post":{"closed":true}}`},
+		{"topic", func() (Post, error) { return client.MovePost(ctx, 900, 115000553549) },
+			`{"post":{"topic_id":115000553549}}`},
+	}
+	for _, test := range tests {
+		if _, err := test.update(); err != nil {
+			t.Fatalf("Failed to update post %s: %s", test.name, err)
+		}
+		if body != test.expected {
+			t.Fatalf("unexpected body to update post %s: %s", test.name, body)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// RuleDefinition describes a condition or an action supported by triggers, automations or views,
+// with the operators and the values it accepts
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-trigger-action-and-condition-definitions
+type RuleDefinition struct {
+	Group      string `json:"group"`
+	Nullable   bool   `json:"nullable"`
+	Repeatable bool   `json:"repeatable"`
+	Subject    string `json:"subject"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+	Operators  []struct {
+		Terminal bool   `json:"terminal"`
+		Title    string `json:"title"`
+		Value    string `json:"value"`
+	} `json:"operators,omitempty"`
+	Values []struct {
+		Enabled bool   `json:"enabled"`
+		Title   string `json:"title"`
+		Value   string `json:"value"`
+	} `json:"values,omitempty"`
+}
+
+// RuleDefinitions is the list of the conditions supported by "all" and "any" conditions
+// and the list of the supported actions. Views have no actions.
+type RuleDefinitions struct {
+	ConditionsAll []RuleDefinition `json:"conditions_all"`
+	ConditionsAny []RuleDefinition `json:"conditions_any"`
+	Actions       []RuleDefinition `json:"actions,omitempty"`
+}
+
+// GetTriggerDefinitions returns the definitions of the conditions and the actions of triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-trigger-action-and-condition-definitions
+func (z *Client) GetTriggerDefinitions(ctx context.Context) (RuleDefinitions, error) {
+	return z.getRuleDefinitions(ctx, "/triggers/definitions.json")
+}
+
+// GetAutomationDefinitions returns the definitions of the conditions and the actions of automations
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/automations/
+func (z *Client) GetAutomationDefinitions(ctx context.Context) (RuleDefinitions, error) {
+	return z.getRuleDefinitions(ctx, "/automations/definitions.json")
+}
+
+// GetViewDefinitions returns the definitions of the conditions of views
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-view-definitions
+func (z *Client) GetViewDefinitions(ctx context.Context) (RuleDefinitions, error) {
+	return z.getRuleDefinitions(ctx, "/views/definitions.json")
+}
+
+func (z *Client) getRuleDefinitions(ctx context.Context, path string) (RuleDefinitions, error) {
+	var result struct {
+		Definitions RuleDefinitions `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return RuleDefinitions{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return RuleDefinitions{}, err
+	}
+	return result.Definitions, nil
+}
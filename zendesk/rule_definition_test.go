@@ -0,0 +1,42 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetRuleDefinitions(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write(readFixture("GET/rule_definitions.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetTriggerDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get trigger definitions: %s", err)
+	}
+	if len(definitions.Actions) != 1 || len(definitions.ConditionsAll) != 1 || len(definitions.ConditionsAny) != 1 {
+		t.Fatalf("unexpected definitions: %+v", definitions)
+	}
+	if len(definitions.ConditionsAll[0].Operators) != 2 || !definitions.ConditionsAny[0].Operators[0].Terminal {
+		t.Fatalf("unexpected operators: %+v", definitions)
+	}
+
+	if _, err := client.GetAutomationDefinitions(ctx); err != nil {
+		t.Fatalf("Failed to get automation definitions: %s", err)
+	}
+	if _, err := client.GetViewDefinitions(ctx); err != nil {
+		t.Fatalf("Failed to get view definitions: %s", err)
+	}
+
+	expected := []string{"/triggers/definitions.json", "/automations/definitions.json", "/views/definitions.json"}
+	for i, path := range expected {
+		if paths[i] != path {
+			t.Fatalf("expected path is %s, but got %s", path, paths[i])
+		}
+	}
+}
@@ -49,6 +49,47 @@ func TestCountTickets(t *testing.T) {
 	}
 }
 
+func TestSearchExport(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/export.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if filterType := r.URL.Query().Get("filter[type]"); filterType != "ticket" {
+			t.Fatalf("unexpected filter[type]: %s", filterType)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "search_export_ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, meta, err := client.SearchExport(ctx, &SearchExportOptions{
+		CursorPagination: CursorPagination{PageSize: 1},
+		Query:            "status:new",
+		FilterType:       "ticket",
+	})
+	if err != nil {
+		t.Fatalf("Failed to export search results: %s", err)
+	}
+
+	list := results.List()
+	if len(list) != 1 {
+		t.Fatalf("expected length of search results is 1, but got %d", len(list))
+	}
+	if _, ok := list[0].(Ticket); !ok {
+		t.Fatalf("Cannot assert %v as a ticket", list[0])
+	}
+	if !meta.HasMore || meta.AfterCursor == "" {
+		t.Fatalf("unexpected cursor meta: %+v", meta)
+	}
+}
+
+func TestSearchExportWithoutOptions(t *testing.T) {
+	client, _ := NewClient(nil)
+	if _, _, err := client.SearchExport(ctx, nil); err == nil {
+		t.Fatal("SearchExport should fail without options")
+	}
+}
+
 func BenchmarkUnmarshalSearchResults(b *testing.B) {
 	file := readFixture("ticket_result.json")
 	for i := 0; i < b.N; i++ {
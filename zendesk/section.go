@@ -0,0 +1,245 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Section is a Help Center section, which contains articles and belongs to a category
+// or to a parent section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#json-format
+type Section struct {
+	ID              int64  `json:"id,omitempty"`
+	URL             string `json:"url,omitempty"`
+	HTMLURL         string `json:"html_url,omitempty"`
+	CategoryID      int64  `json:"category_id,omitempty"`
+	ParentSectionID *int64 `json:"parent_section_id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	Description     string `json:"description,omitempty"`
+	Locale          string `json:"locale,omitempty"`
+	SourceLocale    string `json:"source_locale,omitempty"`
+	Outdated        bool   `json:"outdated,omitempty"`
+	Position        int64  `json:"position,omitempty"`
+	// Sorting is the order of the articles of the section.
+	// It can take "manual", "created_at", "updated_at", "title" or "vote_sum".
+	Sorting       string    `json:"sorting,omitempty"`
+	ThemeTemplate string    `json:"theme_template,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
+
+// SectionListOptions is options for ListSections.
+// The sections are scoped to CategoryID when it is set, and to Locale when it is set.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#list-sections
+type SectionListOptions struct {
+	CursorPagination
+	Locale     string `url:"-"`
+	CategoryID int64  `url:"-"`
+
+	// SortBy can take "position", "created_at" or "updated_at"
+	SortBy string `url:"sort_by,omitempty"`
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// SectionAPI an interface containing all Help Center section related methods
+type SectionAPI interface {
+	ListSections(ctx context.Context, opts *SectionListOptions) ([]Section, CursorPaginationMeta, error)
+	GetSection(ctx context.Context, sectionID int64) (Section, error)
+	CreateSection(ctx context.Context, categoryID int64, section Section) (Section, error)
+	UpdateSection(ctx context.Context, sectionID int64, section Section) (Section, error)
+	UpdateSectionPosition(ctx context.Context, sectionID int64, position int64) (Section, error)
+	MoveSection(ctx context.Context, sectionID int64, categoryID int64) (Section, error)
+	DeleteSection(ctx context.Context, sectionID int64) error
+	ListSectionTranslations(ctx context.Context, sectionID int64) ([]Translation, error)
+	GetSectionTranslation(ctx context.Context, sectionID int64, locale string) (Translation, error)
+	CreateSectionTranslation(ctx context.Context, sectionID int64, translation Translation) (Translation, error)
+	UpdateSectionTranslation(
+		ctx context.Context, sectionID int64, locale string, translation Translation) (Translation, error)
+}
+
+// ListSections lists the sections of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#list-sections
+func (z *Client) ListSections(
+	ctx context.Context, opts *SectionListOptions,
+) ([]Section, CursorPaginationMeta, error) {
+	var result struct {
+		Sections []Section            `json:"sections"`
+		Meta     CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SectionListOptions{}
+	}
+
+	path := "/help_center"
+	if tmp.Locale != "" {
+		path += "/" + tmp.Locale
+	}
+	if tmp.CategoryID != 0 {
+		path += fmt.Sprintf("/categories/%d", tmp.CategoryID)
+	}
+
+	u, err := addOptions(path+"/sections.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Sections, result.Meta, nil
+}
+
+// GetSection gets a section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#show-section
+func (z *Client) GetSection(ctx context.Context, sectionID int64) (Section, error) {
+	var result struct {
+		Section Section `json:"section"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/sections/%d.json", sectionID))
+	if err != nil {
+		return Section{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Section{}, err
+	}
+	return result.Section, nil
+}
+
+// CreateSection creates a section in a category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#create-section
+func (z *Client) CreateSection(ctx context.Context, categoryID int64, section Section) (Section, error) {
+	var data, result struct {
+		Section Section `json:"section"`
+	}
+	data.Section = section
+
+	body, err := z.post(ctx, fmt.Sprintf("/help_center/categories/%d/sections.json", categoryID), data)
+	if err != nil {
+		return Section{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Section{}, err
+	}
+	return result.Section, nil
+}
+
+// UpdateSection updates a section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#update-section
+func (z *Client) UpdateSection(ctx context.Context, sectionID int64, section Section) (Section, error) {
+	var data struct {
+		Section Section `json:"section"`
+	}
+	data.Section = section
+
+	return z.updateSection(ctx, sectionID, data)
+}
+
+// UpdateSectionPosition moves a section to position in its category or parent section.
+// Position 0 is the first position.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#update-section
+func (z *Client) UpdateSectionPosition(ctx context.Context, sectionID int64, position int64) (Section, error) {
+	var data struct {
+		Section struct {
+			Position int64 `json:"position"`
+		} `json:"section"`
+	}
+	data.Section.Position = position
+
+	return z.updateSection(ctx, sectionID, data)
+}
+
+// MoveSection moves a section with its articles to another category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#update-section
+func (z *Client) MoveSection(ctx context.Context, sectionID int64, categoryID int64) (Section, error) {
+	var data struct {
+		Section struct {
+			CategoryID int64 `json:"category_id"`
+		} `json:"section"`
+	}
+	data.Section.CategoryID = categoryID
+
+	return z.updateSection(ctx, sectionID, data)
+}
+
+func (z *Client) updateSection(ctx context.Context, sectionID int64, data interface{}) (Section, error) {
+	var result struct {
+		Section Section `json:"section"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/sections/%d.json", sectionID), data)
+	if err != nil {
+		return Section{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Section{}, err
+	}
+	return result.Section, nil
+}
+
+// DeleteSection deletes a section with its articles
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/sections/#delete-section
+func (z *Client) DeleteSection(ctx context.Context, sectionID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/sections/%d.json", sectionID), nil)
+}
+
+// ListSectionTranslations lists the translations of a section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-translations
+func (z *Client) ListSectionTranslations(ctx context.Context, sectionID int64) ([]Translation, error) {
+	return z.getTranslations(ctx, fmt.Sprintf("/help_center/sections/%d/translations.json", sectionID))
+}
+
+// GetSectionTranslation gets the translation of a section in a locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#show-translation
+func (z *Client) GetSectionTranslation(ctx context.Context, sectionID int64, locale string) (Translation, error) {
+	return z.getTranslation(ctx, fmt.Sprintf("/help_center/sections/%d/translations/%s.json", sectionID, locale))
+}
+
+// CreateSectionTranslation creates the translation of a section in the locale of the translation
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#create-translation
+func (z *Client) CreateSectionTranslation(
+	ctx context.Context, sectionID int64, translation Translation,
+) (Translation, error) {
+	return z.saveTranslation(ctx, http.MethodPost,
+		fmt.Sprintf("/help_center/sections/%d/translations.json", sectionID), translation)
+}
+
+// UpdateSectionTranslation updates the translation of a section in a locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#update-translation
+func (z *Client) UpdateSectionTranslation(
+	ctx context.Context, sectionID int64, locale string, translation Translation,
+) (Translation, error) {
+	return z.saveTranslation(ctx, http.MethodPut,
+		fmt.Sprintf("/help_center/sections/%d/translations/%s.json", sectionID, locale), translation)
+}
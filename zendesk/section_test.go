@@ -0,0 +1,125 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListSections(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/categories/37486578/sections.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/sections.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sections, _, err := client.ListSections(ctx, &SectionListOptions{CategoryID: 37486578})
+	if err != nil {
+		t.Fatalf("Failed to list sections: %s", err)
+	}
+
+	if len(sections) != 2 {
+		t.Fatalf("expected length of sections is 2, but got %d", len(sections))
+	}
+	if sections[0].ParentSectionID != nil || *sections[1].ParentSectionID != 98838 {
+		t.Fatalf("unexpected parent sections: %v %v", sections[0].ParentSectionID, sections[1].ParentSectionID)
+	}
+}
+
+func TestGetSection(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "section.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	section, err := client.GetSection(ctx, 98838)
+	if err != nil {
+		t.Fatalf("Failed to get section: %s", err)
+	}
+
+	if section.Sorting != "manual" {
+		t.Fatalf("expected sorting is manual, but got %s", section.Sorting)
+	}
+}
+
+func TestCreateSection(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/help_center/categories/37486578/sections.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/section.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateSection(ctx, 37486578, Section{Name: "Sign in", Locale: "en-us"})
+	if err != nil {
+		t.Fatalf("Failed to create section: %s", err)
+	}
+}
+
+func TestMoveSection(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || string(body) != `{"section":{"category_id":37486579}}` {
+			t.Fatalf("unexpected request: %s %s", r.Method, body)
+		}
+		w.Write(readFixture("PUT/section.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.MoveSection(ctx, 98838, 37486579); err != nil {
+		t.Fatalf("Failed to move section: %s", err)
+	}
+}
+
+func TestUpdateSectionPosition(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"section":{"position":2}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/section.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateSectionPosition(ctx, 98838, 2); err != nil {
+		t.Fatalf("Failed to update section position: %s", err)
+	}
+}
+
+func TestDeleteSection(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/sections/98838.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteSection(ctx, 98838); err != nil {
+		t.Fatalf("Failed to delete section: %s", err)
+	}
+}
+
+func TestUpdateSectionTranslation(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/help_center/sections/98838/translations/fr.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("PUT/translation.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateSectionTranslation(ctx, 98838, "fr", Translation{Locale: "fr", Title: "Connexion"}); err != nil {
+		t.Fatalf("Failed to update section translation: %s", err)
+	}
+}
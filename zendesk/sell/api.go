@@ -0,0 +1,18 @@
+package sell
+
+// API an interface containing all of the Sell client methods
+type API interface {
+	ContactAPI
+	CustomFieldAPI
+	DealAPI
+	FirehoseAPI
+	LeadAPI
+	NoteAPI
+	ProductAPI
+	SearchAPI
+	SyncAPI
+	TaskAPI
+	VisitAPI
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,123 @@
+package sell
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Contact is a Sell contact, a person or an organization
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/
+type Contact struct {
+	ID        int64 `json:"id,omitempty"`
+	CreatorID int64 `json:"creator_id,omitempty"`
+	OwnerID   int64 `json:"owner_id,omitempty"`
+	// IsOrganization can't be changed after the contact is created
+	IsOrganization bool `json:"is_organization,omitempty"`
+	// ContactID is the organization of a person
+	ContactID            int64                  `json:"contact_id,omitempty"`
+	ParentOrganizationID int64                  `json:"parent_organization_id,omitempty"`
+	Name                 string                 `json:"name,omitempty"`
+	FirstName            string                 `json:"first_name,omitempty"`
+	LastName             string                 `json:"last_name,omitempty"`
+	CustomerStatus       string                 `json:"customer_status,omitempty"`
+	ProspectStatus       string                 `json:"prospect_status,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Industry             string                 `json:"industry,omitempty"`
+	Website              string                 `json:"website,omitempty"`
+	Email                string                 `json:"email,omitempty"`
+	Phone                string                 `json:"phone,omitempty"`
+	Mobile               string                 `json:"mobile,omitempty"`
+	Fax                  string                 `json:"fax,omitempty"`
+	Twitter              string                 `json:"twitter,omitempty"`
+	Facebook             string                 `json:"facebook,omitempty"`
+	Linkedin             string                 `json:"linkedin,omitempty"`
+	Skype                string                 `json:"skype,omitempty"`
+	Address              *Address               `json:"address,omitempty"`
+	BillingAddress       *Address               `json:"billing_address,omitempty"`
+	ShippingAddress      *Address               `json:"shipping_address,omitempty"`
+	Tags                 []string               `json:"tags,omitempty"`
+	CustomFields         map[string]interface{} `json:"custom_fields,omitempty"`
+	CreatedAt            *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt            *time.Time             `json:"updated_at,omitempty"`
+}
+
+// ContactListOptions is options for ListContacts
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/#retrieve-all-contacts
+type ContactListOptions struct {
+	ListOptions
+	IDs            string `url:"ids,omitempty"`
+	CreatorID      int64  `url:"creator_id,omitempty"`
+	OwnerID        int64  `url:"owner_id,omitempty"`
+	IsOrganization *bool  `url:"is_organization,omitempty"`
+	ContactID      int64  `url:"contact_id,omitempty"`
+	Name           string `url:"name,omitempty"`
+	FirstName      string `url:"first_name,omitempty"`
+	LastName       string `url:"last_name,omitempty"`
+	Email          string `url:"email,omitempty"`
+	Phone          string `url:"phone,omitempty"`
+	CustomerStatus string `url:"customer_status,omitempty"`
+	ProspectStatus string `url:"prospect_status,omitempty"`
+}
+
+// ContactAPI an interface containing all Sell contact related methods
+type ContactAPI interface {
+	ListContacts(ctx context.Context, opts *ContactListOptions) ([]Contact, ListMeta, error)
+	GetContact(ctx context.Context, contactID int64) (Contact, error)
+	CreateContact(ctx context.Context, contact Contact) (Contact, error)
+	UpdateContact(ctx context.Context, contactID int64, contact Contact) (Contact, error)
+	UpsertContact(ctx context.Context, filter map[string]string, contact Contact) (Contact, error)
+	DeleteContact(ctx context.Context, contactID int64) error
+}
+
+// ListContacts lists the contacts
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/#retrieve-all-contacts
+func (c *Client) ListContacts(ctx context.Context, opts *ContactListOptions) ([]Contact, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &ContactListOptions{}
+	}
+	return listItems[Contact](ctx, c, "/contacts", tmp)
+}
+
+// GetContact gets a contact
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/#retrieve-a-single-contact
+func (c *Client) GetContact(ctx context.Context, contactID int64) (Contact, error) {
+	return getItem[Contact](ctx, c, fmt.Sprintf("/contacts/%d", contactID))
+}
+
+// CreateContact creates a contact. Name is required by organizations and LastName by people.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/#create-a-contact
+func (c *Client) CreateContact(ctx context.Context, contact Contact) (Contact, error) {
+	return sendItem[Contact](ctx, c, http.MethodPost, "/contacts", contact)
+}
+
+// UpdateContact updates the fields of a contact which are set
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/#update-a-contact
+func (c *Client) UpdateContact(ctx context.Context, contactID int64, contact Contact) (Contact, error) {
+	return sendItem[Contact](ctx, c, http.MethodPut, fmt.Sprintf("/contacts/%d", contactID), contact)
+}
+
+// UpsertContact updates the contact matching filter or creates a contact when none matches.
+// filter is keyed by contact attributes or custom fields, such as "email" or "custom_fields[support_user_id]".
+// It fails when more than one contact matches.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/#upsert-a-contact
+func (c *Client) UpsertContact(ctx context.Context, filter map[string]string, contact Contact) (Contact, error) {
+	return sendItem[Contact](ctx, c, http.MethodPost, upsertPath("/contacts/upsert", filter), contact)
+}
+
+// DeleteContact deletes a contact
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/contacts/#delete-a-contact
+func (c *Client) DeleteContact(ctx context.Context, contactID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/contacts/%d", contactID))
+}
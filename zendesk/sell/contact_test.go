@@ -0,0 +1,88 @@
+package sell
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListContacts(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/contacts" || r.URL.Query().Get("is_organization") != "true" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/sell_contacts.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	isOrganization := true
+	contacts, meta, err := client.ListContacts(ctx, &ContactListOptions{IsOrganization: &isOrganization})
+	if err != nil {
+		t.Fatalf("Failed to list contacts: %s", err)
+	}
+
+	if len(contacts) != 2 || !contacts[0].IsOrganization {
+		t.Fatalf("unexpected contacts: %+v", contacts)
+	}
+	if meta.HasMore() {
+		t.Fatalf("unexpected meta: %+v", meta)
+	}
+}
+
+func TestGetContact(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_contact.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	contact, err := client.GetContact(ctx, 2002)
+	if err != nil {
+		t.Fatalf("Failed to get contact: %s", err)
+	}
+
+	if contact.ContactID != 2001 || contact.LastName != "Johnson" {
+		t.Fatalf("unexpected contact: %+v", contact)
+	}
+}
+
+func TestUpsertContact(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"data":{"last_name":"Johnson","custom_fields":{"support_user_id":"35436"}}}`
+		if r.Method != http.MethodPost || r.URL.Path != "/contacts/upsert" ||
+			r.URL.Query().Get("custom_fields[support_user_id]") != "35436" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.String(), body)
+		}
+		w.Write(readFixture("POST/sell_contact.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	contact, err := client.UpsertContact(ctx, map[string]string{"custom_fields[support_user_id]": "35436"}, Contact{
+		LastName:     "Johnson",
+		CustomFields: map[string]interface{}{"support_user_id": "35436"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to upsert contact: %s", err)
+	}
+
+	if contact.ID != 2002 {
+		t.Fatalf("unexpected contact: %+v", contact)
+	}
+}
+
+func TestDeleteContact(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/contacts/2002" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteContact(ctx, 2002); err != nil {
+		t.Fatalf("Failed to delete contact: %s", err)
+	}
+}
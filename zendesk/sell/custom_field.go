@@ -0,0 +1,89 @@
+package sell
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// ResourceTypeProspectAndCustomer is the resource type of the custom fields of the prospects and customers
+const ResourceTypeProspectAndCustomer = "prospect_and_customer"
+
+// Sell custom field types
+const (
+	CustomFieldTypeString          = "string"
+	CustomFieldTypeNumber          = "number"
+	CustomFieldTypeList            = "list"
+	CustomFieldTypeMultiSelectList = "multi_select_list"
+	CustomFieldTypeBool            = "bool"
+	CustomFieldTypeDate            = "date"
+	CustomFieldTypeDateTime        = "datetime"
+	CustomFieldTypePhone           = "phone"
+	CustomFieldTypeEmail           = "email"
+	CustomFieldTypeURL             = "url"
+	CustomFieldTypeAddress         = "address"
+)
+
+// CustomFieldChoice is a choice of a list or multi select list custom field
+type CustomFieldChoice struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// CustomField is the definition of a Sell custom field.
+// The values of the custom fields are in the CustomFields of the resources, keyed by Name.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/custom_fields/
+type CustomField struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	// Type is one of the CustomFieldType constants
+	Type string `json:"type"`
+	// ForContact and ForCompany tell if a contact custom field applies to people and to organizations
+	ForContact  bool                `json:"for_contact,omitempty"`
+	ForCompany  bool                `json:"for_company,omitempty"`
+	Choices     []CustomFieldChoice `json:"choices,omitempty"`
+	SearchAPIID string              `json:"search_api_id,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+}
+
+// IsList returns true if the value of the field is a choice or a list of choices
+func (f CustomField) IsList() bool {
+	return f.Type == CustomFieldTypeList || f.Type == CustomFieldTypeMultiSelectList
+}
+
+// ChoiceName returns the name of a choice of the field by its id
+func (f CustomField) ChoiceName(choiceID int64) (string, bool) {
+	for _, choice := range f.Choices {
+		if choice.ID == choiceID {
+			return choice.Name, true
+		}
+	}
+	return "", false
+}
+
+// CustomFieldListOptions is options for ListCustomFields
+type CustomFieldListOptions struct {
+	ListOptions
+}
+
+// CustomFieldAPI an interface containing all Sell custom field related methods
+type CustomFieldAPI interface {
+	ListCustomFields(
+		ctx context.Context, resourceType string, opts *CustomFieldListOptions) ([]CustomField, ListMeta, error)
+}
+
+// ListCustomFields lists the custom fields of a resource type, ResourceTypeLead, ResourceTypeContact,
+// ResourceTypeDeal or ResourceTypeProspectAndCustomer
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/custom_fields/#retrieve-custom-fields
+func (c *Client) ListCustomFields(
+	ctx context.Context, resourceType string, opts *CustomFieldListOptions,
+) ([]CustomField, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomFieldListOptions{}
+	}
+	return listItems[CustomField](ctx, c, "/"+url.PathEscape(resourceType)+"/custom_fields", tmp)
+}
@@ -0,0 +1,33 @@
+package sell
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListCustomFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/contact/custom_fields" {
+			t.Fatalf("unexpected request: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/sell_custom_fields.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	fields, _, err := client.ListCustomFields(ctx, ResourceTypeContact, nil)
+	if err != nil {
+		t.Fatalf("Failed to list custom fields: %s", err)
+	}
+
+	if len(fields) != 2 {
+		t.Fatalf("expected length of custom fields is 2, but got %d", len(fields))
+	}
+	if fields[0].IsList() || !fields[1].IsList() {
+		t.Fatalf("unexpected field types: %s, %s", fields[0].Type, fields[1].Type)
+	}
+	if name, ok := fields[1].ChoiceName(2); !ok || name != "Enterprise" {
+		t.Fatalf("unexpected choice name: %s", name)
+	}
+}
@@ -0,0 +1,206 @@
+package sell
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DealValue is the value of a deal, a decimal in the currency of the deal such as "1000.50".
+// Sell returns it as a string or a number, it is sent as a string to keep its precision.
+type DealValue string
+
+// Float64 returns the value as a float64
+func (v DealValue) Float64() (float64, error) {
+	return strconv.ParseFloat(string(v), 64)
+}
+
+// UnmarshalJSON decodes a value from a JSON string or number
+func (v *DealValue) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*v = ""
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*v = DealValue(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*v = DealValue(n.String())
+	return nil
+}
+
+// Deal is a Sell deal, an opportunity to sell to a contact
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/deals/
+type Deal struct {
+	ID        int64     `json:"id,omitempty"`
+	CreatorID int64     `json:"creator_id,omitempty"`
+	OwnerID   int64     `json:"owner_id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Value     DealValue `json:"value,omitempty"`
+	// Currency is an ISO 4217 code, such as "USD". The currency of the account is used when it is not set.
+	Currency            string `json:"currency,omitempty"`
+	Hot                 *bool  `json:"hot,omitempty"`
+	StageID             int64  `json:"stage_id,omitempty"`
+	SourceID            int64  `json:"source_id,omitempty"`
+	LossReasonID        int64  `json:"loss_reason_id,omitempty"`
+	UnqualifiedReasonID int64  `json:"unqualified_reason_id,omitempty"`
+	ContactID           int64  `json:"contact_id,omitempty"`
+	OrganizationID      int64  `json:"organization_id,omitempty"`
+	DropboxEmail        string `json:"dropbox_email,omitempty"`
+	// EstimatedCloseDate is a date such as "2023-12-31"
+	EstimatedCloseDate      string                 `json:"estimated_close_date,omitempty"`
+	CustomizedWinLikelihood int64                  `json:"customized_win_likelihood,omitempty"`
+	Tags                    []string               `json:"tags,omitempty"`
+	CustomFields            map[string]interface{} `json:"custom_fields,omitempty"`
+	AddedAt                 *time.Time             `json:"added_at,omitempty"`
+	LastStageChangeAt       *time.Time             `json:"last_stage_change_at,omitempty"`
+	LastActivityAt          *time.Time             `json:"last_activity_at,omitempty"`
+	CreatedAt               *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt               *time.Time             `json:"updated_at,omitempty"`
+}
+
+// DealListOptions is options for ListDeals
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/deals/#retrieve-all-deals
+type DealListOptions struct {
+	ListOptions
+	IDs            string `url:"ids,omitempty"`
+	CreatorID      int64  `url:"creator_id,omitempty"`
+	OwnerID        int64  `url:"owner_id,omitempty"`
+	ContactID      int64  `url:"contact_id,omitempty"`
+	OrganizationID int64  `url:"organization_id,omitempty"`
+	StageID        int64  `url:"stage_id,omitempty"`
+	SourceID       int64  `url:"source_id,omitempty"`
+	Name           string `url:"name,omitempty"`
+	Hot            *bool  `url:"hot,omitempty"`
+	// Includes adds related resources, such as "associated_contacts"
+	Includes string `url:"includes,omitempty"`
+}
+
+// Pipeline is a Sell pipeline, the stages deals go through
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/pipelines/
+type Pipeline struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Disabled  bool       `json:"disabled"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// Stage is a stage of a Sell pipeline
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/stages/
+type Stage struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	PipelineID int64  `json:"pipeline_id"`
+	// Category is the kind of the stage, such as "incoming", "won" or "lost"
+	Category   string     `json:"category"`
+	Active     bool       `json:"active"`
+	Position   int64      `json:"position"`
+	Likelihood int64      `json:"likelihood"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// PipelineListOptions is options for ListPipelines
+type PipelineListOptions struct {
+	ListOptions
+	IDs string `url:"ids,omitempty"`
+}
+
+// StageListOptions is options for ListStages
+type StageListOptions struct {
+	ListOptions
+	IDs        string `url:"ids,omitempty"`
+	PipelineID int64  `url:"pipeline_id,omitempty"`
+	Active     *bool  `url:"active,omitempty"`
+}
+
+// DealAPI an interface containing all Sell deal, pipeline and stage related methods
+type DealAPI interface {
+	ListDeals(ctx context.Context, opts *DealListOptions) ([]Deal, ListMeta, error)
+	GetDeal(ctx context.Context, dealID int64) (Deal, error)
+	CreateDeal(ctx context.Context, deal Deal) (Deal, error)
+	UpdateDeal(ctx context.Context, dealID int64, deal Deal) (Deal, error)
+	DeleteDeal(ctx context.Context, dealID int64) error
+	ListPipelines(ctx context.Context, opts *PipelineListOptions) ([]Pipeline, ListMeta, error)
+	ListStages(ctx context.Context, opts *StageListOptions) ([]Stage, ListMeta, error)
+}
+
+// ListDeals lists the deals
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/deals/#retrieve-all-deals
+func (c *Client) ListDeals(ctx context.Context, opts *DealListOptions) ([]Deal, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &DealListOptions{}
+	}
+	return listItems[Deal](ctx, c, "/deals", tmp)
+}
+
+// GetDeal gets a deal
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/deals/#retrieve-a-single-deal
+func (c *Client) GetDeal(ctx context.Context, dealID int64) (Deal, error) {
+	return getItem[Deal](ctx, c, fmt.Sprintf("/deals/%d", dealID))
+}
+
+// CreateDeal creates a deal. Name and ContactID are required.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/deals/#create-a-deal
+func (c *Client) CreateDeal(ctx context.Context, deal Deal) (Deal, error) {
+	return sendItem[Deal](ctx, c, http.MethodPost, "/deals", deal)
+}
+
+// UpdateDeal updates the fields of a deal which are set
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/deals/#update-a-deal
+func (c *Client) UpdateDeal(ctx context.Context, dealID int64, deal Deal) (Deal, error) {
+	return sendItem[Deal](ctx, c, http.MethodPut, fmt.Sprintf("/deals/%d", dealID), deal)
+}
+
+// DeleteDeal deletes a deal
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/deals/#delete-a-deal
+func (c *Client) DeleteDeal(ctx context.Context, dealID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/deals/%d", dealID))
+}
+
+// ListPipelines lists the pipelines
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/pipelines/#retrieve-all-pipelines
+func (c *Client) ListPipelines(ctx context.Context, opts *PipelineListOptions) ([]Pipeline, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &PipelineListOptions{}
+	}
+	return listItems[Pipeline](ctx, c, "/pipelines", tmp)
+}
+
+// ListStages lists the stages of the pipelines
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/stages/#retrieve-all-stages
+func (c *Client) ListStages(ctx context.Context, opts *StageListOptions) ([]Stage, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &StageListOptions{}
+	}
+	return listItems[Stage](ctx, c, "/stages", tmp)
+}
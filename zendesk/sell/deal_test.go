@@ -0,0 +1,121 @@
+package sell
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListDeals(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_deals.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	deals, _, err := client.ListDeals(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list deals: %s", err)
+	}
+
+	if len(deals) != 2 {
+		t.Fatalf("expected length of deals is 2, but got %d", len(deals))
+	}
+	if deals[0].Value != "1000.50" || deals[1].Value != "2500" || deals[1].Currency != "EUR" {
+		t.Fatalf("unexpected deal values: %q %q", deals[0].Value, deals[1].Value)
+	}
+
+	value, err := deals[0].Value.Float64()
+	if err != nil || value != 1000.5 {
+		t.Fatalf("unexpected float value: %v %v", value, err)
+	}
+}
+
+func TestCreateDeal(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"data":{"name":"Website Redesign","value":"1000.50","currency":"USD","contact_id":2002}}`
+		if r.Method != http.MethodPost || r.URL.Path != "/deals" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("POST/sell_deal.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	deal, err := client.CreateDeal(ctx, Deal{Name: "Website Redesign", Value: "1000.50", Currency: "USD", ContactID: 2002})
+	if err != nil {
+		t.Fatalf("Failed to create deal: %s", err)
+	}
+
+	if deal.ID != 3001 {
+		t.Fatalf("unexpected deal: %+v", deal)
+	}
+}
+
+func TestUpdateDeal(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"data":{"hot":false}}`
+		if r.Method != http.MethodPut || r.URL.Path != "/deals/3001" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("POST/sell_deal.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	hot := false
+	if _, err := client.UpdateDeal(ctx, 3001, Deal{Hot: &hot}); err != nil {
+		t.Fatalf("Failed to update deal: %s", err)
+	}
+}
+
+func TestDeleteDeal(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/deals/3001" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteDeal(ctx, 3001); err != nil {
+		t.Fatalf("Failed to delete deal: %s", err)
+	}
+}
+
+func TestListPipelines(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_pipelines.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	pipelines, _, err := client.ListPipelines(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list pipelines: %s", err)
+	}
+
+	if len(pipelines) != 1 {
+		t.Fatalf("expected length of pipelines is 1, but got %d", len(pipelines))
+	}
+}
+
+func TestListStages(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stages" || r.URL.Query().Get("pipeline_id") != "1" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/sell_stages.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	stages, _, err := client.ListStages(ctx, &StageListOptions{PipelineID: 1})
+	if err != nil {
+		t.Fatalf("Failed to list stages: %s", err)
+	}
+
+	if len(stages) != 2 || stages[1].Category != "won" {
+		t.Fatalf("unexpected stages: %+v", stages)
+	}
+}
@@ -0,0 +1,163 @@
+package sell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Firehose positions to start a stream from, any other position is a Position of a previous page
+const (
+	// FirehosePositionTail is the oldest event which is still retained
+	FirehosePositionTail = "tail"
+	// FirehosePositionTop is the newest event, to receive only the following events
+	FirehosePositionTop = "top"
+)
+
+// Firehose event types
+const (
+	FirehoseEventTypeCreated = "created"
+	FirehoseEventTypeUpdated = "updated"
+	FirehoseEventTypeDeleted = "deleted"
+)
+
+// Firehose resources
+const (
+	FirehoseResourceLeads    = "leads"
+	FirehoseResourceContacts = "contacts"
+	FirehoseResourceDeals    = "deals"
+)
+
+// FirehoseEvent is a change of a resource in the Firehose stream.
+// Data is the resource after the change, which can be decoded with Decode, such as into a *Lead.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/firehose/introduction/
+type FirehoseEvent struct {
+	Data json.RawMessage `json:"data"`
+	Meta struct {
+		Type string `json:"type"`
+		// Sequence increases with each change of a resource
+		Sequence  int64     `json:"sequence"`
+		EventType string    `json:"event_type"`
+		EventTime time.Time `json:"event_time"`
+		// Previous contains the previous values of the changed attributes of an update
+		Previous map[string]interface{} `json:"previous,omitempty"`
+	} `json:"meta"`
+}
+
+// Decode decodes the resource of the event into v
+func (e FirehoseEvent) Decode(v interface{}) error {
+	return json.Unmarshal(e.Data, v)
+}
+
+// FirehoseOptions is options for GetFirehoseEvents
+type FirehoseOptions struct {
+	// Position is FirehosePositionTail, FirehosePositionTop or the Position of a previous page
+	Position string `url:"position"`
+	Limit    int    `url:"limit,omitempty"`
+}
+
+// FirehosePage is a page of a Firehose stream
+type FirehosePage struct {
+	Events []FirehoseEvent
+	// Position is the position to fetch the following events from
+	Position string
+	// Top is true when the page reached the newest event
+	Top bool
+}
+
+// FirehoseAPI an interface containing all Sell Firehose related methods
+type FirehoseAPI interface {
+	GetFirehoseEvents(ctx context.Context, resource string, opts *FirehoseOptions) (FirehosePage, error)
+	GetFirehoseIterator(ctx context.Context, resource string, opts *FirehoseOptions) *FirehoseIterator
+}
+
+// GetFirehoseEvents gets the events of a resource stream, FirehoseResourceLeads, FirehoseResourceContacts
+// or FirehoseResourceDeals. The stream starts at the tail when opts.Position is empty.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/firehose/introduction/
+func (c *Client) GetFirehoseEvents(ctx context.Context, resource string, opts *FirehoseOptions) (FirehosePage, error) {
+	var result struct {
+		Items []FirehoseEvent `json:"items"`
+		Meta  struct {
+			Position string `json:"position"`
+			Top      bool   `json:"top"`
+		} `json:"meta"`
+	}
+
+	tmp := FirehoseOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	if tmp.Position == "" {
+		tmp.Position = FirehosePositionTail
+	}
+
+	u, err := addOptions("/"+url.PathEscape(resource)+"/stream", tmp)
+	if err != nil {
+		return FirehosePage{}, err
+	}
+
+	body, _, err := c.send(ctx, http.MethodGet, c.v3Endpoint()+u, nil, nil)
+	if err != nil {
+		return FirehosePage{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return FirehosePage{}, err
+	}
+	return FirehosePage{Events: result.Items, Position: result.Meta.Position, Top: result.Meta.Top}, nil
+}
+
+// FirehoseIterator iterates over the events of a Firehose stream until it reaches the newest event.
+// Position can be saved to resume the stream later, such as after a restart.
+type FirehoseIterator struct {
+	client   *Client
+	resource string
+	opts     FirehoseOptions
+	hasMore  bool
+	ctx      context.Context
+}
+
+// GetFirehoseIterator returns an iterator over the events of a resource stream starting at opts.Position
+func (c *Client) GetFirehoseIterator(ctx context.Context, resource string, opts *FirehoseOptions) *FirehoseIterator {
+	it := &FirehoseIterator{
+		client:   c,
+		resource: resource,
+		hasMore:  true,
+		ctx:      ctx,
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	return it
+}
+
+// HasMore returns a boolean indicating whether the newest event has not been reached yet.
+func (i *FirehoseIterator) HasMore() bool {
+	return i.hasMore
+}
+
+// Position returns the position of the events following the last page, to resume the stream from
+func (i *FirehoseIterator) Position() string {
+	return i.opts.Position
+}
+
+// GetNext retrieves the next events and moves the position after them.
+// In case of an error, it sets hasMore to false and returns an error, the position is kept.
+func (i *FirehoseIterator) GetNext() ([]FirehoseEvent, error) {
+	page, err := i.client.GetFirehoseEvents(i.ctx, i.resource, &i.opts)
+	if err != nil {
+		i.hasMore = false
+		return nil, err
+	}
+
+	if page.Position != "" {
+		i.opts.Position = page.Position
+	}
+	i.hasMore = !page.Top
+	return page.Events, nil
+}
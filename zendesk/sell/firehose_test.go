@@ -0,0 +1,75 @@
+package sell
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetFirehoseEvents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/leads/stream" || r.URL.Query().Get("position") != FirehosePositionTail {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/sell_firehose_leads.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	page, err := client.GetFirehoseEvents(ctx, FirehoseResourceLeads, nil)
+	if err != nil {
+		t.Fatalf("Failed to get firehose events: %s", err)
+	}
+
+	if len(page.Events) != 2 || !page.Top || page.Position == "" {
+		t.Fatalf("unexpected page: %+v", page)
+	}
+
+	event := page.Events[1]
+	if event.Meta.EventType != FirehoseEventTypeUpdated || event.Meta.Previous["status"] != "New" {
+		t.Fatalf("unexpected event: %+v", event.Meta)
+	}
+
+	var lead Lead
+	if err := event.Decode(&lead); err != nil {
+		t.Fatalf("Failed to decode lead: %s", err)
+	}
+	if lead.Status != "Working" {
+		t.Fatalf("unexpected lead: %+v", lead)
+	}
+}
+
+func TestFirehoseIterator(t *testing.T) {
+	var positions []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		position := r.URL.Query().Get("position")
+		positions = append(positions, position)
+		if position == "saved" {
+			w.Write([]byte(`{"items":[],"meta":{"position":"next","top":false}}`))
+			return
+		}
+		w.Write(readFixture("GET/sell_firehose_leads.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetFirehoseIterator(ctx, FirehoseResourceLeads, &FirehoseOptions{Position: "saved", Limit: 100})
+	var events []FirehoseEvent
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get next events: %s", err)
+		}
+		events = append(events, page...)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected length of events is 2, but got %d", len(events))
+	}
+	if len(positions) != 2 || positions[1] != "next" {
+		t.Fatalf("unexpected positions: %v", positions)
+	}
+	if it.Position() != "92d4a1b0-5c3e-4f5b-8b8a-4c0e2d1f3a6c" {
+		t.Fatalf("unexpected position to resume from: %s", it.Position())
+	}
+}
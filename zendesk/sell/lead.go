@@ -0,0 +1,124 @@
+package sell
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Lead is a Sell lead, a person or an organization which may become a customer
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/
+type Lead struct {
+	ID               int64                  `json:"id,omitempty"`
+	CreatorID        int64                  `json:"creator_id,omitempty"`
+	OwnerID          int64                  `json:"owner_id,omitempty"`
+	FirstName        string                 `json:"first_name,omitempty"`
+	LastName         string                 `json:"last_name,omitempty"`
+	OrganizationName string                 `json:"organization_name,omitempty"`
+	Status           string                 `json:"status,omitempty"`
+	SourceID         int64                  `json:"source_id,omitempty"`
+	Title            string                 `json:"title,omitempty"`
+	Description      string                 `json:"description,omitempty"`
+	Industry         string                 `json:"industry,omitempty"`
+	Website          string                 `json:"website,omitempty"`
+	Email            string                 `json:"email,omitempty"`
+	Phone            string                 `json:"phone,omitempty"`
+	Mobile           string                 `json:"mobile,omitempty"`
+	Fax              string                 `json:"fax,omitempty"`
+	Twitter          string                 `json:"twitter,omitempty"`
+	Facebook         string                 `json:"facebook,omitempty"`
+	Linkedin         string                 `json:"linkedin,omitempty"`
+	Skype            string                 `json:"skype,omitempty"`
+	Address          *Address               `json:"address,omitempty"`
+	Tags             []string               `json:"tags,omitempty"`
+	CustomFields     map[string]interface{} `json:"custom_fields,omitempty"`
+	CreatedAt        *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time             `json:"updated_at,omitempty"`
+}
+
+// LeadListOptions is options for ListLeads
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/#retrieve-all-leads
+type LeadListOptions struct {
+	ListOptions
+	IDs              string `url:"ids,omitempty"`
+	CreatorID        int64  `url:"creator_id,omitempty"`
+	OwnerID          int64  `url:"owner_id,omitempty"`
+	SourceID         int64  `url:"source_id,omitempty"`
+	FirstName        string `url:"first_name,omitempty"`
+	LastName         string `url:"last_name,omitempty"`
+	OrganizationName string `url:"organization_name,omitempty"`
+	Email            string `url:"email,omitempty"`
+	Phone            string `url:"phone,omitempty"`
+	Status           string `url:"status,omitempty"`
+}
+
+// LeadAPI an interface containing all Sell lead related methods
+type LeadAPI interface {
+	ListLeads(ctx context.Context, opts *LeadListOptions) ([]Lead, ListMeta, error)
+	GetLead(ctx context.Context, leadID int64) (Lead, error)
+	CreateLead(ctx context.Context, lead Lead) (Lead, error)
+	UpdateLead(ctx context.Context, leadID int64, lead Lead) (Lead, error)
+	UpsertLead(ctx context.Context, filter map[string]string, lead Lead) (Lead, error)
+	DeleteLead(ctx context.Context, leadID int64) error
+}
+
+// ListLeads lists the leads
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/#retrieve-all-leads
+func (c *Client) ListLeads(ctx context.Context, opts *LeadListOptions) ([]Lead, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &LeadListOptions{}
+	}
+	return listItems[Lead](ctx, c, "/leads", tmp)
+}
+
+// GetLead gets a lead
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/#retrieve-a-single-lead
+func (c *Client) GetLead(ctx context.Context, leadID int64) (Lead, error) {
+	return getItem[Lead](ctx, c, fmt.Sprintf("/leads/%d", leadID))
+}
+
+// CreateLead creates a lead. LastName or OrganizationName is required.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/#create-a-lead
+func (c *Client) CreateLead(ctx context.Context, lead Lead) (Lead, error) {
+	return sendItem[Lead](ctx, c, http.MethodPost, "/leads", lead)
+}
+
+// UpdateLead updates the fields of a lead which are set
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/#update-a-lead
+func (c *Client) UpdateLead(ctx context.Context, leadID int64, lead Lead) (Lead, error) {
+	return sendItem[Lead](ctx, c, http.MethodPut, fmt.Sprintf("/leads/%d", leadID), lead)
+}
+
+// UpsertLead updates the lead matching filter or creates a lead when none matches.
+// filter is keyed by lead attributes or custom fields, such as "email" or "custom_fields[external_id]".
+// It fails when more than one lead matches.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/#upsert-a-lead
+func (c *Client) UpsertLead(ctx context.Context, filter map[string]string, lead Lead) (Lead, error) {
+	return sendItem[Lead](ctx, c, http.MethodPost, upsertPath("/leads/upsert", filter), lead)
+}
+
+// DeleteLead deletes a lead
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/leads/#delete-a-lead
+func (c *Client) DeleteLead(ctx context.Context, leadID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/leads/%d", leadID))
+}
+
+// upsertPath adds the filter of an upsert to its path
+func upsertPath(path string, filter map[string]string) string {
+	values := url.Values{}
+	for key, value := range filter {
+		values.Set(key, value)
+	}
+	return path + "?" + values.Encode()
+}
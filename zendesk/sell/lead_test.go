@@ -0,0 +1,117 @@
+package sell
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListLeads(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/leads" || r.URL.Query().Get("per_page") != "2" || r.URL.Query().Get("status") != "New" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/sell_leads.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	leads, meta, err := client.ListLeads(ctx, &LeadListOptions{ListOptions: ListOptions{PerPage: 2}, Status: "New"})
+	if err != nil {
+		t.Fatalf("Failed to list leads: %s", err)
+	}
+
+	if len(leads) != 2 || leads[0].Address.City != "Hyannis" {
+		t.Fatalf("unexpected leads: %+v", leads)
+	}
+	if !meta.HasMore() || meta.Count != 2 {
+		t.Fatalf("unexpected meta: %+v", meta)
+	}
+}
+
+func TestGetLead(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_lead.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	lead, err := client.GetLead(ctx, 1001)
+	if err != nil {
+		t.Fatalf("Failed to get lead: %s", err)
+	}
+
+	if lead.ID != 1001 || lead.CustomFields["external_id"] != "crm-1001" {
+		t.Fatalf("unexpected lead: %+v", lead)
+	}
+}
+
+func TestCreateLead(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"data":{"first_name":"Mark","last_name":"Johnson"}}`
+		if r.Method != http.MethodPost || r.URL.Path != "/leads" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture("POST/sell_lead.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	lead, err := client.CreateLead(ctx, Lead{FirstName: "Mark", LastName: "Johnson"})
+	if err != nil {
+		t.Fatalf("Failed to create lead: %s", err)
+	}
+
+	if lead.ID != 1001 {
+		t.Fatalf("unexpected lead: %+v", lead)
+	}
+}
+
+func TestUpdateLead(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "sell_lead.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	lead, err := client.UpdateLead(ctx, 1001, Lead{Status: "Working"})
+	if err != nil {
+		t.Fatalf("Failed to update lead: %s", err)
+	}
+
+	if lead.Status != "Working" {
+		t.Fatalf("unexpected lead: %+v", lead)
+	}
+}
+
+func TestUpsertLead(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/leads/upsert" ||
+			r.URL.Query().Get("custom_fields[external_id]") != "crm-1001" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Write(readFixture("POST/sell_lead.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpsertLead(ctx, map[string]string{"custom_fields[external_id]": "crm-1001"},
+		Lead{LastName: "Johnson"})
+	if err != nil {
+		t.Fatalf("Failed to upsert lead: %s", err)
+	}
+}
+
+func TestDeleteLead(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/leads/1001" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteLead(ctx, 1001); err != nil {
+		t.Fatalf("Failed to delete lead: %s", err)
+	}
+}
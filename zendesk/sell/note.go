@@ -0,0 +1,85 @@
+package sell
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Note is a Sell note attached to a lead, a contact or a deal
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/notes/
+type Note struct {
+	ID        int64 `json:"id,omitempty"`
+	CreatorID int64 `json:"creator_id,omitempty"`
+	// ResourceType is ResourceTypeLead, ResourceTypeContact or ResourceTypeDeal and ResourceID is its id
+	ResourceType string     `json:"resource_type,omitempty"`
+	ResourceID   int64      `json:"resource_id,omitempty"`
+	Content      string     `json:"content,omitempty"`
+	IsImportant  *bool      `json:"is_important,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
+	Type         string     `json:"type,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// NoteListOptions is options for ListNotes
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/notes/#retrieve-all-notes
+type NoteListOptions struct {
+	ListOptions
+	IDs          string `url:"ids,omitempty"`
+	CreatorID    int64  `url:"creator_id,omitempty"`
+	ResourceType string `url:"resource_type,omitempty"`
+	ResourceID   int64  `url:"resource_id,omitempty"`
+	Q            string `url:"q,omitempty"`
+}
+
+// NoteAPI an interface containing all Sell note related methods
+type NoteAPI interface {
+	ListNotes(ctx context.Context, opts *NoteListOptions) ([]Note, ListMeta, error)
+	GetNote(ctx context.Context, noteID int64) (Note, error)
+	CreateNote(ctx context.Context, note Note) (Note, error)
+	UpdateNote(ctx context.Context, noteID int64, note Note) (Note, error)
+	DeleteNote(ctx context.Context, noteID int64) error
+}
+
+// ListNotes lists the notes
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/notes/#retrieve-all-notes
+func (c *Client) ListNotes(ctx context.Context, opts *NoteListOptions) ([]Note, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &NoteListOptions{}
+	}
+	return listItems[Note](ctx, c, "/notes", tmp)
+}
+
+// GetNote gets a note
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/notes/#retrieve-a-single-note
+func (c *Client) GetNote(ctx context.Context, noteID int64) (Note, error) {
+	return getItem[Note](ctx, c, fmt.Sprintf("/notes/%d", noteID))
+}
+
+// CreateNote creates a note. ResourceType, ResourceID and Content are required.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/notes/#create-a-note
+func (c *Client) CreateNote(ctx context.Context, note Note) (Note, error) {
+	return sendItem[Note](ctx, c, http.MethodPost, "/notes", note)
+}
+
+// UpdateNote updates the fields of a note which are set
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/notes/#update-a-note
+func (c *Client) UpdateNote(ctx context.Context, noteID int64, note Note) (Note, error) {
+	return sendItem[Note](ctx, c, http.MethodPut, fmt.Sprintf("/notes/%d", noteID), note)
+}
+
+// DeleteNote deletes a note
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/notes/#delete-a-note
+func (c *Client) DeleteNote(ctx context.Context, noteID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/notes/%d", noteID))
+}
@@ -0,0 +1,56 @@
+package sell
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListNotes(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_notes.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	notes, _, err := client.ListNotes(ctx, &NoteListOptions{ResourceType: ResourceTypeContact, ResourceID: 2002})
+	if err != nil {
+		t.Fatalf("Failed to list notes: %s", err)
+	}
+
+	if len(notes) != 1 {
+		t.Fatalf("expected length of notes is 1, but got %d", len(notes))
+	}
+}
+
+func TestCreateNote(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "sell_note.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	note, err := client.CreateNote(ctx, Note{
+		ResourceType: ResourceTypeContact,
+		ResourceID:   2002,
+		Content:      "Outbound call, 4 min. Interested in the annual plan.",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create note: %s", err)
+	}
+
+	if note.ID != 6001 || !*note.IsImportant {
+		t.Fatalf("unexpected note: %+v", note)
+	}
+}
+
+func TestDeleteNote(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/notes/6001" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteNote(ctx, 6001); err != nil {
+		t.Fatalf("Failed to delete note: %s", err)
+	}
+}
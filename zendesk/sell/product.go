@@ -0,0 +1,227 @@
+package sell
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ProductPrice is a price of a product in a currency
+type ProductPrice struct {
+	// Amount is a decimal such as "1599.99"
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// Product is a product of the Sell catalog
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/products/
+type Product struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	SKU         string `json:"sku,omitempty"`
+	Active      *bool  `json:"active,omitempty"`
+	// MaxDiscount and MaxMarkup are percentages of the price allowed on a line item
+	MaxDiscount  int64          `json:"max_discount,omitempty"`
+	MaxMarkup    int64          `json:"max_markup,omitempty"`
+	Cost         float64        `json:"cost,omitempty"`
+	CostCurrency string         `json:"cost_currency,omitempty"`
+	Prices       []ProductPrice `json:"prices,omitempty"`
+	CreatedAt    *time.Time     `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time     `json:"updated_at,omitempty"`
+}
+
+// ProductListOptions is options for ListProducts
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/products/#retrieve-all-products
+type ProductListOptions struct {
+	ListOptions
+	IDs    string `url:"ids,omitempty"`
+	Name   string `url:"name,omitempty"`
+	SKU    string `url:"sku,omitempty"`
+	Active *bool  `url:"active,omitempty"`
+}
+
+// Order is an order of a deal, which contains its line items
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/orders/
+type Order struct {
+	ID     int64 `json:"id,omitempty"`
+	DealID int64 `json:"deal_id,omitempty"`
+	// Discount is a percentage applied to the whole order
+	Discount  int64      `json:"discount,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// OrderListOptions is options for ListOrders
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/orders/#retrieve-all-orders
+type OrderListOptions struct {
+	ListOptions
+	IDs    string `url:"ids,omitempty"`
+	DealID int64  `url:"deal_id,omitempty"`
+}
+
+// LineItem is a product added to an order.
+// Name, SKU, Description and Price are copied from the product when the line item is created.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/line_items/
+type LineItem struct {
+	ID        int64 `json:"id,omitempty"`
+	ProductID int64 `json:"product_id,omitempty"`
+	// Value is the total of the line item and Price is the unit price, decimals such as "1599.99"
+	Value string `json:"value,omitempty"`
+	// Variation is the discount or markup in percent of the price, such as -10
+	Variation   float64    `json:"variation,omitempty"`
+	Currency    string     `json:"currency,omitempty"`
+	Quantity    int64      `json:"quantity,omitempty"`
+	Price       string     `json:"price,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	SKU         string     `json:"sku,omitempty"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// LineItemListOptions is options for ListLineItems
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/line_items/#retrieve-order-line-items
+type LineItemListOptions struct {
+	ListOptions
+	IDs      string `url:"ids,omitempty"`
+	Quantity int64  `url:"quantity,omitempty"`
+	Value    string `url:"value,omitempty"`
+}
+
+// ProductAPI an interface containing all Sell product, order and line item related methods
+type ProductAPI interface {
+	ListProducts(ctx context.Context, opts *ProductListOptions) ([]Product, ListMeta, error)
+	GetProduct(ctx context.Context, productID int64) (Product, error)
+	CreateProduct(ctx context.Context, product Product) (Product, error)
+	UpdateProduct(ctx context.Context, productID int64, product Product) (Product, error)
+	DeleteProduct(ctx context.Context, productID int64) error
+	ListOrders(ctx context.Context, opts *OrderListOptions) ([]Order, ListMeta, error)
+	GetOrder(ctx context.Context, orderID int64) (Order, error)
+	CreateOrder(ctx context.Context, order Order) (Order, error)
+	UpdateOrder(ctx context.Context, orderID int64, order Order) (Order, error)
+	DeleteOrder(ctx context.Context, orderID int64) error
+	ListLineItems(ctx context.Context, orderID int64, opts *LineItemListOptions) ([]LineItem, ListMeta, error)
+	GetLineItem(ctx context.Context, orderID, lineItemID int64) (LineItem, error)
+	CreateLineItem(ctx context.Context, orderID int64, lineItem LineItem) (LineItem, error)
+	DeleteLineItem(ctx context.Context, orderID, lineItemID int64) error
+}
+
+// ListProducts lists the products of the catalog
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/products/#retrieve-all-products
+func (c *Client) ListProducts(ctx context.Context, opts *ProductListOptions) ([]Product, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &ProductListOptions{}
+	}
+	return listItems[Product](ctx, c, "/products", tmp)
+}
+
+// GetProduct gets a product
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/products/#retrieve-a-single-product
+func (c *Client) GetProduct(ctx context.Context, productID int64) (Product, error) {
+	return getItem[Product](ctx, c, fmt.Sprintf("/products/%d", productID))
+}
+
+// CreateProduct creates a product. Name and Prices are required.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/products/#create-a-product
+func (c *Client) CreateProduct(ctx context.Context, product Product) (Product, error) {
+	return sendItem[Product](ctx, c, http.MethodPost, "/products", product)
+}
+
+// UpdateProduct updates the fields of a product which are set. Prices replace the current ones when they are set.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/products/#update-a-product
+func (c *Client) UpdateProduct(ctx context.Context, productID int64, product Product) (Product, error) {
+	return sendItem[Product](ctx, c, http.MethodPut, fmt.Sprintf("/products/%d", productID), product)
+}
+
+// DeleteProduct deletes a product
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/products/#delete-a-product
+func (c *Client) DeleteProduct(ctx context.Context, productID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/products/%d", productID))
+}
+
+// ListOrders lists the orders
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/orders/#retrieve-all-orders
+func (c *Client) ListOrders(ctx context.Context, opts *OrderListOptions) ([]Order, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &OrderListOptions{}
+	}
+	return listItems[Order](ctx, c, "/orders", tmp)
+}
+
+// GetOrder gets an order
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/orders/#retrieve-a-single-order
+func (c *Client) GetOrder(ctx context.Context, orderID int64) (Order, error) {
+	return getItem[Order](ctx, c, fmt.Sprintf("/orders/%d", orderID))
+}
+
+// CreateOrder creates an order for a deal. A deal has at most one order.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/orders/#create-an-order
+func (c *Client) CreateOrder(ctx context.Context, order Order) (Order, error) {
+	return sendItem[Order](ctx, c, http.MethodPost, "/orders", order)
+}
+
+// UpdateOrder updates the discount of an order
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/orders/#update-an-order
+func (c *Client) UpdateOrder(ctx context.Context, orderID int64, order Order) (Order, error) {
+	return sendItem[Order](ctx, c, http.MethodPut, fmt.Sprintf("/orders/%d", orderID), order)
+}
+
+// DeleteOrder deletes an order and its line items
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/orders/#delete-an-order
+func (c *Client) DeleteOrder(ctx context.Context, orderID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/orders/%d", orderID))
+}
+
+// ListLineItems lists the line items of an order
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/line_items/#retrieve-order-line-items
+func (c *Client) ListLineItems(
+	ctx context.Context, orderID int64, opts *LineItemListOptions,
+) ([]LineItem, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &LineItemListOptions{}
+	}
+	return listItems[LineItem](ctx, c, fmt.Sprintf("/orders/%d/line_items", orderID), tmp)
+}
+
+// GetLineItem gets a line item of an order
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/line_items/#retrieve-a-single-line-item
+func (c *Client) GetLineItem(ctx context.Context, orderID, lineItemID int64) (LineItem, error) {
+	return getItem[LineItem](ctx, c, fmt.Sprintf("/orders/%d/line_items/%d", orderID, lineItemID))
+}
+
+// CreateLineItem adds a product to an order. ProductID, Value, Variation, Currency and Quantity are required.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/line_items/#create-a-line-item
+func (c *Client) CreateLineItem(ctx context.Context, orderID int64, lineItem LineItem) (LineItem, error) {
+	return sendItem[LineItem](ctx, c, http.MethodPost, fmt.Sprintf("/orders/%d/line_items", orderID), lineItem)
+}
+
+// DeleteLineItem removes a line item from an order. Line items cannot be updated, they are replaced.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/line_items/#delete-a-line-item
+func (c *Client) DeleteLineItem(ctx context.Context, orderID, lineItemID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/orders/%d/line_items/%d", orderID, lineItemID))
+}
@@ -0,0 +1,124 @@
+package sell
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListProducts(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_products.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	products, _, err := client.ListProducts(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list products: %s", err)
+	}
+
+	if len(products) != 1 || len(products[0].Prices) != 2 || products[0].Prices[0].Amount != "1599.99" {
+		t.Fatalf("unexpected products: %+v", products)
+	}
+}
+
+func TestCreateProduct(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || !strings.Contains(string(body), `"prices":[{"amount":"1599.99","currency":"USD"}]`) {
+			t.Fatalf("unexpected request: %s %s", r.Method, body)
+		}
+		w.Write(readFixture("POST/sell_product.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	product, err := client.CreateProduct(ctx, Product{
+		Name:   "Enterprise Plan",
+		Prices: []ProductPrice{{Amount: "1599.99", Currency: "USD"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %s", err)
+	}
+
+	if product.ID != 7001 {
+		t.Fatalf("unexpected product: %+v", product)
+	}
+}
+
+func TestCreateOrder(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPost, "sell_order.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	order, err := client.CreateOrder(ctx, Order{DealID: 3001, Discount: 4})
+	if err != nil {
+		t.Fatalf("Failed to create order: %s", err)
+	}
+
+	if order.ID != 8001 {
+		t.Fatalf("unexpected order: %+v", order)
+	}
+}
+
+func TestListLineItems(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/orders/8001/line_items" {
+			t.Fatalf("unexpected request: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/sell_line_items.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	lineItems, _, err := client.ListLineItems(ctx, 8001, nil)
+	if err != nil {
+		t.Fatalf("Failed to list line items: %s", err)
+	}
+
+	if len(lineItems) != 1 || lineItems[0].Variation != -10 {
+		t.Fatalf("unexpected line items: %+v", lineItems)
+	}
+}
+
+func TestCreateLineItem(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/orders/8001/line_items" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("POST/sell_line_item.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	lineItem, err := client.CreateLineItem(ctx, 8001, LineItem{
+		ProductID: 7001,
+		Value:     "2879.98",
+		Variation: -10,
+		Currency:  "USD",
+		Quantity:  2,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create line item: %s", err)
+	}
+
+	if lineItem.Price != "1599.99" {
+		t.Fatalf("unexpected line item: %+v", lineItem)
+	}
+}
+
+func TestDeleteLineItem(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/orders/8001/line_items/9001" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteLineItem(ctx, 8001, 9001); err != nil {
+		t.Fatalf("Failed to delete line item: %s", err)
+	}
+}
@@ -0,0 +1,233 @@
+package sell
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// Search sort orders
+const (
+	SearchOrderAscending  = "ascending"
+	SearchOrderDescending = "descending"
+)
+
+// SearchFilter is a filter of a Sell search query. It is a logical combination of filters with And, Or or Not,
+// or a condition on an attribute with Filter. The filters are usually built with the Search functions, such as
+//
+//	SearchAnd(SearchEq("stage.name", "Won"), SearchRangeOf("value", SearchRange{GTE: 1000}))
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/search/filters/
+type SearchFilter struct {
+	And    []SearchFilter   `json:"and,omitempty"`
+	Or     []SearchFilter   `json:"or,omitempty"`
+	Not    *SearchFilter    `json:"not,omitempty"`
+	Filter *SearchCondition `json:"filter,omitempty"`
+}
+
+// SearchAttribute is an attribute of a searched resource, such as "name" or "custom_fields.segment"
+type SearchAttribute struct {
+	Name string `json:"name"`
+}
+
+// SearchCondition is a condition on an attribute, one field of Parameter is set
+type SearchCondition struct {
+	Attribute SearchAttribute `json:"attribute"`
+	Parameter SearchParameter `json:"parameter"`
+}
+
+// SearchParameter is the parameter of a condition
+type SearchParameter struct {
+	Eq         interface{}   `json:"eq,omitempty"`
+	Any        []interface{} `json:"any,omitempty"`
+	Contains   string        `json:"contains,omitempty"`
+	StartsWith string        `json:"starts_with,omitempty"`
+	Range      *SearchRange  `json:"range,omitempty"`
+	IsNull     *bool         `json:"is_null,omitempty"`
+}
+
+// SearchRange is the bounds of a range condition, numbers or dates such as "2023-01-01T00:00:00Z"
+type SearchRange struct {
+	GT  interface{} `json:"gt,omitempty"`
+	GTE interface{} `json:"gte,omitempty"`
+	LT  interface{} `json:"lt,omitempty"`
+	LTE interface{} `json:"lte,omitempty"`
+}
+
+// SearchAnd matches the resources which match all the filters
+func SearchAnd(filters ...SearchFilter) SearchFilter {
+	return SearchFilter{And: filters}
+}
+
+// SearchOr matches the resources which match any of the filters
+func SearchOr(filters ...SearchFilter) SearchFilter {
+	return SearchFilter{Or: filters}
+}
+
+// SearchNot matches the resources which do not match the filter
+func SearchNot(filter SearchFilter) SearchFilter {
+	return SearchFilter{Not: &filter}
+}
+
+// SearchEq matches the resources whose attribute equals value
+func SearchEq(attribute string, value interface{}) SearchFilter {
+	return searchCondition(attribute, SearchParameter{Eq: value})
+}
+
+// SearchAny matches the resources whose attribute equals any of values
+func SearchAny(attribute string, values ...interface{}) SearchFilter {
+	return searchCondition(attribute, SearchParameter{Any: values})
+}
+
+// SearchContains matches the resources whose attribute contains value
+func SearchContains(attribute, value string) SearchFilter {
+	return searchCondition(attribute, SearchParameter{Contains: value})
+}
+
+// SearchStartsWith matches the resources whose attribute starts with value
+func SearchStartsWith(attribute, value string) SearchFilter {
+	return searchCondition(attribute, SearchParameter{StartsWith: value})
+}
+
+// SearchRangeOf matches the resources whose attribute is within the range
+func SearchRangeOf(attribute string, r SearchRange) SearchFilter {
+	return searchCondition(attribute, SearchParameter{Range: &r})
+}
+
+// SearchIsNull matches the resources whose attribute is null, or is not null when isNull is false
+func SearchIsNull(attribute string, isNull bool) SearchFilter {
+	return searchCondition(attribute, SearchParameter{IsNull: &isNull})
+}
+
+func searchCondition(attribute string, parameter SearchParameter) SearchFilter {
+	return SearchFilter{Filter: &SearchCondition{Attribute: SearchAttribute{Name: attribute}, Parameter: parameter}}
+}
+
+// SearchSort is a sort of the hits by an attribute
+type SearchSort struct {
+	Attribute SearchAttribute `json:"attribute"`
+	// Order is SearchOrderAscending or SearchOrderDescending
+	Order string `json:"order"`
+}
+
+// SearchQuery is a Sell search query. Projection selects the attributes of the hits, such as "name" or "value".
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/search/introduction/
+type SearchQuery struct {
+	Filter     *SearchFilter     `json:"filter,omitempty"`
+	Projection []SearchAttribute `json:"projection,omitempty"`
+	Sort       []SearchSort      `json:"sort,omitempty"`
+}
+
+// SearchOptions is options for the Sell search
+type SearchOptions struct {
+	PerPage int
+	// Cursor is the NextCursor of a previous page
+	Cursor string
+}
+
+// SearchMeta is the meta of a page of hits
+type SearchMeta struct {
+	Count      int64
+	TotalCount int64
+	// NextCursor is the cursor of the following page, it is empty on the last page
+	NextCursor string
+}
+
+// SearchAPI an interface containing all Sell search related methods
+type SearchAPI interface {
+	SearchLeads(ctx context.Context, query SearchQuery, opts *SearchOptions) ([]Lead, SearchMeta, error)
+	SearchContacts(ctx context.Context, query SearchQuery, opts *SearchOptions) ([]Contact, SearchMeta, error)
+	SearchDeals(ctx context.Context, query SearchQuery, opts *SearchOptions) ([]Deal, SearchMeta, error)
+}
+
+// SearchLeads searches the leads. Only the attributes of the projection are set in the hits.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/search/introduction/
+func (c *Client) SearchLeads(ctx context.Context, query SearchQuery, opts *SearchOptions) ([]Lead, SearchMeta, error) {
+	return search[Lead](ctx, c, "leads", query, opts)
+}
+
+// SearchContacts searches the contacts. Only the attributes of the projection are set in the hits.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/search/introduction/
+func (c *Client) SearchContacts(
+	ctx context.Context, query SearchQuery, opts *SearchOptions,
+) ([]Contact, SearchMeta, error) {
+	return search[Contact](ctx, c, "contacts", query, opts)
+}
+
+// SearchDeals searches the deals. Only the attributes of the projection are set in the hits.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/search/introduction/
+func (c *Client) SearchDeals(ctx context.Context, query SearchQuery, opts *SearchOptions) ([]Deal, SearchMeta, error) {
+	return search[Deal](ctx, c, "deals", query, opts)
+}
+
+// search sends a query to the search endpoint of a resource
+func search[T any](
+	ctx context.Context, c *Client, resource string, query SearchQuery, opts *SearchOptions,
+) ([]T, SearchMeta, error) {
+	type searchRequest struct {
+		Data struct {
+			Query SearchQuery `json:"query"`
+		} `json:"data"`
+		PerPage int    `json:"per_page,omitempty"`
+		Cursor  string `json:"cursor,omitempty"`
+	}
+
+	var result struct {
+		Items []struct {
+			Successful bool `json:"successful"`
+			Items      []struct {
+				Data T `json:"data"`
+			} `json:"items"`
+			Meta struct {
+				Count      int64 `json:"count"`
+				TotalCount int64 `json:"total_count"`
+				Links      struct {
+					NextPage string `json:"next_page"`
+				} `json:"links"`
+			} `json:"meta"`
+			Errors json.RawMessage `json:"errors,omitempty"`
+		} `json:"items"`
+	}
+
+	req := searchRequest{}
+	req.Data.Query = query
+	if opts != nil {
+		req.PerPage = opts.PerPage
+		req.Cursor = opts.Cursor
+	}
+	data := struct {
+		Items []searchRequest `json:"items"`
+	}{Items: []searchRequest{req}}
+
+	path := "/" + url.PathEscape(resource) + "/search"
+	body, _, err := c.send(ctx, http.MethodPost, c.v3Endpoint()+path, data, nil)
+	if err != nil {
+		return nil, SearchMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, SearchMeta{}, err
+	}
+	if len(result.Items) == 0 {
+		return nil, SearchMeta{}, errors.New("search response has no result")
+	}
+
+	page := result.Items[0]
+	if !page.Successful {
+		return nil, SearchMeta{}, errors.New("search failed: " + string(page.Errors))
+	}
+
+	hits := make([]T, len(page.Items))
+	for i, item := range page.Items {
+		hits[i] = item.Data
+	}
+	meta := SearchMeta{Count: page.Meta.Count, TotalCount: page.Meta.TotalCount, NextCursor: page.Meta.Links.NextPage}
+	return hits, meta, nil
+}
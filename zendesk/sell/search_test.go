@@ -0,0 +1,77 @@
+package sell
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchFilterJSON(t *testing.T) {
+	filter := SearchAnd(
+		SearchEq("stage.name", "Won"),
+		SearchNot(SearchIsNull("owner.id", true)),
+		SearchRangeOf("value", SearchRange{GTE: 1000}),
+	)
+
+	b, err := json.Marshal(filter)
+	if err != nil {
+		t.Fatalf("Failed to marshal filter: %s", err)
+	}
+
+	expected := `{"and":[` +
+		`{"filter":{"attribute":{"name":"stage.name"},"parameter":{"eq":"Won"}}},` +
+		`{"not":{"filter":{"attribute":{"name":"owner.id"},"parameter":{"is_null":true}}}},` +
+		`{"filter":{"attribute":{"name":"value"},"parameter":{"range":{"gte":1000}}}}]}`
+	if string(b) != expected {
+		t.Fatalf("unexpected filter: %s", b)
+	}
+}
+
+func TestSearchDeals(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"items":[{"data":{"query":{` +
+			`"filter":{"filter":{"attribute":{"name":"value"},"parameter":{"range":{"gte":1000}}}},` +
+			`"projection":[{"name":"name"},{"name":"value"}],` +
+			`"sort":[{"attribute":{"name":"value"},"order":"descending"}]}},"per_page":2}]}`
+		if r.Method != http.MethodPost || r.URL.Path != "/deals/search" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("POST/sell_search_deals.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	filter := SearchRangeOf("value", SearchRange{GTE: 1000})
+	deals, meta, err := client.SearchDeals(ctx, SearchQuery{
+		Filter:     &filter,
+		Projection: []SearchAttribute{{Name: "name"}, {Name: "value"}},
+		Sort:       []SearchSort{{Attribute: SearchAttribute{Name: "value"}, Order: SearchOrderDescending}},
+	}, &SearchOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("Failed to search deals: %s", err)
+	}
+
+	if len(deals) != 2 || deals[0].Value != "1000.50" {
+		t.Fatalf("unexpected deals: %+v", deals)
+	}
+	if meta.TotalCount != 5 || meta.NextCursor == "" {
+		t.Fatalf("unexpected meta: %+v", meta)
+	}
+}
+
+func TestSearchFailed(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"items":[{"successful":false,"errors":[{"code":"invalid_attribute"}]}]}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.SearchLeads(ctx, SearchQuery{}, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
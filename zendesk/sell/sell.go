@@ -0,0 +1,269 @@
+// Package sell is a client of the Zendesk Sell API.
+//
+// Sell is served from its own base URL, wraps its resources in a data envelope
+// and is authenticated with an OAuth access token, so it has a client separate from zendesk.Client.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/introduction/
+package sell
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+	"github.com/harrisonzhao/go-zendesk/zendesk"
+)
+
+const (
+	baseURL   = "https://api.getbase.com/v2"
+	v3BaseURL = "https://api.getbase.com/v3"
+)
+
+var defaultHeaders = map[string]string{
+	"User-Agent":   "nukosuke/go-zendesk/0.18.0",
+	"Content-Type": "application/json",
+	"Accept":       "application/json",
+}
+
+// Client of Zendesk Sell API
+type Client struct {
+	baseURL    *url.URL
+	v3URL      *url.URL
+	httpClient *http.Client
+	credential zendesk.Credential
+	headers    map[string]string
+}
+
+// NewClient creates new Zendesk Sell API client
+func NewClient(httpClient *http.Client) (*Client, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &Client{baseURL: u, httpClient: httpClient, headers: map[string]string{}}
+	for key, value := range defaultHeaders {
+		client.headers[key] = value
+	}
+	return client, nil
+}
+
+// SetHeader saves HTTP header in client. It will be included all API request
+func (c *Client) SetHeader(key string, value string) {
+	c.headers[key] = value
+}
+
+// SetEndpointURL replaces the URL of the Sell API.
+// This is mainly used for testing to point to mock API server.
+func (c *Client) SetEndpointURL(newURL string) error {
+	u, err := url.Parse(newURL)
+	if err != nil {
+		return err
+	}
+
+	c.baseURL = u
+	return nil
+}
+
+// SetV3EndpointURL replaces the URL of the v3 Sell API, which serves the Firehose and the search.
+// This is mainly used for testing to point to mock API server.
+func (c *Client) SetV3EndpointURL(newURL string) error {
+	u, err := url.Parse(newURL)
+	if err != nil {
+		return err
+	}
+
+	c.v3URL = u
+	return nil
+}
+
+func (c *Client) v3Endpoint() string {
+	if c.v3URL == nil {
+		return v3BaseURL
+	}
+	return c.v3URL.String()
+}
+
+// SetCredential saves credential in client, usually an access token
+// created with zendesk.NewBearerTokenCredential
+func (c *Client) SetCredential(cred zendesk.Credential) {
+	c.credential = cred
+}
+
+// ListOptions is the pagination options of the Sell lists
+type ListOptions struct {
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
+	// SortBy is a field and an optional order, such as "created_at:desc"
+	SortBy string `url:"sort_by,omitempty"`
+}
+
+// ListMeta is the meta of a page of a Sell list
+type ListMeta struct {
+	Type  string `json:"type"`
+	Count int64  `json:"count"`
+	Links struct {
+		Self     string `json:"self"`
+		NextPage string `json:"next_page"`
+		PrevPage string `json:"prev_page"`
+	} `json:"links"`
+}
+
+// HasMore returns true if there is a page after this page
+func (m ListMeta) HasMore() bool {
+	return m.Links.NextPage != ""
+}
+
+// Address is a postal address of a Sell resource
+type Address struct {
+	Line1      string `json:"line1,omitempty"`
+	City       string `json:"city,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	State      string `json:"state,omitempty"`
+	Country    string `json:"country,omitempty"`
+}
+
+// request sends a request to the Sell API and returns the response body as []byte.
+// Any 2xx status is a success.
+func (c *Client) request(ctx context.Context, method, path string, data interface{}) ([]byte, error) {
+	body, _, err := c.send(ctx, method, c.baseURL.String()+path, data, nil)
+	return body, err
+}
+
+// send sends a request to a URL with extra headers and returns the response body and status code
+func (c *Client) send(
+	ctx context.Context, method, rawURL string, data interface{}, headers map[string]string,
+) ([]byte, int, error) {
+	var reqBody io.Reader
+	if data != nil {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return nil, 0, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, reqBody)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for key, value := range c.headers {
+		req.Header.Set(key, value)
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	if c.credential != nil {
+		if c.credential.Bearer() {
+			req.Header.Add("Authorization", "Bearer "+c.credential.Secret())
+		} else {
+			req.SetBasicAuth(c.credential.Email(), c.credential.Secret())
+		}
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, resp.StatusCode, zendesk.NewError(body, resp)
+	}
+	return body, resp.StatusCode, nil
+}
+
+// getItem gets a resource in a data envelope
+func getItem[T any](ctx context.Context, c *Client, path string) (T, error) {
+	return sendItem[T](ctx, c, http.MethodGet, path, nil)
+}
+
+// sendItem sends a resource in a data envelope and returns the resource of the response
+func sendItem[T any](ctx context.Context, c *Client, method, path string, data interface{}) (T, error) {
+	var result struct {
+		Data T `json:"data"`
+	}
+
+	var payload interface{}
+	if data != nil {
+		payload = struct {
+			Data interface{} `json:"data"`
+		}{data}
+	}
+
+	body, err := c.request(ctx, method, path, payload)
+	if err != nil {
+		return result.Data, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	return result.Data, err
+}
+
+// listItems gets a page of resources in data envelopes
+func listItems[T any](ctx context.Context, c *Client, path string, opts interface{}) ([]T, ListMeta, error) {
+	var result struct {
+		Items []struct {
+			Data T `json:"data"`
+		} `json:"items"`
+		Meta ListMeta `json:"meta"`
+	}
+
+	u, err := addOptions(path, opts)
+	if err != nil {
+		return nil, ListMeta{}, err
+	}
+
+	body, err := c.request(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, ListMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, ListMeta{}, err
+	}
+
+	items := make([]T, len(result.Items))
+	for i, item := range result.Items {
+		items[i] = item.Data
+	}
+	return items, result.Meta, nil
+}
+
+// deleteItem deletes a resource
+func (c *Client) deleteItem(ctx context.Context, path string) error {
+	_, err := c.request(ctx, http.MethodDelete, path, nil)
+	return err
+}
+
+// addOptions build query string
+func addOptions(s string, opts interface{}) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs, err := query.Values(opts)
+	if err != nil {
+		return s, err
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
@@ -0,0 +1,89 @@
+package sell
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/harrisonzhao/go-zendesk/zendesk"
+)
+
+////////// Helper //////////
+
+var ctx = context.Background()
+
+func fixture(filename string) string {
+	dir, err := filepath.Abs("../../fixture")
+	if err != nil {
+		fmt.Printf("Failed to resolve fixture directory. Check the path: %s", err)
+		os.Exit(1)
+	}
+	return filepath.Join(dir, filename)
+}
+
+func readFixture(filename string) []byte {
+	bytes, err := ioutil.ReadFile(fixture(filename))
+	if err != nil {
+		fmt.Printf("Failed to read fixture. Check the path: %s", err)
+		os.Exit(1)
+	}
+	return bytes
+}
+
+func newMockAPI(method string, filename string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(readFixture(filepath.Join(method, filename)))
+	}))
+}
+
+func newMockAPIWithStatus(method string, filename string, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write(readFixture(filepath.Join(method, filename)))
+	}))
+}
+
+func newTestClient(mockAPI *httptest.Server) *Client {
+	c, _ := NewClient(nil)
+	c.SetCredential(zendesk.NewBearerTokenCredential("token"))
+	c.SetEndpointURL(mockAPI.URL)
+	c.SetV3EndpointURL(mockAPI.URL)
+	return c
+}
+
+////////// Test //////////
+
+func TestRequestCredential(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("Accept") != "application/json" {
+			t.Fatalf("unexpected headers: %v", r.Header)
+		}
+		w.Write(readFixture("GET/sell_lead.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.GetLead(ctx, 1001); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+}
+
+func TestRequestError(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"errors":[{"error":{"code":"invalid","message":"can't be blank"}}],"meta":{"type":"errors"}}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateLead(ctx, Lead{})
+	zerr, ok := err.(zendesk.Error)
+	if !ok || zerr.Status() != http.StatusUnprocessableEntity {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+package sell
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// Sync event types
+const (
+	SyncEventTypeCreated = "created"
+	SyncEventTypeUpdated = "updated"
+	SyncEventTypeDeleted = "deleted"
+)
+
+// syncDeviceHeader identifies the device which replicates the data, each device has its own queue
+const syncDeviceHeader = "X-Basecrm-Device-UUID"
+
+// SyncSession is a session of the Sell Sync API.
+// Its ID is empty when there is nothing to synchronize.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/sync/reference/
+type SyncSession struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// SyncMeta is the sync information of a queued item
+type SyncMeta struct {
+	// EventType is SyncEventTypeCreated, SyncEventTypeUpdated or SyncEventTypeDeleted
+	EventType string `json:"event_type"`
+	AckKey    string `json:"ack_key"`
+	Revision  int64  `json:"revision"`
+}
+
+// SyncItem is a changed resource queued by the Sync API.
+// Data is the resource of Type, such as "lead" or "deal", which can be decoded with Decode.
+type SyncItem struct {
+	Data json.RawMessage `json:"data"`
+	Meta struct {
+		Type string   `json:"type"`
+		Sync SyncMeta `json:"sync"`
+	} `json:"meta"`
+}
+
+// Decode decodes the resource of the item into v, such as a *Lead for an item of type "lead"
+func (i SyncItem) Decode(v interface{}) error {
+	return json.Unmarshal(i.Data, v)
+}
+
+// SyncAPI an interface containing all Sell sync related methods
+type SyncAPI interface {
+	StartSyncSession(ctx context.Context, deviceUUID string) (SyncSession, error)
+	FetchSyncQueue(ctx context.Context, deviceUUID, sessionID string) ([]SyncItem, error)
+	AckSyncItems(ctx context.Context, deviceUUID string, ackKeys []string) error
+	GetSyncIterator(ctx context.Context, deviceUUID string) *SyncIterator
+}
+
+// StartSyncSession starts a sync session for the device.
+// The session ID is empty when there is nothing to synchronize.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/sync/reference/#start-synchronization-session
+func (c *Client) StartSyncSession(ctx context.Context, deviceUUID string) (SyncSession, error) {
+	var result struct {
+		Data SyncSession `json:"data"`
+	}
+
+	body, status, err := c.send(ctx, http.MethodPost, c.baseURL.String()+"/sync/start", nil, syncHeaders(deviceUUID))
+	if err != nil {
+		return SyncSession{}, err
+	}
+	if status == http.StatusNoContent {
+		return SyncSession{}, nil
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SyncSession{}, err
+	}
+	return result.Data, nil
+}
+
+// FetchSyncQueue fetches the next items of the queue of the session.
+// It returns no item when the queue is drained.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/sync/reference/#get-data-from-queue
+func (c *Client) FetchSyncQueue(ctx context.Context, deviceUUID, sessionID string) ([]SyncItem, error) {
+	var result struct {
+		Items []SyncItem `json:"items"`
+	}
+
+	path := "/sync/" + url.PathEscape(sessionID) + "/queues/main"
+	body, status, err := c.send(ctx, http.MethodGet, c.baseURL.String()+path, nil, syncHeaders(deviceUUID))
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNoContent {
+		return nil, nil
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
+
+// AckSyncItems acknowledges the items which were processed by their ack keys.
+// The items which are not acknowledged are queued again by the next session.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/sync/reference/#acknowledgement-keys
+func (c *Client) AckSyncItems(ctx context.Context, deviceUUID string, ackKeys []string) error {
+	if len(ackKeys) == 0 {
+		return nil
+	}
+
+	data := struct {
+		Data struct {
+			AckKeys []string `json:"ack_keys"`
+		} `json:"data"`
+	}{}
+	data.Data.AckKeys = ackKeys
+
+	_, _, err := c.send(ctx, http.MethodPost, c.baseURL.String()+"/sync/ack", data, syncHeaders(deviceUUID))
+	return err
+}
+
+// SyncIterator iterates over the items queued for a device.
+// It starts a session with the first GetNext and ends when the queue is drained.
+type SyncIterator struct {
+	client     *Client
+	deviceUUID string
+	sessionID  string
+	hasMore    bool
+	ctx        context.Context
+}
+
+// GetSyncIterator returns an iterator over the items queued for the device
+func (c *Client) GetSyncIterator(ctx context.Context, deviceUUID string) *SyncIterator {
+	return &SyncIterator{
+		client:     c,
+		deviceUUID: deviceUUID,
+		hasMore:    true,
+		ctx:        ctx,
+	}
+}
+
+// HasMore returns a boolean indicating whether the queue may have more items.
+func (i *SyncIterator) HasMore() bool {
+	return i.hasMore
+}
+
+// GetNext fetches the next items of the queue. The items must be acknowledged with Ack once processed.
+// In case of an error, it sets hasMore to false and returns an error.
+func (i *SyncIterator) GetNext() ([]SyncItem, error) {
+	if i.sessionID == "" {
+		session, err := i.client.StartSyncSession(i.ctx, i.deviceUUID)
+		if err != nil {
+			i.hasMore = false
+			return nil, err
+		}
+		if session.ID == "" {
+			i.hasMore = false
+			return nil, nil
+		}
+		i.sessionID = session.ID
+	}
+
+	items, err := i.client.FetchSyncQueue(i.ctx, i.deviceUUID, i.sessionID)
+	if err != nil {
+		i.hasMore = false
+		return nil, err
+	}
+	if len(items) == 0 {
+		i.hasMore = false
+	}
+	return items, nil
+}
+
+// Ack acknowledges the items returned by GetNext
+func (i *SyncIterator) Ack(items []SyncItem) error {
+	keys := make([]string, len(items))
+	for idx, item := range items {
+		keys[idx] = item.Meta.Sync.AckKey
+	}
+	return i.client.AckSyncItems(i.ctx, i.deviceUUID, keys)
+}
+
+func syncHeaders(deviceUUID string) map[string]string {
+	return map[string]string{syncDeviceHeader: deviceUUID}
+}
@@ -0,0 +1,118 @@
+package sell
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testDeviceUUID = "6dadcec8-6e61-4691-b318-1aab27b8fecf"
+
+func TestStartSyncSession(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/sync/start" || r.Header.Get(syncDeviceHeader) != testDeviceUUID {
+			t.Fatalf("unexpected request: %s %s %v", r.Method, r.URL.Path, r.Header)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/sell_sync_start.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	session, err := client.StartSyncSession(ctx, testDeviceUUID)
+	if err != nil {
+		t.Fatalf("Failed to start sync session: %s", err)
+	}
+
+	if session.ID != "29d2e0f5-3b4c-4c1a-9b5a-0e8c1f1d2a3b" {
+		t.Fatalf("unexpected session: %+v", session)
+	}
+}
+
+func TestStartSyncSessionNothingToSync(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	session, err := client.StartSyncSession(ctx, testDeviceUUID)
+	if err != nil {
+		t.Fatalf("Failed to start sync session: %s", err)
+	}
+
+	if session.ID != "" {
+		t.Fatalf("expected no session, but got %+v", session)
+	}
+}
+
+func TestFetchSyncQueue(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_sync_queue.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	items, err := client.FetchSyncQueue(ctx, testDeviceUUID, "29d2e0f5-3b4c-4c1a-9b5a-0e8c1f1d2a3b")
+	if err != nil {
+		t.Fatalf("Failed to fetch sync queue: %s", err)
+	}
+
+	if len(items) != 2 || items[1].Meta.Sync.EventType != SyncEventTypeDeleted {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+
+	var lead Lead
+	if err := items[0].Decode(&lead); err != nil {
+		t.Fatalf("Failed to decode lead: %s", err)
+	}
+	if lead.LastName != "Johnson" {
+		t.Fatalf("unexpected lead: %+v", lead)
+	}
+}
+
+func TestSyncIterator(t *testing.T) {
+	fetched := 0
+	var acked string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sync/start":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/sell_sync_start.json"))
+		case "/sync/29d2e0f5-3b4c-4c1a-9b5a-0e8c1f1d2a3b/queues/main":
+			fetched++
+			if fetched > 1 {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Write(readFixture("GET/sell_sync_queue.json"))
+		case "/sync/ack":
+			body, _ := io.ReadAll(r.Body)
+			acked = string(body)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			t.Fatalf("unexpected request: %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var all []SyncItem
+	it := client.GetSyncIterator(ctx, testDeviceUUID)
+	for it.HasMore() {
+		items, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get next items: %s", err)
+		}
+		if err := it.Ack(items); err != nil {
+			t.Fatalf("Failed to ack items: %s", err)
+		}
+		all = append(all, items...)
+	}
+
+	if len(all) != 2 {
+		t.Fatalf("expected length of items is 2, but got %d", len(all))
+	}
+	if acked != `{"data":{"ack_keys":["Lead-1001-1","Deal-3001-4"]}}` {
+		t.Fatalf("unexpected ack: %s", acked)
+	}
+}
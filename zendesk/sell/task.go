@@ -0,0 +1,101 @@
+package sell
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Resource types of the resources tasks and notes are attached to
+const (
+	ResourceTypeLead    = "lead"
+	ResourceTypeContact = "contact"
+	ResourceTypeDeal    = "deal"
+)
+
+// Task is a Sell task, a to-do attached to a lead, a contact or a deal
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/tasks/
+type Task struct {
+	ID        int64 `json:"id,omitempty"`
+	CreatorID int64 `json:"creator_id,omitempty"`
+	OwnerID   int64 `json:"owner_id,omitempty"`
+	// ResourceType is ResourceTypeLead, ResourceTypeContact or ResourceTypeDeal and ResourceID is its id.
+	// A task without a resource is a floating task.
+	ResourceType string     `json:"resource_type,omitempty"`
+	ResourceID   int64      `json:"resource_id,omitempty"`
+	Content      string     `json:"content,omitempty"`
+	Completed    *bool      `json:"completed,omitempty"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+	RemindAt     *time.Time `json:"remind_at,omitempty"`
+	Overdue      bool       `json:"overdue,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// TaskListOptions is options for ListTasks
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/tasks/#retrieve-all-tasks
+type TaskListOptions struct {
+	ListOptions
+	IDs          string `url:"ids,omitempty"`
+	CreatorID    int64  `url:"creator_id,omitempty"`
+	OwnerID      int64  `url:"owner_id,omitempty"`
+	ResourceType string `url:"resource_type,omitempty"`
+	ResourceID   int64  `url:"resource_id,omitempty"`
+	Completed    *bool  `url:"completed,omitempty"`
+	Overdue      *bool  `url:"overdue,omitempty"`
+	// Type is "floating" or "related"
+	Type string `url:"type,omitempty"`
+	Q    string `url:"q,omitempty"`
+}
+
+// TaskAPI an interface containing all Sell task related methods
+type TaskAPI interface {
+	ListTasks(ctx context.Context, opts *TaskListOptions) ([]Task, ListMeta, error)
+	GetTask(ctx context.Context, taskID int64) (Task, error)
+	CreateTask(ctx context.Context, task Task) (Task, error)
+	UpdateTask(ctx context.Context, taskID int64, task Task) (Task, error)
+	DeleteTask(ctx context.Context, taskID int64) error
+}
+
+// ListTasks lists the tasks
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/tasks/#retrieve-all-tasks
+func (c *Client) ListTasks(ctx context.Context, opts *TaskListOptions) ([]Task, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &TaskListOptions{}
+	}
+	return listItems[Task](ctx, c, "/tasks", tmp)
+}
+
+// GetTask gets a task
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/tasks/#retrieve-a-single-task
+func (c *Client) GetTask(ctx context.Context, taskID int64) (Task, error) {
+	return getItem[Task](ctx, c, fmt.Sprintf("/tasks/%d", taskID))
+}
+
+// CreateTask creates a task. Content is required.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/tasks/#create-a-task
+func (c *Client) CreateTask(ctx context.Context, task Task) (Task, error) {
+	return sendItem[Task](ctx, c, http.MethodPost, "/tasks", task)
+}
+
+// UpdateTask updates the fields of a task which are set, such as completing it
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/tasks/#update-a-task
+func (c *Client) UpdateTask(ctx context.Context, taskID int64, task Task) (Task, error) {
+	return sendItem[Task](ctx, c, http.MethodPut, fmt.Sprintf("/tasks/%d", taskID), task)
+}
+
+// DeleteTask deletes a task
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/tasks/#delete-a-task
+func (c *Client) DeleteTask(ctx context.Context, taskID int64) error {
+	return c.deleteItem(ctx, fmt.Sprintf("/tasks/%d", taskID))
+}
@@ -0,0 +1,89 @@
+package sell
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListTasks(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if r.URL.Path != "/tasks" || query.Get("resource_type") != "lead" || query.Get("completed") != "false" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/sell_tasks.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	completed := false
+	tasks, _, err := client.ListTasks(ctx, &TaskListOptions{ResourceType: ResourceTypeLead, Completed: &completed})
+	if err != nil {
+		t.Fatalf("Failed to list tasks: %s", err)
+	}
+
+	if len(tasks) != 2 || tasks[1].CompletedAt == nil {
+		t.Fatalf("unexpected tasks: %+v", tasks)
+	}
+}
+
+func TestCreateTask(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"data":{"resource_type":"lead","resource_id":1001,"content":"Call back about the proposal"}}`
+		if r.Method != http.MethodPost || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s", r.Method, body)
+		}
+		w.Write(readFixture("POST/sell_task.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTask(ctx, Task{
+		ResourceType: ResourceTypeLead,
+		ResourceID:   1001,
+		Content:      "Call back about the proposal",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create task: %s", err)
+	}
+}
+
+func TestUpdateTask(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/tasks/5001" || string(body) != `{"data":{"completed":true}}` {
+			t.Fatalf("unexpected request: %s %s %s", r.Method, r.URL.Path, body)
+		}
+		w.Write(readFixture("PUT/sell_task.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	completed := true
+	task, err := client.UpdateTask(ctx, 5001, Task{Completed: &completed})
+	if err != nil {
+		t.Fatalf("Failed to update task: %s", err)
+	}
+
+	if !*task.Completed {
+		t.Fatalf("unexpected task: %+v", task)
+	}
+}
+
+func TestDeleteTask(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/tasks/5001" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTask(ctx, 5001); err != nil {
+		t.Fatalf("Failed to delete task: %s", err)
+	}
+}
@@ -0,0 +1,90 @@
+package sell
+
+import (
+	"context"
+	"time"
+)
+
+// Visit location verification statuses
+const (
+	VisitLocationVerified    = "verified"
+	VisitLocationUnverified  = "unverified"
+	VisitLocationNotRequired = "not_required"
+)
+
+// Visit is a visit of a sales rep to a lead or a contact, logged by the Sell mobile app
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/visits/
+type Visit struct {
+	ID        int64 `json:"id"`
+	CreatorID int64 `json:"creator_id"`
+	OutcomeID int64 `json:"outcome_id"`
+	// ResourceType is ResourceTypeLead or ResourceTypeContact and ResourceID is its id
+	ResourceType    string `json:"resource_type"`
+	ResourceID      int64  `json:"resource_id"`
+	ResourceAddress string `json:"resource_address"`
+	// RepLocationVerificationStatus tells if the rep was at the address, one of the VisitLocation constants
+	RepLocationVerificationStatus string     `json:"rep_location_verification_status"`
+	Summary                       string     `json:"summary"`
+	VisitedAt                     *time.Time `json:"visited_at"`
+	CreatedAt                     *time.Time `json:"created_at"`
+	UpdatedAt                     *time.Time `json:"updated_at"`
+}
+
+// VisitListOptions is options for ListVisits
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/visits/#retrieve-visits
+type VisitListOptions struct {
+	ListOptions
+	OutcomeID                     int64  `url:"outcome_id,omitempty"`
+	CreatorID                     int64  `url:"creator_id,omitempty"`
+	ResourceType                  string `url:"resource_type,omitempty"`
+	ResourceID                    int64  `url:"resource_id,omitempty"`
+	RepLocationVerificationStatus string `url:"rep_location_verification_status,omitempty"`
+}
+
+// VisitOutcome is an outcome of a visit, such as "Demo scheduled"
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/visit_outcomes/
+type VisitOutcome struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	CreatorID int64      `json:"creator_id"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// VisitOutcomeListOptions is options for ListVisitOutcomes
+type VisitOutcomeListOptions struct {
+	ListOptions
+}
+
+// VisitAPI an interface containing all Sell visit related methods
+type VisitAPI interface {
+	ListVisits(ctx context.Context, opts *VisitListOptions) ([]Visit, ListMeta, error)
+	ListVisitOutcomes(ctx context.Context, opts *VisitOutcomeListOptions) ([]VisitOutcome, ListMeta, error)
+}
+
+// ListVisits lists the visits. Visits are logged by the mobile app, the API is read only.
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/visits/#retrieve-visits
+func (c *Client) ListVisits(ctx context.Context, opts *VisitListOptions) ([]Visit, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &VisitListOptions{}
+	}
+	return listItems[Visit](ctx, c, "/visits", tmp)
+}
+
+// ListVisitOutcomes lists the outcomes of the visits
+//
+// ref: https://developer.zendesk.com/api-reference/sales-crm/resources/visit_outcomes/#retrieve-visit-outcomes
+func (c *Client) ListVisitOutcomes(
+	ctx context.Context, opts *VisitOutcomeListOptions,
+) ([]VisitOutcome, ListMeta, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &VisitOutcomeListOptions{}
+	}
+	return listItems[VisitOutcome](ctx, c, "/visit_outcomes", tmp)
+}
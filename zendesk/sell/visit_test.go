@@ -0,0 +1,42 @@
+package sell
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListVisits(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/visits" || r.URL.Query().Get("resource_type") != "contact" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/sell_visits.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	visits, _, err := client.ListVisits(ctx, &VisitListOptions{ResourceType: ResourceTypeContact})
+	if err != nil {
+		t.Fatalf("Failed to list visits: %s", err)
+	}
+
+	if len(visits) != 1 || visits[0].RepLocationVerificationStatus != VisitLocationVerified {
+		t.Fatalf("unexpected visits: %+v", visits)
+	}
+}
+
+func TestListVisitOutcomes(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sell_visit_outcomes.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	outcomes, _, err := client.ListVisitOutcomes(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list visit outcomes: %s", err)
+	}
+
+	if len(outcomes) != 2 {
+		t.Fatalf("expected length of visit outcomes is 2, but got %d", len(outcomes))
+	}
+}
@@ -0,0 +1,157 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Session is a browser session of a user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#json-format
+type Session struct {
+	ID              int64     `json:"id"`
+	UserID          int64     `json:"user_id"`
+	URL             string    `json:"url,omitempty"`
+	AuthenticatedAt time.Time `json:"authenticated_at"`
+	LastSeenAt      time.Time `json:"last_seen_at"`
+}
+
+// SessionListOptions is options for GetSessions and GetUserSessions
+type SessionListOptions struct {
+	CursorPagination
+}
+
+// SessionAPI an interface containing all session related methods
+type SessionAPI interface {
+	GetSessions(ctx context.Context, opts *SessionListOptions) ([]Session, CursorPaginationMeta, error)
+	GetUserSessions(
+		ctx context.Context, userID int64, opts *SessionListOptions) ([]Session, CursorPaginationMeta, error)
+	GetSession(ctx context.Context, userID int64, sessionID int64) (Session, error)
+	GetCurrentSession(ctx context.Context) (Session, error)
+	DeleteSession(ctx context.Context, userID int64, sessionID int64) error
+	DeleteUserSessions(ctx context.Context, userID int64) error
+	BulkDeleteSessionsByUser(ctx context.Context, userIDs []int64) error
+	LogoutCurrentSession(ctx context.Context) error
+}
+
+// GetSessions lists the sessions of all users of the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#list-sessions
+func (z *Client) GetSessions(ctx context.Context, opts *SessionListOptions) ([]Session, CursorPaginationMeta, error) {
+	return z.getSessions(ctx, "/sessions.json", opts)
+}
+
+// GetUserSessions lists the sessions of the user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#list-sessions
+func (z *Client) GetUserSessions(
+	ctx context.Context, userID int64, opts *SessionListOptions,
+) ([]Session, CursorPaginationMeta, error) {
+	return z.getSessions(ctx, fmt.Sprintf("/users/%d/sessions.json", userID), opts)
+}
+
+func (z *Client) getSessions(
+	ctx context.Context, path string, opts *SessionListOptions,
+) ([]Session, CursorPaginationMeta, error) {
+	var result struct {
+		Sessions []Session            `json:"sessions"`
+		Meta     CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SessionListOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Sessions, result.Meta, nil
+}
+
+// GetSession returns the session of the user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#show-session
+func (z *Client) GetSession(ctx context.Context, userID int64, sessionID int64) (Session, error) {
+	return z.getSession(ctx, fmt.Sprintf("/users/%d/sessions/%d.json", userID, sessionID))
+}
+
+// GetCurrentSession returns the session of the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#show-the-currently-authenticated-session
+func (z *Client) GetCurrentSession(ctx context.Context) (Session, error) {
+	return z.getSession(ctx, "/users/me/session.json")
+}
+
+func (z *Client) getSession(ctx context.Context, path string) (Session, error) {
+	var result struct {
+		Session Session `json:"session"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Session{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Session{}, err
+	}
+	return result.Session, nil
+}
+
+// DeleteSession deletes the session of the user, which logs the user out of it
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#delete-session
+func (z *Client) DeleteSession(ctx context.Context, userID int64, sessionID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/users/%d/sessions/%d.json", userID, sessionID), nil)
+}
+
+// DeleteUserSessions deletes all sessions of the user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#bulk-delete-sessions
+func (z *Client) DeleteUserSessions(ctx context.Context, userID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/users/%d/sessions.json", userID), nil)
+}
+
+// BulkDeleteSessionsByUser deletes all sessions of each user, which logs the users out everywhere.
+// A failure for a user does not stop the deletion for the other users, the failures are
+// returned in a *SessionsDeletionError.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#bulk-delete-sessions
+func (z *Client) BulkDeleteSessionsByUser(ctx context.Context, userIDs []int64) error {
+	errs := map[int64]error{}
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := z.DeleteUserSessions(ctx, userID); err != nil {
+			errs[userID] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return &SessionsDeletionError{Errors: errs}
+	}
+	return nil
+}
+
+// LogoutCurrentSession deletes the session of the authenticated user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/sessions/#delete-the-authenticated-session
+func (z *Client) LogoutCurrentSession(ctx context.Context) error {
+	return z.delete(ctx, "/users/me/logout.json", nil)
+}
@@ -0,0 +1,122 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSessions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sessions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sessions, _, err := client.GetSessions(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get sessions: %s", err)
+	}
+
+	if len(sessions) != 2 {
+		t.Fatalf("expected length of sessions is 2, but got %d", len(sessions))
+	}
+}
+
+func TestGetUserSessions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/12/sessions.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/sessions.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.GetUserSessions(ctx, 12, &SessionListOptions{CursorPagination{PageSize: 2}})
+	if err != nil {
+		t.Fatalf("Failed to get user sessions: %s", err)
+	}
+}
+
+func TestGetSession(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "session.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	session, err := client.GetSession(ctx, 12, 3432)
+	if err != nil {
+		t.Fatalf("Failed to get session: %s", err)
+	}
+
+	if session.ID != 3432 || session.UserID != 12 {
+		t.Fatalf("unexpected session: %+v", session)
+	}
+}
+
+func TestGetCurrentSession(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/me/session.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/session.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetCurrentSession(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get current session: %s", err)
+	}
+}
+
+func TestDeleteSessions(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteSession(ctx, 12, 3432); err != nil {
+		t.Fatalf("Failed to delete session: %s", err)
+	}
+	if err := client.DeleteUserSessions(ctx, 12); err != nil {
+		t.Fatalf("Failed to delete user sessions: %s", err)
+	}
+	if err := client.LogoutCurrentSession(ctx); err != nil {
+		t.Fatalf("Failed to logout current session: %s", err)
+	}
+
+	expected := []string{"/users/12/sessions/3432.json", "/users/12/sessions.json", "/users/me/logout.json"}
+	for i, path := range expected {
+		if paths[i] != path {
+			t.Fatalf("expected path is %s, but got %s", path, paths[i])
+		}
+	}
+}
+
+func TestBulkDeleteSessionsByUser(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path == "/users/13/sessions.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.BulkDeleteSessionsByUser(ctx, []int64{12, 13, 14})
+	deletionErr, ok := err.(*SessionsDeletionError)
+	if !ok {
+		t.Fatalf("expected a SessionsDeletionError, but got %v", err)
+	}
+	if len(deletionErr.Errors) != 1 || deletionErr.Errors[13] == nil {
+		t.Fatalf("unexpected errors: %v", deletionErr.Errors)
+	}
+	if len(paths) != 3 {
+		t.Fatalf("expected sessions of 3 users to be deleted, but got %v", paths)
+	}
+}
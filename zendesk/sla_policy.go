@@ -28,11 +28,41 @@ const (
 	RequesterWaitTimeMetric  = "requester_wait_time"
 )
 
+// SLAPolicyMetric is the target of a metric for tickets with the priority.
+// Target is in minutes, and TargetInSeconds can be used instead for targets under a minute.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#policy-metric
 type SLAPolicyMetric struct {
-	Priority      string `json:"priority"`
-	Metric        string `json:"metric"`
-	Target        int    `json:"target"`
-	BusinessHours bool   `json:"business_hours"`
+	Priority        string `json:"priority"`
+	Metric          string `json:"metric"`
+	Target          int    `json:"target,omitempty"`
+	TargetInSeconds int    `json:"target_in_seconds,omitempty"`
+	BusinessHours   bool   `json:"business_hours"`
+}
+
+// SLAPolicyFilterDefinition describes a field that can be used in the filter of SLA policies
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#retrieve-supported-filter-definition-items
+type SLAPolicyFilterDefinition struct {
+	Group     string `json:"group"`
+	Title     string `json:"title"`
+	Value     string `json:"value"`
+	Target    string `json:"target,omitempty"`
+	Operators []struct {
+		Title string `json:"title"`
+		Value string `json:"value"`
+	} `json:"operators"`
+	Values []struct {
+		Title   string      `json:"title"`
+		Value   interface{} `json:"value"`
+		Enabled bool        `json:"enabled"`
+	} `json:"values,omitempty"`
+}
+
+// SLAPolicyFilterDefinitions is the list of fields supported by "all" and "any" filters
+type SLAPolicyFilterDefinitions struct {
+	All []SLAPolicyFilterDefinition `json:"all"`
+	Any []SLAPolicyFilterDefinition `json:"any"`
 }
 
 // SLAPolicy is zendesk slaPolicy JSON payload format
@@ -70,6 +100,8 @@ type SLAPolicyAPI interface {
 	GetSLAPolicy(ctx context.Context, id int64) (SLAPolicy, error)
 	UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPolicy) (SLAPolicy, error)
 	DeleteSLAPolicy(ctx context.Context, id int64) error
+	ReorderSLAPolicies(ctx context.Context, slaPolicyIDs []int64) error
+	GetSLAPolicyFilterDefinitions(ctx context.Context) (SLAPolicyFilterDefinitions, error)
 	GetSLAPoliciesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SLAPolicy]
 	GetSLAPoliciesOBP(ctx context.Context, opts *OBPOptions) ([]SLAPolicy, Page, error)
 	GetSLAPoliciesCBP(ctx context.Context, opts *CBPOptions) ([]SLAPolicy, CursorPaginationMeta, error)
@@ -184,3 +216,37 @@ func (z *Client) DeleteSLAPolicy(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// ReorderSLAPolicies sets the position of the slaPolicies to their order in slaPolicyIDs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#reorder-sla-policies
+func (z *Client) ReorderSLAPolicies(ctx context.Context, slaPolicyIDs []int64) error {
+	var data struct {
+		SLAPolicyIDs []int64 `json:"sla_policy_ids"`
+	}
+	data.SLAPolicyIDs = slaPolicyIDs
+
+	_, err := z.put(ctx, "/slas/policies/reorder.json", data)
+	return err
+}
+
+// GetSLAPolicyFilterDefinitions returns the fields and operators supported by slaPolicy filters
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/sla_policies/#retrieve-supported-filter-definition-items
+func (z *Client) GetSLAPolicyFilterDefinitions(ctx context.Context) (SLAPolicyFilterDefinitions, error) {
+	var result struct {
+		Definitions SLAPolicyFilterDefinitions `json:"definitions"`
+	}
+
+	body, err := z.get(ctx, "/slas/policies/definitions.json")
+	if err != nil {
+		return SLAPolicyFilterDefinitions{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SLAPolicyFilterDefinitions{}, err
+	}
+
+	return result.Definitions, nil
+}
@@ -1,6 +1,7 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -134,3 +135,46 @@ func TestDeleteSLAPolicyFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestReorderSLAPolicies(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/slas/policies/reorder.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			SLAPolicyIDs []int64 `json:"sla_policy_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.SLAPolicyIDs) != 2 || data.SLAPolicyIDs[0] != 55 {
+			t.Fatalf("unexpected sla policy ids: %v", data.SLAPolicyIDs)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderSLAPolicies(ctx, []int64{55, 36})
+	if err != nil {
+		t.Fatalf("Failed to reorder sla policies: %s", err)
+	}
+}
+
+func TestGetSLAPolicyFilterDefinitions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sla_policy_definitions.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	definitions, err := client.GetSLAPolicyFilterDefinitions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get sla policy filter definitions: %s", err)
+	}
+
+	if len(definitions.All) != 1 || len(definitions.Any) != 1 {
+		t.Fatalf("unexpected sla policy filter definitions: %+v", definitions)
+	}
+	if definitions.All[0].Value != "brand_id" || len(definitions.All[0].Operators) != 2 {
+		t.Fatalf("unexpected sla policy filter definition: %+v", definitions.All[0])
+	}
+}
@@ -0,0 +1,221 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Subscription is the subscription of a user to a Help Center article, section,
+// community topic or community post. The user is notified of the new content and comments.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#json-format
+type Subscription struct {
+	ID  int64  `json:"id,omitempty"`
+	URL string `json:"url,omitempty"`
+	// UserID can be set by agents to subscribe another user
+	UserID      int64  `json:"user_id,omitempty"`
+	ContentID   int64  `json:"content_id,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	// SourceLocale is the locale of the subscribed content. It is required to subscribe to articles and sections.
+	SourceLocale string `json:"source_locale,omitempty"`
+	Locale       string `json:"locale,omitempty"`
+	// IncludeComments subscribes to the comments of the articles of a section, or to the comments
+	// of the posts of a topic
+	IncludeComments bool      `json:"include_comments,omitempty"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at,omitempty"`
+}
+
+// SubscriptionListOptions is options for listing subscriptions
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#list-subscriptions
+type SubscriptionListOptions struct {
+	CursorPagination
+}
+
+// SubscriptionAPI an interface containing all Help Center subscription related methods
+type SubscriptionAPI interface {
+	ListArticleSubscriptions(
+		ctx context.Context, articleID int64, opts *SubscriptionListOptions) ([]Subscription, CursorPaginationMeta, error)
+	ListSectionSubscriptions(
+		ctx context.Context, sectionID int64, opts *SubscriptionListOptions) ([]Subscription, CursorPaginationMeta, error)
+	ListTopicSubscriptions(
+		ctx context.Context, topicID int64, opts *SubscriptionListOptions) ([]Subscription, CursorPaginationMeta, error)
+	ListPostSubscriptions(
+		ctx context.Context, postID int64, opts *SubscriptionListOptions) ([]Subscription, CursorPaginationMeta, error)
+	ListUserSubscriptions(
+		ctx context.Context, userID int64, opts *SubscriptionListOptions) ([]Subscription, CursorPaginationMeta, error)
+	CreateArticleSubscription(ctx context.Context, articleID int64, subscription Subscription) (Subscription, error)
+	CreateSectionSubscription(ctx context.Context, sectionID int64, subscription Subscription) (Subscription, error)
+	CreateTopicSubscription(ctx context.Context, topicID int64, subscription Subscription) (Subscription, error)
+	CreatePostSubscription(ctx context.Context, postID int64, subscription Subscription) (Subscription, error)
+	DeleteArticleSubscription(ctx context.Context, articleID int64, subscriptionID int64) error
+	DeleteSectionSubscription(ctx context.Context, sectionID int64, subscriptionID int64) error
+	DeleteTopicSubscription(ctx context.Context, topicID int64, subscriptionID int64) error
+	DeletePostSubscription(ctx context.Context, postID int64, subscriptionID int64) error
+}
+
+// ListArticleSubscriptions lists the subscriptions to an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#list-article-subscriptions
+func (z *Client) ListArticleSubscriptions(
+	ctx context.Context, articleID int64, opts *SubscriptionListOptions,
+) ([]Subscription, CursorPaginationMeta, error) {
+	return z.listSubscriptions(ctx, fmt.Sprintf("/help_center/articles/%d/subscriptions.json", articleID), opts)
+}
+
+// ListSectionSubscriptions lists the subscriptions to a section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#list-section-subscriptions
+func (z *Client) ListSectionSubscriptions(
+	ctx context.Context, sectionID int64, opts *SubscriptionListOptions,
+) ([]Subscription, CursorPaginationMeta, error) {
+	return z.listSubscriptions(ctx, fmt.Sprintf("/help_center/sections/%d/subscriptions.json", sectionID), opts)
+}
+
+// ListTopicSubscriptions lists the subscriptions to a community topic
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#list-topic-subscriptions
+func (z *Client) ListTopicSubscriptions(
+	ctx context.Context, topicID int64, opts *SubscriptionListOptions,
+) ([]Subscription, CursorPaginationMeta, error) {
+	return z.listSubscriptions(ctx, fmt.Sprintf("/help_center/community/topics/%d/subscriptions.json", topicID), opts)
+}
+
+// ListPostSubscriptions lists the subscriptions to a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#list-post-subscriptions
+func (z *Client) ListPostSubscriptions(
+	ctx context.Context, postID int64, opts *SubscriptionListOptions,
+) ([]Subscription, CursorPaginationMeta, error) {
+	return z.listSubscriptions(ctx, fmt.Sprintf("/help_center/community/posts/%d/subscriptions.json", postID), opts)
+}
+
+// ListUserSubscriptions lists the subscriptions of a user to any content
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#list-subscriptions-by-user
+func (z *Client) ListUserSubscriptions(
+	ctx context.Context, userID int64, opts *SubscriptionListOptions,
+) ([]Subscription, CursorPaginationMeta, error) {
+	return z.listSubscriptions(ctx, fmt.Sprintf("/help_center/users/%d/subscriptions.json", userID), opts)
+}
+
+func (z *Client) listSubscriptions(
+	ctx context.Context, path string, opts *SubscriptionListOptions,
+) ([]Subscription, CursorPaginationMeta, error) {
+	var result struct {
+		Subscriptions []Subscription       `json:"subscriptions"`
+		Meta          CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SubscriptionListOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Subscriptions, result.Meta, nil
+}
+
+// CreateArticleSubscription subscribes a user to an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#create-article-subscription
+func (z *Client) CreateArticleSubscription(
+	ctx context.Context, articleID int64, subscription Subscription,
+) (Subscription, error) {
+	return z.createSubscription(ctx, fmt.Sprintf("/help_center/articles/%d/subscriptions.json", articleID), subscription)
+}
+
+// CreateSectionSubscription subscribes a user to a section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#create-section-subscription
+func (z *Client) CreateSectionSubscription(
+	ctx context.Context, sectionID int64, subscription Subscription,
+) (Subscription, error) {
+	return z.createSubscription(ctx, fmt.Sprintf("/help_center/sections/%d/subscriptions.json", sectionID), subscription)
+}
+
+// CreateTopicSubscription subscribes a user to a community topic
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#create-topic-subscription
+func (z *Client) CreateTopicSubscription(
+	ctx context.Context, topicID int64, subscription Subscription,
+) (Subscription, error) {
+	return z.createSubscription(ctx,
+		fmt.Sprintf("/help_center/community/topics/%d/subscriptions.json", topicID), subscription)
+}
+
+// CreatePostSubscription subscribes a user to a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#create-post-subscription
+func (z *Client) CreatePostSubscription(
+	ctx context.Context, postID int64, subscription Subscription,
+) (Subscription, error) {
+	return z.createSubscription(ctx,
+		fmt.Sprintf("/help_center/community/posts/%d/subscriptions.json", postID), subscription)
+}
+
+func (z *Client) createSubscription(ctx context.Context, path string, subscription Subscription) (Subscription, error) {
+	var data, result struct {
+		Subscription Subscription `json:"subscription"`
+	}
+	data.Subscription = subscription
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Subscription{}, err
+	}
+	return result.Subscription, nil
+}
+
+// DeleteArticleSubscription unsubscribes a user from an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#delete-article-subscription
+func (z *Client) DeleteArticleSubscription(ctx context.Context, articleID int64, subscriptionID int64) error {
+	return z.delete(ctx,
+		fmt.Sprintf("/help_center/articles/%d/subscriptions/%d.json", articleID, subscriptionID), nil)
+}
+
+// DeleteSectionSubscription unsubscribes a user from a section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#delete-section-subscription
+func (z *Client) DeleteSectionSubscription(ctx context.Context, sectionID int64, subscriptionID int64) error {
+	return z.delete(ctx,
+		fmt.Sprintf("/help_center/sections/%d/subscriptions/%d.json", sectionID, subscriptionID), nil)
+}
+
+// DeleteTopicSubscription unsubscribes a user from a community topic
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#delete-topic-subscription
+func (z *Client) DeleteTopicSubscription(ctx context.Context, topicID int64, subscriptionID int64) error {
+	return z.delete(ctx,
+		fmt.Sprintf("/help_center/community/topics/%d/subscriptions/%d.json", topicID, subscriptionID), nil)
+}
+
+// DeletePostSubscription unsubscribes a user from a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/subscriptions/#delete-post-subscription
+func (z *Client) DeletePostSubscription(ctx context.Context, postID int64, subscriptionID int64) error {
+	return z.delete(ctx,
+		fmt.Sprintf("/help_center/community/posts/%d/subscriptions/%d.json", postID, subscriptionID), nil)
+}
@@ -0,0 +1,108 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListUserSubscriptions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/users/888887/subscriptions.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/subscriptions.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	subscriptions, _, err := client.ListUserSubscriptions(ctx, 888887, nil)
+	if err != nil {
+		t.Fatalf("Failed to list user subscriptions: %s", err)
+	}
+
+	if len(subscriptions) != 2 || subscriptions[0].ContentType != "Section" || !subscriptions[0].IncludeComments {
+		t.Fatalf("unexpected subscriptions: %+v", subscriptions)
+	}
+}
+
+func TestListSubscriptions(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write(readFixture("GET/subscriptions.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, _, err := client.ListArticleSubscriptions(ctx, 37486578, nil); err != nil {
+		t.Fatalf("Failed to list article subscriptions: %s", err)
+	}
+	if _, _, err := client.ListSectionSubscriptions(ctx, 98838, nil); err != nil {
+		t.Fatalf("Failed to list section subscriptions: %s", err)
+	}
+	if _, _, err := client.ListTopicSubscriptions(ctx, 115000553548, nil); err != nil {
+		t.Fatalf("Failed to list topic subscriptions: %s", err)
+	}
+	if _, _, err := client.ListPostSubscriptions(ctx, 900, nil); err != nil {
+		t.Fatalf("Failed to list post subscriptions: %s", err)
+	}
+
+	expected := []string{
+		"/help_center/articles/37486578/subscriptions.json",
+		"/help_center/sections/98838/subscriptions.json",
+		"/help_center/community/topics/115000553548/subscriptions.json",
+		"/help_center/community/posts/900/subscriptions.json",
+	}
+	for i, path := range expected {
+		if paths[i] != path {
+			t.Fatalf("expected path is %s, but got %s", path, paths[i])
+		}
+	}
+}
+
+func TestCreateSectionSubscription(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/help_center/sections/98838/subscriptions.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			Subscription Subscription `json:"subscription"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode body: %s", err)
+		}
+		if data.Subscription.SourceLocale != "en-us" || data.Subscription.UserID != 888887 {
+			t.Fatalf("unexpected subscription: %+v", data.Subscription)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/subscription.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateSectionSubscription(ctx, 98838, Subscription{
+		SourceLocale:    "en-us",
+		UserID:          888887,
+		IncludeComments: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create section subscription: %s", err)
+	}
+}
+
+func TestDeletePostSubscription(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/community/posts/900/subscriptions/35467.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeletePostSubscription(ctx, 900, 35467); err != nil {
+		t.Fatalf("Failed to delete post subscription: %s", err)
+	}
+}
@@ -9,6 +9,19 @@ import (
 // Tag is an alias for string
 type Tag string
 
+// TagCount is a tag used in the account with its number of uses
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#list-tags
+type TagCount struct {
+	Name  Tag   `json:"name"`
+	Count int64 `json:"count"`
+}
+
+// TagListOptions is options for ListTags
+type TagListOptions struct {
+	CursorPagination
+}
+
 // TagAPI an interface containing all tag related methods
 type TagAPI interface {
 	GetTicketTags(ctx context.Context, ticketID int64) ([]Tag, error)
@@ -17,7 +30,13 @@ type TagAPI interface {
 	AddTicketTags(ctx context.Context, ticketID int64, tags []Tag) ([]Tag, error)
 	AddOrganizationTags(ctx context.Context, organizationID int64, tags []Tag) ([]Tag, error)
 	AddUserTags(ctx context.Context, userID int64, tags []Tag) ([]Tag, error)
+	SetOrganizationTags(ctx context.Context, organizationID int64, tags []Tag) ([]Tag, error)
+	SetUserTags(ctx context.Context, userID int64, tags []Tag) ([]Tag, error)
 	RemoveTicketTags(ctx context.Context, ticketID int64, tags []Tag) error
+	RemoveOrganizationTags(ctx context.Context, organizationID int64, tags []Tag) error
+	RemoveUserTags(ctx context.Context, userID int64, tags []Tag) error
+	ListTags(ctx context.Context, opts *TagListOptions) ([]TagCount, CursorPaginationMeta, error)
+	AutocompleteTags(ctx context.Context, prefix string) ([]Tag, error)
 }
 
 // GetTicketTags get ticket tag list
@@ -146,6 +165,38 @@ func (z *Client) AddUserTags(ctx context.Context, userID int64, tags []Tag) ([]T
 	return result.Tags, nil
 }
 
+// SetOrganizationTags replaces all tags of organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#set-tags
+func (z *Client) SetOrganizationTags(ctx context.Context, organizationID int64, tags []Tag) ([]Tag, error) {
+	return z.setTags(ctx, fmt.Sprintf("/organizations/%d/tags.json", organizationID), tags)
+}
+
+// SetUserTags replaces all tags of user
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#set-tags
+func (z *Client) SetUserTags(ctx context.Context, userID int64, tags []Tag) ([]Tag, error) {
+	return z.setTags(ctx, fmt.Sprintf("/users/%d/tags.json", userID), tags)
+}
+
+func (z *Client) setTags(ctx context.Context, path string, tags []Tag) ([]Tag, error) {
+	var data, result struct {
+		Tags []Tag `json:"tags"`
+	}
+	data.Tags = tags
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Tags, nil
+}
+
 // RemoveTicketTags remove tags from ticket
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tags#remove-tags
@@ -157,3 +208,87 @@ func (z *Client) RemoveTicketTags(ctx context.Context, ticketID int64, tags []Ta
 	err := z.delete(ctx, fmt.Sprintf("/tickets/%d/tags", ticketID), data)
 	return err
 }
+
+// RemoveOrganizationTags remove tags from organization
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tags#remove-tags
+func (z *Client) RemoveOrganizationTags(ctx context.Context, organizationID int64, tags []Tag) error {
+	var data struct {
+		Tags []Tag `json:"tags"`
+	}
+	data.Tags = tags
+	return z.delete(ctx, fmt.Sprintf("/organizations/%d/tags.json", organizationID), data)
+}
+
+// RemoveUserTags remove tags from user
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tags#remove-tags
+func (z *Client) RemoveUserTags(ctx context.Context, userID int64, tags []Tag) error {
+	var data struct {
+		Tags []Tag `json:"tags"`
+	}
+	data.Tags = tags
+	return z.delete(ctx, fmt.Sprintf("/users/%d/tags.json", userID), data)
+}
+
+// ListTags lists up to 20,000 most popular tags of the account in the last 60 days,
+// in decreasing popularity
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#list-tags
+func (z *Client) ListTags(ctx context.Context, opts *TagListOptions) ([]TagCount, CursorPaginationMeta, error) {
+	var result struct {
+		Tags []TagCount           `json:"tags"`
+		Meta CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TagListOptions{}
+	}
+
+	u, err := addOptions("/tags.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	return result.Tags, result.Meta, nil
+}
+
+// AutocompleteTags returns the tags starting with prefix.
+// The prefix must have at least two characters.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/tags/#search-tags
+func (z *Client) AutocompleteTags(ctx context.Context, prefix string) ([]Tag, error) {
+	var result struct {
+		Tags []Tag `json:"tags"`
+	}
+
+	u, err := addOptions("/autocomplete/tags.json", struct {
+		Name string `url:"name"`
+	}{prefix})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Tags, nil
+}
@@ -1,7 +1,9 @@
 package zendesk
 
 import (
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -115,3 +117,123 @@ func TestAddUserTags(t *testing.T) {
 		t.Fatalf("Returned tags does not have the expexted tag %s. %s given", "important", tags[0])
 	}
 }
+
+func TestListTags(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "account_tags.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tags, meta, err := client.ListTags(ctx, &TagListOptions{CursorPagination{PageSize: 2}})
+	if err != nil {
+		t.Fatalf("Failed to list tags: %s", err)
+	}
+
+	if len(tags) != 2 {
+		t.Fatalf("expected length of tags is 2, but got %d", len(tags))
+	}
+	if tags[0].Name != "important" || tags[0].Count != 47 {
+		t.Fatalf("unexpected tag: %+v", tags[0])
+	}
+	if !meta.HasMore {
+		t.Fatal("expected more tags")
+	}
+}
+
+func TestAutocompleteTags(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/autocomplete/tags.json" || r.URL.Query().Get("name") != "att" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/autocomplete_tags.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tags, err := client.AutocompleteTags(ctx, "att")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete tags: %s", err)
+	}
+
+	if len(tags) != 2 || tags[0] != "attention" {
+		t.Fatalf("unexpected tags: %v", tags)
+	}
+}
+
+func TestSetUserTags(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/users/2/tags.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture("GET/tags.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tags, err := client.SetUserTags(ctx, 2, []Tag{"important", "customer"})
+	if err != nil {
+		t.Fatalf("Failed to set user tags: %s", err)
+	}
+
+	if len(tags) != 2 {
+		t.Fatalf("Returned tags does not have the expexted length 2. Tags length is %d", len(tags))
+	}
+}
+
+func TestSetOrganizationTags(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/organizations/2/tags.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write(readFixture("GET/tags.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.SetOrganizationTags(ctx, 2, []Tag{"important", "customer"})
+	if err != nil {
+		t.Fatalf("Failed to set organization tags: %s", err)
+	}
+}
+
+func TestRemoveUserTags(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/users/2/tags.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			Tags []Tag `json:"tags"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.Tags) != 1 || data.Tags[0] != "important" {
+			t.Fatalf("unexpected tags: %v", data.Tags)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.RemoveUserTags(ctx, 2, []Tag{"important"})
+	if err != nil {
+		t.Fatalf("Failed to remove user tags: %s", err)
+	}
+}
+
+func TestRemoveOrganizationTags(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/organizations/2/tags.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.RemoveOrganizationTags(ctx, 2, []Tag{"important"})
+	if err != nil {
+		t.Fatalf("Failed to remove organization tags: %s", err)
+	}
+}
@@ -0,0 +1,91 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Talk agent states
+const (
+	TalkAgentStateOnline        = "online"
+	TalkAgentStateAway          = "away"
+	TalkAgentStateTransfersOnly = "transfers_only"
+	TalkAgentStateOffline       = "offline"
+)
+
+// Talk availability vias, the way an agent receives calls
+const (
+	TalkAvailabilityViaClient = "client"
+	TalkAvailabilityViaPhone  = "phone"
+)
+
+// TalkAvailability is the availability of an agent in Talk.
+// Accounts on the unified agent status use AgentAvailabilityAPI instead.
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/availabilities/
+type TalkAvailability struct {
+	AgentState string `json:"agent_state,omitempty"`
+	Via        string `json:"via,omitempty"`
+	// CallStatus is set while the agent is on a call, e.g. "on_call" or "wrap_up"
+	CallStatus string `json:"call_status,omitempty"`
+	Available  bool   `json:"available,omitempty"`
+}
+
+// TalkAvailabilityAPI an interface containing all Talk availability related methods
+type TalkAvailabilityAPI interface {
+	GetTalkAvailability(ctx context.Context, agentID int64) (TalkAvailability, error)
+	UpdateTalkAvailability(ctx context.Context, agentID int64, availability TalkAvailability) (TalkAvailability, error)
+}
+
+// GetTalkAvailability gets the Talk availability of an agent
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/availabilities/#show-availability
+func (z *Client) GetTalkAvailability(ctx context.Context, agentID int64) (TalkAvailability, error) {
+	var result struct {
+		Availability TalkAvailability `json:"availability"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/availabilities/%d.json", agentID))
+	if err != nil {
+		return TalkAvailability{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkAvailability{}, err
+	}
+	return result.Availability, nil
+}
+
+// UpdateTalkAvailability updates the agent state and the via of an agent in Talk.
+// CallStatus and Available are read only.
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/availabilities/#update-availability
+func (z *Client) UpdateTalkAvailability(
+	ctx context.Context, agentID int64, availability TalkAvailability,
+) (TalkAvailability, error) {
+	var data struct {
+		Availability struct {
+			AgentState string `json:"agent_state,omitempty"`
+			Via        string `json:"via,omitempty"`
+		} `json:"availability"`
+	}
+	data.Availability.AgentState = availability.AgentState
+	data.Availability.Via = availability.Via
+
+	var result struct {
+		Availability TalkAvailability `json:"availability"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/channels/voice/availabilities/%d.json", agentID), data)
+	if err != nil {
+		return TalkAvailability{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkAvailability{}, err
+	}
+	return result.Availability, nil
+}
@@ -0,0 +1,50 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetTalkAvailability(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_availability.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availability, err := client.GetTalkAvailability(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to get talk availability: %s", err)
+	}
+
+	if availability.AgentState != TalkAgentStateOnline || !availability.Available {
+		t.Fatalf("unexpected availability: %+v", availability)
+	}
+}
+
+func TestUpdateTalkAvailability(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"availability":{"agent_state":"away","via":"phone"}}`
+		if string(body) != expected {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(readFixture("PUT/talk_availability.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availability, err := client.UpdateTalkAvailability(ctx, 1234, TalkAvailability{
+		AgentState: TalkAgentStateAway,
+		Via:        TalkAvailabilityViaPhone,
+		Available:  true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update talk availability: %s", err)
+	}
+
+	if availability.AgentState != TalkAgentStateAway {
+		t.Fatalf("unexpected availability: %+v", availability)
+	}
+}
@@ -0,0 +1,230 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Talk greeting category ids
+const (
+	TalkGreetingCategoryVoicemail = 1
+	TalkGreetingCategoryAvailable = 2
+	TalkGreetingCategoryWait      = 3
+	TalkGreetingCategoryHold      = 4
+	TalkGreetingCategoryIVR       = 5
+)
+
+// TalkGreeting is a message played to callers, such as the voicemail or the IVR prompt
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#json-format
+type TalkGreeting struct {
+	ID         int64  `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	CategoryID int64  `json:"category_id,omitempty"`
+	// Default is true for the system greetings, which can't be updated nor deleted
+	Default        bool    `json:"default,omitempty"`
+	DefaultLang    bool    `json:"default_lang,omitempty"`
+	Active         bool    `json:"active,omitempty"`
+	Pending        bool    `json:"pending,omitempty"`
+	HasSubSettings bool    `json:"has_sub_settings,omitempty"`
+	AudioName      string  `json:"audio_name,omitempty"`
+	AudioURL       string  `json:"audio_url,omitempty"`
+	IVRIDs         []int64 `json:"ivr_ids,omitempty"`
+	PhoneNumberIDs []int64 `json:"phone_number_ids,omitempty"`
+}
+
+// TalkGreetingCategory is a category of greetings, such as voicemail or wait
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#list-greeting-categories
+type TalkGreetingCategory struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// TalkGreetingListOptions is options for ListTalkGreetings
+type TalkGreetingListOptions struct {
+	CursorPagination
+}
+
+// TalkGreetingAPI an interface containing all Talk greeting related methods
+type TalkGreetingAPI interface {
+	ListTalkGreetings(
+		ctx context.Context, opts *TalkGreetingListOptions) ([]TalkGreeting, CursorPaginationMeta, error)
+	GetTalkGreeting(ctx context.Context, greetingID int64) (TalkGreeting, error)
+	CreateTalkGreeting(ctx context.Context, greeting TalkGreeting) (TalkGreeting, error)
+	UpdateTalkGreeting(ctx context.Context, greetingID int64, greeting TalkGreeting) (TalkGreeting, error)
+	UploadTalkGreetingAudio(ctx context.Context, greetingID int64, filename string, file io.Reader) (TalkGreeting, error)
+	DeleteTalkGreeting(ctx context.Context, greetingID int64) error
+	ListTalkGreetingCategories(ctx context.Context) ([]TalkGreetingCategory, error)
+	GetTalkGreetingCategory(ctx context.Context, categoryID int64) (TalkGreetingCategory, error)
+}
+
+// ListTalkGreetings lists the Talk greetings
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#list-greetings
+func (z *Client) ListTalkGreetings(
+	ctx context.Context, opts *TalkGreetingListOptions,
+) ([]TalkGreeting, CursorPaginationMeta, error) {
+	var result struct {
+		Greetings []TalkGreeting       `json:"greetings"`
+		Meta      CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TalkGreetingListOptions{}
+	}
+
+	u, err := addOptions("/channels/voice/greetings.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Greetings, result.Meta, nil
+}
+
+// GetTalkGreeting gets a Talk greeting
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#show-greeting
+func (z *Client) GetTalkGreeting(ctx context.Context, greetingID int64) (TalkGreeting, error) {
+	var result struct {
+		Greeting TalkGreeting `json:"greeting"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/greetings/%d.json", greetingID))
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+	return result.Greeting, nil
+}
+
+// CreateTalkGreeting creates a custom Talk greeting. Its audio is sent with UploadTalkGreetingAudio.
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#create-greetings
+func (z *Client) CreateTalkGreeting(ctx context.Context, greeting TalkGreeting) (TalkGreeting, error) {
+	var data, result struct {
+		Greeting TalkGreeting `json:"greeting"`
+	}
+	data.Greeting = greeting
+
+	body, err := z.post(ctx, "/channels/voice/greetings.json", data)
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+	return result.Greeting, nil
+}
+
+// UpdateTalkGreeting updates a custom Talk greeting
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#update-greeting
+func (z *Client) UpdateTalkGreeting(
+	ctx context.Context, greetingID int64, greeting TalkGreeting,
+) (TalkGreeting, error) {
+	var data, result struct {
+		Greeting TalkGreeting `json:"greeting"`
+	}
+	data.Greeting = greeting
+
+	body, err := z.put(ctx, fmt.Sprintf("/channels/voice/greetings/%d.json", greetingID), data)
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+	return result.Greeting, nil
+}
+
+// UploadTalkGreetingAudio uploads the MP3 or WAV recording played by a custom Talk greeting
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#upload-greeting-recording
+func (z *Client) UploadTalkGreetingAudio(
+	ctx context.Context, greetingID int64, filename string, file io.Reader,
+) (TalkGreeting, error) {
+	var result struct {
+		Greeting TalkGreeting `json:"greeting"`
+	}
+
+	body, err := z.uploadFile(ctx, http.MethodPut, fmt.Sprintf("/channels/voice/greetings/%d/upload.json", greetingID),
+		"greeting[upload_recording]", filename, file)
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkGreeting{}, err
+	}
+	return result.Greeting, nil
+}
+
+// DeleteTalkGreeting deletes a custom Talk greeting
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#delete-greeting
+func (z *Client) DeleteTalkGreeting(ctx context.Context, greetingID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/channels/voice/greetings/%d.json", greetingID), nil)
+}
+
+// ListTalkGreetingCategories lists the categories of Talk greetings
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#list-greeting-categories
+func (z *Client) ListTalkGreetingCategories(ctx context.Context) ([]TalkGreetingCategory, error) {
+	var result struct {
+		GreetingCategories []TalkGreetingCategory `json:"greeting_categories"`
+	}
+
+	body, err := z.get(ctx, "/channels/voice/greeting_categories.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.GreetingCategories, nil
+}
+
+// GetTalkGreetingCategory gets a category of Talk greetings
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/greetings/#show-greeting-category
+func (z *Client) GetTalkGreetingCategory(ctx context.Context, categoryID int64) (TalkGreetingCategory, error) {
+	var result struct {
+		GreetingCategory TalkGreetingCategory `json:"greeting_category"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/greeting_categories/%d.json", categoryID))
+	if err != nil {
+		return TalkGreetingCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkGreetingCategory{}, err
+	}
+	return result.GreetingCategory, nil
+}
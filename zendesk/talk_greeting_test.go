@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListTalkGreetings(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_greetings.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	greetings, _, err := client.ListTalkGreetings(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list greetings: %s", err)
+	}
+
+	if len(greetings) != 2 || !greetings[0].Default {
+		t.Fatalf("unexpected greetings: %+v", greetings)
+	}
+}
+
+func TestCreateTalkGreeting(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "talk_greeting.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	greeting, err := client.CreateTalkGreeting(ctx, TalkGreeting{
+		Name:       "Welcome",
+		CategoryID: TalkGreetingCategoryAvailable,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create greeting: %s", err)
+	}
+
+	if greeting.ID != 360000123 {
+		t.Fatalf("unexpected greeting: %+v", greeting)
+	}
+}
+
+func TestUploadTalkGreetingAudio(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/channels/voice/greetings/360000123/upload.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		file, header, err := r.FormFile("greeting[upload_recording]")
+		if err != nil {
+			t.Fatalf("Failed to read recording: %s", err)
+		}
+		file.Close()
+		if header.Filename != "welcome.mp3" {
+			t.Fatalf("unexpected filename: %s", header.Filename)
+		}
+		w.Write(readFixture("PUT/talk_greeting.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UploadTalkGreetingAudio(ctx, 360000123, "welcome.mp3", strings.NewReader("mp3")); err != nil {
+		t.Fatalf("Failed to upload greeting audio: %s", err)
+	}
+}
+
+func TestDeleteTalkGreeting(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/channels/voice/greetings/360000123.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTalkGreeting(ctx, 360000123); err != nil {
+		t.Fatalf("Failed to delete greeting: %s", err)
+	}
+}
+
+func TestListTalkGreetingCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_greeting_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, err := client.ListTalkGreetingCategories(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list greeting categories: %s", err)
+	}
+
+	if len(categories) != 5 || categories[4].ID != TalkGreetingCategoryIVR {
+		t.Fatalf("unexpected categories: %+v", categories)
+	}
+}
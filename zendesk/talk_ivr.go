@@ -0,0 +1,369 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Talk IVR route actions
+const (
+	TalkIVRRouteGroupTransfer       = "group_transfer"
+	TalkIVRRoutePhoneNumberTransfer = "phone_number_transfer"
+	TalkIVRRouteMenuTransfer        = "menu_transfer"
+	TalkIVRRouteVoicemail           = "voicemail"
+	TalkIVRRouteTextback            = "textback"
+)
+
+// TalkIVR is an interactive voice response, a phone tree of menus played to callers
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivrs/#json-format
+type TalkIVR struct {
+	ID               int64         `json:"id,omitempty"`
+	Name             string        `json:"name,omitempty"`
+	Menus            []TalkIVRMenu `json:"menus,omitempty"`
+	PhoneNumberIDs   []int64       `json:"phone_number_ids,omitempty"`
+	PhoneNumberNames []string      `json:"phone_number_names,omitempty"`
+}
+
+// TalkIVRMenu is a menu of an IVR, a greeting followed by the routes callers choose with their keypad
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_menus/#json-format
+type TalkIVRMenu struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+	// Default is true for the menu played when the IVR answers
+	Default    bool           `json:"default,omitempty"`
+	GreetingID int64          `json:"greeting_id,omitempty"`
+	Routes     []TalkIVRRoute `json:"routes,omitempty"`
+}
+
+// TalkIVRRoute is an option of an IVR menu, the action run when the caller presses Keypress
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_routes/#json-format
+type TalkIVRRoute struct {
+	ID int64 `json:"id,omitempty"`
+	// Keypress can take "0" to "9", "*" or "#", or "default" for the route run on timeout
+	Keypress string `json:"keypress,omitempty"`
+	// Action is one of the TalkIVRRoute actions
+	Action     string `json:"action,omitempty"`
+	Greeting   string `json:"greeting,omitempty"`
+	OptionText string `json:"option_text,omitempty"`
+	// Options are the parameters of the action, such as "group_ids" for TalkIVRRouteGroupTransfer,
+	// "menu_id" for TalkIVRRouteMenuTransfer or "phone_number" for TalkIVRRoutePhoneNumberTransfer
+	Options map[string]interface{} `json:"options,omitempty"`
+	// OverflowOptions are the actions run outside the schedule of the destination group
+	OverflowOptions []map[string]interface{} `json:"overflow_options,omitempty"`
+}
+
+// TalkIVRListOptions is options for ListTalkIVRs
+type TalkIVRListOptions struct {
+	CursorPagination
+}
+
+// TalkIVRAPI an interface containing all Talk IVR related methods
+type TalkIVRAPI interface {
+	ListTalkIVRs(ctx context.Context, opts *TalkIVRListOptions) ([]TalkIVR, CursorPaginationMeta, error)
+	GetTalkIVR(ctx context.Context, ivrID int64) (TalkIVR, error)
+	CreateTalkIVR(ctx context.Context, ivr TalkIVR) (TalkIVR, error)
+	UpdateTalkIVR(ctx context.Context, ivrID int64, ivr TalkIVR) (TalkIVR, error)
+	DeleteTalkIVR(ctx context.Context, ivrID int64) error
+	ListTalkIVRMenus(ctx context.Context, ivrID int64) ([]TalkIVRMenu, error)
+	GetTalkIVRMenu(ctx context.Context, ivrID int64, menuID int64) (TalkIVRMenu, error)
+	CreateTalkIVRMenu(ctx context.Context, ivrID int64, menu TalkIVRMenu) (TalkIVRMenu, error)
+	UpdateTalkIVRMenu(ctx context.Context, ivrID int64, menuID int64, menu TalkIVRMenu) (TalkIVRMenu, error)
+	DeleteTalkIVRMenu(ctx context.Context, ivrID int64, menuID int64) error
+	ListTalkIVRRoutes(ctx context.Context, ivrID int64, menuID int64) ([]TalkIVRRoute, error)
+	GetTalkIVRRoute(ctx context.Context, ivrID int64, menuID int64, routeID int64) (TalkIVRRoute, error)
+	CreateTalkIVRRoute(ctx context.Context, ivrID int64, menuID int64, route TalkIVRRoute) (TalkIVRRoute, error)
+	UpdateTalkIVRRoute(
+		ctx context.Context, ivrID int64, menuID int64, routeID int64, route TalkIVRRoute) (TalkIVRRoute, error)
+	DeleteTalkIVRRoute(ctx context.Context, ivrID int64, menuID int64, routeID int64) error
+}
+
+// ListTalkIVRs lists the IVRs of Talk
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivrs/#list-ivrs
+func (z *Client) ListTalkIVRs(ctx context.Context, opts *TalkIVRListOptions) ([]TalkIVR, CursorPaginationMeta, error) {
+	var result struct {
+		IVRs []TalkIVR            `json:"ivrs"`
+		Meta CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TalkIVRListOptions{}
+	}
+
+	u, err := addOptions("/channels/voice/ivr.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.IVRs, result.Meta, nil
+}
+
+// GetTalkIVR gets an IVR with its menus and routes
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivrs/#show-ivr
+func (z *Client) GetTalkIVR(ctx context.Context, ivrID int64) (TalkIVR, error) {
+	var result struct {
+		IVR TalkIVR `json:"ivr"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/ivr/%d.json", ivrID))
+	if err != nil {
+		return TalkIVR{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVR{}, err
+	}
+	return result.IVR, nil
+}
+
+// CreateTalkIVR creates an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivrs/#create-ivr
+func (z *Client) CreateTalkIVR(ctx context.Context, ivr TalkIVR) (TalkIVR, error) {
+	var data, result struct {
+		IVR TalkIVR `json:"ivr"`
+	}
+	data.IVR = ivr
+
+	body, err := z.post(ctx, "/channels/voice/ivr.json", data)
+	if err != nil {
+		return TalkIVR{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVR{}, err
+	}
+	return result.IVR, nil
+}
+
+// UpdateTalkIVR updates an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivrs/#update-ivr
+func (z *Client) UpdateTalkIVR(ctx context.Context, ivrID int64, ivr TalkIVR) (TalkIVR, error) {
+	var data, result struct {
+		IVR TalkIVR `json:"ivr"`
+	}
+	data.IVR = ivr
+
+	body, err := z.put(ctx, fmt.Sprintf("/channels/voice/ivr/%d.json", ivrID), data)
+	if err != nil {
+		return TalkIVR{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVR{}, err
+	}
+	return result.IVR, nil
+}
+
+// DeleteTalkIVR deletes an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivrs/#delete-ivr
+func (z *Client) DeleteTalkIVR(ctx context.Context, ivrID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/channels/voice/ivr/%d.json", ivrID), nil)
+}
+
+// ListTalkIVRMenus lists the menus of an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_menus/#list-ivr-menus
+func (z *Client) ListTalkIVRMenus(ctx context.Context, ivrID int64) ([]TalkIVRMenu, error) {
+	var result struct {
+		IVRMenus []TalkIVRMenu `json:"ivr_menus"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus.json", ivrID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.IVRMenus, nil
+}
+
+// GetTalkIVRMenu gets a menu of an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_menus/#show-ivr-menu
+func (z *Client) GetTalkIVRMenu(ctx context.Context, ivrID int64, menuID int64) (TalkIVRMenu, error) {
+	var result struct {
+		IVRMenu TalkIVRMenu `json:"ivr_menu"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus/%d.json", ivrID, menuID))
+	if err != nil {
+		return TalkIVRMenu{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVRMenu{}, err
+	}
+	return result.IVRMenu, nil
+}
+
+// CreateTalkIVRMenu creates a menu in an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_menus/#create-ivr-menu
+func (z *Client) CreateTalkIVRMenu(ctx context.Context, ivrID int64, menu TalkIVRMenu) (TalkIVRMenu, error) {
+	var data, result struct {
+		IVRMenu TalkIVRMenu `json:"ivr_menu"`
+	}
+	data.IVRMenu = menu
+
+	body, err := z.post(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus.json", ivrID), data)
+	if err != nil {
+		return TalkIVRMenu{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVRMenu{}, err
+	}
+	return result.IVRMenu, nil
+}
+
+// UpdateTalkIVRMenu updates a menu of an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_menus/#update-ivr-menu
+func (z *Client) UpdateTalkIVRMenu(
+	ctx context.Context, ivrID int64, menuID int64, menu TalkIVRMenu,
+) (TalkIVRMenu, error) {
+	var data, result struct {
+		IVRMenu TalkIVRMenu `json:"ivr_menu"`
+	}
+	data.IVRMenu = menu
+
+	body, err := z.put(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus/%d.json", ivrID, menuID), data)
+	if err != nil {
+		return TalkIVRMenu{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVRMenu{}, err
+	}
+	return result.IVRMenu, nil
+}
+
+// DeleteTalkIVRMenu deletes a menu of an IVR
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_menus/#delete-ivr-menu
+func (z *Client) DeleteTalkIVRMenu(ctx context.Context, ivrID int64, menuID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus/%d.json", ivrID, menuID), nil)
+}
+
+// ListTalkIVRRoutes lists the routes of an IVR menu
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_routes/#list-ivr-routes
+func (z *Client) ListTalkIVRRoutes(ctx context.Context, ivrID int64, menuID int64) ([]TalkIVRRoute, error) {
+	var result struct {
+		IVRRoutes []TalkIVRRoute `json:"ivr_routes"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus/%d/routes.json", ivrID, menuID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.IVRRoutes, nil
+}
+
+// GetTalkIVRRoute gets a route of an IVR menu
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_routes/#show-ivr-route
+func (z *Client) GetTalkIVRRoute(
+	ctx context.Context, ivrID int64, menuID int64, routeID int64,
+) (TalkIVRRoute, error) {
+	var result struct {
+		IVRRoute TalkIVRRoute `json:"ivr_route"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus/%d/routes/%d.json", ivrID, menuID, routeID))
+	if err != nil {
+		return TalkIVRRoute{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVRRoute{}, err
+	}
+	return result.IVRRoute, nil
+}
+
+// CreateTalkIVRRoute creates a route in an IVR menu
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_routes/#create-ivr-route
+func (z *Client) CreateTalkIVRRoute(
+	ctx context.Context, ivrID int64, menuID int64, route TalkIVRRoute,
+) (TalkIVRRoute, error) {
+	var data, result struct {
+		IVRRoute TalkIVRRoute `json:"ivr_route"`
+	}
+	data.IVRRoute = route
+
+	body, err := z.post(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus/%d/routes.json", ivrID, menuID), data)
+	if err != nil {
+		return TalkIVRRoute{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVRRoute{}, err
+	}
+	return result.IVRRoute, nil
+}
+
+// UpdateTalkIVRRoute updates a route of an IVR menu
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_routes/#update-ivr-route
+func (z *Client) UpdateTalkIVRRoute(
+	ctx context.Context, ivrID int64, menuID int64, routeID int64, route TalkIVRRoute,
+) (TalkIVRRoute, error) {
+	var data, result struct {
+		IVRRoute TalkIVRRoute `json:"ivr_route"`
+	}
+	data.IVRRoute = route
+
+	body, err := z.put(ctx,
+		fmt.Sprintf("/channels/voice/ivr/%d/menus/%d/routes/%d.json", ivrID, menuID, routeID), data)
+	if err != nil {
+		return TalkIVRRoute{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkIVRRoute{}, err
+	}
+	return result.IVRRoute, nil
+}
+
+// DeleteTalkIVRRoute deletes a route of an IVR menu
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/ivr_routes/#delete-ivr-route
+func (z *Client) DeleteTalkIVRRoute(ctx context.Context, ivrID int64, menuID int64, routeID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/channels/voice/ivr/%d/menus/%d/routes/%d.json", ivrID, menuID, routeID), nil)
+}
@@ -0,0 +1,97 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListTalkIVRs(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_ivrs.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ivrs, _, err := client.ListTalkIVRs(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list IVRs: %s", err)
+	}
+
+	if len(ivrs) != 1 || len(ivrs[0].Menus) != 1 || len(ivrs[0].Menus[0].Routes) != 2 {
+		t.Fatalf("unexpected IVRs: %+v", ivrs)
+	}
+}
+
+func TestCreateTalkIVR(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "talk_ivr.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.CreateTalkIVR(ctx, TalkIVR{Name: "Support line"}); err != nil {
+		t.Fatalf("Failed to create IVR: %s", err)
+	}
+}
+
+func TestListTalkIVRMenus(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/voice/ivr/100/menus.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/talk_ivr_menus.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	menus, err := client.ListTalkIVRMenus(ctx, 100)
+	if err != nil {
+		t.Fatalf("Failed to list IVR menus: %s", err)
+	}
+
+	if len(menus) != 1 || !menus[0].Default {
+		t.Fatalf("unexpected menus: %+v", menus)
+	}
+}
+
+func TestCreateTalkIVRRoute(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"ivr_route":{"keypress":"1","action":"group_transfer","option_text":"Sales",` +
+			`"options":{"group_ids":[360001234]}}}`
+		if r.URL.Path != "/channels/voice/ivr/100/menus/1001/routes.json" || string(body) != expected {
+			t.Fatalf("unexpected request: %s %s", r.URL.Path, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/talk_ivr_route.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	route, err := client.CreateTalkIVRRoute(ctx, 100, 1001, TalkIVRRoute{
+		Keypress:   "1",
+		Action:     TalkIVRRouteGroupTransfer,
+		OptionText: "Sales",
+		Options:    map[string]interface{}{"group_ids": []int64{360001234}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create IVR route: %s", err)
+	}
+
+	if route.ID != 10001 {
+		t.Fatalf("unexpected route: %+v", route)
+	}
+}
+
+func TestDeleteTalkIVRRoute(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/channels/voice/ivr/100/menus/1001/routes/10001.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTalkIVRRoute(ctx, 100, 1001, 10001); err != nil {
+		t.Fatalf("Failed to delete IVR route: %s", err)
+	}
+}
@@ -0,0 +1,177 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Talk line types
+const (
+	TalkLineTypePhone   = "phone"
+	TalkLineTypeDigital = "digital"
+)
+
+// TalkLine is a Talk line, a phone number or a digital line which receives calls from the web widget
+// and the mobile SDK. The fields about the number are only set for phone numbers.
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/lines/#json-format
+type TalkLine struct {
+	ID       int64  `json:"id,omitempty"`
+	Nickname string `json:"nickname,omitempty"`
+	// LineType is TalkLineTypePhone or TalkLineTypeDigital
+	LineType           string     `json:"line_type,omitempty"`
+	BrandID            int64      `json:"brand_id,omitempty"`
+	GroupIDs           []int64    `json:"group_ids,omitempty"`
+	DefaultGroupID     int64      `json:"default_group_id,omitempty"`
+	GreetingIDs        []int64    `json:"greeting_ids,omitempty"`
+	DefaultGreetingIDs []string   `json:"default_greeting_ids,omitempty"`
+	ScheduleID         int64      `json:"schedule_id,omitempty"`
+	Priority           int64      `json:"priority,omitempty"`
+	Recorded           *bool      `json:"recorded,omitempty"`
+	Transcription      *bool      `json:"transcription,omitempty"`
+	Token              string     `json:"token,omitempty"`
+	Number             string     `json:"number,omitempty"`
+	DisplayNumber      string     `json:"display_number,omitempty"`
+	CountryCode        string     `json:"country_code,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+}
+
+// TalkLineListOptions is options for ListTalkLines and ListTalkDigitalLines
+type TalkLineListOptions struct {
+	CursorPagination
+}
+
+// TalkLineAPI an interface containing all Talk line related methods
+type TalkLineAPI interface {
+	ListTalkLines(ctx context.Context, opts *TalkLineListOptions) ([]TalkLine, CursorPaginationMeta, error)
+	ListTalkDigitalLines(ctx context.Context, opts *TalkLineListOptions) ([]TalkLine, CursorPaginationMeta, error)
+	GetTalkDigitalLine(ctx context.Context, digitalLineID int64) (TalkLine, error)
+	CreateTalkDigitalLine(ctx context.Context, digitalLine TalkLine) (TalkLine, error)
+	UpdateTalkDigitalLine(ctx context.Context, digitalLineID int64, digitalLine TalkLine) (TalkLine, error)
+	DeleteTalkDigitalLine(ctx context.Context, digitalLineID int64) error
+}
+
+// ListTalkLines lists the phone numbers and the digital lines of Talk
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/lines/#list-lines
+func (z *Client) ListTalkLines(ctx context.Context, opts *TalkLineListOptions) ([]TalkLine, CursorPaginationMeta, error) {
+	var result struct {
+		Lines []TalkLine           `json:"lines"`
+		Meta  CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TalkLineListOptions{}
+	}
+
+	u, err := addOptions("/channels/voice/lines.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Lines, result.Meta, nil
+}
+
+// ListTalkDigitalLines lists the digital lines of Talk.
+// It filters the pages of ListTalkLines, so a page may have fewer lines than its size.
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/lines/#list-lines
+func (z *Client) ListTalkDigitalLines(
+	ctx context.Context, opts *TalkLineListOptions,
+) ([]TalkLine, CursorPaginationMeta, error) {
+	lines, meta, err := z.ListTalkLines(ctx, opts)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	digitalLines := make([]TalkLine, 0, len(lines))
+	for _, line := range lines {
+		if line.LineType == TalkLineTypeDigital {
+			digitalLines = append(digitalLines, line)
+		}
+	}
+	return digitalLines, meta, nil
+}
+
+// GetTalkDigitalLine gets a digital line
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/digital_lines/#show-digital-line
+func (z *Client) GetTalkDigitalLine(ctx context.Context, digitalLineID int64) (TalkLine, error) {
+	var result struct {
+		DigitalLine TalkLine `json:"digital_line"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/voice/digital_lines/%d.json", digitalLineID))
+	if err != nil {
+		return TalkLine{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkLine{}, err
+	}
+	return result.DigitalLine, nil
+}
+
+// CreateTalkDigitalLine creates a digital line
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/digital_lines/#create-digital-line
+func (z *Client) CreateTalkDigitalLine(ctx context.Context, digitalLine TalkLine) (TalkLine, error) {
+	var data, result struct {
+		DigitalLine TalkLine `json:"digital_line"`
+	}
+	data.DigitalLine = digitalLine
+
+	body, err := z.post(ctx, "/channels/voice/digital_lines.json", data)
+	if err != nil {
+		return TalkLine{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkLine{}, err
+	}
+	return result.DigitalLine, nil
+}
+
+// UpdateTalkDigitalLine updates a digital line
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/digital_lines/#update-digital-line
+func (z *Client) UpdateTalkDigitalLine(
+	ctx context.Context, digitalLineID int64, digitalLine TalkLine,
+) (TalkLine, error) {
+	var data, result struct {
+		DigitalLine TalkLine `json:"digital_line"`
+	}
+	data.DigitalLine = digitalLine
+
+	body, err := z.put(ctx, fmt.Sprintf("/channels/voice/digital_lines/%d.json", digitalLineID), data)
+	if err != nil {
+		return TalkLine{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkLine{}, err
+	}
+	return result.DigitalLine, nil
+}
+
+// DeleteTalkDigitalLine deletes a digital line
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/digital_lines/#delete-digital-line
+func (z *Client) DeleteTalkDigitalLine(ctx context.Context, digitalLineID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/channels/voice/digital_lines/%d.json", digitalLineID), nil)
+}
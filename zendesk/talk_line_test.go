@@ -0,0 +1,93 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListTalkLines(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_lines.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	lines, _, err := client.ListTalkLines(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list lines: %s", err)
+	}
+
+	if len(lines) != 2 {
+		t.Fatalf("expected length of lines is 2, but got %d", len(lines))
+	}
+}
+
+func TestListTalkDigitalLines(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_lines.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	lines, _, err := client.ListTalkDigitalLines(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list digital lines: %s", err)
+	}
+
+	if len(lines) != 1 || lines[0].ID != 6001 {
+		t.Fatalf("unexpected digital lines: %+v", lines)
+	}
+}
+
+func TestCreateTalkDigitalLine(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "talk_digital_line.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	line, err := client.CreateTalkDigitalLine(ctx, TalkLine{Nickname: "Web widget (EU brand)", BrandID: 360001110})
+	if err != nil {
+		t.Fatalf("Failed to create digital line: %s", err)
+	}
+
+	if line.LineType != TalkLineTypeDigital {
+		t.Fatalf("unexpected line: %+v", line)
+	}
+}
+
+func TestUpdateTalkDigitalLine(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/channels/voice/digital_lines/6001.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"digital_line":{"recorded":false}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/talk_digital_line.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	recorded := false
+	line, err := client.UpdateTalkDigitalLine(ctx, 6001, TalkLine{Recorded: &recorded})
+	if err != nil {
+		t.Fatalf("Failed to update digital line: %s", err)
+	}
+
+	if line.ID != 6001 {
+		t.Fatalf("unexpected line: %+v", line)
+	}
+}
+
+func TestDeleteTalkDigitalLine(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/channels/voice/digital_lines/6001.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTalkDigitalLine(ctx, 6001); err != nil {
+		t.Fatalf("Failed to delete digital line: %s", err)
+	}
+}
@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// Talk recording types
+const (
+	TalkRecordingTypeCall      = "call"
+	TalkRecordingTypeVoicemail = "voicemail"
+	TalkRecordingTypeAll       = "all"
+)
+
+// TalkRecordingAPI an interface containing all Talk recording related methods
+type TalkRecordingAPI interface {
+	DownloadTalkRecording(ctx context.Context, recordingURL string, w io.Writer) error
+	DeleteTalkRecording(ctx context.Context, callID int64, recordingType string) error
+}
+
+// DownloadTalkRecording writes the audio of a call recording or a voicemail to w.
+// recordingURL is the recording_url of the voice comment on the ticket of the call.
+// The request is authenticated, so the url must be on the host of the client.
+//
+// ref: https://support.zendesk.com/hc/en-us/articles/4408834103834
+func (z *Client) DownloadTalkRecording(ctx context.Context, recordingURL string, w io.Writer) error {
+	u, err := url.Parse(recordingURL)
+	if err != nil {
+		return err
+	}
+	if u.Host != z.baseURL.Host {
+		return fmt.Errorf("recording url %s is not on host %s", recordingURL, z.baseURL.Host)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req = z.prepareRequest(ctx, req)
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// DeleteTalkRecording deletes the recordings of a call.
+// recordingType is one of TalkRecordingTypeCall, TalkRecordingTypeVoicemail or TalkRecordingTypeAll.
+//
+// ref: https://developer.zendesk.com/api-reference/voice/talk-api/recordings/#delete-call-recording
+func (z *Client) DeleteTalkRecording(ctx context.Context, callID int64, recordingType string) error {
+	return z.delete(ctx, fmt.Sprintf("/channels/voice/calls/%d/recordings/%s.json", callID, recordingType), nil)
+}
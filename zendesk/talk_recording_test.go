@@ -0,0 +1,57 @@
+package zendesk
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDownloadTalkRecording(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, ok := r.BasicAuth(); !ok {
+			t.Fatal("recording request is not authenticated")
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("audio"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var buf bytes.Buffer
+	err := client.DownloadTalkRecording(ctx, mockAPI.URL+"/channels/voice/calls/CA123/twilio/call/recording", &buf)
+	if err != nil {
+		t.Fatalf("Failed to download recording: %s", err)
+	}
+
+	if buf.String() != "audio" {
+		t.Fatalf("unexpected recording: %s", buf.String())
+	}
+}
+
+func TestDownloadTalkRecordingOtherHost(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_availability.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var buf bytes.Buffer
+	err := client.DownloadTalkRecording(ctx, "https://example.com/recording.mp3", &buf)
+	if err == nil {
+		t.Fatal("expected an error for a recording on another host")
+	}
+}
+
+func TestDeleteTalkRecording(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/channels/voice/calls/1234/recordings/voicemail.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTalkRecording(ctx, 1234, TalkRecordingTypeVoicemail); err != nil {
+		t.Fatalf("Failed to delete recording: %s", err)
+	}
+}
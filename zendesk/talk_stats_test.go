@@ -0,0 +1,228 @@
+package zendesk
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetIncrementalTalkCalls(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/voice/stats/incremental/calls.json" || r.URL.Query().Get("start_time") != "1688169600" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/incremental_talk_calls.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalTalkCalls(ctx, &IncrementalExportOptions{StartTime: 1688169600})
+	if err != nil {
+		t.Fatalf("Failed to get incremental calls: %s", err)
+	}
+
+	if len(result.Calls) != 1 || result.Calls[0].TalkTime != 160 || result.EndTime != 1688202182 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestGetIncrementalTalkCallLegs(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "incremental_talk_legs.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalTalkCallLegs(ctx, &IncrementalExportOptions{StartTime: 1688169600})
+	if err != nil {
+		t.Fatalf("Failed to get incremental legs: %s", err)
+	}
+
+	if len(result.Legs) != 1 || result.Legs[0].CallID != 360109880 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestGetIncrementalTalkCallsIterator(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			fmt.Fprint(w, `{"calls":[{"id":1},{"id":2}],"count":2,"end_time":1688200000,"next_page":"next"}`)
+		case 2:
+			if r.URL.Query().Get("start_time") != "1688200000" {
+				t.Fatalf("unexpected second query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"calls":[],"count":0,"end_time":1688200000,"next_page":"next"}`)
+		default:
+			t.Fatal("iterator requested after an empty page")
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetIncrementalTalkCallsIterator(ctx, &IncrementalExportOptions{StartTime: 1688169600})
+	it.interval = 0
+
+	callCount := 0
+	for it.HasMore() {
+		calls, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get incremental calls: %s", err)
+		}
+		callCount += len(calls)
+	}
+
+	if callCount != 2 {
+		t.Fatalf("expected length of calls is 2, but got %d", callCount)
+	}
+}
+
+func TestGetTalkCurrentQueueActivity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_current_queue_activity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	activity, err := client.GetTalkCurrentQueueActivity(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get current queue activity: %s", err)
+	}
+
+	if activity.CallsWaiting != 3 || activity.LongestWaitTime != 120 {
+		t.Fatalf("unexpected activity: %+v", activity)
+	}
+}
+
+func TestGetTalkAccountOverview(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_account_overview.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	overview, err := client.GetTalkAccountOverview(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get account overview: %s", err)
+	}
+
+	if overview.TotalCalls != 57 || overview.AverageCallDuration != 242 {
+		t.Fatalf("unexpected overview: %+v", overview)
+	}
+}
+
+func TestListTalkAgentsActivity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_agents_activity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	activities, _, err := client.ListTalkAgentsActivity(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list agents activity: %s", err)
+	}
+
+	if len(activities) != 2 || activities[0].CallsAccepted != 18 {
+		t.Fatalf("unexpected agents activity: %+v", activities)
+	}
+}
+
+func TestGetTalkLineUsage(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start_time") != "1688169600" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"calls":[
+			{"id":1,"line_id":17,"line":"+15551234567","line_type":"phone","duration":20,"created_at":"2023-07-01T09:00:00Z"},
+			{"id":2,"line_id":17,"line":"+15551234567","line_type":"phone","duration":60,"minutes_billed":1,"call_charge":"0.002","created_at":"2023-07-01T10:00:00Z"},
+			{"id":3,"line_id":6001,"line":"Web widget","line_type":"digital","duration":30,"created_at":"2023-07-01T11:00:00Z"},
+			{"id":1,"line_id":17,"line":"+15551234567","line_type":"phone","duration":182,"minutes_billed":4,"call_charge":"0.013","created_at":"2023-07-01T09:00:00Z"},
+			{"id":4,"line_id":17,"line":"+15551234567","line_type":"phone","duration":60,"minutes_billed":1,"call_charge":"0.002","created_at":"2023-08-01T10:00:00Z"}
+		],"count":5,"end_time":1690884000,"next_page":null}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	usage, err := client.GetTalkLineUsage(ctx, &TalkLineUsageOptions{
+		From: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Failed to get line usage: %s", err)
+	}
+
+	if len(usage) != 2 {
+		t.Fatalf("expected length of usage is 2, but got %d", len(usage))
+	}
+	phone := usage[0]
+	if phone.LineID != 17 || phone.Calls != 2 || phone.Duration != 242 || phone.MinutesBilled != 5 ||
+		math.Abs(phone.CallCharge-0.015) > 1e-9 {
+		t.Fatalf("unexpected phone line usage: %+v", phone)
+	}
+	if usage[1].LineType != "digital" || usage[1].Calls != 1 || usage[1].CallCharge != 0 {
+		t.Fatalf("unexpected digital line usage: %+v", usage[1])
+	}
+}
+
+func TestGetTalkLineUsageStopsAfterTo(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintf(w, `{"calls":[
+			{"id":1,"line_id":17,"duration":60,"minutes_billed":1,"call_charge":"0.002","created_at":"2023-07-01T09:00:00Z"}
+		],"count":1,"end_time":1690884000,"next_page":"%s/channels/voice/stats/incremental/calls.json?start_time=1690884000"}`,
+			"https://example.zendesk.com/api/v2")
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	usage, err := client.GetTalkLineUsage(ctx, &TalkLineUsageOptions{
+		From: time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Failed to get line usage: %s", err)
+	}
+
+	if requests != 1 {
+		t.Fatalf("expected 1 request, but got %d", requests)
+	}
+	if len(usage) != 1 || usage[0].Calls != 1 {
+		t.Fatalf("unexpected usage: %+v", usage)
+	}
+}
+
+func TestSummarizeTalkLineUsageCountsLastVersion(t *testing.T) {
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	calls := []TalkCall{
+		{ID: 1, LineID: 17, Duration: 20, CreatedAt: created},
+		{ID: 2, LineID: 17, Duration: 60, MinutesBilled: 1, CallCharge: "0.002", CreatedAt: created},
+		// the call 1 was transferred to the line 18, the line 17 keeps the call 2
+		{ID: 1, LineID: 18, Duration: 182, MinutesBilled: 4, CallCharge: "0.013", CreatedAt: created},
+		// the call 2 was moved out of the period, the line 17 has no calls left
+		{ID: 2, LineID: 17, Duration: 60, CreatedAt: created.AddDate(0, -1, 0)},
+	}
+
+	usage := SummarizeTalkLineUsage(calls, created.AddDate(0, 0, -1), time.Time{})
+	if len(usage) != 1 {
+		t.Fatalf("expected length of usage is 1, but got %+v", usage)
+	}
+	if usage[0].LineID != 18 || usage[0].Calls != 1 || usage[0].Duration != 182 ||
+		math.Abs(usage[0].CallCharge-0.013) > 1e-9 {
+		t.Fatalf("unexpected line usage: %+v", usage[0])
+	}
+}
+
+func TestGetTalkLineUsageWithNil(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "incremental_talk_calls.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetTalkLineUsage(ctx, nil)
+	if err == nil {
+		t.Fatal("expected an OptionsError, but no error")
+	}
+
+	_, ok := err.(*OptionsError)
+	if !ok {
+		t.Fatalf("unexpected error type: %v", err)
+	}
+}
@@ -0,0 +1,374 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Theme job statuses
+const (
+	ThemeJobStatusPending   = "pending"
+	ThemeJobStatusCompleted = "completed"
+	ThemeJobStatusFailed    = "failed"
+)
+
+// ThemeJobPollInterval is the default interval between two checks of a theme job in WaitThemeJob
+const ThemeJobPollInterval = 2 * time.Second
+
+// Theme is a Help Center theme
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#json-format
+type Theme struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Author  string `json:"author"`
+	Version string `json:"version"`
+	// Live is true for the published theme of the brand
+	Live      bool      `json:"live"`
+	BrandID   int64     `json:"brand_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ThemeListOptions is options for ListThemes
+type ThemeListOptions struct {
+	BrandID int64 `url:"brand_id,omitempty"`
+}
+
+// ThemeJob is an import, update or export job of a theme.
+// The theme package of import and update jobs is sent with UploadThemePackage,
+// the one of export jobs is fetched with DownloadThemePackage once the job has completed.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#theming-job-statuses
+type ThemeJob struct {
+	ID     string          `json:"id"`
+	Status string          `json:"status"`
+	Data   ThemeJobData    `json:"data"`
+	Errors []ThemeJobError `json:"errors,omitempty"`
+}
+
+// ThemeJobData is the data of a theme job
+type ThemeJobData struct {
+	ThemeID  string            `json:"theme_id,omitempty"`
+	BrandID  int64             `json:"brand_id,omitempty"`
+	Format   string            `json:"format,omitempty"`
+	Upload   *ThemeJobUpload   `json:"upload,omitempty"`
+	Download *ThemeJobDownload `json:"download,omitempty"`
+}
+
+// ThemeJobUpload is where the theme package of an import or update job is uploaded
+type ThemeJobUpload struct {
+	URL string `json:"url"`
+	// Parameters are form fields to send along with the package
+	Parameters map[string]string `json:"parameters"`
+}
+
+// ThemeJobDownload is where the theme package of an export job is downloaded
+type ThemeJobDownload struct {
+	URL string `json:"url"`
+}
+
+// ThemeJobError is an error of a failed theme job
+type ThemeJobError struct {
+	Title string                 `json:"title"`
+	Code  string                 `json:"code"`
+	Meta  map[string]interface{} `json:"meta,omitempty"`
+}
+
+// Done returns true when the job is not pending anymore
+func (j ThemeJob) Done() bool {
+	return j.Status == ThemeJobStatusCompleted || j.Status == ThemeJobStatusFailed
+}
+
+// ThemeAPI an interface containing all Help Center theme related methods
+type ThemeAPI interface {
+	ListThemes(ctx context.Context, opts *ThemeListOptions) ([]Theme, error)
+	GetTheme(ctx context.Context, themeID string) (Theme, error)
+	DeleteTheme(ctx context.Context, themeID string) error
+	PublishTheme(ctx context.Context, themeID string) (Theme, error)
+	CreateThemeImportJob(ctx context.Context, brandID int64) (ThemeJob, error)
+	CreateThemeUpdateJob(ctx context.Context, themeID string, replaceSettings bool) (ThemeJob, error)
+	CreateThemeExportJob(ctx context.Context, themeID string) (ThemeJob, error)
+	GetThemeJob(ctx context.Context, jobID string) (ThemeJob, error)
+	WaitThemeJob(ctx context.Context, jobID string, interval time.Duration) (ThemeJob, error)
+	UploadThemePackage(ctx context.Context, job ThemeJob, filename string, file io.Reader) error
+	DownloadThemePackage(ctx context.Context, job ThemeJob, w io.Writer) error
+}
+
+// ListThemes lists the themes of the Help Center
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#list-themes
+func (z *Client) ListThemes(ctx context.Context, opts *ThemeListOptions) ([]Theme, error) {
+	var result struct {
+		Themes []Theme `json:"themes"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ThemeListOptions{}
+	}
+
+	u, err := addOptions("/guide/theming/themes", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Themes, nil
+}
+
+// GetTheme gets a theme
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#show-theme
+func (z *Client) GetTheme(ctx context.Context, themeID string) (Theme, error) {
+	var result struct {
+		Theme Theme `json:"theme"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/guide/theming/themes/%s", themeID))
+	if err != nil {
+		return Theme{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Theme{}, err
+	}
+	return result.Theme, nil
+}
+
+// DeleteTheme deletes a theme. The live theme can't be deleted.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#delete-theme
+func (z *Client) DeleteTheme(ctx context.Context, themeID string) error {
+	return z.delete(ctx, fmt.Sprintf("/guide/theming/themes/%s", themeID), nil)
+}
+
+// PublishTheme makes a theme the live theme of its brand
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#publish-theme
+func (z *Client) PublishTheme(ctx context.Context, themeID string) (Theme, error) {
+	var result struct {
+		Theme Theme `json:"theme"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/guide/theming/themes/%s/publish", themeID), nil)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Theme{}, err
+	}
+	return result.Theme, nil
+}
+
+// CreateThemeImportJob starts the import of a new theme in a brand.
+// The theme package must then be sent with UploadThemePackage.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#create-theme-import-job
+func (z *Client) CreateThemeImportJob(ctx context.Context, brandID int64) (ThemeJob, error) {
+	var data struct {
+		Job struct {
+			Attributes struct {
+				BrandID int64  `json:"brand_id"`
+				Format  string `json:"format"`
+			} `json:"attributes"`
+		} `json:"job"`
+	}
+	data.Job.Attributes.BrandID = brandID
+	data.Job.Attributes.Format = "zip"
+
+	return z.createThemeJob(ctx, "/guide/theming/jobs/themes/imports", data)
+}
+
+// CreateThemeUpdateJob starts the update of a theme with a new package.
+// The settings of the theme are replaced by the ones of the package when replaceSettings is true.
+// The theme package must then be sent with UploadThemePackage.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#create-theme-update-job
+func (z *Client) CreateThemeUpdateJob(ctx context.Context, themeID string, replaceSettings bool) (ThemeJob, error) {
+	var data struct {
+		Job struct {
+			Attributes struct {
+				ThemeID         string `json:"theme_id"`
+				ReplaceSettings bool   `json:"replace_settings"`
+				Format          string `json:"format"`
+			} `json:"attributes"`
+		} `json:"job"`
+	}
+	data.Job.Attributes.ThemeID = themeID
+	data.Job.Attributes.ReplaceSettings = replaceSettings
+	data.Job.Attributes.Format = "zip"
+
+	return z.createThemeJob(ctx, "/guide/theming/jobs/themes/updates", data)
+}
+
+// CreateThemeExportJob starts the export of a theme.
+// The theme package can be fetched with DownloadThemePackage once the job has completed.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#create-theme-export-job
+func (z *Client) CreateThemeExportJob(ctx context.Context, themeID string) (ThemeJob, error) {
+	var data struct {
+		Job struct {
+			Attributes struct {
+				ThemeID string `json:"theme_id"`
+				Format  string `json:"format"`
+			} `json:"attributes"`
+		} `json:"job"`
+	}
+	data.Job.Attributes.ThemeID = themeID
+	data.Job.Attributes.Format = "zip"
+
+	return z.createThemeJob(ctx, "/guide/theming/jobs/themes/exports", data)
+}
+
+func (z *Client) createThemeJob(ctx context.Context, path string, data interface{}) (ThemeJob, error) {
+	var result struct {
+		Job ThemeJob `json:"job"`
+	}
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return ThemeJob{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ThemeJob{}, err
+	}
+	return result.Job, nil
+}
+
+// GetThemeJob gets the status of a theme job
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#show-theming-job
+func (z *Client) GetThemeJob(ctx context.Context, jobID string) (ThemeJob, error) {
+	var result struct {
+		Job ThemeJob `json:"job"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/guide/theming/jobs/%s", jobID))
+	if err != nil {
+		return ThemeJob{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return ThemeJob{}, err
+	}
+	return result.Job, nil
+}
+
+// WaitThemeJob polls a theme job every interval until it is done, and returns its last status.
+// interval defaults to ThemeJobPollInterval. The job may have failed, its Status must be checked.
+func (z *Client) WaitThemeJob(ctx context.Context, jobID string, interval time.Duration) (ThemeJob, error) {
+	if interval <= 0 {
+		interval = ThemeJobPollInterval
+	}
+
+	for {
+		job, err := z.GetThemeJob(ctx, jobID)
+		if err != nil {
+			return ThemeJob{}, err
+		}
+		if job.Done() {
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// UploadThemePackage sends the zip package of an import or update job.
+// The package is sent to the storage URL of the job, without the credentials of the client.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#create-theme-import-job
+func (z *Client) UploadThemePackage(ctx context.Context, job ThemeJob, filename string, file io.Reader) error {
+	if job.Data.Upload == nil {
+		return errors.New("the theme job has no upload url")
+	}
+
+	buf, contentType, err := newMultipartBody(job.Data.Upload.Parameters, "file", filename, file)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.Data.Upload.URL, buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return Error{
+			body: body,
+			resp: resp,
+		}
+	}
+	return nil
+}
+
+// DownloadThemePackage writes the zip package of a completed export job to w
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/theming/#create-theme-export-job
+func (z *Client) DownloadThemePackage(ctx context.Context, job ThemeJob, w io.Writer) error {
+	if job.Data.Download == nil {
+		return errors.New("the theme job has no download url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, job.Data.Download.URL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
@@ -0,0 +1,132 @@
+package zendesk
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListThemes(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "themes.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	themes, err := client.ListThemes(ctx, &ThemeListOptions{BrandID: 360001110})
+	if err != nil {
+		t.Fatalf("Failed to list themes: %s", err)
+	}
+
+	if len(themes) != 2 || !themes[0].Live {
+		t.Fatalf("unexpected themes: %+v", themes)
+	}
+}
+
+func TestPublishTheme(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/guide/theming/themes/5a2ff9c1-6cb0-4e9a-a3b8-b6f4e5c38b56/publish" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("POST/theme.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.PublishTheme(ctx, "5a2ff9c1-6cb0-4e9a-a3b8-b6f4e5c38b56"); err != nil {
+		t.Fatalf("Failed to publish theme: %s", err)
+	}
+}
+
+func TestCreateThemeImportJob(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"job":{"attributes":{"brand_id":360001110,"format":"zip"}}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write(readFixture("POST/theme_job.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.CreateThemeImportJob(ctx, 360001110)
+	if err != nil {
+		t.Fatalf("Failed to create theme import job: %s", err)
+	}
+
+	if job.Data.Upload == nil || job.Data.Upload.Parameters["key"] != "imports/9ed9f2f5.zip" {
+		t.Fatalf("unexpected job: %+v", job)
+	}
+}
+
+func TestWaitThemeJob(t *testing.T) {
+	calls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Write([]byte(`{"job":{"id":"8a1b9f3e-0e3c-4c2f-8d55-7d3f1b0e6a44","status":"pending"}}`))
+			return
+		}
+		w.Write(readFixture("GET/theme_job.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.WaitThemeJob(ctx, "8a1b9f3e-0e3c-4c2f-8d55-7d3f1b0e6a44", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to wait theme job: %s", err)
+	}
+
+	if calls != 2 || job.Status != ThemeJobStatusCompleted {
+		t.Fatalf("unexpected job after %d calls: %+v", calls, job)
+	}
+}
+
+func TestUploadThemePackage(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Fatal("the credentials of the client must not be sent to the storage")
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Failed to parse form: %s", err)
+		}
+		if r.FormValue("key") != "imports/9ed9f2f5.zip" {
+			t.Fatalf("unexpected key: %s", r.FormValue("key"))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer storage.Close()
+
+	client := newTestClient(storage)
+	client.SetCredential(NewBasicAuthCredential("john.doe@example.com", "password"))
+
+	job := ThemeJob{Data: ThemeJobData{Upload: &ThemeJobUpload{
+		URL:        storage.URL,
+		Parameters: map[string]string{"key": "imports/9ed9f2f5.zip"},
+	}}}
+	if err := client.UploadThemePackage(ctx, job, "theme.zip", strings.NewReader("zip")); err != nil {
+		t.Fatalf("Failed to upload theme package: %s", err)
+	}
+}
+
+func TestDownloadThemePackage(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("zip"))
+	}))
+	defer storage.Close()
+
+	client := newTestClient(storage)
+	job := ThemeJob{Data: ThemeJobData{Download: &ThemeJobDownload{URL: storage.URL}}}
+
+	var buf bytes.Buffer
+	if err := client.DownloadThemePackage(ctx, job, &buf); err != nil {
+		t.Fatalf("Failed to download theme package: %s", err)
+	}
+
+	if buf.String() != "zip" {
+		t.Fatalf("unexpected package: %s", buf.String())
+	}
+}
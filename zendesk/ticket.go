@@ -4,8 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
-	"strings"
 	"time"
 )
 
@@ -120,16 +118,6 @@ type Requester struct {
 	LocaleID int64  `json:"locale_id,omitempty"`
 }
 
-// Via is information about source of Ticket or TicketComment
-type Via struct {
-	Channel string `json:"channel"`
-	Source  struct {
-		From map[string]interface{} `json:"from"`
-		To   map[string]interface{} `json:"to"`
-		Rel  string                 `json:"rel"`
-	} `json:"source"`
-}
-
 // TicketListOptions struct is used to specify options for listing tickets in OBP (Offset Based Pagination).
 // It embeds the PageOptions struct for pagination and provides options for sorting the result;
 // SortBy specifies the field to sort by, and SortOrder specifies the order (either 'asc' or 'desc').
@@ -161,10 +149,15 @@ type TicketAPI interface {
 	GetOrganizationTicketsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error)
 	GetOrganizationTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
 	GetTicket(ctx context.Context, id int64) (Ticket, error)
+	GetTicketsCount(ctx context.Context) (Count, error)
+	GetOrganizationTicketsCount(ctx context.Context, organizationID int64) (Count, error)
 	GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]Ticket, error)
 	CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error)
 	UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error)
 	DeleteTicket(ctx context.Context, ticketID int64) error
+	MarkTicketAsSpam(ctx context.Context, ticketID int64) error
+	MarkManyTicketsAsSpam(ctx context.Context, ticketIDs []int64) (JobStatus, error)
+	SuspendTicketRequesters(ctx context.Context, ticketIDs []int64) (JobStatus, error)
 }
 
 // GetTickets get ticket list with offset based pagination
@@ -248,6 +241,20 @@ func (z *Client) GetTicket(ctx context.Context, ticketID int64) (Ticket, error)
 	return result.Ticket, err
 }
 
+// GetTicketsCount returns an approximate count of tickets in the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#count-tickets
+func (z *Client) GetTicketsCount(ctx context.Context) (Count, error) {
+	return z.getCount(ctx, "/tickets/count.json")
+}
+
+// GetOrganizationTicketsCount returns an approximate count of tickets of the organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#count-organization-tickets
+func (z *Client) GetOrganizationTicketsCount(ctx context.Context, organizationID int64) (Count, error) {
+	return z.getCount(ctx, fmt.Sprintf("/organizations/%d/tickets/count.json", organizationID))
+}
+
 // GetMultipleTickets gets multiple specified tickets
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#show-multiple-tickets
@@ -259,11 +266,7 @@ func (z *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]T
 	var req struct {
 		IDs string `url:"ids,omitempty"`
 	}
-	idStrs := make([]string, len(ticketIDs))
-	for i := 0; i < len(ticketIDs); i++ {
-		idStrs[i] = strconv.FormatInt(ticketIDs[i], 10)
-	}
-	req.IDs = strings.Join(idStrs, ",")
+	req.IDs = joinIDs(ticketIDs)
 
 	u, err := addOptions("/tickets/show_many.json", req)
 	if err != nil {
@@ -336,3 +339,76 @@ func (z *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
 
 	return nil
 }
+
+// MarkTicketAsSpam marks the ticket as spam and suspends its requester
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#mark-ticket-as-spam-and-suspend-requester
+func (z *Client) MarkTicketAsSpam(ctx context.Context, ticketID int64) error {
+	_, err := z.put(ctx, fmt.Sprintf("/tickets/%d/mark_as_spam.json", ticketID), nil)
+	return err
+}
+
+// MarkManyTicketsAsSpam marks up to 100 tickets as spam in a background job.
+// Unlike MarkTicketAsSpam, the requesters are not suspended.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#bulk-mark-tickets-as-spam
+func (z *Client) MarkManyTicketsAsSpam(ctx context.Context, ticketIDs []int64) (JobStatus, error) {
+	u, err := addOptions("/tickets/mark_many_as_spam.json", bulkIDsOptions{IDs: joinIDs(ticketIDs)})
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return z.putJobStatus(ctx, u, nil)
+}
+
+// SuspendTicketRequesters suspends the end-user requesters of the tickets in a background job.
+// Agents and admins are never suspended. When no requester is left to suspend, it returns
+// an empty JobStatus without queuing a job.
+// It's meant to be used with MarkManyTicketsAsSpam to clean up a spam wave.
+func (z *Client) SuspendTicketRequesters(ctx context.Context, ticketIDs []int64) (JobStatus, error) {
+	tickets, err := z.GetMultipleTickets(ctx, ticketIDs)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	seen := make(map[int64]bool)
+	var userIDs []int64
+	for _, ticket := range tickets {
+		if ticket.RequesterID == 0 || seen[ticket.RequesterID] {
+			continue
+		}
+		seen[ticket.RequesterID] = true
+		userIDs = append(userIDs, ticket.RequesterID)
+	}
+	if len(userIDs) == 0 {
+		return JobStatus{}, nil
+	}
+
+	users, err := z.GetManyUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	var requesterIDs []int64
+	for _, user := range users {
+		if user.Role == UserRoleText(UserRoleEndUser) {
+			requesterIDs = append(requesterIDs, user.ID)
+		}
+	}
+	if len(requesterIDs) == 0 {
+		return JobStatus{}, nil
+	}
+
+	u, err := addOptions("/users/update_many.json", bulkIDsOptions{IDs: joinIDs(requesterIDs)})
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	var data struct {
+		User struct {
+			Suspended bool `json:"suspended"`
+		} `json:"user"`
+	}
+	data.User.Suspended = true
+
+	return z.putJobStatus(ctx, u, data)
+}
@@ -28,6 +28,12 @@ type TicketAuditVia struct {
 	} `json:"source,omitempty"`
 }
 
+// TypedSource decodes Source into a ViaSource.
+// It returns an error if a field of the source does not have the documented type.
+func (v TicketAuditVia) TypedSource() (ViaSource, error) {
+	return newViaSource(v.Source.From, v.Source.To, v.Source.Ref)
+}
+
 // TicketAuditAPI an interface containing all of the ticket audit related zendesk methods
 type TicketAuditAPI interface {
 	GetAllTicketAudits(ctx context.Context, opts CursorOption) ([]TicketAudit, Cursor, error)
@@ -36,6 +42,7 @@ type TicketAuditAPI interface {
 	GetTicketAuditsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketAudit]
 	GetTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([]TicketAudit, Page, error)
 	GetTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([]TicketAudit, CursorPaginationMeta, error)
+	ListTicketEmailNotifications(ctx context.Context, ticketID int64) ([]EmailNotification, error)
 }
 
 // GetAllTicketAudits list all ticket audits
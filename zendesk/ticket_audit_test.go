@@ -50,3 +50,21 @@ func TestGetTicketAudit(t *testing.T) {
 		t.Fatalf("Returned ticket audit does not have the expected ID %d. Ticket audit id is %d", expectedID, ticketAudit.ID)
 	}
 }
+
+func TestGetTicketAuditVia(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audit.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketAudit, err := client.GetTicketAudit(ctx, 666, 2127301143)
+	if err != nil {
+		t.Fatalf("Failed to get ticket audit: %s", err)
+	}
+
+	if ticketAudit.Via.Channel != ViaChannelWeb {
+		t.Fatalf("expected via channel is web, but got %s", ticketAudit.Via.Channel)
+	}
+	if _, err := ticketAudit.Via.TypedSource(); err != nil {
+		t.Fatalf("Failed to decode via source: %s", err)
+	}
+}
@@ -0,0 +1,123 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Types of the audit events sending email notifications
+const (
+	TicketAuditEventNotification         = "Notification"
+	TicketAuditEventCc                   = "Cc"
+	TicketAuditEventOrganizationActivity = "OrganizationActivity"
+)
+
+// EmailNotification is an email sent by Zendesk for an update of a ticket,
+// derived from the notification events of the audit of the update
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/ticket-audit-events-reference/
+type EmailNotification struct {
+	AuditID  int64 `json:"audit_id"`
+	EventID  int64 `json:"event_id"`
+	TicketID int64 `json:"ticket_id"`
+	// AuthorID is the author of the update, not the sender of the email
+	AuthorID int64 `json:"author_id"`
+	// Type is the type of the audit event, such as TicketAuditEventNotification
+	Type       string     `json:"type"`
+	Subject    string     `json:"subject,omitempty"`
+	Body       string     `json:"body,omitempty"`
+	Recipients []int64    `json:"recipients"`
+	Via        Via        `json:"via"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	// MessageID is the Message-ID of the email which made the update, when the update came by email
+	MessageID string `json:"message_id,omitempty"`
+}
+
+// ListTicketEmailNotifications lists the email notifications sent for the updates of a ticket.
+// It fetches all audits of the ticket.
+func (z *Client) ListTicketEmailNotifications(ctx context.Context, ticketID int64) ([]EmailNotification, error) {
+	opts := NewPaginationOptions()
+	opts.Id = ticketID
+
+	var notifications []EmailNotification
+	it := z.GetTicketAuditsIterator(ctx, opts)
+	for {
+		audits, err := it.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, EmailNotificationsFromAudits(audits)...)
+		if !it.HasMore() {
+			return notifications, nil
+		}
+	}
+}
+
+// EmailNotificationsFromAudits returns the email notifications sent by the updates of the audits
+func EmailNotificationsFromAudits(audits []TicketAudit) []EmailNotification {
+	var notifications []EmailNotification
+	for _, audit := range audits {
+		messageID := auditMessageID(audit)
+		for _, e := range audit.Events {
+			var event struct {
+				ID         int64   `json:"id"`
+				Type       string  `json:"type"`
+				Subject    string  `json:"subject"`
+				Body       string  `json:"body"`
+				Recipients []int64 `json:"recipients"`
+				Via        Via     `json:"via"`
+			}
+			if !decodeAuditValue(e, &event) {
+				continue
+			}
+
+			switch event.Type {
+			case TicketAuditEventNotification, TicketAuditEventCc, TicketAuditEventOrganizationActivity:
+			default:
+				continue
+			}
+
+			notifications = append(notifications, EmailNotification{
+				AuditID:    audit.ID,
+				EventID:    event.ID,
+				TicketID:   audit.TicketID,
+				AuthorID:   audit.AuthorID,
+				Type:       event.Type,
+				Subject:    event.Subject,
+				Body:       event.Body,
+				Recipients: event.Recipients,
+				Via:        event.Via,
+				CreatedAt:  audit.CreatedAt,
+				MessageID:  messageID,
+			})
+		}
+	}
+	return notifications
+}
+
+// auditMessageID returns the Message-ID in the system metadata of the audit
+func auditMessageID(audit TicketAudit) string {
+	var metadata struct {
+		System struct {
+			MessageID string `json:"message_id"`
+		} `json:"system"`
+	}
+	if !decodeAuditValue(audit.Metadata, &metadata) {
+		return ""
+	}
+	return metadata.System.MessageID
+}
+
+// decodeAuditValue decodes a value of a TicketAudit decoded as interface{} into v
+func decodeAuditValue(value interface{}, v interface{}) bool {
+	if value == nil {
+		return false
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
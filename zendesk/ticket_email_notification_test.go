@@ -0,0 +1,36 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestListTicketEmailNotifications(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audits_notifications.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	notifications, err := client.ListTicketEmailNotifications(ctx, 666)
+	if err != nil {
+		t.Fatalf("Failed to list ticket email notifications: %s", err)
+	}
+
+	if len(notifications) != 2 {
+		t.Fatalf("expected length of notifications is 2, but got %d", len(notifications))
+	}
+
+	n := notifications[0]
+	if n.Type != TicketAuditEventNotification || n.AuditID != 2127301143 || n.EventID != 1564246 {
+		t.Fatalf("unexpected notification: %+v", n)
+	}
+	if len(n.Recipients) != 1 || n.Recipients[0] != 5246746 {
+		t.Fatalf("unexpected recipients: %v", n.Recipients)
+	}
+	if n.MessageID != "<CAB4=abc@mail.example.com>" {
+		t.Fatalf("unexpected message id: %s", n.MessageID)
+	}
+
+	if len(notifications[1].Recipients) != 2 || notifications[1].MessageID != "" {
+		t.Fatalf("unexpected notification: %+v", notifications[1])
+	}
+}
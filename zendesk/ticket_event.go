@@ -0,0 +1,150 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// TicketEvent is an update of a ticket returned by the incremental ticket event export
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+type TicketEvent struct {
+	ID              int64                  `json:"id"`
+	TicketID        int64                  `json:"ticket_id"`
+	Timestamp       int64                  `json:"timestamp"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdaterID       int64                  `json:"updater_id"`
+	Via             string                 `json:"via"`
+	System          map[string]interface{} `json:"system,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	EventType       string                 `json:"event_type"`
+	MergedTicketIDs []int64                `json:"merged_ticket_ids,omitempty"`
+	ChildEvents     []TicketChildEvent     `json:"child_events"`
+}
+
+// TicketChildEvent is a single change included in a TicketEvent
+type TicketChildEvent struct {
+	ID             int64       `json:"id"`
+	EventType      string      `json:"event_type"`
+	Via            string      `json:"via,omitempty"`
+	ViaReferenceID int64       `json:"via_reference_id,omitempty"`
+	PreviousValue  interface{} `json:"previous_value,omitempty"`
+
+	// Comment is set when EventType is "Comment"
+	Comment *TicketEventComment `json:"-"`
+
+	// Fields contains the ticket fields set by the event keyed by field name, such as "status" or "tags".
+	// It is empty for comment events.
+	Fields map[string]interface{} `json:"-"`
+}
+
+// TicketEventComment is the comment added by a "Comment" child event.
+// It's included when comment_events is sideloaded.
+type TicketEventComment struct {
+	Body           string       `json:"body"`
+	HTMLBody       string       `json:"html_body"`
+	PlainBody      string       `json:"plain_body"`
+	Public         bool         `json:"public"`
+	AuthorID       int64        `json:"author_id"`
+	Attachments    []Attachment `json:"attachments"`
+	CommentPresent bool         `json:"comment_present"`
+	CommentPublic  bool         `json:"comment_public"`
+	CreatedAt      *time.Time   `json:"created_at,omitempty"`
+}
+
+// ticketChildEventKeys are the keys that are not ticket fields
+var ticketChildEventKeys = map[string]bool{
+	"id":               true,
+	"event_type":       true,
+	"via":              true,
+	"via_reference_id": true,
+	"previous_value":   true,
+}
+
+// UnmarshalJSON is a custom unmarshal function because the payload of child events
+// depends on their event type.
+func (e *TicketChildEvent) UnmarshalJSON(data []byte) error {
+	type alias TicketChildEvent
+	var tmp alias
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	*e = TicketChildEvent(tmp)
+
+	if e.EventType == "Comment" {
+		var comment TicketEventComment
+		if err := json.Unmarshal(data, &comment); err != nil {
+			return err
+		}
+		e.Comment = &comment
+		return nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for key := range ticketChildEventKeys {
+		delete(fields, key)
+	}
+	e.Fields = fields
+	return nil
+}
+
+// IncrementalTicketEventsResult is a page of the incremental ticket event export
+type IncrementalTicketEventsResult struct {
+	TicketEvents []TicketEvent `json:"ticket_events"`
+	IncrementalExportMeta
+}
+
+// TicketEventAPI an interface containing all ticket event related methods
+type TicketEventAPI interface {
+	GetIncrementalTicketEvents(ctx context.Context, opts *IncrementalExportOptions) (*IncrementalTicketEventsResult, error)
+	GetIncrementalTicketEventsIterator(
+		ctx context.Context, opts *IncrementalExportOptions) *IncrementalExportIterator[TicketEvent]
+}
+
+// GetIncrementalTicketEvents returns the ticket events since opts.StartTime.
+// Set opts.Include to "comment_events" to include comments in child events.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+func (z *Client) GetIncrementalTicketEvents(
+	ctx context.Context, opts *IncrementalExportOptions,
+) (*IncrementalTicketEventsResult, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &IncrementalExportOptions{}
+	}
+
+	u, err := addOptions("/incremental/ticket_events.json", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	var result IncrementalTicketEventsResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetIncrementalTicketEventsIterator returns an iterator over the incremental ticket event export
+func (z *Client) GetIncrementalTicketEventsIterator(
+	ctx context.Context, opts *IncrementalExportOptions,
+) *IncrementalExportIterator[TicketEvent] {
+	return newIncrementalExportIterator(ctx, opts,
+		func(ctx context.Context, opts *IncrementalExportOptions) ([]TicketEvent, IncrementalExportMeta, error) {
+			result, err := z.GetIncrementalTicketEvents(ctx, opts)
+			if err != nil {
+				return nil, IncrementalExportMeta{}, err
+			}
+			return result.TicketEvents, result.IncrementalExportMeta, nil
+		})
+}
@@ -0,0 +1,71 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetIncrementalTicketEvents(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "incremental_ticket_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.GetIncrementalTicketEvents(ctx, &IncrementalExportOptions{
+		StartTime: 1601357000,
+		Include:   "comment_events",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get incremental ticket events: %s", err)
+	}
+
+	if len(result.TicketEvents) != 1 {
+		t.Fatalf("expected length of ticket events is 1, but got %d", len(result.TicketEvents))
+	}
+	if !result.EndOfStream {
+		t.Fatal("expected end of stream")
+	}
+
+	children := result.TicketEvents[0].ChildEvents
+	if len(children) != 3 {
+		t.Fatalf("expected length of child events is 3, but got %d", len(children))
+	}
+
+	comment := children[0].Comment
+	if comment == nil || comment.Body != "My printer is on fire!" || comment.AuthorID != 7 {
+		t.Fatalf("unexpected comment event: %+v", comment)
+	}
+	if len(children[0].Fields) != 0 {
+		t.Fatalf("expected comment event to have no fields, but got %v", children[0].Fields)
+	}
+
+	change := children[2]
+	if change.Comment != nil {
+		t.Fatal("expected change event to have no comment")
+	}
+	if change.Fields["status"] != "open" || change.PreviousValue != "new" {
+		t.Fatalf("unexpected change event: %+v", change)
+	}
+	if change.ViaReferenceID != 360000313354 {
+		t.Fatalf("unexpected via reference id: %d", change.ViaReferenceID)
+	}
+}
+
+func TestGetIncrementalTicketEventsIterator(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "incremental_ticket_events.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := client.GetIncrementalTicketEventsIterator(ctx, &IncrementalExportOptions{StartTime: 1601357000})
+	count := 0
+	for it.HasMore() {
+		events, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get incremental ticket events: %s", err)
+		}
+		count += len(events)
+	}
+
+	if count != 1 {
+		t.Fatalf("expected length of ticket events is 1, but got %d", count)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 // TicketForm is JSON payload struct
@@ -21,6 +22,127 @@ type TicketForm struct {
 	TicketFieldIDs     []int64 `json:"ticket_field_ids,omitempty"`
 	InAllBrands        bool    `json:"in_all_brands,omitempty"`
 	RestrictedBrandIDs []int64 `json:"restricted_brand_ids,omitempty"`
+
+	// AgentConditions and EndUserConditions show child fields depending on the value of a parent field
+	AgentConditions   []TicketFormCondition `json:"agent_conditions,omitempty"`
+	EndUserConditions []TicketFormCondition `json:"end_user_conditions,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// Values of TicketFormRequiredOnStatuses.Type
+const (
+	RequiredOnNoStatuses   = "NO_STATUSES"
+	RequiredOnAllStatuses  = "ALL_STATUSES"
+	RequiredOnSomeStatuses = "SOME_STATUSES"
+)
+
+// TicketFormCondition shows the child fields when the parent field has the value
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_forms/#json-format
+type TicketFormCondition struct {
+	ParentFieldID   int64  `json:"parent_field_id"`
+	ParentFieldType string `json:"parent_field_type,omitempty"`
+
+	// Value is a string for most fields and a bool for checkbox fields
+	Value       interface{}                `json:"value"`
+	ChildFields []TicketFormConditionChild `json:"child_fields"`
+}
+
+// TicketFormConditionChild is a field shown by a TicketFormCondition
+type TicketFormConditionChild struct {
+	ID         int64 `json:"id"`
+	IsRequired bool  `json:"is_required"`
+
+	// RequiredOnStatuses is only supported by agent conditions
+	RequiredOnStatuses *TicketFormRequiredOnStatuses `json:"required_on_statuses,omitempty"`
+}
+
+// TicketFormRequiredOnStatuses sets the ticket statuses on which an agent child field is required
+type TicketFormRequiredOnStatuses struct {
+	Type     string   `json:"type"`
+	Statuses []string `json:"statuses,omitempty"`
+}
+
+// ticketFormConditionStatuses are the statuses supported by RequiredOnStatuses
+var ticketFormConditionStatuses = map[string]bool{
+	"new":     true,
+	"open":    true,
+	"pending": true,
+	"hold":    true,
+	"solved":  true,
+}
+
+// ValidateConditions checks the conditions of the form before sending them.
+// Parent and child fields must belong to the form, a field can't be its own child,
+// and RequiredOnStatuses must be consistent and only used by agent conditions.
+func (f TicketForm) ValidateConditions() error {
+	fieldIDs := make(map[int64]bool, len(f.TicketFieldIDs))
+	for _, id := range f.TicketFieldIDs {
+		fieldIDs[id] = true
+	}
+
+	validate := func(kind string, conditions []TicketFormCondition) error {
+		for _, c := range conditions {
+			if !fieldIDs[c.ParentFieldID] {
+				return fmt.Errorf("%s condition: parent field %d is not in the form", kind, c.ParentFieldID)
+			}
+			if len(c.ChildFields) == 0 {
+				return fmt.Errorf("%s condition: parent field %d has no child field", kind, c.ParentFieldID)
+			}
+
+			children := make(map[int64]bool, len(c.ChildFields))
+			for _, child := range c.ChildFields {
+				switch {
+				case child.ID == c.ParentFieldID:
+					return fmt.Errorf("%s condition: field %d can't be its own child", kind, child.ID)
+				case !fieldIDs[child.ID]:
+					return fmt.Errorf("%s condition: child field %d is not in the form", kind, child.ID)
+				case children[child.ID]:
+					return fmt.Errorf("%s condition: child field %d is duplicated", kind, child.ID)
+				}
+				children[child.ID] = true
+
+				if child.RequiredOnStatuses == nil {
+					continue
+				}
+				if kind != "agent" {
+					return fmt.Errorf("%s condition: child field %d can't use required_on_statuses", kind, child.ID)
+				}
+				if err := child.RequiredOnStatuses.validate(); err != nil {
+					return fmt.Errorf("%s condition: child field %d: %w", kind, child.ID, err)
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := validate("agent", f.AgentConditions); err != nil {
+		return err
+	}
+	return validate("end user", f.EndUserConditions)
+}
+
+func (r TicketFormRequiredOnStatuses) validate() error {
+	switch r.Type {
+	case RequiredOnNoStatuses, RequiredOnAllStatuses:
+		if len(r.Statuses) != 0 {
+			return fmt.Errorf("statuses must be empty for %s", r.Type)
+		}
+	case RequiredOnSomeStatuses:
+		if len(r.Statuses) == 0 {
+			return fmt.Errorf("statuses are required for %s", r.Type)
+		}
+		for _, status := range r.Statuses {
+			if !ticketFormConditionStatuses[status] {
+				return fmt.Errorf("unsupported status %q", status)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported required_on_statuses type %q", r.Type)
+	}
+	return nil
 }
 
 // TicketFormListOptions is options for GetTicketForms
@@ -115,3 +115,97 @@ func TestUpdateTicketFormFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestGetTicketFormConditions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_form.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	f, err := client.GetTicketForm(ctx, 47)
+	if err != nil {
+		t.Fatalf("Failed to get ticket form: %s", err)
+	}
+
+	if len(f.AgentConditions) != 2 || len(f.EndUserConditions) != 2 {
+		t.Fatalf("unexpected conditions: %+v %+v", f.AgentConditions, f.EndUserConditions)
+	}
+	child := f.AgentConditions[0].ChildFields[1]
+	if child.ID != 200 || !child.IsRequired {
+		t.Fatalf("unexpected child field: %+v", child)
+	}
+	if f.AgentConditions[0].Value != "matching_value" {
+		t.Fatalf("unexpected condition value: %v", f.AgentConditions[0].Value)
+	}
+}
+
+func TestTicketFormValidateConditions(t *testing.T) {
+	form := func(agent, endUser []TicketFormCondition) TicketForm {
+		return TicketForm{
+			TicketFieldIDs:    []int64{100, 101, 102},
+			AgentConditions:   agent,
+			EndUserConditions: endUser,
+		}
+	}
+	child := func(id int64, statuses *TicketFormRequiredOnStatuses) []TicketFormConditionChild {
+		return []TicketFormConditionChild{{ID: id, IsRequired: true, RequiredOnStatuses: statuses}}
+	}
+
+	cases := map[string]struct {
+		form  TicketForm
+		valid bool
+	}{
+		"valid": {
+			form: form([]TicketFormCondition{{
+				ParentFieldID: 100,
+				Value:         true,
+				ChildFields: child(101, &TicketFormRequiredOnStatuses{
+					Type:     RequiredOnSomeStatuses,
+					Statuses: []string{"pending", "solved"},
+				}),
+			}}, []TicketFormCondition{{ParentFieldID: 100, Value: true, ChildFields: child(102, nil)}}),
+			valid: true,
+		},
+		"parent not in form": {
+			form: form([]TicketFormCondition{{ParentFieldID: 999, ChildFields: child(101, nil)}}, nil),
+		},
+		"child not in form": {
+			form: form(nil, []TicketFormCondition{{ParentFieldID: 100, ChildFields: child(999, nil)}}),
+		},
+		"own child": {
+			form: form([]TicketFormCondition{{ParentFieldID: 100, ChildFields: child(100, nil)}}, nil),
+		},
+		"some statuses without status": {
+			form: form([]TicketFormCondition{{
+				ParentFieldID: 100,
+				ChildFields:   child(101, &TicketFormRequiredOnStatuses{Type: RequiredOnSomeStatuses}),
+			}}, nil),
+		},
+		"unknown status": {
+			form: form([]TicketFormCondition{{
+				ParentFieldID: 100,
+				ChildFields: child(101, &TicketFormRequiredOnStatuses{
+					Type:     RequiredOnSomeStatuses,
+					Statuses: []string{"closed"},
+				}),
+			}}, nil),
+		},
+		"statuses on end user condition": {
+			form: form(nil, []TicketFormCondition{{
+				ParentFieldID: 100,
+				ChildFields:   child(101, &TicketFormRequiredOnStatuses{Type: RequiredOnAllStatuses}),
+			}}),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := c.form.ValidateConditions()
+			if c.valid && err != nil {
+				t.Fatalf("expected conditions to be valid, but got %s", err)
+			}
+			if !c.valid && err == nil {
+				t.Fatal("expected conditions to be invalid")
+			}
+		})
+	}
+}
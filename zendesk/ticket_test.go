@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -246,6 +247,14 @@ func TestGetTicket(t *testing.T) {
 	if !reflect.DeepEqual(ticket.Via, expectedVia) {
 		t.Fatal(fmt.Sprintf("Expected ticket via object to be %v but got %v", expectedVia, ticket.Via))
 	}
+
+	source, err := ticket.Via.TypedSource()
+	if err != nil {
+		t.Fatalf("Failed to decode via source: %s", err)
+	}
+	if source.From.Address != "nukosuke@lavabit.com" || source.To.Name != "Terraform Zendesk provider" {
+		t.Fatalf("unexpected via source: %+v", source)
+	}
 }
 
 func TestGetTicketCanceledContext(t *testing.T) {
@@ -423,3 +432,176 @@ func TestTicketMarshalling(t *testing.T) {
 	}
 
 }
+
+func TestGetTicketsCount(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetTicketsCount(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get tickets count: %s", err)
+	}
+
+	if count.Value != 102 {
+		t.Fatalf("expected count is 102, but got %d", count.Value)
+	}
+	if count.RefreshedAt.IsZero() {
+		t.Fatal("expected refreshed_at to be set")
+	}
+}
+
+func TestMarkTicketAsSpam(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/2/mark_as_spam.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.MarkTicketAsSpam(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to mark ticket as spam: %s", err)
+	}
+}
+
+func TestMarkManyTicketsAsSpam(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/mark_many_as_spam.json" || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.MarkManyTicketsAsSpam(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to mark many tickets as spam: %s", err)
+	}
+
+	if jobStatus.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", jobStatus.Status)
+	}
+}
+
+func TestSuspendTicketRequesters(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/show_many.json":
+			fmt.Fprint(w, `{"tickets":[{"id":1,"requester_id":10},{"id":2,"requester_id":10},{"id":3,"requester_id":20}]}`)
+		case "/users/show_many.json":
+			if ids := r.URL.Query().Get("ids"); ids != "10,20" {
+				t.Fatalf("unexpected user ids: %s", ids)
+			}
+			fmt.Fprint(w, `{"users":[{"id":10,"role":"end-user"},{"id":20,"role":"end-user"}]}`)
+		case "/users/update_many.json":
+			if ids := r.URL.Query().Get("ids"); ids != "10,20" {
+				t.Fatalf("unexpected requester ids: %s", ids)
+			}
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"user":{"suspended":true}}` {
+				t.Fatalf("unexpected request body: %s", body)
+			}
+			w.Write(readFixture("PUT/job_status.json"))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.SuspendTicketRequesters(ctx, []int64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Failed to suspend ticket requesters: %s", err)
+	}
+}
+
+func TestSuspendTicketRequestersSkipsAgents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/show_many.json":
+			fmt.Fprint(w, `{"tickets":[{"id":1,"requester_id":10},{"id":2,"requester_id":20},{"id":3,"requester_id":30}]}`)
+		case "/users/show_many.json":
+			fmt.Fprint(w, `{"users":[{"id":10,"role":"agent"},{"id":20,"role":"end-user"},{"id":30,"role":"admin"}]}`)
+		case "/users/update_many.json":
+			if ids := r.URL.Query().Get("ids"); ids != "20" {
+				t.Fatalf("unexpected requester ids: %s", ids)
+			}
+			w.Write(readFixture("PUT/job_status.json"))
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.SuspendTicketRequesters(ctx, []int64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Failed to suspend ticket requesters: %s", err)
+	}
+}
+
+func TestSuspendTicketRequestersWithoutEndUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/show_many.json":
+			fmt.Fprint(w, `{"tickets":[{"id":1,"requester_id":10},{"id":2}]}`)
+		case "/users/show_many.json":
+			fmt.Fprint(w, `{"users":[{"id":10,"role":"agent"}]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.SuspendTicketRequesters(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to suspend ticket requesters: %s", err)
+	}
+	if job.ID != "" {
+		t.Fatalf("expected no job, but got %v", job)
+	}
+}
+
+func TestSuspendTicketRequestersWithoutRequesters(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/show_many.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"tickets":[]}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.SuspendTicketRequesters(ctx, []int64{1})
+	if err != nil {
+		t.Fatalf("Failed to suspend ticket requesters: %s", err)
+	}
+	if job.ID != "" {
+		t.Fatalf("expected no job, but got %v", job)
+	}
+}
+
+func TestGetOrganizationTicketsCount(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/123/tickets/count.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/count.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetOrganizationTicketsCount(ctx, 123)
+	if err != nil {
+		t.Fatalf("Failed to get organization tickets count: %s", err)
+	}
+
+	if count.Value != 102 {
+		t.Fatalf("expected count is 102, but got %d", count.Value)
+	}
+}
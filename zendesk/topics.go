@@ -1,17 +1,174 @@
 package zendesk
 
-import "time"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
+// Values of Topic.ManageableBy
+const (
+	TopicManageableByStaff    = "staff"
+	TopicManageableByManagers = "managers"
+)
+
+// Topic is a community topic, which contains posts
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#json-format
 type Topic struct {
-	ID            int64     `json:"id"`
-	URL           string    `json:"url"`
-	HTMLURL       string    `json:"html_url"`
-	Name          string    `json:"name"`
-	Description   string    `json:"description"`
-	Position      int       `json:"position"`
-	FollowerCount int       `json:"follower_count"`
-	ManageableBy  string    `json:"manageable_by"`
-	UserSegmentID int64     `json:"user_segment_id"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID            int64  `json:"id,omitempty"`
+	URL           string `json:"url,omitempty"`
+	HTMLURL       string `json:"html_url,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Description   string `json:"description,omitempty"`
+	Position      int    `json:"position,omitempty"`
+	FollowerCount int    `json:"follower_count,omitempty"`
+	// ManageableBy is the set of users who can manage the topic,
+	// TopicManageableByStaff or TopicManageableByManagers
+	ManageableBy string `json:"manageable_by,omitempty"`
+	// UserSegmentID is the user segment who can view the topic. Zero means everyone.
+	UserSegmentID int64     `json:"user_segment_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+	UpdatedAt     time.Time `json:"updated_at,omitempty"`
+}
+
+// TopicListOptions is options for ListTopics
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#list-topics
+type TopicListOptions struct {
+	CursorPagination
+}
+
+// TopicAPI an interface containing all community topic related methods
+type TopicAPI interface {
+	ListTopics(ctx context.Context, opts *TopicListOptions) ([]Topic, CursorPaginationMeta, error)
+	GetTopic(ctx context.Context, topicID int64) (Topic, error)
+	CreateTopic(ctx context.Context, topic Topic) (Topic, error)
+	UpdateTopic(ctx context.Context, topicID int64, topic Topic) (Topic, error)
+	UpdateTopicUserSegment(ctx context.Context, topicID int64, userSegmentID *int64) (Topic, error)
+	DeleteTopic(ctx context.Context, topicID int64) error
+}
+
+// ListTopics lists the community topics
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#list-topics
+func (z *Client) ListTopics(ctx context.Context, opts *TopicListOptions) ([]Topic, CursorPaginationMeta, error) {
+	var result struct {
+		Topics []Topic              `json:"topics"`
+		Meta   CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TopicListOptions{}
+	}
+
+	u, err := addOptions("/help_center/community/topics.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Topics, result.Meta, nil
+}
+
+// GetTopic gets a community topic
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#show-topic
+func (z *Client) GetTopic(ctx context.Context, topicID int64) (Topic, error) {
+	var result struct {
+		Topic Topic `json:"topic"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/community/topics/%d.json", topicID))
+	if err != nil {
+		return Topic{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Topic{}, err
+	}
+	return result.Topic, nil
+}
+
+// CreateTopic creates a community topic. Only Help Center managers can create topics.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#create-topic
+func (z *Client) CreateTopic(ctx context.Context, topic Topic) (Topic, error) {
+	var data, result struct {
+		Topic Topic `json:"topic"`
+	}
+	data.Topic = topic
+
+	body, err := z.post(ctx, "/help_center/community/topics.json", data)
+	if err != nil {
+		return Topic{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Topic{}, err
+	}
+	return result.Topic, nil
+}
+
+// UpdateTopic updates a community topic
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#update-topic
+func (z *Client) UpdateTopic(ctx context.Context, topicID int64, topic Topic) (Topic, error) {
+	var data struct {
+		Topic Topic `json:"topic"`
+	}
+	data.Topic = topic
+
+	return z.updateTopic(ctx, topicID, data)
+}
+
+// UpdateTopicUserSegment sets the user segment who can view a community topic.
+// A nil userSegmentID makes the topic visible to everyone, which UpdateTopic can't do.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#update-topic
+func (z *Client) UpdateTopicUserSegment(ctx context.Context, topicID int64, userSegmentID *int64) (Topic, error) {
+	var data struct {
+		Topic struct {
+			UserSegmentID *int64 `json:"user_segment_id"`
+		} `json:"topic"`
+	}
+	data.Topic.UserSegmentID = userSegmentID
+
+	return z.updateTopic(ctx, topicID, data)
+}
+
+func (z *Client) updateTopic(ctx context.Context, topicID int64, data interface{}) (Topic, error) {
+	var result struct {
+		Topic Topic `json:"topic"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/help_center/community/topics/%d.json", topicID), data)
+	if err != nil {
+		return Topic{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Topic{}, err
+	}
+	return result.Topic, nil
+}
+
+// DeleteTopic deletes a community topic with its posts
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/topics/#delete-topic
+func (z *Client) DeleteTopic(ctx context.Context, topicID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/community/topics/%d.json", topicID), nil)
 }
@@ -0,0 +1,92 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListTopics(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "topics.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	topics, _, err := client.ListTopics(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list topics: %s", err)
+	}
+
+	if len(topics) != 2 {
+		t.Fatalf("expected length of topics is 2, but got %d", len(topics))
+	}
+}
+
+func TestGetTopic(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "topic.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	topic, err := client.GetTopic(ctx, 115000553548)
+	if err != nil {
+		t.Fatalf("Failed to get topic: %s", err)
+	}
+
+	if topic.ManageableBy != TopicManageableByManagers || topic.UserSegmentID != 7446900 {
+		t.Fatalf("unexpected topic: %+v", topic)
+	}
+}
+
+func TestCreateTopic(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), `"manageable_by":"managers","user_segment_id":7446900`) {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/topic.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTopic(ctx, Topic{
+		Name:          "Feature requests",
+		ManageableBy:  TopicManageableByManagers,
+		UserSegmentID: 7446900,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create topic: %s", err)
+	}
+}
+
+func TestUpdateTopicUserSegment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"topic":{"user_segment_id":null}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/topic.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateTopicUserSegment(ctx, 115000553548, nil); err != nil {
+		t.Fatalf("Failed to update topic user segment: %s", err)
+	}
+}
+
+func TestDeleteTopic(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/community/topics/115000553548.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTopic(ctx, 115000553548); err != nil {
+		t.Fatalf("Failed to delete topic: %s", err)
+	}
+}
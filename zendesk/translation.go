@@ -0,0 +1,199 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Translation is the content of a Help Center article, section or category in a locale
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#json-format
+type Translation struct {
+	ID         int64  `json:"id,omitempty"`
+	URL        string `json:"url,omitempty"`
+	HTMLURL    string `json:"html_url,omitempty"`
+	SourceID   int64  `json:"source_id,omitempty"`
+	SourceType string `json:"source_type,omitempty"`
+	Locale     string `json:"locale"`
+	Title      string `json:"title,omitempty"`
+	Body       string `json:"body,omitempty"`
+	Outdated   bool   `json:"outdated,omitempty"`
+	// Draft is only changed when it is set, false publishes a draft translation
+	Draft       *bool      `json:"draft,omitempty"`
+	Hidden      bool       `json:"hidden,omitempty"`
+	CreatedByID int64      `json:"created_by_id,omitempty"`
+	UpdatedByID int64      `json:"updated_by_id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// TranslationAPI an interface containing the Help Center translation methods
+// shared by articles, sections and categories
+type TranslationAPI interface {
+	DeleteTranslation(ctx context.Context, translationID int64) error
+	ListMissingArticleTranslations(ctx context.Context, articleID int64) ([]string, error)
+	ListMissingSectionTranslations(ctx context.Context, sectionID int64) ([]string, error)
+	ListMissingCategoryTranslations(ctx context.Context, categoryID int64) ([]string, error)
+	ListOutdatedArticleTranslations(ctx context.Context, articleID int64) ([]Translation, error)
+	ListOutdatedSectionTranslations(ctx context.Context, sectionID int64) ([]Translation, error)
+	ListOutdatedCategoryTranslations(ctx context.Context, categoryID int64) ([]Translation, error)
+}
+
+// DeleteTranslation deletes a translation of an article, a section or a category.
+// The translation in the source locale can't be deleted.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#delete-translation
+func (z *Client) DeleteTranslation(ctx context.Context, translationID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/translations/%d.json", translationID), nil)
+}
+
+// ListMissingArticleTranslations lists the enabled locales of the Help Center without a translation of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-missing-translations
+func (z *Client) ListMissingArticleTranslations(ctx context.Context, articleID int64) ([]string, error) {
+	return z.getMissingTranslations(ctx, fmt.Sprintf("/help_center/articles/%d/translations/missing.json", articleID))
+}
+
+// ListMissingSectionTranslations lists the enabled locales of the Help Center without a translation of a section
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-missing-translations
+func (z *Client) ListMissingSectionTranslations(ctx context.Context, sectionID int64) ([]string, error) {
+	return z.getMissingTranslations(ctx, fmt.Sprintf("/help_center/sections/%d/translations/missing.json", sectionID))
+}
+
+// ListMissingCategoryTranslations lists the enabled locales of the Help Center without a translation of a category
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-missing-translations
+func (z *Client) ListMissingCategoryTranslations(ctx context.Context, categoryID int64) ([]string, error) {
+	return z.getMissingTranslations(ctx,
+		fmt.Sprintf("/help_center/categories/%d/translations/missing.json", categoryID))
+}
+
+// ListOutdatedArticleTranslations lists the translations of an article flagged as outdated
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-translations
+func (z *Client) ListOutdatedArticleTranslations(ctx context.Context, articleID int64) ([]Translation, error) {
+	return z.getTranslations(ctx, fmt.Sprintf("/help_center/articles/%d/translations.json?outdated=true", articleID))
+}
+
+// ListOutdatedSectionTranslations lists the translations of a section flagged as outdated
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-translations
+func (z *Client) ListOutdatedSectionTranslations(ctx context.Context, sectionID int64) ([]Translation, error) {
+	return z.getTranslations(ctx, fmt.Sprintf("/help_center/sections/%d/translations.json?outdated=true", sectionID))
+}
+
+// ListOutdatedCategoryTranslations lists the translations of a category flagged as outdated
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/translations/#list-translations
+func (z *Client) ListOutdatedCategoryTranslations(ctx context.Context, categoryID int64) ([]Translation, error) {
+	return z.getTranslations(ctx,
+		fmt.Sprintf("/help_center/categories/%d/translations.json?outdated=true", categoryID))
+}
+
+// StaleTranslations returns the translations which were updated before the translation in sourceLocale,
+// whether or not they are flagged as outdated. It returns nil when there is no translation in sourceLocale.
+func StaleTranslations(translations []Translation, sourceLocale string) []Translation {
+	var source *Translation
+	for i := range translations {
+		if translations[i].Locale == sourceLocale {
+			source = &translations[i]
+			break
+		}
+	}
+	if source == nil || source.UpdatedAt == nil {
+		return nil
+	}
+
+	var stale []Translation
+	for _, translation := range translations {
+		if translation.Locale == sourceLocale || translation.UpdatedAt == nil {
+			continue
+		}
+		if translation.UpdatedAt.Before(*source.UpdatedAt) {
+			stale = append(stale, translation)
+		}
+	}
+	return stale
+}
+
+func (z *Client) getMissingTranslations(ctx context.Context, path string) ([]string, error) {
+	var result struct {
+		Locales []string `json:"locales"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Locales, nil
+}
+
+func (z *Client) getTranslations(ctx context.Context, path string) ([]Translation, error) {
+	var result struct {
+		Translations []Translation `json:"translations"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Translations, nil
+}
+
+func (z *Client) getTranslation(ctx context.Context, path string) (Translation, error) {
+	var result struct {
+		Translation Translation `json:"translation"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return Translation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Translation{}, err
+	}
+	return result.Translation, nil
+}
+
+// saveTranslation creates the translation with POST or updates it with PUT
+func (z *Client) saveTranslation(
+	ctx context.Context, method string, path string, translation Translation,
+) (Translation, error) {
+	var data, result struct {
+		Translation Translation `json:"translation"`
+	}
+	data.Translation = translation
+
+	var body []byte
+	var err error
+	if method == http.MethodPost {
+		body, err = z.post(ctx, path, data)
+	} else {
+		body, err = z.put(ctx, path, data)
+	}
+	if err != nil {
+		return Translation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Translation{}, err
+	}
+	return result.Translation, nil
+}
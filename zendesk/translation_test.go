@@ -0,0 +1,62 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListMissingArticleTranslations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/37486578/translations/missing.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/missing_translations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	locales, err := client.ListMissingArticleTranslations(ctx, 37486578)
+	if err != nil {
+		t.Fatalf("Failed to list missing translations: %s", err)
+	}
+
+	if len(locales) != 2 || locales[0] != "de" {
+		t.Fatalf("unexpected locales: %v", locales)
+	}
+}
+
+func TestListOutdatedSectionTranslations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/sections/98838/translations.json" || r.URL.Query().Get("outdated") != "true" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/translations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.ListOutdatedSectionTranslations(ctx, 98838); err != nil {
+		t.Fatalf("Failed to list outdated translations: %s", err)
+	}
+}
+
+func TestStaleTranslations(t *testing.T) {
+	now := time.Now()
+	before, after := now.Add(-time.Hour), now.Add(time.Hour)
+	translations := []Translation{
+		{Locale: "fr", UpdatedAt: &before},
+		{Locale: "en-us", UpdatedAt: &now},
+		{Locale: "de", UpdatedAt: &after},
+	}
+
+	stale := StaleTranslations(translations, "en-us")
+	if len(stale) != 1 || stale[0].Locale != "fr" {
+		t.Fatalf("unexpected stale translations: %+v", stale)
+	}
+
+	if stale := StaleTranslations(translations, "ja"); stale != nil {
+		t.Fatalf("expected no stale translations without a source translation, but got %+v", stale)
+	}
+}
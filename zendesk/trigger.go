@@ -54,6 +54,18 @@ type TriggerListOptions struct {
 	SortOrder  string `url:"sort_order,omitempty"`
 }
 
+// TriggerSearchOptions is options for SearchTriggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#search-triggers
+type TriggerSearchOptions struct {
+	PageOptions
+	Query     string `url:"query"`
+	Active    *bool  `url:"active,omitempty"`
+	Include   string `url:"include,omitempty"`
+	SortBy    string `url:"sort_by,omitempty"`
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
 // TriggerAPI an interface containing all trigger related methods
 type TriggerAPI interface {
 	GetTriggers(ctx context.Context, opts *TriggerListOptions) ([]Trigger, Page, error)
@@ -61,6 +73,8 @@ type TriggerAPI interface {
 	GetTrigger(ctx context.Context, id int64) (Trigger, error)
 	UpdateTrigger(ctx context.Context, id int64, trigger Trigger) (Trigger, error)
 	DeleteTrigger(ctx context.Context, id int64) error
+	SearchTriggers(ctx context.Context, opts *TriggerSearchOptions) ([]Trigger, Page, error)
+	GetTriggerDefinitions(ctx context.Context) (RuleDefinitions, error)
 	GetTriggersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Trigger]
 	GetTriggersOBP(ctx context.Context, opts *OBPOptions) ([]Trigger, Page, error)
 	GetTriggersCBP(ctx context.Context, opts *CBPOptions) ([]Trigger, CursorPaginationMeta, error)
@@ -170,3 +184,33 @@ func (z *Client) DeleteTrigger(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// SearchTriggers searches triggers by title
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#search-triggers
+func (z *Client) SearchTriggers(ctx context.Context, opts *TriggerSearchOptions) ([]Trigger, Page, error) {
+	var data struct {
+		Triggers []Trigger `json:"triggers"`
+		Page
+	}
+
+	if opts == nil {
+		return []Trigger{}, Page{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/triggers/search.json", opts)
+	if err != nil {
+		return []Trigger{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return []Trigger{}, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return []Trigger{}, Page{}, err
+	}
+	return data.Triggers, data.Page, nil
+}
@@ -0,0 +1,210 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TriggerCategory is a category used to organize triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#json-format
+type TriggerCategory struct {
+	ID        string     `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	Position  int64      `json:"position,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TriggerCategoryListOptions is options for GetTriggerCategories
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#list-trigger-categories
+type TriggerCategoryListOptions struct {
+	CursorPagination
+
+	// Sort can take "position", "-position", "name", "-name", "created_at",
+	// "-created_at", "updated_at" or "-updated_at"
+	Sort string `url:"sort,omitempty"`
+
+	// Include can take "rule_counts"
+	Include string `url:"include,omitempty"`
+}
+
+// TriggerCategoryPosition is the new position of a trigger category in a batch job
+type TriggerCategoryPosition struct {
+	ID       string `json:"id"`
+	Position int64  `json:"position"`
+}
+
+// TriggerPosition is the new position and category of a trigger in a batch job
+type TriggerPosition struct {
+	ID         string `json:"id"`
+	Position   int64  `json:"position"`
+	CategoryID string `json:"category_id,omitempty"`
+}
+
+// TriggerCategoryBatchJob reorders trigger categories and moves triggers between categories
+// in a single request.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-batch-job-for-trigger-categories
+type TriggerCategoryBatchJob struct {
+	TriggerCategories []TriggerCategoryPosition `json:"trigger_categories,omitempty"`
+	Triggers          []TriggerPosition         `json:"triggers,omitempty"`
+}
+
+// TriggerCategoryBatchJobResult is the result of TriggerCategoryBatchJob
+type TriggerCategoryBatchJobResult struct {
+	TriggerCategories []TriggerCategory `json:"trigger_categories"`
+	Triggers          []Trigger         `json:"triggers"`
+}
+
+// TriggerCategoryAPI an interface containing all trigger category related methods
+type TriggerCategoryAPI interface {
+	GetTriggerCategories(
+		ctx context.Context, opts *TriggerCategoryListOptions) ([]TriggerCategory, CursorPaginationMeta, error)
+	CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error)
+	GetTriggerCategory(ctx context.Context, id string) (TriggerCategory, error)
+	UpdateTriggerCategory(ctx context.Context, id string, category TriggerCategory) (TriggerCategory, error)
+	DeleteTriggerCategory(ctx context.Context, id string) error
+	CreateTriggerCategoryBatchJob(
+		ctx context.Context, job TriggerCategoryBatchJob) (TriggerCategoryBatchJobResult, error)
+}
+
+// GetTriggerCategories fetches trigger category list
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#list-trigger-categories
+func (z *Client) GetTriggerCategories(
+	ctx context.Context, opts *TriggerCategoryListOptions,
+) ([]TriggerCategory, CursorPaginationMeta, error) {
+	var result struct {
+		TriggerCategories []TriggerCategory    `json:"trigger_categories"`
+		Meta              CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TriggerCategoryListOptions{}
+	}
+
+	u, err := addOptions("/trigger_categories.json", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.TriggerCategories, result.Meta, nil
+}
+
+// CreateTriggerCategory creates new trigger category
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-trigger-category
+func (z *Client) CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.post(ctx, "/trigger_categories.json", data)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// GetTriggerCategory returns the specified trigger category
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#show-trigger-category
+func (z *Client) GetTriggerCategory(ctx context.Context, id string) (TriggerCategory, error) {
+	var result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/trigger_categories/%s.json", id))
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// UpdateTriggerCategory updates the specified trigger category and returns the updated one
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#update-trigger-category
+func (z *Client) UpdateTriggerCategory(
+	ctx context.Context, id string, category TriggerCategory,
+) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.patch(ctx, fmt.Sprintf("/trigger_categories/%s.json", id), data)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// DeleteTriggerCategory deletes the specified trigger category.
+// The category must not contain any trigger.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#delete-trigger-category
+func (z *Client) DeleteTriggerCategory(ctx context.Context, id string) error {
+	return z.delete(ctx, fmt.Sprintf("/trigger_categories/%s.json", id), nil)
+}
+
+// CreateTriggerCategoryBatchJob updates the positions of trigger categories and
+// the positions and categories of triggers at once
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/trigger_categories/#create-batch-job-for-trigger-categories
+func (z *Client) CreateTriggerCategoryBatchJob(
+	ctx context.Context, job TriggerCategoryBatchJob,
+) (TriggerCategoryBatchJobResult, error) {
+	var data struct {
+		Job struct {
+			Action string                  `json:"action"`
+			Items  TriggerCategoryBatchJob `json:"items"`
+		} `json:"job"`
+	}
+	data.Job.Action = "patch"
+	data.Job.Items = job
+
+	var result struct {
+		Results TriggerCategoryBatchJobResult `json:"results"`
+	}
+
+	body, err := z.post(ctx, "/trigger_categories/jobs.json", data)
+	if err != nil {
+		return TriggerCategoryBatchJobResult{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategoryBatchJobResult{}, err
+	}
+	return result.Results, nil
+}
@@ -0,0 +1,118 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetTriggerCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, meta, err := client.GetTriggerCategories(ctx, &TriggerCategoryListOptions{Sort: "position"})
+	if err != nil {
+		t.Fatalf("Failed to get trigger categories: %s", err)
+	}
+
+	if len(categories) != 2 {
+		t.Fatalf("expected length of trigger categories is 2, but got %d", len(categories))
+	}
+	if !meta.HasMore {
+		t.Fatal("expected more trigger categories")
+	}
+}
+
+func TestCreateTriggerCategory(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "trigger_category.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.CreateTriggerCategory(ctx, TriggerCategory{Name: "Notifications"})
+	if err != nil {
+		t.Fatalf("Failed to create trigger category: %s", err)
+	}
+
+	if category.ID != "10026" {
+		t.Fatalf("expected trigger category id is 10026, but got %s", category.ID)
+	}
+}
+
+func TestGetTriggerCategory(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_category.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.GetTriggerCategory(ctx, "10026")
+	if err != nil {
+		t.Fatalf("Failed to get trigger category: %s", err)
+	}
+
+	if category.Name != "Notifications" {
+		t.Fatalf("expected trigger category name is Notifications, but got %s", category.Name)
+	}
+}
+
+func TestUpdateTriggerCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		w.Write(readFixture("GET/trigger_category.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTriggerCategory(ctx, "10026", TriggerCategory{Name: "Notifications"})
+	if err != nil {
+		t.Fatalf("Failed to update trigger category: %s", err)
+	}
+}
+
+func TestDeleteTriggerCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteTriggerCategory(ctx, "10026")
+	if err != nil {
+		t.Fatalf("Failed to delete trigger category: %s", err)
+	}
+}
+
+func TestCreateTriggerCategoryBatchJob(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Job struct {
+				Action string                  `json:"action"`
+				Items  TriggerCategoryBatchJob `json:"items"`
+			} `json:"job"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if data.Job.Action != "patch" || len(data.Job.Items.Triggers) != 1 {
+			t.Fatalf("unexpected batch job: %+v", data.Job)
+		}
+		w.Write(readFixture("POST/trigger_category_jobs.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.CreateTriggerCategoryBatchJob(ctx, TriggerCategoryBatchJob{
+		TriggerCategories: []TriggerCategoryPosition{{ID: "10026", Position: 1}},
+		Triggers:          []TriggerPosition{{ID: "360056295714", Position: 0, CategoryID: "10026"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create trigger category batch job: %s", err)
+	}
+
+	if len(result.Triggers) != 1 || result.Triggers[0].CategoryID != "10026" {
+		t.Fatalf("unexpected batch job result: %+v", result)
+	}
+}
@@ -130,3 +130,23 @@ func TestDeleteTriggerFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestSearchTriggers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/triggers/search.json" || r.URL.Query().Get("query") != "notify" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/triggers.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	triggers, _, err := client.SearchTriggers(ctx, &TriggerSearchOptions{Query: "notify"})
+	if err != nil {
+		t.Fatalf("Failed to search triggers: %s", err)
+	}
+
+	if len(triggers) != 8 {
+		t.Fatalf("expected length of triggers is 8, but got %d", len(triggers))
+	}
+}
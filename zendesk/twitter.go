@@ -0,0 +1,142 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MonitoredTwitterHandle is an X (formerly Twitter) account connected to the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#json-format
+type MonitoredTwitterHandle struct {
+	ID            int64     `json:"id"`
+	ScreenName    string    `json:"screen_name"`
+	TwitterUserID int64     `json:"twitter_user_id"`
+	BrandID       int64     `json:"brand_id"`
+	AllowReply    bool      `json:"allow_reply"`
+	CanReply      bool      `json:"can_reply"`
+	AvatarURL     string    `json:"avatar_url,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// TwitterStatus is the status of the tweet of a ticket comment on X
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#list-ticket-statuses
+type TwitterStatus struct {
+	ID           int64 `json:"id"`
+	Favorited    bool  `json:"favorited"`
+	Retweeted    bool  `json:"retweeted"`
+	UserFollowed bool  `json:"user_followed"`
+}
+
+// TwitterAPI an interface containing all X (formerly Twitter) channel related methods
+type TwitterAPI interface {
+	GetMonitoredTwitterHandles(ctx context.Context) ([]MonitoredTwitterHandle, error)
+	GetMonitoredTwitterHandle(ctx context.Context, handleID int64) (MonitoredTwitterHandle, error)
+	CreateTicketFromTweet(ctx context.Context, tweetID int64, handleID int64) (Ticket, error)
+	GetTwitterStatuses(ctx context.Context, ticketID int64, commentIDs []int64) ([]TwitterStatus, error)
+}
+
+// GetMonitoredTwitterHandles lists the X accounts connected to the account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#list-monitored-x-handles
+func (z *Client) GetMonitoredTwitterHandles(ctx context.Context) ([]MonitoredTwitterHandle, error) {
+	var result struct {
+		MonitoredTwitterHandles []MonitoredTwitterHandle `json:"monitored_twitter_handles"`
+	}
+
+	body, err := z.get(ctx, "/channels/twitter/monitored_twitter_handles.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.MonitoredTwitterHandles, nil
+}
+
+// GetMonitoredTwitterHandle gets a connected X account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#show-monitored-x-handle
+func (z *Client) GetMonitoredTwitterHandle(ctx context.Context, handleID int64) (MonitoredTwitterHandle, error) {
+	var result struct {
+		MonitoredTwitterHandle MonitoredTwitterHandle `json:"monitored_twitter_handle"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/channels/twitter/monitored_twitter_handles/%d.json", handleID))
+	if err != nil {
+		return MonitoredTwitterHandle{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MonitoredTwitterHandle{}, err
+	}
+	return result.MonitoredTwitterHandle, nil
+}
+
+// CreateTicketFromTweet creates a ticket from a tweet mentioning or sent to a connected X account
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#create-ticket-from-tweet
+func (z *Client) CreateTicketFromTweet(ctx context.Context, tweetID int64, handleID int64) (Ticket, error) {
+	var data struct {
+		Ticket struct {
+			TwitterStatusMessageID   int64 `json:"twitter_status_message_id"`
+			MonitoredTwitterHandleID int64 `json:"monitored_twitter_handle_id"`
+		} `json:"ticket"`
+	}
+	data.Ticket.TwitterStatusMessageID = tweetID
+	data.Ticket.MonitoredTwitterHandleID = handleID
+
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+
+	body, err := z.post(ctx, "/channels/twitter/tickets.json", data)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return result.Ticket, nil
+}
+
+// GetTwitterStatuses gets the status on X of the comments of a ticket.
+// The statuses of all comments are returned when commentIDs is empty.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/account-configuration/twitter_channel/#list-ticket-statuses
+func (z *Client) GetTwitterStatuses(ctx context.Context, ticketID int64, commentIDs []int64) ([]TwitterStatus, error) {
+	var result struct {
+		Statuses []TwitterStatus `json:"statuses"`
+	}
+
+	u := fmt.Sprintf("/channels/twitter/tickets/%d/statuses.json", ticketID)
+	if len(commentIDs) > 0 {
+		var err error
+		u, err = addOptions(u, struct {
+			CommentIDs string `url:"comment_ids"`
+		}{joinIDs(commentIDs)})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Statuses, nil
+}
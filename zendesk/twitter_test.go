@@ -0,0 +1,69 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetMonitoredTwitterHandles(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "monitored_twitter_handles.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	handles, err := client.GetMonitoredTwitterHandles(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get monitored twitter handles: %s", err)
+	}
+
+	if len(handles) != 2 || handles[0].ScreenName != "@zendeskcare" {
+		t.Fatalf("unexpected monitored twitter handles: %v", handles)
+	}
+}
+
+func TestCreateTicketFromTweet(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/channels/twitter/tickets.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var data struct {
+			Ticket map[string]int64 `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode body: %s", err)
+		}
+		if data.Ticket["twitter_status_message_id"] != 8605426295771136 || data.Ticket["monitored_twitter_handle_id"] != 211 {
+			t.Fatalf("unexpected body: %v", data)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/ticket.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTicketFromTweet(ctx, 8605426295771136, 211)
+	if err != nil {
+		t.Fatalf("Failed to create ticket from tweet: %s", err)
+	}
+}
+
+func TestGetTwitterStatuses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/channels/twitter/tickets/35436/statuses.json" || r.URL.Query().Get("comment_ids") != "1,3" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/twitter_statuses.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetTwitterStatuses(ctx, 35436, []int64{1, 3})
+	if err != nil {
+		t.Fatalf("Failed to get twitter statuses: %s", err)
+	}
+
+	if len(statuses) != 2 || !statuses[1].Retweeted {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+}
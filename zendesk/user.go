@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -16,7 +17,7 @@ type User struct {
 	ID                   int64      `json:"id,omitempty"`
 	URL                  string     `json:"url,omitempty"`
 	Email                string     `json:"email,omitempty"`
-	Name                 string     `json:"name"`
+	Name                 string     `json:"name,omitempty"`
 	Active               bool       `json:"active,omitempty"`
 	Alias                string     `json:"alias,omitempty"`
 	ChatOnly             bool       `json:"chat_only,omitempty"`
@@ -69,14 +70,50 @@ var userRoleText = map[int]string{
 	UserRoleAdmin:   "admin",
 }
 
-// UserListOptions is options for GetUsers
+// UserRole is the name of a role used to filter users, such as AgentRole.
+// It is an alias of string, so that filters built from strings keep working.
+type UserRole = string
+
+const (
+	// EndUserRole end-user
+	EndUserRole UserRole = "end-user"
+	// AgentRole agent
+	AgentRole UserRole = "agent"
+	// AdminRole admin
+	AdminRole UserRole = "admin"
+)
+
+// UserListOptions is options for GetUsers, GetGroupUsers and GetOrganizationUsers
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#list-users
 type UserListOptions struct {
 	PageOptions
-	Role          string   `url:"role,omitempty"`
-	Roles         []string `url:"role[],omitempty"`
-	PermissionSet int64    `url:"permission_set,omitempty"`
+	Role UserRole `url:"role,omitempty"`
+	// Roles filters by several roles, it is ignored when Role is set
+	Roles []UserRole `url:"role[],omitempty"`
+	// PermissionSet is the id of a custom role, it requires Role to be AgentRole
+	PermissionSet int64 `url:"permission_set,omitempty"`
+	// ExternalID lists the users with the external id
+	ExternalID string `url:"external_id,omitempty"`
+}
+
+// ListUsersOptions is options for ListUsers, ListGroupUsers and ListOrganizationUsers
+//
+// Deprecated: use CBPOptions with GetUsersCBP, GetGroupUsersCBP and GetOrganizationUsersCBP.
+type ListUsersOptions struct {
+	CursorPagination
+
+	// Role can take EndUserRole, AgentRole or AdminRole
+	Role UserRole `url:"role,omitempty"`
+
+	// Roles filters by several roles, it is ignored when Role is set
+	Roles []UserRole `url:"role[],omitempty"`
+
+	// PermissionSet is the id of a custom role, it requires Role to be AgentRole
+	PermissionSet int64 `url:"permission_set,omitempty"`
+
+	// ExternalID lists the users with the external id
+	ExternalID string `url:"external_id,omitempty"`
 }
 
 // UserRoleText takes role type and returns role name string
@@ -84,6 +121,9 @@ func UserRoleText(role int) string {
 	return userRoleText[role]
 }
 
+// showManyLimit is the maximum number of ids accepted by show_many endpoints
+const showManyLimit = 100
+
 // GetManyUsersOptions is options for GetManyUsers
 //
 // ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-many-users
@@ -111,23 +151,73 @@ type SearchUsersOptions struct {
 	Query       string `json:"query,omitempty" url:"query,omitempty"`
 }
 
+// UpdateManyUsersOptions selects the users updated by UpdateManyUsers.
+// Either IDs or ExternalIDs is required.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+type UpdateManyUsersOptions struct {
+	IDs         []int64
+	ExternalIDs []string
+}
+
+// DeleteManyUsersOptions selects the users deleted by DeleteManyUsers.
+// Either IDs or ExternalIDs is required.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#bulk-delete-users
+type DeleteManyUsersOptions struct {
+	IDs         []int64
+	ExternalIDs []string
+}
+
+// CurrentUser is the user authenticated by the credential of the client
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-self
+type CurrentUser struct {
+	User
+
+	// AuthenticityToken is the CSRF token of the session, set when authenticated by a browser session
+	AuthenticityToken string `json:"authenticity_token,omitempty"`
+}
+
 // UserAPI an interface containing all user related methods
 type UserAPI interface {
 	SearchUsers(ctx context.Context, opts *SearchUsersOptions) ([]User, Page, error)
+	AutocompleteUsers(ctx context.Context, name string) ([]User, error)
 	GetManyUsers(ctx context.Context, opts *GetManyUsersOptions) ([]User, Page, error)
+	GetManyUsersByIDs(ctx context.Context, userIDs []int64) ([]User, error)
+	GetManyUsersByExternalIDs(ctx context.Context, externalIDs []string) ([]User, error)
 	GetUsers(ctx context.Context, opts *UserListOptions) ([]User, Page, error)
 	GetOrganizationUsers(ctx context.Context, orgID int64, opts *UserListOptions) ([]User, Page, error)
+	GetGroupUsers(ctx context.Context, groupID int64, opts *UserListOptions) ([]User, Page, error)
+	ListUsers(ctx context.Context, opts *ListUsersOptions) ([]User, CursorPaginationMeta, error)
+	ListGroupUsers(ctx context.Context, groupID int64, opts *ListUsersOptions) ([]User, CursorPaginationMeta, error)
+	ListOrganizationUsers(
+		ctx context.Context, orgID int64, opts *ListUsersOptions) ([]User, CursorPaginationMeta, error)
 	GetUser(ctx context.Context, userID int64) (User, error)
+	FindUserByExternalID(ctx context.Context, externalID string) (User, error)
+	GetCurrentUser(ctx context.Context) (CurrentUser, error)
+	GetUsersCount(ctx context.Context) (Count, error)
+	GetOrganizationUsersCount(ctx context.Context, orgID int64) (Count, error)
 	CreateUser(ctx context.Context, user User) (User, error)
 	CreateOrUpdateUser(ctx context.Context, user User) (User, error)
+	CreateOrUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error)
 	UpdateUser(ctx context.Context, userID int64, user User) (User, error)
+	DeleteUser(ctx context.Context, userID int64) (User, error)
+	CreateManyUsers(ctx context.Context, users []User) (JobStatus, error)
+	UpdateManyUsers(ctx context.Context, opts *UpdateManyUsersOptions, user User) (JobStatus, error)
+	BatchUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error)
+	DeleteManyUsers(ctx context.Context, opts *DeleteManyUsersOptions) (JobStatus, error)
 	GetUserRelated(ctx context.Context, userID int64) (UserRelated, error)
+	MergeUsers(ctx context.Context, userID int64, intoUserID int64) (User, error)
 	GetUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
 	GetUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
 	GetUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
 	GetOrganizationUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
 	GetOrganizationUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
 	GetOrganizationUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
+	GetGroupUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
+	GetGroupUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
+	GetGroupUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, CursorPaginationMeta, error)
 }
 
 // GetUsers fetch user list
@@ -160,6 +250,38 @@ func (z *Client) GetUsers(ctx context.Context, opts *UserListOptions) ([]User, P
 	return data.Users, data.Page, nil
 }
 
+// GetGroupUsers fetch the list of the agents of a group
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+// /api/v2/groups/{group_id}/users
+func (z *Client) GetGroupUsers(ctx context.Context, groupID int64, opts *UserListOptions) ([]User, Page, error) {
+	var data struct {
+		Users []User `json:"users"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &UserListOptions{}
+	}
+	apiURL := fmt.Sprintf("/groups/%d/users.json", groupID)
+
+	u, err := addOptions(apiURL, tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Users, data.Page, nil
+}
+
 // GetOrganizationUsers fetch organization users list
 // https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
 // /api/v2/organizations/{organization_id}/users
@@ -224,6 +346,53 @@ func (z *Client) SearchUsers(ctx context.Context, opts *SearchUsersOptions) ([]U
 	return data.Users, data.Page, nil
 }
 
+// FindUserByExternalID gets the user with the external ID.
+// It returns a *NotFoundError when no user has the external ID,
+// and an *AmbiguousMatchError when several users share it.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#search-users
+func (z *Client) FindUserByExternalID(ctx context.Context, externalID string) (User, error) {
+	users, _, err := z.SearchUsers(ctx, &SearchUsersOptions{ExternalIDs: externalID})
+	if err != nil {
+		return User{}, err
+	}
+
+	switch len(users) {
+	case 0:
+		return User{}, &NotFoundError{Resource: "user", Query: externalID}
+	case 1:
+		return users[0], nil
+	default:
+		return User{}, &AmbiguousMatchError{Resource: "user", Query: externalID, Count: len(users)}
+	}
+}
+
+// AutocompleteUsers returns the users whose name starts with name.
+// The name must have at least two characters.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#autocomplete-users
+func (z *Client) AutocompleteUsers(ctx context.Context, name string) ([]User, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+
+	u, err := addOptions("/users/autocomplete.json", struct {
+		Name string `url:"name"`
+	}{name})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Users, nil
+}
+
 // GetManyUsers fetch user list
 // https://developer.zendesk.com/api-reference/ticketing/users/users/#show-many-users
 func (z *Client) GetManyUsers(ctx context.Context, opts *GetManyUsersOptions) ([]User, Page, error) {
@@ -258,6 +427,47 @@ func (z *Client) GetManyUsers(ctx context.Context, opts *GetManyUsersOptions) ([
 
 //TODO: GetUsersByGroupID, GetUsersByOrganizationID
 
+// GetManyUsersByIDs fetches the users with the ids.
+// The ids are split into as many requests as needed by the limit of 100 ids per request.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#show-many-users
+func (z *Client) GetManyUsersByIDs(ctx context.Context, userIDs []int64) ([]User, error) {
+	var users []User
+	for start := 0; start < len(userIDs); start += showManyLimit {
+		end := start + showManyLimit
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+
+		chunk, _, err := z.GetManyUsers(ctx, &GetManyUsersOptions{IDs: joinIDs(userIDs[start:end])})
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, chunk...)
+	}
+	return users, nil
+}
+
+// GetManyUsersByExternalIDs fetches the users with the external ids.
+// The external ids are split into as many requests as needed by the limit of 100 ids per request.
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#show-many-users
+func (z *Client) GetManyUsersByExternalIDs(ctx context.Context, externalIDs []string) ([]User, error) {
+	var users []User
+	for start := 0; start < len(externalIDs); start += showManyLimit {
+		end := start + showManyLimit
+		if end > len(externalIDs) {
+			end = len(externalIDs)
+		}
+
+		opts := &GetManyUsersOptions{ExternalIDs: strings.Join(externalIDs[start:end], ",")}
+		chunk, _, err := z.GetManyUsers(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, chunk...)
+	}
+	return users, nil
+}
+
 // CreateUser creates new user
 // ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-user
 func (z *Client) CreateUser(ctx context.Context, user User) (User, error) {
@@ -278,7 +488,8 @@ func (z *Client) CreateUser(ctx context.Context, user User) (User, error) {
 	return result.User, nil
 }
 
-// CreateOrUpdateUser creates new user or updates a matching user
+// CreateOrUpdateUser creates new user or updates a matching user.
+// The user is matched by its ExternalID first, then by its Email.
 // ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-user
 func (z *Client) CreateOrUpdateUser(ctx context.Context, user User) (User, error) {
 	var data, result struct {
@@ -298,7 +509,76 @@ func (z *Client) CreateOrUpdateUser(ctx context.Context, user User) (User, error
 	return result.User, nil
 }
 
-// TODO: CreateOrUpdateManyUsers(users []User)
+// CreateOrUpdateManyUsers creates or updates up to 100 users in a background job.
+// Users are matched like CreateOrUpdateUser.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-or-update-many-users
+func (z *Client) CreateOrUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+	data.Users = users
+
+	return z.postJobStatus(ctx, "/users/create_or_update_many.json", data)
+}
+
+// ListUsers lists the users of the account with cursor pagination
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+//
+// Deprecated: use GetUsersCBP or GetUsersIterator, which take the same filters in CommonOptions.
+func (z *Client) ListUsers(ctx context.Context, opts *ListUsersOptions) ([]User, CursorPaginationMeta, error) {
+	return z.listUsers(ctx, "/users.json", opts)
+}
+
+// ListGroupUsers lists the agents of the group with cursor pagination
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+//
+// Deprecated: use GetGroupUsersCBP or GetGroupUsersIterator, which take the same filters in CommonOptions.
+func (z *Client) ListGroupUsers(
+	ctx context.Context, groupID int64, opts *ListUsersOptions,
+) ([]User, CursorPaginationMeta, error) {
+	return z.listUsers(ctx, fmt.Sprintf("/groups/%d/users.json", groupID), opts)
+}
+
+// ListOrganizationUsers lists the users of the organization with cursor pagination
+// https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+//
+// Deprecated: use GetOrganizationUsersCBP or GetOrganizationUsersIterator,
+// which take the same filters in CommonOptions.
+func (z *Client) ListOrganizationUsers(
+	ctx context.Context, orgID int64, opts *ListUsersOptions,
+) ([]User, CursorPaginationMeta, error) {
+	return z.listUsers(ctx, fmt.Sprintf("/organizations/%d/users.json", orgID), opts)
+}
+
+func (z *Client) listUsers(
+	ctx context.Context, path string, opts *ListUsersOptions,
+) ([]User, CursorPaginationMeta, error) {
+	var data struct {
+		Users []User               `json:"users"`
+		Meta  CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ListUsersOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return data.Users, data.Meta, nil
+}
 
 // GetUser get an existing user
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#show-user
@@ -319,6 +599,38 @@ func (z *Client) GetUser(ctx context.Context, userID int64) (User, error) {
 	return result.User, nil
 }
 
+// GetCurrentUser returns the user authenticated by the credential of the client,
+// including its role and locale. Use GetCurrentSession to get its session.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-self
+func (z *Client) GetCurrentUser(ctx context.Context) (CurrentUser, error) {
+	var result struct {
+		User CurrentUser `json:"user"`
+	}
+
+	body, err := z.get(ctx, "/users/me.json")
+	if err != nil {
+		return CurrentUser{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CurrentUser{}, err
+	}
+	return result.User, nil
+}
+
+// GetUsersCount returns an approximate count of users in the account
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#count-users
+func (z *Client) GetUsersCount(ctx context.Context) (Count, error) {
+	return z.getCount(ctx, "/users/count.json")
+}
+
+// GetOrganizationUsersCount returns an approximate count of users of the organization
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#count-users
+func (z *Client) GetOrganizationUsersCount(ctx context.Context, orgID int64) (Count, error) {
+	return z.getCount(ctx, fmt.Sprintf("/organizations/%d/users/count.json", orgID))
+}
+
 // UpdateUser update an existing user
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#update-user
 func (z *Client) UpdateUser(ctx context.Context, userID int64, user User) (User, error) {
@@ -339,6 +651,26 @@ func (z *Client) UpdateUser(ctx context.Context, userID int64, user User) (User,
 	return result.User, nil
 }
 
+// DeleteUser deletes the user and returns it. The user can be restored until it is permanently deleted
+// with PermanentlyDeleteUser.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#delete-user
+func (z *Client) DeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.deleteWithResponse(ctx, fmt.Sprintf("/users/%d.json", userID))
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
 // GetUserRelated retrieves user related user information
 // ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#show-user-related-information
 func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated, error) {
@@ -357,3 +689,94 @@ func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated,
 
 	return data.UserRelated, nil
 }
+
+// CreateManyUsers creates up to 100 users in a background job
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#create-many-users
+func (z *Client) CreateManyUsers(ctx context.Context, users []User) (JobStatus, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+	data.Users = users
+
+	return z.postJobStatus(ctx, "/users/create_many.json", data)
+}
+
+// UpdateManyUsers applies the same update to up to 100 users in a background job
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+func (z *Client) UpdateManyUsers(ctx context.Context, opts *UpdateManyUsersOptions, user User) (JobStatus, error) {
+	if opts == nil || (len(opts.IDs) == 0 && len(opts.ExternalIDs) == 0) {
+		return JobStatus{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/users/update_many.json", bulkIDsOptions{
+		IDs:         joinIDs(opts.IDs),
+		ExternalIDs: strings.Join(opts.ExternalIDs, ","),
+	})
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	var data struct {
+		User User `json:"user"`
+	}
+	data.User = user
+
+	return z.putJobStatus(ctx, u, data)
+}
+
+// BatchUpdateManyUsers applies a different update to each of up to 100 users in a background job.
+// Each user must have an ID or an ExternalID.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-many-users
+func (z *Client) BatchUpdateManyUsers(ctx context.Context, users []User) (JobStatus, error) {
+	var data struct {
+		Users []User `json:"users"`
+	}
+	data.Users = users
+
+	return z.putJobStatus(ctx, "/users/update_many.json", data)
+}
+
+// DeleteManyUsers deletes up to 100 users in a background job
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#bulk-delete-users
+func (z *Client) DeleteManyUsers(ctx context.Context, opts *DeleteManyUsersOptions) (JobStatus, error) {
+	if opts == nil || (len(opts.IDs) == 0 && len(opts.ExternalIDs) == 0) {
+		return JobStatus{}, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/users/destroy_many.json", bulkIDsOptions{
+		IDs:         joinIDs(opts.IDs),
+		ExternalIDs: strings.Join(opts.ExternalIDs, ","),
+	})
+	if err != nil {
+		return JobStatus{}, err
+	}
+
+	return z.deleteJobStatus(ctx, u)
+}
+
+// MergeUsers merges the end user into another user and returns the user merged into.
+// The merged user is deleted.
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#merge-end-users
+func (z *Client) MergeUsers(ctx context.Context, userID int64, intoUserID int64) (User, error) {
+	var data struct {
+		User struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+	}
+	data.User.ID = intoUserID
+
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/merge.json", userID), data)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
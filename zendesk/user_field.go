@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -33,6 +34,14 @@ type UserFieldListOptions struct {
 type UserFieldAPI interface {
 	GetUserFields(ctx context.Context, opts *UserFieldListOptions) ([]UserField, Page, error)
 	CreateUserField(ctx context.Context, userField UserField) (UserField, error)
+	GetUserField(ctx context.Context, fieldID int64) (UserField, error)
+	UpdateUserField(ctx context.Context, fieldID int64, userField UserField) (UserField, error)
+	DeleteUserField(ctx context.Context, fieldID int64) error
+	ReorderUserFields(ctx context.Context, fieldIDs []int64) error
+	GetUserFieldOptions(ctx context.Context, fieldID int64) ([]CustomFieldOption, error)
+	GetUserFieldOption(ctx context.Context, fieldID int64, optionID int64) (CustomFieldOption, error)
+	CreateOrUpdateUserFieldOption(ctx context.Context, fieldID int64, option CustomFieldOption) (CustomFieldOption, error)
+	DeleteUserFieldOption(ctx context.Context, fieldID int64, optionID int64) error
 	GetUserFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[UserField]
 	GetUserFieldsOBP(ctx context.Context, opts *OBPOptions) ([]UserField, Page, error)
 	GetUserFieldsCBP(ctx context.Context, opts *CBPOptions) ([]UserField, CursorPaginationMeta, error)
@@ -88,3 +97,87 @@ func (z *Client) CreateUserField(ctx context.Context, userField UserField) (User
 	}
 	return result.UserField, nil
 }
+
+// GetUserField gets a specified user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#show-user-field
+func (z *Client) GetUserField(ctx context.Context, fieldID int64) (UserField, error) {
+	var result struct {
+		UserField UserField `json:"user_field"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/user_fields/%d.json", fieldID))
+	if err != nil {
+		return UserField{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserField{}, err
+	}
+	return result.UserField, nil
+}
+
+// UpdateUserField updates a user field with the specified user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#update-user-field
+func (z *Client) UpdateUserField(ctx context.Context, fieldID int64, userField UserField) (UserField, error) {
+	var data, result struct {
+		UserField UserField `json:"user_field"`
+	}
+	data.UserField = userField
+
+	body, err := z.put(ctx, fmt.Sprintf("/user_fields/%d.json", fieldID), data)
+	if err != nil {
+		return UserField{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserField{}, err
+	}
+	return result.UserField, nil
+}
+
+// DeleteUserField deletes the specified user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#delete-user-field
+func (z *Client) DeleteUserField(ctx context.Context, fieldID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/user_fields/%d.json", fieldID), nil)
+}
+
+// ReorderUserFields sets the position of the user fields to their order in fieldIDs
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#reorder-user-field
+func (z *Client) ReorderUserFields(ctx context.Context, fieldIDs []int64) error {
+	var data struct {
+		UserFieldIDs []int64 `json:"user_field_ids"`
+	}
+	data.UserFieldIDs = fieldIDs
+
+	_, err := z.put(ctx, "/user_fields/reorder.json", data)
+	return err
+}
+
+// GetUserFieldOptions lists the options of a dropdown user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#list-user-field-options
+func (z *Client) GetUserFieldOptions(ctx context.Context, fieldID int64) ([]CustomFieldOption, error) {
+	return z.getCustomFieldOptions(ctx, fmt.Sprintf("/user_fields/%d/options.json", fieldID))
+}
+
+// GetUserFieldOption gets an option of a dropdown user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#show-a-user-field-option
+func (z *Client) GetUserFieldOption(ctx context.Context, fieldID int64, optionID int64) (CustomFieldOption, error) {
+	return z.getCustomFieldOption(ctx, fmt.Sprintf("/user_fields/%d/options", fieldID), optionID)
+}
+
+// CreateOrUpdateUserFieldOption creates an option of a dropdown user field,
+// or updates it when option.ID is set
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#create-or-update-a-user-field-option
+func (z *Client) CreateOrUpdateUserFieldOption(
+	ctx context.Context, fieldID int64, option CustomFieldOption,
+) (CustomFieldOption, error) {
+	return z.createOrUpdateCustomFieldOption(ctx, fmt.Sprintf("/user_fields/%d/options", fieldID), option)
+}
+
+// DeleteUserFieldOption deletes an option of a dropdown user field
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_fields/#delete-user-field-option
+func (z *Client) DeleteUserFieldOption(ctx context.Context, fieldID int64, optionID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/user_fields/%d/options/%d.json", fieldID, optionID), nil)
+}
@@ -55,3 +55,142 @@ func TestUserFieldQueryParamsSet(t *testing.T) {
 		t.Fatalf("Received error calling API: %v", err)
 	}
 }
+
+func TestGetUserField(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "user_field.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	field, err := client.GetUserField(ctx, 7)
+	if err != nil {
+		t.Fatalf("Failed to get user field: %s", err)
+	}
+
+	if field.Key != "custom_field_1" {
+		t.Fatalf("unexpected user field key: %s", field.Key)
+	}
+}
+
+func TestUpdateUserField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/user_fields/7.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("GET/user_field.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateUserField(ctx, 7, UserField{Title: "Custom Field 1"})
+	if err != nil {
+		t.Fatalf("Failed to update user field: %s", err)
+	}
+}
+
+func TestDeleteUserField(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/user_fields/7.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteUserField(ctx, 7)
+	if err != nil {
+		t.Fatalf("Failed to delete user field: %s", err)
+	}
+}
+
+func TestReorderUserFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/user_fields/reorder.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderUserFields(ctx, []int64{8, 7})
+	if err != nil {
+		t.Fatalf("Failed to reorder user fields: %s", err)
+	}
+}
+
+func TestGetUserFieldOptions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user_fields/7/options.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/custom_field_options.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	options, err := client.GetUserFieldOptions(ctx, 7)
+	if err != nil {
+		t.Fatalf("Failed to get user field options: %s", err)
+	}
+
+	if len(options) != 2 {
+		t.Fatalf("expected length of options is 2, but got %d", len(options))
+	}
+}
+
+func TestGetUserFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user_fields/7/options/10001.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/custom_field_option.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.GetUserFieldOption(ctx, 7, 10001)
+	if err != nil {
+		t.Fatalf("Failed to get user field option: %s", err)
+	}
+}
+
+func TestCreateOrUpdateUserFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/user_fields/7/options.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("GET/custom_field_option.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	option, err := client.CreateOrUpdateUserFieldOption(ctx, 7, CustomFieldOption{
+		ID:    10001,
+		Name:  "Bananas",
+		Value: "banana",
+	})
+	if err != nil {
+		t.Fatalf("Failed to update user field option: %s", err)
+	}
+
+	if option.Name != "Bananas" {
+		t.Fatalf("unexpected option name: %s", option.Name)
+	}
+}
+
+func TestDeleteUserFieldOption(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/user_fields/7/options/10001.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteUserFieldOption(ctx, 7, 10001)
+	if err != nil {
+		t.Fatalf("Failed to delete user field option: %s", err)
+	}
+}
@@ -0,0 +1,89 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// User identity types
+const (
+	UserIdentityEmail           = "email"
+	UserIdentityPhone           = "phone_number"
+	UserIdentityTwitter         = "twitter"
+	UserIdentityFacebook        = "facebook"
+	UserIdentityGoogle          = "google"
+	UserIdentityAgentForwarding = "agent_forwarding"
+)
+
+// UserIdentity is a way to identify a user, such as an email address or a phone number
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#json-format
+type UserIdentity struct {
+	ID                 int64      `json:"id,omitempty"`
+	URL                string     `json:"url,omitempty"`
+	UserID             int64      `json:"user_id,omitempty"`
+	Type               string     `json:"type,omitempty"`
+	Value              string     `json:"value,omitempty"`
+	Verified           bool       `json:"verified,omitempty"`
+	Primary            bool       `json:"primary,omitempty"`
+	DeliverableState   string     `json:"deliverable_state,omitempty"`
+	UndeliverableCount int64      `json:"undeliverable_count,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+}
+
+// UserIdentityAPI an interface containing all user identity related methods
+type UserIdentityAPI interface {
+	GetUserIdentities(ctx context.Context, userID int64) ([]UserIdentity, error)
+	UpdateUserIdentity(ctx context.Context, userID int64, identityID int64, identity UserIdentity) (UserIdentity, error)
+	DeleteUserIdentity(ctx context.Context, userID int64, identityID int64) error
+}
+
+// GetUserIdentities lists the identities of the user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#list-identities
+func (z *Client) GetUserIdentities(ctx context.Context, userID int64) ([]UserIdentity, error) {
+	var result struct {
+		Identities []UserIdentity `json:"identities"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/users/%d/identities.json", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Identities, nil
+}
+
+// UpdateUserIdentity updates the value of an identity or marks it as verified
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#update-identity
+func (z *Client) UpdateUserIdentity(
+	ctx context.Context, userID int64, identityID int64, identity UserIdentity,
+) (UserIdentity, error) {
+	var data, result struct {
+		Identity UserIdentity `json:"identity"`
+	}
+	data.Identity = identity
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/identities/%d.json", userID, identityID), data)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserIdentity{}, err
+	}
+	return result.Identity, nil
+}
+
+// DeleteUserIdentity deletes an identity of the user
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/user_identities/#delete-identity
+func (z *Client) DeleteUserIdentity(ctx context.Context, userID int64, identityID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/users/%d/identities/%d.json", userID, identityID), nil)
+}
@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUserIdentities(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/135/identities.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/user_identities.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identities, err := client.GetUserIdentities(ctx, 135)
+	if err != nil {
+		t.Fatalf("Failed to get user identities: %s", err)
+	}
+
+	if len(identities) != 2 {
+		t.Fatalf("expected length of identities is 2, but got %d", len(identities))
+	}
+	if !identities[0].Primary || identities[0].Type != UserIdentityEmail {
+		t.Fatalf("unexpected identity: %+v", identities[0])
+	}
+}
+
+func TestUpdateUserIdentity(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/users/135/identities/77136.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"identity":{"value":"someone@example.com"}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		fmt.Fprint(w, `{"identity":{"id":77136,"user_id":135,"type":"email","value":"someone@example.com"}}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	identity, err := client.UpdateUserIdentity(ctx, 135, 77136, UserIdentity{Value: "someone@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to update user identity: %s", err)
+	}
+
+	if identity.ID != 77136 || identity.CreatedAt != nil {
+		t.Fatalf("unexpected identity: %+v", identity)
+	}
+}
+
+func TestDeleteUserIdentity(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/users/135/identities/77136.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteUserIdentity(ctx, 135, 77136)
+	if err != nil {
+		t.Fatalf("Failed to delete user identity: %s", err)
+	}
+}
@@ -0,0 +1,150 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// UserImage is an image uploaded to the Help Center, which can be used in
+// the content of articles and posts by its Path
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_images/
+type UserImage struct {
+	Path        string `json:"path"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size,omitempty"`
+}
+
+// UserImageUpload is where the content of a user image is sent.
+// The request must have Headers, and Token is used to create the image once the content is sent.
+type UserImageUpload struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	Token   string            `json:"token"`
+}
+
+// UserImageAPI an interface containing all Help Center user image related methods
+type UserImageAPI interface {
+	CreateUserImageUpload(ctx context.Context, contentType string, fileSize int64) (UserImageUpload, error)
+	SendUserImageContent(ctx context.Context, upload UserImageUpload, file io.Reader, fileSize int64) error
+	CreateUserImage(ctx context.Context, token string) (UserImage, error)
+	UploadUserImage(ctx context.Context, contentType string, fileSize int64, file io.Reader) (UserImage, error)
+}
+
+// CreateUserImageUpload gets the URL where the content of a new user image is sent
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_images/#create-image-upload-url
+func (z *Client) CreateUserImageUpload(
+	ctx context.Context, contentType string, fileSize int64,
+) (UserImageUpload, error) {
+	var data struct {
+		ContentType string `json:"content_type"`
+		FileSize    int64  `json:"file_size"`
+	}
+	data.ContentType = contentType
+	data.FileSize = fileSize
+
+	var result struct {
+		Upload UserImageUpload `json:"upload"`
+	}
+
+	body, err := z.post(ctx, "/guide/user_images/uploads", data)
+	if err != nil {
+		return UserImageUpload{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserImageUpload{}, err
+	}
+	return result.Upload, nil
+}
+
+// SendUserImageContent sends the content of a user image to the URL of upload.
+// The content is sent to the storage of the upload, without the credentials of the client.
+// fileSize must be the exact size of the content of file, since the storage rejects chunked uploads.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_images/#upload-the-image
+func (z *Client) SendUserImageContent(ctx context.Context, upload UserImageUpload, file io.Reader, fileSize int64) error {
+	if upload.URL == "" {
+		return errors.New("the user image upload has no url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, upload.URL, file)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = fileSize
+	if fileSize == 0 {
+		req.Body = http.NoBody
+	}
+	for key, value := range upload.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return Error{
+			body: body,
+			resp: resp,
+		}
+	}
+	return nil
+}
+
+// CreateUserImage creates a user image from the token of an upload whose content was sent
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/user_images/#create-user-image-path
+func (z *Client) CreateUserImage(ctx context.Context, token string) (UserImage, error) {
+	var data struct {
+		Token string `json:"token"`
+	}
+	data.Token = token
+
+	var result struct {
+		UserImage UserImage `json:"user_image"`
+	}
+
+	body, err := z.post(ctx, "/guide/user_images", data)
+	if err != nil {
+		return UserImage{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return UserImage{}, err
+	}
+	return result.UserImage, nil
+}
+
+// UploadUserImage runs the whole upload of a user image: it gets an upload URL,
+// sends the content of the image and creates the image.
+// fileSize must be the exact size of the content of file.
+func (z *Client) UploadUserImage(
+	ctx context.Context, contentType string, fileSize int64, file io.Reader,
+) (UserImage, error) {
+	upload, err := z.CreateUserImageUpload(ctx, contentType, fileSize)
+	if err != nil {
+		return UserImage{}, err
+	}
+
+	if err := z.SendUserImageContent(ctx, upload, file, fileSize); err != nil {
+		return UserImage{}, err
+	}
+
+	return z.CreateUserImage(ctx, upload.Token)
+}
@@ -0,0 +1,56 @@
+package zendesk
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUploadUserImage(t *testing.T) {
+	var serverURL string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch r.URL.Path {
+		case "/guide/user_images/uploads":
+			if string(body) != `{"content_type":"image/png","file_size":3}` {
+				t.Fatalf("unexpected body: %s", body)
+			}
+			fmt.Fprintf(w, `{"upload":{"url":"%s/storage","headers":{"Content-Type":"image/png"},"token":"01GDXYD7ZTWYP6DXXYD6B6Y9"}}`,
+				serverURL)
+		case "/storage":
+			if r.Method != http.MethodPut || r.Header.Get("Content-Type") != "image/png" || string(body) != "png" {
+				t.Fatalf("unexpected upload: %s %s %s", r.Method, r.Header.Get("Content-Type"), body)
+			}
+			if r.ContentLength != 3 || len(r.TransferEncoding) != 0 {
+				t.Fatalf("unexpected content length: %d %v", r.ContentLength, r.TransferEncoding)
+			}
+			if r.Header.Get("Authorization") != "" {
+				t.Fatal("the credentials of the client must not be sent to the storage")
+			}
+		case "/guide/user_images":
+			if string(body) != `{"token":"01GDXYD7ZTWYP6DXXYD6B6Y9"}` {
+				t.Fatalf("unexpected body: %s", body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture("POST/user_image.json"))
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	serverURL = mockAPI.URL
+	client := newTestClient(mockAPI)
+	client.SetCredential(NewBasicAuthCredential("john.doe@example.com", "password"))
+	defer mockAPI.Close()
+
+	image, err := client.UploadUserImage(ctx, "image/png", 3, io.MultiReader(strings.NewReader("png")))
+	if err != nil {
+		t.Fatalf("Failed to upload user image: %s", err)
+	}
+
+	if image.Path != "/guide-media/01GDXYD7ZTWYP6DXXYD6B6Y9" {
+		t.Fatalf("unexpected path: %s", image.Path)
+	}
+}
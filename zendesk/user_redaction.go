@@ -0,0 +1,124 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RedactUserPollInterval is the default interval between two checks of the compliance deletion statuses
+// in RedactUser
+const RedactUserPollInterval = 10 * time.Second
+
+// RedactUserOptions is options for RedactUser
+type RedactUserOptions struct {
+	// Name replaces the name of the user. Defaults to "Redacted User".
+	Name string
+
+	// Email replaces the primary email of the user. Defaults to "redacted-{user_id}@example.invalid".
+	Email string
+
+	// PollInterval is the interval between two checks of the compliance deletion statuses.
+	// Defaults to RedactUserPollInterval.
+	PollInterval time.Duration
+}
+
+// RedactUser erases a user for GDPR compliance and waits until the deletion has completed
+// in every Zendesk application. It runs the following steps:
+//
+//  1. deletes the identities of the user and replaces its primary email
+//  2. replaces the name of the user
+//  3. deletes the user
+//  4. permanently deletes the user
+//  5. polls the compliance deletion statuses until every application has completed
+//
+// The returned statuses are the last compliance deletion statuses fetched.
+// As RedactUser can wait for a long time, ctx should have a deadline.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/permanently-deleting-users-in-zendesk/
+func (z *Client) RedactUser(
+	ctx context.Context, userID int64, opts *RedactUserOptions,
+) ([]ComplianceDeletionStatus, error) {
+	tmp := RedactUserOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	if tmp.Name == "" {
+		tmp.Name = "Redacted User"
+	}
+	if tmp.Email == "" {
+		tmp.Email = fmt.Sprintf("redacted-%d@example.invalid", userID)
+	}
+	if tmp.PollInterval <= 0 {
+		tmp.PollInterval = RedactUserPollInterval
+	}
+
+	identities, err := z.GetUserIdentities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		// the primary email can't be deleted so its value is replaced instead
+		if identity.Primary && identity.Type == UserIdentityEmail {
+			_, err = z.UpdateUserIdentity(ctx, userID, identity.ID, UserIdentity{Value: tmp.Email})
+		} else {
+			err = z.DeleteUserIdentity(ctx, userID, identity.ID)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := z.UpdateUser(ctx, userID, User{Name: tmp.Name}); err != nil {
+		return nil, err
+	}
+	if _, err := z.DeleteUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	if _, err := z.PermanentlyDeleteUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	for {
+		statuses, err := z.GetComplianceDeletionStatuses(ctx, userID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if complianceDeletionCompleted(statuses) {
+			return statuses, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return statuses, ctx.Err()
+		case <-time.After(tmp.PollInterval):
+		}
+	}
+}
+
+// complianceDeletionCompleted returns true when every application which started
+// the deletion of the user has completed it
+func complianceDeletionCompleted(statuses []ComplianceDeletionStatus) bool {
+	completed := map[string]bool{}
+	for _, status := range statuses {
+		if status.Application == "all" {
+			continue
+		}
+		if _, ok := completed[status.Application]; !ok {
+			completed[status.Application] = false
+		}
+		if status.Action == "complete" {
+			completed[status.Application] = true
+		}
+	}
+
+	if len(completed) == 0 {
+		return false
+	}
+	for _, ok := range completed {
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
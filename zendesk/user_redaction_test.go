@@ -0,0 +1,102 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRedactUser(t *testing.T) {
+	var steps []string
+	polls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		step := r.Method + " " + r.URL.Path
+		steps = append(steps, step)
+		switch step {
+		case "GET /users/135/identities.json":
+			w.Write(readFixture("GET/user_identities.json"))
+		case "PUT /users/135/identities/35436.json":
+			var data struct {
+				Identity UserIdentity `json:"identity"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Fatalf("Failed to decode request body: %s", err)
+			}
+			if data.Identity.Value != "redacted-135@example.invalid" {
+				t.Fatalf("unexpected email: %s", data.Identity.Value)
+			}
+			fmt.Fprint(w, `{"identity":{"id":35436}}`)
+		case "DELETE /users/135/identities/77136.json":
+			w.WriteHeader(http.StatusNoContent)
+		case "PUT /users/135.json":
+			var data struct {
+				User User `json:"user"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Fatalf("Failed to decode request body: %s", err)
+			}
+			if data.User.Name != "Redacted User" || data.User.Email != "" {
+				t.Fatalf("unexpected user update: %+v", data.User)
+			}
+			w.Write(readFixture("GET/user.json"))
+		case "DELETE /users/135.json":
+			w.Write(readFixture("GET/user.json"))
+		case "DELETE /deleted_users/135.json":
+			w.Write(readFixture("GET/deleted_user.json"))
+		case "GET /users/135/compliance_deletion_statuses.json":
+			polls++
+			if polls == 1 {
+				fmt.Fprint(w, `{"compliance_deletion_statuses":[
+					{"action":"request_deletion","application":"all"},
+					{"action":"started","application":"support"}]}`)
+				return
+			}
+			w.Write(readFixture("GET/compliance_deletion_statuses.json"))
+		default:
+			t.Fatalf("unexpected request: %s", step)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.RedactUser(ctx, 135, &RedactUserOptions{PollInterval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Failed to redact user: %s", err)
+	}
+
+	if len(statuses) != 2 || polls != 2 {
+		t.Fatalf("unexpected statuses after %d polls: %+v", polls, statuses)
+	}
+	if len(steps) != 8 || steps[5] != "DELETE /deleted_users/135.json" {
+		t.Fatalf("unexpected steps: %v", steps)
+	}
+}
+
+func TestComplianceDeletionCompleted(t *testing.T) {
+	cases := []struct {
+		statuses []ComplianceDeletionStatus
+		expected bool
+	}{
+		{nil, false},
+		{[]ComplianceDeletionStatus{{Action: "request_deletion", Application: "all"}}, false},
+		{[]ComplianceDeletionStatus{
+			{Action: "started", Application: "support"},
+			{Action: "complete", Application: "support"},
+			{Action: "started", Application: "chat"},
+		}, false},
+		{[]ComplianceDeletionStatus{
+			{Action: "request_deletion", Application: "all"},
+			{Action: "complete", Application: "support"},
+			{Action: "complete", Application: "chat"},
+		}, true},
+	}
+
+	for i, c := range cases {
+		if got := complianceDeletionCompleted(c.statuses); got != c.expected {
+			t.Fatalf("case %d: expected %v, but got %v", i, c.expected, got)
+		}
+	}
+}
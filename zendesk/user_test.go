@@ -1,9 +1,13 @@
 package zendesk
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -75,6 +79,42 @@ func TestSearchUsers(t *testing.T) {
 	}
 }
 
+func TestSearchUsersByExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/search.json" || r.URL.Query().Get("external_id") != "abc" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/users.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, err := client.SearchUsers(ctx, &SearchUsersOptions{ExternalIDs: "abc"})
+	if err != nil {
+		t.Fatalf("Failed to search users: %s", err)
+	}
+}
+
+func TestAutocompleteUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/autocomplete.json" || r.URL.Query().Get("name") != "sa" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/users.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.AutocompleteUsers(ctx, "sa")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
+
 func TestGetUser(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodGet, "user.json", http.StatusOK)
 	client := newTestClient(mockAPI)
@@ -233,3 +273,450 @@ func TestGetUserRelated(t *testing.T) {
 		t.Fatalf("Returned user does not have the expected assigned tickets %d. It is %d", expectedAssignedTickets, userRelated.AssignedTickets)
 	}
 }
+
+func TestGetUsersCount(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetUsersCount(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get users count: %s", err)
+	}
+
+	if count.Value != 102 {
+		t.Fatalf("expected count is 102, but got %d", count.Value)
+	}
+}
+
+func TestCreateManyUsers(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "job_status.json", http.StatusOK)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.CreateManyUsers(ctx, []User{{Name: "Roger", Email: "roger@example.org"}})
+	if err != nil {
+		t.Fatalf("Failed to create many users: %s", err)
+	}
+
+	if jobStatus.ID != "8b726e606741012ffc2d782bcb7848fe" {
+		t.Fatalf("unexpected job status id: %s", jobStatus.ID)
+	}
+}
+
+func TestUpdateManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/update_many.json" || r.URL.Query().Get("external_ids") != "a,b" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateManyUsers(ctx, &UpdateManyUsersOptions{ExternalIDs: []string{"a", "b"}}, User{
+		OrganizationID: 1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to update many users: %s", err)
+	}
+}
+
+func TestUpdateManyUsersWithoutIDs(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateManyUsers(ctx, &UpdateManyUsersOptions{}, User{})
+	if _, ok := err.(*OptionsError); !ok {
+		t.Fatalf("unexpected error type: %v", err)
+	}
+}
+
+func TestBatchUpdateManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.BatchUpdateManyUsers(ctx, []User{{ID: 1, Name: "Roger"}, {ID: 2, Name: "Woger"}})
+	if err != nil {
+		t.Fatalf("Failed to batch update many users: %s", err)
+	}
+}
+
+func TestDeleteManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.Write(readFixture("PUT/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.DeleteManyUsers(ctx, &DeleteManyUsersOptions{IDs: []int64{1, 2}})
+	if err != nil {
+		t.Fatalf("Failed to delete many users: %s", err)
+	}
+
+	if jobStatus.Status != JobStatusQueued {
+		t.Fatalf("expected job status is queued, but got %s", jobStatus.Status)
+	}
+}
+
+func TestCreateOrUpdateManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/users/create_or_update_many.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture("POST/job_status.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobStatus, err := client.CreateOrUpdateManyUsers(ctx, []User{
+		{Name: "Roger", ExternalID: "account_54"},
+		{Name: "Woger", Email: "woger@example.org"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create or update many users: %s", err)
+	}
+
+	if jobStatus.Total != 2 {
+		t.Fatalf("expected total of job status is 2, but got %d", jobStatus.Total)
+	}
+}
+
+func TestMergeUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/users/12345/merge.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"user":{"id":369531345753}}` {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.Write(readFixture("PUT/user.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.MergeUsers(ctx, 12345, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to merge users: %s", err)
+	}
+
+	if user.ID == 0 {
+		t.Fatal("expected the user merged into")
+	}
+}
+
+func TestGetManyUsersByIDs(t *testing.T) {
+	var requestedIDs []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if len(ids) > 100 {
+			t.Fatalf("expected at most 100 ids per request, but got %d", len(ids))
+		}
+		requestedIDs = append(requestedIDs, ids...)
+		w.Write(readFixture("GET/users.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	users, err := client.GetManyUsersByIDs(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to get many users by ids: %s", err)
+	}
+
+	if len(requestedIDs) != 250 {
+		t.Fatalf("expected 250 requested ids, but got %d", len(requestedIDs))
+	}
+	if len(users) != 6 {
+		t.Fatalf("expected length of users is 6, but got %d", len(users))
+	}
+}
+
+func TestGetManyUsersByExternalIDs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if externalIDs := r.URL.Query().Get("external_ids"); externalIDs != "a,b" {
+			t.Fatalf("unexpected external ids: %s", externalIDs)
+		}
+		w.Write(readFixture("GET/users.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.GetManyUsersByExternalIDs(ctx, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("Failed to get many users by external ids: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
+
+func TestGetCurrentUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/me.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/user_me.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.GetCurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get current user: %s", err)
+	}
+
+	if user.ID != 369531345753 || user.Role != "admin" || user.Locale != "en-US" {
+		t.Fatalf("unexpected current user: %+v", user.User)
+	}
+	if user.AuthenticityToken == "" {
+		t.Fatal("expected authenticity token")
+	}
+}
+
+func TestGetOrganizationUsersCount(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/123/users/count.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Write(readFixture("GET/count.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetOrganizationUsersCount(ctx, 123)
+	if err != nil {
+		t.Fatalf("Failed to get organization users count: %s", err)
+	}
+
+	if count.Value != 102 {
+		t.Fatalf("expected count is 102, but got %d", count.Value)
+	}
+}
+
+func TestFindUserByExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/search.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("external_id") {
+		case "missing":
+			fmt.Fprint(w, `{"users":[],"count":0}`)
+		case "shared":
+			fmt.Fprint(w, `{"users":[{"id":1,"external_id":"shared"},{"id":2,"external_id":"shared"}],"count":2}`)
+		default:
+			fmt.Fprint(w, `{"users":[{"id":1,"external_id":"account_54"}],"count":1}`)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.FindUserByExternalID(ctx, "account_54")
+	if err != nil {
+		t.Fatalf("Failed to find user by external id: %s", err)
+	}
+	if user.ID != 1 {
+		t.Fatalf("unexpected user: %v", user)
+	}
+
+	_, err = client.FindUserByExternalID(ctx, "missing")
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) || notFound.Resource != "user" {
+		t.Fatalf("expected a not found error, but got %v", err)
+	}
+
+	_, err = client.FindUserByExternalID(ctx, "shared")
+	var ambiguous *AmbiguousMatchError
+	if !errors.As(err, &ambiguous) || ambiguous.Count != 2 {
+		t.Fatalf("expected an ambiguous match error, but got %v", err)
+	}
+}
+
+func TestGetUsersCBPRoles(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if roles := query["role[]"]; len(roles) != 2 || roles[0] != "agent" || roles[1] != "admin" {
+			t.Fatalf("unexpected roles: %v", roles)
+		}
+		if query.Get("page[size]") != "50" {
+			t.Fatalf("unexpected page size: %s", query.Get("page[size]"))
+		}
+		fmt.Fprint(w, `{"users":[{"id":1},{"id":2}],"meta":{"has_more":true,"after_cursor":"next"}}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, meta, err := client.GetUsersCBP(ctx, &CBPOptions{
+		CursorPagination: CursorPagination{PageSize: 50},
+		CommonOptions:    CommonOptions{Roles: []UserRole{AgentRole, AdminRole}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get users: %s", err)
+	}
+
+	if len(users) != 2 || !meta.HasMore || meta.AfterCursor != "next" {
+		t.Fatalf("unexpected result: %d users, meta %+v", len(users), meta)
+	}
+}
+
+func TestGetGroupUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/123/users.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("role") != "agent" || r.URL.Query().Get("permission_set") != "7" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/users.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.GetGroupUsers(ctx, 123, &UserListOptions{Role: AgentRole, PermissionSet: 7})
+	if err != nil {
+		t.Fatalf("Failed to get group users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
+
+func TestGetOrganizationUsersWithRole(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/123/users.json" || r.URL.Query().Get("role") != "end-user" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/users.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.GetOrganizationUsers(ctx, 123, &UserListOptions{Role: EndUserRole})
+	if err != nil {
+		t.Fatalf("Failed to get organization users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if roles := query["role[]"]; len(roles) != 2 || roles[0] != "agent" || roles[1] != "admin" {
+			t.Fatalf("unexpected roles: %v", roles)
+		}
+		if query.Get("page[size]") != "50" {
+			t.Fatalf("unexpected page size: %s", query.Get("page[size]"))
+		}
+		fmt.Fprint(w, `{"users":[{"id":1},{"id":2}],"meta":{"has_more":true,"after_cursor":"next"}}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, meta, err := client.ListUsers(ctx, &ListUsersOptions{
+		CursorPagination: CursorPagination{PageSize: 50},
+		Roles:            []string{"agent", "admin"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to list users: %s", err)
+	}
+
+	if len(users) != 2 || !meta.HasMore || meta.AfterCursor != "next" {
+		t.Fatalf("unexpected result: %d users, meta %+v", len(users), meta)
+	}
+}
+
+func TestListGroupUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/123/users.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("role") != "agent" || r.URL.Query().Get("permission_set") != "7" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"users":[{"id":1}],"meta":{"has_more":false}}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.ListGroupUsers(ctx, 123, &ListUsersOptions{Role: "agent", PermissionSet: 7})
+	if err != nil {
+		t.Fatalf("Failed to list group users: %s", err)
+	}
+
+	if len(users) != 1 {
+		t.Fatalf("expected length of users is 1, but got %d", len(users))
+	}
+}
+
+func TestListOrganizationUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/123/users.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"users":[{"id":1},{"id":2},{"id":3}],"meta":{"has_more":false}}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, _, err := client.ListOrganizationUsers(ctx, 123, nil)
+	if err != nil {
+		t.Fatalf("Failed to list organization users: %s", err)
+	}
+
+	if len(users) != 3 {
+		t.Fatalf("expected length of users is 3, but got %d", len(users))
+	}
+}
+
+func TestGetGroupUsersCBP(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/groups/123/users.json" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("role") != "agent" || query.Get("page[size]") != "2" || query.Get("page[after]") != "next" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"users":[{"id":1},{"id":2}],"meta":{"has_more":false}}`)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, meta, err := client.GetGroupUsersCBP(ctx, &CBPOptions{
+		CursorPagination: CursorPagination{PageSize: 2, PageAfter: "next"},
+		CommonOptions:    CommonOptions{Id: 123, Role: AgentRole},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get group users: %s", err)
+	}
+
+	if len(users) != 2 || meta.HasMore {
+		t.Fatalf("unexpected result: %d users, meta %+v", len(users), meta)
+	}
+}
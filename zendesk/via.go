@@ -0,0 +1,114 @@
+package zendesk
+
+import (
+	"encoding/json"
+)
+
+// Via channels
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/via-object-reference/
+const (
+	ViaChannelAPI              = "api"
+	ViaChannelAnyChannel       = "any_channel"
+	ViaChannelChat             = "chat"
+	ViaChannelEmail            = "email"
+	ViaChannelFacebook         = "facebook"
+	ViaChannelHelpCenter       = "help_center"
+	ViaChannelMobile           = "mobile"
+	ViaChannelMobileSDK        = "mobile_sdk"
+	ViaChannelNativeMessaging  = "native_messaging"
+	ViaChannelRule             = "rule"
+	ViaChannelSideConversation = "side_conversation"
+	ViaChannelSystem           = "system"
+	ViaChannelTwitter          = "twitter"
+	ViaChannelVoice            = "voice"
+	ViaChannelWeb              = "web"
+)
+
+// Via is information about source of Ticket or TicketComment
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/via-object-reference/
+type Via struct {
+	Channel string `json:"channel"`
+	Source  struct {
+		From map[string]interface{} `json:"from"`
+		To   map[string]interface{} `json:"to"`
+		Rel  string                 `json:"rel"`
+	} `json:"source"`
+}
+
+// TypedSource decodes Source into a ViaSource.
+// It returns an error if a field of the source does not have the documented type.
+func (v Via) TypedSource() (ViaSource, error) {
+	return newViaSource(v.Source.From, v.Source.To, v.Source.Rel)
+}
+
+// ViaSource describes where the ticket or event came from.
+// Rel gives more detail about the source, such as "trigger", "follow_up", "merge" or "voicemail".
+type ViaSource struct {
+	From ViaSourceEndpoint `json:"from"`
+	To   ViaSourceEndpoint `json:"to"`
+	Rel  string            `json:"rel"`
+}
+
+// ViaSourceEndpoint is the sender or the recipient of a Via source.
+// Only the fields relevant to the channel are set.
+type ViaSourceEndpoint struct {
+	// Email and chat
+	Address            string   `json:"address,omitempty"`
+	Name               string   `json:"name,omitempty"`
+	Email              string   `json:"email,omitempty"`
+	OriginalRecipients []string `json:"original_recipients,omitempty"`
+
+	// Voice
+	Phone          string `json:"phone,omitempty"`
+	FormattedPhone string `json:"formatted_phone,omitempty"`
+	BrandID        int64  `json:"brand_id,omitempty"`
+
+	// Twitter and Facebook
+	ProfileURL string `json:"profile_url,omitempty"`
+	Username   string `json:"username,omitempty"`
+	FacebookID string `json:"facebook_id,omitempty"`
+	TwitterID  string `json:"twitter_id,omitempty"`
+
+	// Rule (trigger and automation)
+	ID         int64  `json:"id,omitempty"`
+	Title      string `json:"title,omitempty"`
+	Deleted    bool   `json:"deleted,omitempty"`
+	RevisionID int64  `json:"revision_id,omitempty"`
+
+	// Follow-up, merge and side conversation
+	TicketID  int64   `json:"ticket_id,omitempty"`
+	TicketIDs []int64 `json:"ticket_ids,omitempty"`
+	Subject   string  `json:"subject,omitempty"`
+	Channel   string  `json:"channel,omitempty"`
+
+	// API and any channel integrations
+	ServiceInfo                      string `json:"service_info,omitempty"`
+	SupportsChannelback              bool   `json:"supports_channelback,omitempty"`
+	SupportsClickthrough             bool   `json:"supports_clickthrough,omitempty"`
+	RegisteredIntegrationServiceName string `json:"registered_integration_service_name,omitempty"`
+}
+
+// newViaSource decodes the loosely typed from and to of a via source into a ViaSource
+func newViaSource(from, to interface{}, rel string) (ViaSource, error) {
+	source := ViaSource{Rel: rel}
+	if err := decodeViaSourceEndpoint(from, &source.From); err != nil {
+		return ViaSource{}, err
+	}
+	if err := decodeViaSourceEndpoint(to, &source.To); err != nil {
+		return ViaSource{}, err
+	}
+	return source, nil
+}
+
+func decodeViaSourceEndpoint(value interface{}, endpoint *ViaSourceEndpoint) error {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, endpoint)
+}
@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestViaUnmarshalJSON(t *testing.T) {
+	cases := map[string]struct {
+		payload string
+		check   func(source ViaSource) bool
+	}{
+		"voice": {
+			payload: `{"channel":"voice","source":{"rel":"voicemail",
+				"from":{"formatted_phone":"+1 (555) 010-0000","phone":"+15550100000","name":"Caller"},
+				"to":{"formatted_phone":"+1 (555) 010-0001","phone":"+15550100001","name":"Support","brand_id":360000001}}}`,
+			check: func(source ViaSource) bool {
+				return source.From.Phone == "+15550100000" && source.To.BrandID == 360000001
+			},
+		},
+		"twitter": {
+			payload: `{"channel":"twitter","source":{"rel":"direct_message",
+				"from":{"profile_url":"https://twitter.com/zendesk","username":"zendesk","name":"Zendesk"},
+				"to":{"profile_url":"https://twitter.com/support","username":"support","name":"Support"}}}`,
+			check: func(source ViaSource) bool {
+				return source.From.Username == "zendesk" && source.Rel == "direct_message"
+			},
+		},
+		"rule": {
+			payload: `{"channel":"rule","source":{"rel":"trigger","to":{},
+				"from":{"id":35079792,"title":"Assign to first responder","deleted":false,"revision_id":1}}}`,
+			check: func(source ViaSource) bool {
+				return source.From.ID == 35079792 && source.From.RevisionID == 1
+			},
+		},
+		"side conversation": {
+			payload: `{"channel":"side_conversation","source":{"rel":"side_conversation",
+				"from":{"ticket_id":35436,"subject":"Escalation"},"to":{}}}`,
+			check: func(source ViaSource) bool {
+				return source.From.TicketID == 35436
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var via Via
+			if err := json.Unmarshal([]byte(c.payload), &via); err != nil {
+				t.Fatalf("Failed to unmarshal via: %s", err)
+			}
+			source, err := via.TypedSource()
+			if err != nil {
+				t.Fatalf("Failed to decode via source: %s", err)
+			}
+			if !c.check(source) {
+				t.Fatalf("unexpected via: %+v", via)
+			}
+		})
+	}
+}
+
+func TestViaTypedSourceUnexpectedType(t *testing.T) {
+	var via Via
+	payload := `{"channel":"api","source":{"rel":null,"from":{"id":"abc","name":"Integration"},"to":{}}}`
+	if err := json.Unmarshal([]byte(payload), &via); err != nil {
+		t.Fatalf("Failed to unmarshal via: %s", err)
+	}
+
+	if _, err := via.TypedSource(); err == nil {
+		t.Fatal("expected an error for the string id")
+	}
+}
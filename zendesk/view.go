@@ -12,19 +12,84 @@ type (
 	// View is struct for group membership payload
 	// https://developer.zendesk.com/api-reference/ticketing/business-rules/views/
 	View struct {
-		ID          int64     `json:"id,omitempty"`
-		Active      bool      `json:"active"`
-		Description string    `json:"description"`
-		Position    int64     `json:"position"`
-		Title       string    `json:"title"`
-		CreatedAt   time.Time `json:"created_at,omitempty"`
-		UpdatedAt   time.Time `json:"updated_at,omitempty"`
+		ID          int64            `json:"id,omitempty"`
+		URL         string           `json:"url,omitempty"`
+		Active      *bool            `json:"active,omitempty"`
+		Description string           `json:"description,omitempty"`
+		Position    int64            `json:"position,omitempty"`
+		Title       string           `json:"title"`
+		RawTitle    string           `json:"raw_title,omitempty"`
+		Default     bool             `json:"default,omitempty"`
+		Watchable   bool             `json:"watchable,omitempty"`
+		Conditions  *ViewConditions  `json:"conditions,omitempty"`
+		Execution   *ViewExecution   `json:"execution,omitempty"`
+		Restriction *ViewRestriction `json:"restriction,omitempty"`
+		CreatedAt   *time.Time       `json:"created_at,omitempty"`
+		UpdatedAt   *time.Time       `json:"updated_at,omitempty"`
 
-		// Conditions Conditions
-		// Execution Execution
-		// Restriction Restriction
+		// All, Any and Output are write only.
+		// They are used instead of Conditions and Execution to create or update a view.
+		All    []ViewCondition `json:"all,omitempty"`
+		Any    []ViewCondition `json:"any,omitempty"`
+		Output *ViewOutput     `json:"output,omitempty"`
 	}
 
+	// ViewCondition is a condition of the tickets shown in a view
+	//
+	// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/views-reference/
+	ViewCondition struct {
+		Field    string      `json:"field"`
+		Operator string      `json:"operator"`
+		Value    interface{} `json:"value"`
+	}
+
+	// ViewConditions are the conditions of a view. Tickets must meet all conditions of All
+	// and at least one condition of Any.
+	ViewConditions struct {
+		All []ViewCondition `json:"all"`
+		Any []ViewCondition `json:"any"`
+	}
+
+	// ViewExecution describes how a view displays its tickets
+	ViewExecution struct {
+		GroupBy      string              `json:"group_by,omitempty"`
+		GroupOrder   string              `json:"group_order,omitempty"`
+		SortBy       string              `json:"sort_by,omitempty"`
+		SortOrder    string              `json:"sort_order,omitempty"`
+		Group        *ViewExecutionOrder `json:"group,omitempty"`
+		Sort         *ViewExecutionOrder `json:"sort,omitempty"`
+		Columns      []ViewColumn        `json:"columns,omitempty"`
+		Fields       []ViewColumn        `json:"fields,omitempty"`
+		CustomFields []ViewColumn        `json:"custom_fields,omitempty"`
+	}
+
+	// ViewExecutionOrder is the column used to group or sort the tickets of a view
+	ViewExecutionOrder struct {
+		ID    interface{} `json:"id"`
+		Title string      `json:"title"`
+		Order string      `json:"order"`
+	}
+
+	// ViewOutput is the display settings used to create or update a view.
+	// Columns can contain system column names and custom field IDs.
+	ViewOutput struct {
+		Columns    []interface{} `json:"columns,omitempty"`
+		GroupBy    string        `json:"group_by,omitempty"`
+		GroupOrder string        `json:"group_order,omitempty"`
+		SortBy     string        `json:"sort_by,omitempty"`
+		SortOrder  string        `json:"sort_order,omitempty"`
+	}
+
+	// ViewRestriction restricts the access of a view to a user or groups.
+	// Type can take "User" or "Group".
+	ViewRestriction struct {
+		Type string  `json:"type"`
+		ID   int64   `json:"id,omitempty"`
+		IDs  []int64 `json:"ids,omitempty"`
+	}
+
+	// ViewCount is the count of tickets in a view.
+	// Fresh is false while Zendesk is still computing a cached count.
 	ViewCount struct {
 		ViewID int64  `json:"view_id"`
 		URL    string `json:"url"`
@@ -33,12 +98,64 @@ type (
 		Fresh  bool   `json:"fresh"`
 	}
 
+	// ViewExport is the status of a view export job
+	ViewExport struct {
+		ViewID int64  `json:"view_id"`
+		Status string `json:"status"`
+	}
+
+	// ViewColumn is a column of a view execution.
+	// ID is a string for system columns and a number for custom fields.
+	ViewColumn struct {
+		ID    interface{} `json:"id"`
+		Title string      `json:"title"`
+	}
+
+	// ViewRow is a row of a view execution.
+	// Values holds the value of each column keyed by column ID.
+	ViewRow struct {
+		TicketID int64                  `json:"ticket_id"`
+		Ticket   *Ticket                `json:"ticket,omitempty"`
+		Values   map[string]interface{} `json:"-"`
+	}
+
+	// ExecuteViewOptions is options for ExecuteView
+	//
+	// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#execute-view
+	ExecuteViewOptions struct {
+		CursorPagination
+
+		// SortBy can take any column of the view
+		SortBy string `url:"sort_by,omitempty"`
+
+		// SortOrder can take "asc" or "desc"
+		SortOrder string `url:"sort_order,omitempty"`
+	}
+
+	// ExecuteViewResult contains the rows of a view execution and cursor pagination metadata.
+	ExecuteViewResult struct {
+		View    View                 `json:"view"`
+		Rows    []ViewRow            `json:"rows"`
+		Columns []ViewColumn         `json:"columns"`
+		Groups  []interface{}        `json:"groups"`
+		Meta    CursorPaginationMeta `json:"meta"`
+	}
+
 	// ViewAPI encapsulates methods on view
 	ViewAPI interface {
 		GetView(context.Context, int64) (View, error)
 		GetViews(context.Context) ([]View, Page, error)
+		GetActiveViews(ctx context.Context) ([]View, Page, error)
+		GetCompactViews(ctx context.Context) ([]View, Page, error)
+		CreateView(ctx context.Context, view View) (View, error)
+		UpdateView(ctx context.Context, viewID int64, view View) (View, error)
+		DeleteView(ctx context.Context, viewID int64) error
 		GetTicketsFromView(context.Context, int64, *TicketListOptions) ([]Ticket, Page, error)
 		GetCountTicketsInViews(ctx context.Context, ids []string) ([]ViewCount, error)
+		GetViewCount(ctx context.Context, viewID int64) (ViewCount, error)
+		ExecuteView(ctx context.Context, viewID int64, opts *ExecuteViewOptions) (*ExecuteViewResult, error)
+		ExportView(ctx context.Context, viewID int64) (ViewExport, error)
+		GetViewDefinitions(ctx context.Context) (RuleDefinitions, error)
 		GetTicketsFromViewIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
 		GetTicketsFromViewOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
 		GetTicketsFromViewCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, CursorPaginationMeta, error)
@@ -48,16 +165,49 @@ type (
 	}
 )
 
+// UnmarshalJSON is a custom unmarshal function because columns of a view row
+// are keyed by column ID.
+func (r *ViewRow) UnmarshalJSON(data []byte) error {
+	type alias ViewRow
+	var tmp alias
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	*r = ViewRow(tmp)
+
+	if err := json.Unmarshal(data, &r.Values); err != nil {
+		return err
+	}
+	delete(r.Values, "ticket_id")
+	delete(r.Values, "ticket")
+	return nil
+}
+
 // GetViews gets all views
 // ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-views
 func (z *Client) GetViews(ctx context.Context) ([]View, Page, error) {
+	return z.getViews(ctx, "/views.json")
+}
+
+// GetActiveViews gets active shared and personal views available to the current user
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-active-views
+func (z *Client) GetActiveViews(ctx context.Context) ([]View, Page, error) {
+	return z.getViews(ctx, "/views/active.json")
+}
+
+// GetCompactViews gets a compacted list of shared and personal views available to the current user
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-views---compact
+func (z *Client) GetCompactViews(ctx context.Context) ([]View, Page, error) {
+	return z.getViews(ctx, "/views/compact.json")
+}
+
+func (z *Client) getViews(ctx context.Context, path string) ([]View, Page, error) {
 	var result struct {
 		Views []View `json:"views"`
 		Page
 	}
 
-	body, err := z.get(ctx, "/views.json")
-
+	body, err := z.get(ctx, path)
 	if err != nil {
 		return []View{}, Page{}, err
 	}
@@ -89,6 +239,52 @@ func (z *Client) GetView(ctx context.Context, viewID int64) (View, error) {
 	return result.View, nil
 }
 
+// CreateView creates a new view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#create-view
+func (z *Client) CreateView(ctx context.Context, view View) (View, error) {
+	var data, result struct {
+		View View `json:"view"`
+	}
+	data.View = view
+
+	body, err := z.post(ctx, "/views.json", data)
+	if err != nil {
+		return View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return View{}, err
+	}
+
+	return result.View, nil
+}
+
+// UpdateView updates the specified view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#update-view
+func (z *Client) UpdateView(ctx context.Context, viewID int64, view View) (View, error) {
+	var data, result struct {
+		View View `json:"view"`
+	}
+	data.View = view
+
+	body, err := z.put(ctx, fmt.Sprintf("/views/%d.json", viewID), data)
+	if err != nil {
+		return View{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return View{}, err
+	}
+
+	return result.View, nil
+}
+
+// DeleteView deletes the specified view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#delete-view
+func (z *Client) DeleteView(ctx context.Context, viewID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/views/%d.json", viewID), nil)
+}
+
 // GetTicketsFromView gets the tickets of the specified view
 // ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#list-tickets-from-a-view
 func (z *Client) GetTicketsFromView(ctx context.Context, viewID int64, opts *TicketListOptions,
@@ -139,3 +335,64 @@ func (z *Client) GetCountTicketsInViews(ctx context.Context, ids []string) ([]Vi
 	}
 	return result.ViewCounts, nil
 }
+
+// GetViewCount returns the count of tickets in the specified view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#count-tickets-in-view
+func (z *Client) GetViewCount(ctx context.Context, viewID int64) (ViewCount, error) {
+	var result struct {
+		ViewCount ViewCount `json:"view_count"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/views/%d/count.json", viewID))
+	if err != nil {
+		return ViewCount{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewCount{}, err
+	}
+	return result.ViewCount, nil
+}
+
+// ExecuteView returns the column values of the tickets matching the conditions of the specified view
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#execute-view
+func (z *Client) ExecuteView(ctx context.Context, viewID int64, opts *ExecuteViewOptions) (*ExecuteViewResult, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &ExecuteViewOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/views/%d/execute.json", viewID), tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExecuteViewResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ExportView starts a CSV export of the specified view. The export is sent by email.
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/views/#export-view
+func (z *Client) ExportView(ctx context.Context, viewID int64) (ViewExport, error) {
+	var result struct {
+		Export ViewExport `json:"export"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/views/%d/export.json", viewID))
+	if err != nil {
+		return ViewExport{}, err
+	}
+
+	if err := json.Unmarshal(body, &result); err != nil {
+		return ViewExport{}, err
+	}
+	return result.Export, nil
+}
@@ -1,7 +1,9 @@
 package zendesk
 
 import (
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -50,3 +52,185 @@ func TestGetCountTicketsInViewsTestGetViews(t *testing.T) {
 		t.Fatalf("expected length of views ticket counts is 2, but got %d", len(viewsCount))
 	}
 }
+
+func TestGetViewCount(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_count.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.GetViewCount(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view count: %s", err)
+	}
+
+	if count.Value != 719 || !count.Fresh {
+		t.Fatalf("unexpected view count: %+v", count)
+	}
+}
+
+func TestExecuteView(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_execute.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	result, err := client.ExecuteView(ctx, 360002440594, &ExecuteViewOptions{
+		CursorPagination: CursorPagination{PageSize: 2},
+		SortBy:           "updated",
+		SortOrder:        "desc",
+	})
+	if err != nil {
+		t.Fatalf("Failed to execute view: %s", err)
+	}
+
+	if len(result.Rows) != 2 {
+		t.Fatalf("expected length of rows is 2, but got %d", len(result.Rows))
+	}
+	if len(result.Columns) != 3 {
+		t.Fatalf("expected length of columns is 3, but got %d", len(result.Columns))
+	}
+
+	row := result.Rows[0]
+	if row.TicketID != 2 || row.Ticket == nil || row.Ticket.Status != "open" {
+		t.Fatalf("unexpected row: %+v", row)
+	}
+	if row.Values["360005619134"] != "gold" {
+		t.Fatalf("unexpected custom field column value: %v", row.Values["360005619134"])
+	}
+	if _, ok := row.Values["ticket"]; ok {
+		t.Fatal("ticket should not be included in the column values")
+	}
+}
+
+func TestExportView(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view_export.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	export, err := client.ExportView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to export view: %s", err)
+	}
+
+	if export.ViewID != 360002440594 || export.Status != "starting" {
+		t.Fatalf("unexpected view export: %+v", export)
+	}
+}
+
+func TestGetViewConditions(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "view.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.GetView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view: %s", err)
+	}
+
+	if view.Conditions == nil || len(view.Conditions.All) != 2 {
+		t.Fatalf("unexpected view conditions: %+v", view.Conditions)
+	}
+	if view.Conditions.All[1].Field != "assignee_id" || view.Conditions.All[1].Value != "current_user" {
+		t.Fatalf("unexpected view condition: %+v", view.Conditions.All[1])
+	}
+	if view.Execution == nil || view.Execution.GroupBy != "status" || len(view.Execution.Columns) != 5 {
+		t.Fatalf("unexpected view execution: %+v", view.Execution)
+	}
+	if view.Restriction != nil {
+		t.Fatalf("expected no restriction, but got %+v", view.Restriction)
+	}
+}
+
+func TestGetActiveViews(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "views.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.GetActiveViews(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get active views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestGetCompactViews(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "views.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.GetCompactViews(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get compact views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestCreateView(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "view.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.CreateView(ctx, View{
+		Title: "Wonderful tickets",
+		All: []ViewCondition{
+			{Field: "status", Operator: "less_than", Value: "solved"},
+			{Field: "assignee_id", Operator: "is", Value: "current_user"},
+		},
+		Output: &ViewOutput{
+			Columns: []interface{}{"subject", "requester", 360005619134},
+			GroupBy: "status",
+		},
+		Restriction: &ViewRestriction{Type: "Group", IDs: []int64{360004077472}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create view: %s", err)
+	}
+
+	if view.ID != 360002440594 {
+		t.Fatalf("Returned view does not have the expected ID. View ID is %d", view.ID)
+	}
+}
+
+func TestUpdateView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPut || r.URL.Path != "/views/360002440594.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		// the fields which are not set must not be sent, so that they are not reset
+		if string(body) != `{"view":{"title":"Updated wonderful tickets"}}` {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.Write(readFixture("PUT/view.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.UpdateView(ctx, 360002440594, View{Title: "Updated wonderful tickets"})
+	if err != nil {
+		t.Fatalf("Failed to update view: %s", err)
+	}
+
+	if view.Title != "Updated wonderful tickets" {
+		t.Fatalf("Returned view does not have the expected title. View title is %s", view.Title)
+	}
+}
+
+func TestDeleteView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+	defer mockAPI.Close()
+
+	c := newTestClient(mockAPI)
+	err := c.DeleteView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to delete view: %s", err)
+	}
+}
@@ -0,0 +1,207 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Directions of a vote
+const (
+	VoteUp   = "up"
+	VoteDown = "down"
+)
+
+// Vote is the vote of a user on a Help Center article, a community post or a comment
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#json-format
+type Vote struct {
+	ID     int64  `json:"id"`
+	URL    string `json:"url,omitempty"`
+	UserID int64  `json:"user_id"`
+	// Value is 1 for an up vote and -1 for a down vote
+	Value     int64     `json:"value"`
+	ItemID    int64     `json:"item_id"`
+	ItemType  string    `json:"item_type"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VoteListOptions is options for listing votes
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#list-votes
+type VoteListOptions struct {
+	CursorPagination
+}
+
+// VoteAPI an interface containing all Help Center vote related methods
+type VoteAPI interface {
+	ListArticleVotes(ctx context.Context, articleID int64, opts *VoteListOptions) ([]Vote, CursorPaginationMeta, error)
+	ListArticleCommentVotes(
+		ctx context.Context, articleID, commentID int64, opts *VoteListOptions) ([]Vote, CursorPaginationMeta, error)
+	ListPostVotes(ctx context.Context, postID int64, opts *VoteListOptions) ([]Vote, CursorPaginationMeta, error)
+	ListPostCommentVotes(
+		ctx context.Context, postID, commentID int64, opts *VoteListOptions) ([]Vote, CursorPaginationMeta, error)
+	ListUserVotes(ctx context.Context, userID int64, opts *VoteListOptions) ([]Vote, CursorPaginationMeta, error)
+	GetVote(ctx context.Context, voteID int64) (Vote, error)
+	CreateArticleVote(ctx context.Context, articleID int64, direction string) (Vote, error)
+	CreateArticleCommentVote(ctx context.Context, articleID, commentID int64, direction string) (Vote, error)
+	CreatePostVote(ctx context.Context, postID int64, direction string) (Vote, error)
+	CreatePostCommentVote(ctx context.Context, postID, commentID int64, direction string) (Vote, error)
+	DeleteVote(ctx context.Context, voteID int64) error
+}
+
+// ListArticleVotes lists the votes on an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#list-votes
+func (z *Client) ListArticleVotes(
+	ctx context.Context, articleID int64, opts *VoteListOptions,
+) ([]Vote, CursorPaginationMeta, error) {
+	return z.listVotes(ctx, fmt.Sprintf("/help_center/articles/%d/votes.json", articleID), opts)
+}
+
+// ListArticleCommentVotes lists the votes on a comment of an article
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#list-votes
+func (z *Client) ListArticleCommentVotes(
+	ctx context.Context, articleID, commentID int64, opts *VoteListOptions,
+) ([]Vote, CursorPaginationMeta, error) {
+	return z.listVotes(ctx, fmt.Sprintf("/help_center/articles/%d/comments/%d/votes.json", articleID, commentID), opts)
+}
+
+// ListPostVotes lists the votes on a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#list-votes
+func (z *Client) ListPostVotes(
+	ctx context.Context, postID int64, opts *VoteListOptions,
+) ([]Vote, CursorPaginationMeta, error) {
+	return z.listVotes(ctx, fmt.Sprintf("/help_center/community/posts/%d/votes.json", postID), opts)
+}
+
+// ListPostCommentVotes lists the votes on a comment of a community post
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#list-votes
+func (z *Client) ListPostCommentVotes(
+	ctx context.Context, postID, commentID int64, opts *VoteListOptions,
+) ([]Vote, CursorPaginationMeta, error) {
+	return z.listVotes(ctx,
+		fmt.Sprintf("/help_center/community/posts/%d/comments/%d/votes.json", postID, commentID), opts)
+}
+
+// ListUserVotes lists the votes of a user
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#list-votes
+func (z *Client) ListUserVotes(
+	ctx context.Context, userID int64, opts *VoteListOptions,
+) ([]Vote, CursorPaginationMeta, error) {
+	return z.listVotes(ctx, fmt.Sprintf("/help_center/users/%d/votes.json", userID), opts)
+}
+
+func (z *Client) listVotes(
+	ctx context.Context, path string, opts *VoteListOptions,
+) ([]Vote, CursorPaginationMeta, error) {
+	var result struct {
+		Votes []Vote               `json:"votes"`
+		Meta  CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &VoteListOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Votes, result.Meta, nil
+}
+
+// GetVote gets a vote
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#show-vote
+func (z *Client) GetVote(ctx context.Context, voteID int64) (Vote, error) {
+	var result struct {
+		Vote Vote `json:"vote"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/help_center/votes/%d.json", voteID))
+	if err != nil {
+		return Vote{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Vote{}, err
+	}
+	return result.Vote, nil
+}
+
+// CreateArticleVote votes on an article. direction is VoteUp or VoteDown.
+// The previous vote of the user on the article is replaced.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#create-vote
+func (z *Client) CreateArticleVote(ctx context.Context, articleID int64, direction string) (Vote, error) {
+	return z.createVote(ctx, fmt.Sprintf("/help_center/articles/%d/%s.json", articleID, direction))
+}
+
+// CreateArticleCommentVote votes on a comment of an article. direction is VoteUp or VoteDown.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#create-vote
+func (z *Client) CreateArticleCommentVote(
+	ctx context.Context, articleID, commentID int64, direction string,
+) (Vote, error) {
+	return z.createVote(ctx, fmt.Sprintf("/help_center/articles/%d/comments/%d/%s.json", articleID, commentID, direction))
+}
+
+// CreatePostVote votes on a community post. direction is VoteUp or VoteDown.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#create-vote
+func (z *Client) CreatePostVote(ctx context.Context, postID int64, direction string) (Vote, error) {
+	return z.createVote(ctx, fmt.Sprintf("/help_center/community/posts/%d/%s.json", postID, direction))
+}
+
+// CreatePostCommentVote votes on a comment of a community post. direction is VoteUp or VoteDown.
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#create-vote
+func (z *Client) CreatePostCommentVote(
+	ctx context.Context, postID, commentID int64, direction string,
+) (Vote, error) {
+	return z.createVote(ctx,
+		fmt.Sprintf("/help_center/community/posts/%d/comments/%d/%s.json", postID, commentID, direction))
+}
+
+func (z *Client) createVote(ctx context.Context, path string) (Vote, error) {
+	var result struct {
+		Vote Vote `json:"vote"`
+	}
+
+	body, err := z.post(ctx, path, nil)
+	if err != nil {
+		return Vote{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Vote{}, err
+	}
+	return result.Vote, nil
+}
+
+// DeleteVote deletes a vote
+//
+// ref: https://developer.zendesk.com/api-reference/help_center/help-center-api/votes/#delete-vote
+func (z *Client) DeleteVote(ctx context.Context, voteID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/help_center/votes/%d.json", voteID), nil)
+}
@@ -0,0 +1,120 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListVotes(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write(readFixture("GET/votes.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	votes, _, err := client.ListArticleVotes(ctx, 37486578, nil)
+	if err != nil {
+		t.Fatalf("Failed to list article votes: %s", err)
+	}
+	if len(votes) != 2 || votes[1].Value != -1 {
+		t.Fatalf("unexpected votes: %+v", votes)
+	}
+
+	if _, _, err := client.ListArticleCommentVotes(ctx, 37486578, 1635, nil); err != nil {
+		t.Fatalf("Failed to list article comment votes: %s", err)
+	}
+	if _, _, err := client.ListPostVotes(ctx, 900, nil); err != nil {
+		t.Fatalf("Failed to list post votes: %s", err)
+	}
+	if _, _, err := client.ListPostCommentVotes(ctx, 900, 12, nil); err != nil {
+		t.Fatalf("Failed to list post comment votes: %s", err)
+	}
+	if _, _, err := client.ListUserVotes(ctx, 888887, nil); err != nil {
+		t.Fatalf("Failed to list user votes: %s", err)
+	}
+
+	expected := []string{
+		"/help_center/articles/37486578/votes.json",
+		"/help_center/articles/37486578/comments/1635/votes.json",
+		"/help_center/community/posts/900/votes.json",
+		"/help_center/community/posts/900/comments/12/votes.json",
+		"/help_center/users/888887/votes.json",
+	}
+	for i, path := range expected {
+		if paths[i] != path {
+			t.Fatalf("expected path is %s, but got %s", path, paths[i])
+		}
+	}
+}
+
+func TestGetVote(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "vote.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	vote, err := client.GetVote(ctx, 35467)
+	if err != nil {
+		t.Fatalf("Failed to get vote: %s", err)
+	}
+
+	if vote.ItemType != "Article" {
+		t.Fatalf("expected item type is Article, but got %s", vote.ItemType)
+	}
+}
+
+func TestCreateVotes(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/vote.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.CreateArticleVote(ctx, 37486578, VoteUp); err != nil {
+		t.Fatalf("Failed to create article vote: %s", err)
+	}
+	if _, err := client.CreateArticleCommentVote(ctx, 37486578, 1635, VoteDown); err != nil {
+		t.Fatalf("Failed to create article comment vote: %s", err)
+	}
+	if _, err := client.CreatePostVote(ctx, 900, VoteUp); err != nil {
+		t.Fatalf("Failed to create post vote: %s", err)
+	}
+	if _, err := client.CreatePostCommentVote(ctx, 900, 12, VoteDown); err != nil {
+		t.Fatalf("Failed to create post comment vote: %s", err)
+	}
+
+	expected := []string{
+		"/help_center/articles/37486578/up.json",
+		"/help_center/articles/37486578/comments/1635/down.json",
+		"/help_center/community/posts/900/up.json",
+		"/help_center/community/posts/900/comments/12/down.json",
+	}
+	for i, path := range expected {
+		if paths[i] != path {
+			t.Fatalf("expected path is %s, but got %s", path, paths[i])
+		}
+	}
+}
+
+func TestDeleteVote(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/votes/35467.json" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteVote(ctx, 35467); err != nil {
+		t.Fatalf("Failed to delete vote: %s", err)
+	}
+}
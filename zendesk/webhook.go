@@ -2,6 +2,9 @@ package zendesk
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -13,37 +16,171 @@ type Webhook struct {
 	Authentication *WebhookAuthentication `json:"authentication,omitempty"`
 	CreatedAt      time.Time              `json:"created_at,omitempty"`
 	CreatedBy      string                 `json:"created_by,omitempty"`
+	CustomHeaders  map[string]string      `json:"custom_headers,omitempty"`
 	Description    string                 `json:"description,omitempty"`
-	Endpoint       string                 `json:"endpoint"`
+	Endpoint       string                 `json:"endpoint,omitempty"`
 	ExternalSource interface{}            `json:"external_source,omitempty"`
-	HTTPMethod     string                 `json:"http_method"`
+	HTTPMethod     string                 `json:"http_method,omitempty"`
 	ID             string                 `json:"id,omitempty"`
-	Name           string                 `json:"name"`
-	RequestFormat  string                 `json:"request_format"`
+	Name           string                 `json:"name,omitempty"`
+	RequestFormat  string                 `json:"request_format,omitempty"`
 	SigningSecret  *WebhookSigningSecret  `json:"signing_secret,omitempty"`
-	Status         string                 `json:"status"`
+	Status         string                 `json:"status,omitempty"`
 	Subscriptions  []string               `json:"subscriptions,omitempty"`
 	UpdatedAt      time.Time              `json:"updated_at,omitempty"`
 	UpdatedBy      string                 `json:"updated_by,omitempty"`
 }
 
+// Webhook authentication types
+const (
+	WebhookAuthenticationAPIKey      = "api_key"
+	WebhookAuthenticationBasicAuth   = "basic_auth"
+	WebhookAuthenticationBearerToken = "bearer_token"
+)
+
+// WebhookAuthentication is the authentication of the requests sent by a webhook.
+// Data is a WebhookAPIKey, a WebhookBasicAuth or a WebhookBearerToken depending on Type.
+// Zendesk never returns Data.
 type WebhookAuthentication struct {
 	Type        string      `json:"type"`
 	Data        interface{} `json:"data"`
 	AddPosition string      `json:"add_position"`
 }
 
+// WebhookAPIKey is the data of the api_key authentication.
+// Name is the name of the header the key is sent in.
+type WebhookAPIKey struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// WebhookBasicAuth is the data of the basic_auth authentication
+type WebhookBasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// WebhookBearerToken is the data of the bearer_token authentication
+type WebhookBearerToken struct {
+	Token string `json:"token"`
+}
+
+// NewWebhookAPIKeyAuthentication returns an authentication sending value in the header name
+func NewWebhookAPIKeyAuthentication(name, value string) *WebhookAuthentication {
+	return &WebhookAuthentication{
+		Type:        WebhookAuthenticationAPIKey,
+		Data:        WebhookAPIKey{Name: name, Value: value},
+		AddPosition: "header",
+	}
+}
+
+// NewWebhookBasicAuthentication returns a basic authentication
+func NewWebhookBasicAuthentication(username, password string) *WebhookAuthentication {
+	return &WebhookAuthentication{
+		Type:        WebhookAuthenticationBasicAuth,
+		Data:        WebhookBasicAuth{Username: username, Password: password},
+		AddPosition: "header",
+	}
+}
+
+// NewWebhookBearerTokenAuthentication returns a bearer token authentication
+func NewWebhookBearerTokenAuthentication(token string) *WebhookAuthentication {
+	return &WebhookAuthentication{
+		Type:        WebhookAuthenticationBearerToken,
+		Data:        WebhookBearerToken{Token: token},
+		AddPosition: "header",
+	}
+}
+
+// Headers of the requests sent by webhooks, used to verify the requests with VerifyWebhookSignature
+const (
+	WebhookSignatureHeader          = "X-Zendesk-Webhook-Signature"
+	WebhookSignatureTimestampHeader = "X-Zendesk-Webhook-Signature-Timestamp"
+)
+
 type WebhookSigningSecret struct {
 	Algorithm string `json:"algorithm"`
 	Secret    string `json:"secret"`
 }
 
+// WebhookListOptions is options for ListWebhooks
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhooks
+type WebhookListOptions struct {
+	CursorPagination
+	NameContains string `url:"filter[name_contains],omitempty"`
+	// Status can take "active" or "inactive"
+	Status string `url:"filter[status],omitempty"`
+	// Sort can take "name", "status", "-name" or "-status"
+	Sort string `url:"sort,omitempty"`
+}
+
+// WebhookTestHeader is a header of the request or the response of a webhook test
+type WebhookTestHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// WebhookTestRequest is the request sent by TestWebhook.
+// Webhook is used as the configuration of the test when it is set. Otherwise
+// WebhookID must be the id of an existing webhook.
+type WebhookTestRequest struct {
+	WebhookID string              `json:"-"`
+	Webhook   *Webhook            `json:"webhook,omitempty"`
+	Headers   []WebhookTestHeader `json:"-"`
+	Payload   string              `json:"-"`
+}
+
+// WebhookTestResponse is the response the endpoint of a webhook returned to TestWebhook
+type WebhookTestResponse struct {
+	Headers []WebhookTestHeader `json:"headers"`
+	Body    string              `json:"body"`
+	Status  int                 `json:"status"`
+}
+
 type WebhookAPI interface {
+	ListWebhooks(ctx context.Context, opts *WebhookListOptions) ([]Webhook, CursorPaginationMeta, error)
 	CreateWebhook(ctx context.Context, hook *Webhook) (*Webhook, error)
+	CloneWebhook(ctx context.Context, webhookID string) (*Webhook, error)
 	GetWebhook(ctx context.Context, webhookID string) (*Webhook, error)
 	UpdateWebhook(ctx context.Context, webhookID string, hook *Webhook) error
 	DeleteWebhook(ctx context.Context, webhookID string) error
+	TestWebhook(ctx context.Context, req WebhookTestRequest) (WebhookTestResponse, error)
 	GetWebhookSigningSecret(ctx context.Context, webhookID string) (*WebhookSigningSecret, error)
+	ResetWebhookSigningSecret(ctx context.Context, webhookID string) (*WebhookSigningSecret, error)
+}
+
+// ListWebhooks lists the webhooks of the account.
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#list-webhooks
+func (z *Client) ListWebhooks(
+	ctx context.Context, opts *WebhookListOptions,
+) ([]Webhook, CursorPaginationMeta, error) {
+	var result struct {
+		Webhooks []Webhook            `json:"webhooks"`
+		Meta     CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &WebhookListOptions{}
+	}
+
+	u, err := addOptions("/webhooks", tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Webhooks, result.Meta, nil
 }
 
 // CreateWebhook creates new webhook.
@@ -67,6 +204,34 @@ func (z *Client) CreateWebhook(ctx context.Context, hook *Webhook) (*Webhook, er
 	return result.Webhook, nil
 }
 
+// CloneWebhook creates a copy of the specified webhook.
+// The authentication of the webhook is copied, the signing secret is not.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#create-or-clone-webhook
+func (z *Client) CloneWebhook(ctx context.Context, webhookID string) (*Webhook, error) {
+	var result struct {
+		Webhook *Webhook `json:"webhook"`
+	}
+
+	u, err := addOptions("/webhooks", struct {
+		CloneWebhookID string `url:"clone_webhook_id"`
+	}{webhookID})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.post(ctx, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Webhook, nil
+}
+
 // GetWebhook gets a specified webhook.
 //
 // https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#show-webhook
@@ -88,16 +253,45 @@ func (z *Client) GetWebhook(ctx context.Context, webhookID string) (*Webhook, er
 	return result.Webhook, nil
 }
 
+// webhookPatch is the writable fields of a webhook sent by UpdateWebhook.
+// The read only fields, such as the timestamps, are left out so that they are not sent.
+type webhookPatch struct {
+	Authentication *WebhookAuthentication `json:"authentication,omitempty"`
+	CustomHeaders  map[string]string      `json:"custom_headers,omitempty"`
+	Description    string                 `json:"description,omitempty"`
+	Endpoint       string                 `json:"endpoint,omitempty"`
+	ExternalSource interface{}            `json:"external_source,omitempty"`
+	HTTPMethod     string                 `json:"http_method,omitempty"`
+	Name           string                 `json:"name,omitempty"`
+	RequestFormat  string                 `json:"request_format,omitempty"`
+	Status         string                 `json:"status,omitempty"`
+	Subscriptions  []string               `json:"subscriptions,omitempty"`
+}
+
 // UpdateWebhook updates a webhook with the specified webhook.
+// Only the writable fields which are set in hook are sent, as a JSON merge patch.
 //
-// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#update-webhook
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#patch-webhook
 func (z *Client) UpdateWebhook(ctx context.Context, webhookID string, hook *Webhook) error {
 	var data struct {
-		Webhook *Webhook `json:"webhook"`
+		Webhook webhookPatch `json:"webhook"`
+	}
+	if hook != nil {
+		data.Webhook = webhookPatch{
+			Authentication: hook.Authentication,
+			CustomHeaders:  hook.CustomHeaders,
+			Description:    hook.Description,
+			Endpoint:       hook.Endpoint,
+			ExternalSource: hook.ExternalSource,
+			HTTPMethod:     hook.HTTPMethod,
+			Name:           hook.Name,
+			RequestFormat:  hook.RequestFormat,
+			Status:         hook.Status,
+			Subscriptions:  hook.Subscriptions,
+		}
 	}
-	data.Webhook = hook
 
-	_, err := z.put(ctx, fmt.Sprintf("/webhooks/%s", webhookID), data)
+	_, err := z.mergePatch(ctx, fmt.Sprintf("/webhooks/%s", webhookID), data)
 	if err != nil {
 		return err
 	}
@@ -117,6 +311,50 @@ func (z *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
 	return nil
 }
 
+// TestWebhook sends a test request to the endpoint of a webhook and returns the response of the endpoint
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#test-webhook
+func (z *Client) TestWebhook(ctx context.Context, req WebhookTestRequest) (WebhookTestResponse, error) {
+	type testRequest struct {
+		Headers []WebhookTestHeader `json:"headers,omitempty"`
+		Payload string              `json:"payload,omitempty"`
+	}
+	var data struct {
+		Webhook *Webhook     `json:"webhook,omitempty"`
+		Request *testRequest `json:"request,omitempty"`
+	}
+	data.Webhook = req.Webhook
+	if len(req.Headers) > 0 || req.Payload != "" {
+		data.Request = &testRequest{Headers: req.Headers, Payload: req.Payload}
+	}
+
+	var result struct {
+		Response WebhookTestResponse `json:"response"`
+	}
+
+	u := "/webhooks/test"
+	if req.WebhookID != "" {
+		var err error
+		u, err = addOptions(u, struct {
+			WebhookID string `url:"webhook_id"`
+		}{req.WebhookID})
+		if err != nil {
+			return WebhookTestResponse{}, err
+		}
+	}
+
+	body, err := z.post(ctx, u, data)
+	if err != nil {
+		return WebhookTestResponse{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return WebhookTestResponse{}, err
+	}
+	return result.Response, nil
+}
+
 // GetWebhookSigningSecret gets the signing secret of specified webhook.
 //
 // https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#show-webhook-signing-secret
@@ -137,3 +375,43 @@ func (z *Client) GetWebhookSigningSecret(ctx context.Context, webhookID string)
 
 	return result.SigningSecret, nil
 }
+
+// ResetWebhookSigningSecret replaces the signing secret of specified webhook and returns the new one.
+//
+// https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/#reset-webhook-signing-secret
+func (z *Client) ResetWebhookSigningSecret(ctx context.Context, webhookID string) (*WebhookSigningSecret, error) {
+	var result struct {
+		SigningSecret *WebhookSigningSecret `json:"signing_secret"`
+	}
+
+	body, err := z.post(ctx, fmt.Sprintf("/webhooks/%s/signing_secret", webhookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.SigningSecret, nil
+}
+
+// VerifyWebhookSignature reports whether signature is the signature of a request sent by a webhook.
+// signature and timestamp are the values of the WebhookSignatureHeader and WebhookSignatureTimestampHeader
+// headers, body is the raw body of the request and secret is the signing secret of the webhook.
+//
+// The signature is the base64 encoded HMAC-SHA256 of the timestamp followed by the body.
+//
+// https://developer.zendesk.com/documentation/webhooks/verifying/
+func VerifyWebhookSignature(signature, timestamp string, body []byte, secret string) bool {
+	expected, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,117 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Webhook invocation statuses
+const (
+	WebhookInvocationSuccess          = "success"
+	WebhookInvocationFailed           = "failed"
+	WebhookInvocationCircuitBroken    = "circuit_broken"
+	WebhookInvocationThrottled        = "throttled"
+	WebhookInvocationInProgress       = "in_progress"
+	WebhookInvocationNotYetCompleted  = "not_yet_completed"
+	WebhookInvocationRetriesExhausted = "retries_exhausted"
+)
+
+// WebhookInvocation is a delivery of an event to the endpoint of a webhook.
+// An invocation can be made of several attempts when the endpoint fails.
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/
+type WebhookInvocation struct {
+	ID                string    `json:"id"`
+	Status            string    `json:"status"`
+	LatestCompletedAt time.Time `json:"latest_completed_at"`
+}
+
+// WebhookInvocationAttempt is an attempt to deliver an invocation to the endpoint of a webhook
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocation-attempts/
+type WebhookInvocationAttempt struct {
+	ID           string    `json:"id"`
+	InvocationID string    `json:"invocation_id"`
+	Status       string    `json:"status"`
+	StatusCode   int       `json:"status_code"`
+	CompletedAt  time.Time `json:"completed_at"`
+	// Duration is the latency of the endpoint in milliseconds
+	Duration int64 `json:"duration"`
+}
+
+// WebhookInvocationListOptions is options for ListWebhookInvocations
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/#list-webhook-invocations
+type WebhookInvocationListOptions struct {
+	CursorPagination
+	FromTs time.Time `url:"filter[from_ts],omitempty"`
+	ToTs   time.Time `url:"filter[to_ts],omitempty"`
+	Status string    `url:"filter[status],omitempty"`
+	// Sort can take "latest_completed_at" or "-latest_completed_at"
+	Sort string `url:"sort,omitempty"`
+}
+
+// WebhookInvocationAPI an interface containing all webhook invocation related methods
+type WebhookInvocationAPI interface {
+	ListWebhookInvocations(
+		ctx context.Context, webhookID string, opts *WebhookInvocationListOptions,
+	) ([]WebhookInvocation, CursorPaginationMeta, error)
+	ListInvocationAttempts(ctx context.Context, webhookID, invocationID string) ([]WebhookInvocationAttempt, error)
+}
+
+// ListWebhookInvocations lists the invocations of a webhook in the last 7 days
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocations/#list-webhook-invocations
+func (z *Client) ListWebhookInvocations(
+	ctx context.Context, webhookID string, opts *WebhookInvocationListOptions,
+) ([]WebhookInvocation, CursorPaginationMeta, error) {
+	var result struct {
+		Invocations []WebhookInvocation  `json:"invocations"`
+		Meta        CursorPaginationMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &WebhookInvocationListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/webhooks/%s/invocations", webhookID), tmp)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, CursorPaginationMeta{}, err
+	}
+	return result.Invocations, result.Meta, nil
+}
+
+// ListInvocationAttempts lists the attempts of an invocation of a webhook
+//
+// ref: https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhook-invocation-attempts/#list-webhook-invocation-attempts
+func (z *Client) ListInvocationAttempts(
+	ctx context.Context, webhookID, invocationID string,
+) ([]WebhookInvocationAttempt, error) {
+	var result struct {
+		Attempts []WebhookInvocationAttempt `json:"attempts"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/webhooks/%s/invocations/%s/attempts", webhookID, invocationID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Attempts, nil
+}
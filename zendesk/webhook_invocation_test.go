@@ -0,0 +1,50 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListWebhookInvocations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/01EJFTSCC78X5V07NPY2MHR00M/invocations" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("filter[status]") != WebhookInvocationFailed || query.Get("filter[from_ts]") != "2021-03-16T00:00:00Z" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture("GET/webhook_invocations.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	invocations, _, err := client.ListWebhookInvocations(ctx, "01EJFTSCC78X5V07NPY2MHR00M", &WebhookInvocationListOptions{
+		FromTs: time.Date(2021, 3, 16, 0, 0, 0, 0, time.UTC),
+		Status: WebhookInvocationFailed,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list webhook invocations: %s", err)
+	}
+
+	if len(invocations) != 2 {
+		t.Fatalf("expected length of invocations is 2, but got %d", len(invocations))
+	}
+}
+
+func TestListInvocationAttempts(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "webhook_invocation_attempts.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attempts, err := client.ListInvocationAttempts(ctx, "01EJFTSCC78X5V07NPY2MHR00M", "01F1NH0YZ6KXG9XBB2B1ZTCTB5")
+	if err != nil {
+		t.Fatalf("Failed to list invocation attempts: %s", err)
+	}
+
+	if len(attempts) != 2 || attempts[0].StatusCode != 503 || attempts[0].Duration != 5012 {
+		t.Fatalf("unexpected attempts: %+v", attempts)
+	}
+}
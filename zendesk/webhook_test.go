@@ -2,9 +2,11 @@ package zendesk
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestCreateWebhook(t *testing.T) {
@@ -80,3 +82,142 @@ func TestDeleteWebhook(t *testing.T) {
 		t.Fatalf("Failed to delete webhook: %s", err)
 	}
 }
+
+func TestListWebhooks(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks" || r.URL.Query().Get("filter[status]") != "active" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write(readFixture("GET/webhooks.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	hooks, _, err := client.ListWebhooks(ctx, &WebhookListOptions{Status: "active"})
+	if err != nil {
+		t.Fatalf("Failed to list webhooks: %s", err)
+	}
+
+	if len(hooks) != 2 {
+		t.Fatalf("expected length of webhooks is 2, but got %d", len(hooks))
+	}
+	if hooks[0].CustomHeaders["x-source"] != "zendesk" {
+		t.Fatalf("unexpected custom headers: %v", hooks[0].CustomHeaders)
+	}
+}
+
+func TestCloneWebhook(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Query().Get("clone_webhook_id") != "01EJFTSCC78X5V07NPY2MHR00M" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture("POST/webhooks.json"))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	hook, err := client.CloneWebhook(ctx, "01EJFTSCC78X5V07NPY2MHR00M")
+	if err != nil {
+		t.Fatalf("Failed to clone webhook: %s", err)
+	}
+
+	if hook.ID == "" {
+		t.Fatalf("Invalid response of webhook: %v", hook)
+	}
+}
+
+func TestUpdateWebhookSendsPatch(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/webhooks/01EJFTSCC78X5V07NPY2MHR00M" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+			t.Fatalf("unexpected content type: %s", r.Header.Get("Content-Type"))
+		}
+		body, _ := io.ReadAll(r.Body)
+		expected := `{"webhook":{"authentication":{"type":"bearer_token","data":{"token":"token"},"add_position":"header"},` +
+			`"status":"inactive"}}`
+		if string(body) != expected {
+			t.Fatalf("unexpected body: %s", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.UpdateWebhook(ctx, "01EJFTSCC78X5V07NPY2MHR00M", &Webhook{
+		ID:             "01EJFTSCC78X5V07NPY2MHR00M",
+		Authentication: NewWebhookBearerTokenAuthentication("token"),
+		Status:         "inactive",
+		CreatedAt:      time.Date(2020, 10, 20, 8, 16, 28, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Failed to update webhook: %s", err)
+	}
+}
+
+func TestTestWebhook(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/webhooks/test" || r.URL.Query().Get("webhook_id") != "01EJFTSCC78X5V07NPY2MHR00M" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		w.Write([]byte(`{"response":{"headers":[{"key":"Content-Type","value":"application/json"}],"body":"{}","status":200}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	resp, err := client.TestWebhook(ctx, WebhookTestRequest{
+		WebhookID: "01EJFTSCC78X5V07NPY2MHR00M",
+		Payload:   `{"ticket_id":35436}`,
+	})
+	if err != nil {
+		t.Fatalf("Failed to test webhook: %s", err)
+	}
+
+	if resp.Status != http.StatusOK || len(resp.Headers) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestResetWebhookSigningSecret(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/webhooks/01EJFTSCC78X5V07NPY2MHR00M/signing_secret" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"signing_secret":{"algorithm":"SHA256","secret":"new-secret"}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	secret, err := client.ResetWebhookSigningSecret(ctx, "01EJFTSCC78X5V07NPY2MHR00M")
+	if err != nil {
+		t.Fatalf("Failed to reset webhook signing secret: %s", err)
+	}
+
+	if secret.Secret != "new-secret" {
+		t.Fatalf("unexpected signing secret: %v", secret)
+	}
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	secret := "dGhpc19zZWNyZXRfaXNfZm9yX3Rlc3Rpbmdfb25seQ=="
+	timestamp := "2021-03-16T22:58:03Z"
+	body := []byte(`{"ticket_id":35436}`)
+	// base64(HMAC-SHA256(secret, timestamp + body)), computed independently of this package
+	signature := "Nm+dtZbmq8c1I5UnLX3s/l42K8X/nsIGlEbcnoK2M8Y="
+
+	if !VerifyWebhookSignature(signature, timestamp, body, secret) {
+		t.Fatal("expected signature to be valid")
+	}
+	if VerifyWebhookSignature(signature, "2021-03-16T22:58:04Z", body, secret) {
+		t.Fatal("expected signature with another timestamp to be invalid")
+	}
+	if VerifyWebhookSignature(signature, timestamp, []byte(`{"ticket_id":1}`), secret) {
+		t.Fatal("expected signature with another body to be invalid")
+	}
+	if VerifyWebhookSignature("not base64", timestamp, body, secret) {
+		t.Fatal("expected malformed signature to be invalid")
+	}
+}
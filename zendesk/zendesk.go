@@ -1,14 +1,17 @@
 package zendesk
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/google/go-querystring/query"
@@ -32,6 +35,12 @@ type (
 		httpClient *http.Client
 		credential Credential
 		headers    map[string]string
+
+		// chatURL, chatRealTimeURL and chatCredential are used by the Chat APIs,
+		// which are not served under the subdomain
+		chatURL         *url.URL
+		chatRealTimeURL *url.URL
+		chatCredential  Credential
 	}
 
 	// BaseAPI encapsulates base methods for zendesk client
@@ -175,7 +184,8 @@ func (z *Client) post(ctx context.Context, path string, data interface{}) ([]byt
 		return nil, err
 	}
 
-	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated) {
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated ||
+		resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusNoContent) {
 		return nil, Error{
 			body: body,
 			resp: resp,
@@ -210,8 +220,10 @@ func (z *Client) put(ctx context.Context, path string, data interface{}) ([]byte
 		return nil, err
 	}
 
-	// NOTE: some webhook mutation APIs return status No Content.
-	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent) {
+	// NOTE: some webhook mutation APIs return status No Content,
+	// and upserts return status Created when they create the resource.
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent ||
+		resp.StatusCode == http.StatusCreated) {
 		return nil, Error{
 			body: body,
 			resp: resp,
@@ -223,6 +235,18 @@ func (z *Client) put(ctx context.Context, path string, data interface{}) ([]byte
 
 // patch sends data to API and returns response body as []bytes
 func (z *Client) patch(ctx context.Context, path string, data interface{}) ([]byte, error) {
+	return z.sendPatch(ctx, path, "", data)
+}
+
+// mergePatch sends data to API as a JSON merge patch and returns response body as []bytes
+//
+// ref: https://www.rfc-editor.org/rfc/rfc7396
+func (z *Client) mergePatch(ctx context.Context, path string, data interface{}) ([]byte, error) {
+	return z.sendPatch(ctx, path, "application/merge-patch+json", data)
+}
+
+// sendPatch sends data with the content type, or the default one when it is empty
+func (z *Client) sendPatch(ctx context.Context, path, contentType string, data interface{}) ([]byte, error) {
 	bytes, err := json.Marshal(data)
 	if err != nil {
 		return nil, err
@@ -234,6 +258,9 @@ func (z *Client) patch(ctx context.Context, path string, data interface{}) ([]by
 	}
 
 	req = z.prepareRequest(ctx, req)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
 
 	resp, err := z.httpClient.Do(req)
 	if err != nil {
@@ -246,8 +273,10 @@ func (z *Client) patch(ctx context.Context, path string, data interface{}) ([]by
 		return nil, err
 	}
 
-	// NOTE: some webhook mutation APIs return status No Content.
-	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent) {
+	// NOTE: some webhook mutation APIs return status No Content,
+	// and upserts return status Created when they create the resource.
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent ||
+		resp.StatusCode == http.StatusCreated) {
 		return nil, Error{
 			body: body,
 			resp: resp,
@@ -296,6 +325,114 @@ func (z *Client) delete(ctx context.Context, path string, data interface{}) erro
 	return nil
 }
 
+// deleteWithResponse deletes resources and returns the response body,
+// for bulk endpoints which respond with the status of a background job
+func (z *Client) deleteWithResponse(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodDelete, z.baseURL.String()+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = z.prepareRequest(ctx, req)
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted) {
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	return body, nil
+}
+
+// uploadFile sends a file as multipart form data and returns response body
+func (z *Client) uploadFile(
+	ctx context.Context, method, path, fieldName, filename string, file io.Reader,
+) ([]byte, error) {
+	return z.uploadFileWithFields(ctx, method, path, nil, fieldName, filename, file)
+}
+
+// uploadFileWithFields sends a file with other form fields as multipart form data and returns response body
+func (z *Client) uploadFileWithFields(
+	ctx context.Context, method, path string, fields map[string]string, fieldName, filename string, file io.Reader,
+) ([]byte, error) {
+	buf, contentType, err := newMultipartBody(fields, fieldName, filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, z.baseURL.String()+path, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req = z.prepareRequest(ctx, req)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := z.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated) {
+		return nil, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	return body, nil
+}
+
+// newMultipartBody writes the sorted form fields and then the file as multipart form data,
+// and returns the body with its content type
+func newMultipartBody(
+	fields map[string]string, fieldName, filename string, file io.Reader,
+) (*bytes.Buffer, string, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := mw.WriteField(key, fields[key]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	part, err := mw.CreateFormFile(fieldName, filename)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, "", err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, "", err
+	}
+	return &buf, mw.FormDataContentType(), nil
+}
+
 // prepare request sets common request variables such as authn and user agent
 func (z *Client) prepareRequest(ctx context.Context, req *http.Request) *http.Request {
 	out := req.WithContext(ctx)
@@ -50,6 +50,8 @@ func newTestClient(mockAPI *httptest.Server) *Client {
 		credential: NewAPITokenCredential("", ""),
 	}
 	c.SetEndpointURL(mockAPI.URL)
+	c.SetChatEndpointURL(mockAPI.URL)
+	c.SetChatRealTimeEndpointURL(mockAPI.URL)
 	return c
 }
 
@@ -224,6 +226,19 @@ func TestPost(t *testing.T) {
 	}
 }
 
+func TestPostNoContent(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.post(ctx, "/answer_bot/resolution.json", nil)
+	if err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+}
+
 func TestPostFailure(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPost, "groups.json", http.StatusInternalServerError)
 	client := newTestClient(mockAPI)